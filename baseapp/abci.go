@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"sort"
@@ -11,6 +12,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -139,9 +141,15 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(gasMeter)
 
+	// report the state changes of the block to the streaming services
+	app.addStreamingListeners(app.deliverState.ms)
+
 	if app.beginBlocker != nil {
 		res = app.beginBlocker(app.deliverState.ctx, req)
 	}
+
+	app.streamBeginBlock(req, res)
+
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
 	return res
@@ -159,6 +167,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	app.streamEndBlock(req, res)
+
 	return
 }
 
@@ -208,9 +218,13 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
 
+	defer func() {
+		app.streamDeliverTx(req, res)
+	}()
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, 0, 0, app.trace)
@@ -287,6 +301,10 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		app.halt()
 	}
 
+	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		go app.snapshot(header.Height)
+	}
+
 	return abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
@@ -314,6 +332,36 @@ func (app *BaseApp) halt() {
 	os.Exit(0)
 }
 
+// snapshot takes a snapshot of the current state and prunes any old snapshots.
+func (app *BaseApp) snapshot(height int64) {
+	if app.snapshotManager == nil {
+		app.logger.Info("snapshot manager not configured")
+		return
+	}
+
+	app.logger.Info("creating state snapshot", "height", height)
+
+	snapshot, err := app.snapshotManager.Create(uint64(height))
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+
+	app.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+
+	if app.snapshotKeepRecent > 0 {
+		app.logger.Debug("pruning state snapshots")
+
+		pruned, err := app.snapshotManager.Prune(app.snapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("Failed to prune state snapshots", "err", err)
+			return
+		}
+
+		app.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
 func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
@@ -348,8 +396,116 @@ func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
 	return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query path"))
 }
 
+// ListSnapshots implements the state sync ListSnapshots method. It returns the
+// metadata of all locally stored snapshots, newest first.
+func (app *BaseApp) ListSnapshots() ([]*snapshottypes.Snapshot, error) {
+	if app.snapshotManager == nil {
+		return []*snapshottypes.Snapshot{}, nil
+	}
+
+	snapshots, err := app.snapshotManager.List()
+	if err != nil {
+		app.logger.Error("failed to list snapshots", "err", err)
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// LoadSnapshotChunk implements the state sync LoadSnapshotChunk method. It
+// returns nil if the snapshot or chunk does not exist.
+func (app *BaseApp) LoadSnapshotChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	if app.snapshotManager == nil {
+		return nil, nil
+	}
+
+	bz, err := app.snapshotManager.LoadChunk(height, format, chunk)
+	if err != nil {
+		app.logger.Error(
+			"failed to load snapshot chunk",
+			"height", height,
+			"format", format,
+			"chunk", chunk,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// OfferSnapshot implements the state sync OfferSnapshot method. It begins
+// restoring the given snapshot and must be followed by ApplySnapshotChunk calls.
+// An error wrapping ErrUnknownFormat means the snapshot format should be
+// rejected, an error wrapping ErrInvalidMetadata means the snapshot should be
+// rejected, and any other error means the state sync should be aborted.
+func (app *BaseApp) OfferSnapshot(snapshot *snapshottypes.Snapshot) error {
+	if snapshot == nil {
+		app.logger.Error("received nil snapshot")
+		return sdkerrors.Wrap(snapshottypes.ErrInvalidMetadata, "nil snapshot")
+	}
+
+	if app.snapshotManager == nil {
+		app.logger.Error("snapshot manager not configured")
+		return errors.New("snapshot manager not configured")
+	}
+
+	err := app.snapshotManager.Restore(*snapshot)
+	switch {
+	case err == nil:
+		return nil
+
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return err
+
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		app.logger.Error(
+			"rejecting invalid snapshot",
+			"height", snapshot.Height,
+			"format", snapshot.Format,
+			"err", err,
+		)
+		return err
+
+	default:
+		app.logger.Error(
+			"failed to restore snapshot",
+			"height", snapshot.Height,
+			"format", snapshot.Format,
+			"err", err,
+		)
+		return err
+	}
+}
+
+// ApplySnapshotChunk implements the state sync ApplySnapshotChunk method. It
+// feeds the next chunk to the snapshot restore started by OfferSnapshot and
+// returns true once the restore has completed. An error wrapping
+// ErrChunkHashMismatch means the chunk should be refetched from another peer,
+// while any other error means the state sync should be aborted.
+func (app *BaseApp) ApplySnapshotChunk(chunk []byte) (bool, error) {
+	if app.snapshotManager == nil {
+		app.logger.Error("snapshot manager not configured")
+		return false, errors.New("snapshot manager not configured")
+	}
+
+	done, err := app.snapshotManager.RestoreChunk(chunk)
+	switch {
+	case err == nil:
+		return done, nil
+
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		app.logger.Error("chunk checksum mismatch; rejecting sender and requesting refetch", "err", err)
+		return false, err
+
+	default:
+		app.logger.Error("failed to restore snapshot", "err", err)
+		return false, err
+	}
+}
+
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
-	ctx, err := app.createQueryContext(req)
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
@@ -364,13 +520,15 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 	return res
 }
 
-func (app *BaseApp) createQueryContext(req abci.RequestQuery) (sdk.Context, error) {
+// createQueryContext creates a new sdk.Context for a query, taking as args
+// the block height and whether the query needs a proof or not.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, error) {
 	// when a client did not provide a query height, manually inject the latest
-	if req.Height == 0 {
-		req.Height = app.LastBlockHeight()
+	if height == 0 {
+		height = app.LastBlockHeight()
 	}
 
-	if req.Height <= 1 && req.Prove {
+	if height <= 1 && prove {
 		return sdk.Context{},
 			sdkerrors.Wrap(
 				sdkerrors.ErrInvalidRequest,
@@ -378,12 +536,12 @@ func (app *BaseApp) createQueryContext(req abci.RequestQuery) (sdk.Context, erro
 			)
 	}
 
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
 	if err != nil {
 		return sdk.Context{},
 			sdkerrors.Wrapf(
 				sdkerrors.ErrInvalidRequest,
-				"failed to load state at height %d; %s (latest height: %d)", req.Height, err, app.LastBlockHeight(),
+				"failed to load state at height %d; %s (latest height: %d)", height, err, app.LastBlockHeight(),
 			)
 	}
 
@@ -517,7 +675,7 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", path[1]))
 	}
 
-	ctx, err := app.createQueryContext(req)
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"math/rand"
 	"os"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
@@ -21,6 +25,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -455,6 +461,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetRouter(NewRouter())
 	})
+	require.Panics(t, func() {
+		app.SetStreamingService(&mockStreamingService{})
+	})
 }
 
 func TestSetMinGasPrices(t *testing.T) {
@@ -1678,3 +1687,304 @@ func TestWithRouter(t *testing.T) {
 		app.Commit()
 	}
 }
+
+// setupBaseAppWithSnapshots creates a baseapp containing the given number of blocks, each with
+// the given number of keys written to capKey2, and takes a snapshot at every snapshotInterval.
+func setupBaseAppWithSnapshots(t *testing.T, blocks uint, blockKeys uint, options ...func(*BaseApp)) (*BaseApp, func()) {
+	snapshotInterval := uint64(2)
+	snapshotTimeout := 1 * time.Minute
+	snapshotDir, err := ioutil.TempDir("", "baseapp")
+	require.NoError(t, err)
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), snapshotDir)
+	require.NoError(t, err)
+	teardown := func() {
+		os.RemoveAll(snapshotDir)
+	}
+
+	app := setupBaseApp(t, append(options,
+		SetSnapshotStore(snapshotStore),
+		SetSnapshotInterval(snapshotInterval),
+		SetPruning(sdk.PruningOptions{KeepEvery: 1}),
+	)...)
+
+	app.InitChain(abci.RequestInitChain{})
+
+	r := rand.New(rand.NewSource(3920758213583))
+	for height := int64(1); height <= int64(blocks); height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		store := app.deliverState.ctx.KVStore(capKey2)
+		for i := 0; i < int(blockKeys); i++ {
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, uint64(i))
+			value := make([]byte, 100)
+			_, err := r.Read(value)
+			require.NoError(t, err)
+			store.Set(append([]byte{byte(height)}, key...), value)
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+
+		// Wait for snapshot to be taken, since it happens asynchronously.
+		if uint64(height)%snapshotInterval == 0 {
+			start := time.Now()
+			for {
+				if time.Since(start) > snapshotTimeout {
+					t.Errorf("timed out waiting for snapshot after %v", snapshotTimeout)
+				}
+				snapshot, err := snapshotStore.Get(uint64(height), snapshottypes.CurrentFormat)
+				require.NoError(t, err)
+				if snapshot != nil {
+					break
+				}
+				time.Sleep(100 * time.Millisecond)
+			}
+		}
+	}
+
+	return app, teardown
+}
+
+func TestSnapshotIntervalPruningMismatch(t *testing.T) {
+	snapshotDir, err := ioutil.TempDir("", "baseapp")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), snapshotDir)
+	require.NoError(t, err)
+
+	app := newBaseApp(t.Name(),
+		SetSnapshotStore(snapshotStore),
+		SetSnapshotInterval(3),
+		SetPruning(sdk.PruningOptions{KeepEvery: 2}),
+	)
+	app.MountStores(capKey1)
+	require.Error(t, app.LoadLatestVersion())
+}
+
+func TestListSnapshots(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 5, 4)
+	defer teardown()
+
+	snapshots, err := app.ListSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	for _, s := range snapshots {
+		assert.NotEmpty(t, s.Hash)
+		assert.NotEmpty(t, s.Metadata.ChunkHashes)
+		s.Hash = nil
+		s.Metadata = snapshottypes.Metadata{}
+	}
+	assert.Equal(t, []*snapshottypes.Snapshot{
+		{Height: 4, Format: 1, Chunks: 1},
+		{Height: 2, Format: 1, Chunks: 1},
+	}, snapshots)
+}
+
+func TestLoadSnapshotChunk(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 2, 5)
+	defer teardown()
+
+	testcases := map[string]struct {
+		height      uint64
+		format      uint32
+		chunk       uint32
+		expectEmpty bool
+	}{
+		"Existing snapshot": {2, 1, 0, false},
+		"Missing height":    {100, 1, 0, true},
+		"Missing format":    {2, 2, 0, true},
+		"Missing chunk":     {2, 1, 9, true},
+		"Zero height":       {0, 1, 0, true},
+		"Zero format":       {2, 0, 0, true},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			chunk, err := app.LoadSnapshotChunk(tc.height, tc.format, tc.chunk)
+			require.NoError(t, err)
+			if tc.expectEmpty {
+				assert.Empty(t, chunk)
+				return
+			}
+			assert.NotEmpty(t, chunk)
+		})
+	}
+}
+
+func TestOfferSnapshot_Errors(t *testing.T) {
+	// Set up app before test cases, since it's fairly expensive.
+	app, teardown := setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	m := snapshottypes.Metadata{ChunkHashes: [][]byte{{1}, {2}, {3}}}
+	hash := []byte{1, 2, 3}
+
+	testcases := map[string]struct {
+		snapshot   *snapshottypes.Snapshot
+		expectType error
+	}{
+		"nil snapshot": {nil, snapshottypes.ErrInvalidMetadata},
+		"invalid format": {&snapshottypes.Snapshot{
+			Height: 1, Format: 9, Chunks: 3, Hash: hash, Metadata: m,
+		}, snapshottypes.ErrUnknownFormat},
+		"incorrect chunk count": {&snapshottypes.Snapshot{
+			Height: 1, Format: 1, Chunks: 2, Hash: hash, Metadata: m,
+		}, snapshottypes.ErrInvalidMetadata},
+		"no chunks": {&snapshottypes.Snapshot{
+			Height: 1, Format: 1, Chunks: 0, Hash: hash, Metadata: m,
+		}, snapshottypes.ErrInvalidMetadata},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := app.OfferSnapshot(tc.snapshot)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, tc.expectType), err.Error())
+		})
+	}
+
+	// Offering a snapshot after one has been accepted should error
+	err := app.OfferSnapshot(&snapshottypes.Snapshot{
+		Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 3, Hash: []byte{1, 2, 3}, Metadata: m,
+	})
+	require.NoError(t, err)
+
+	err = app.OfferSnapshot(&snapshottypes.Snapshot{
+		Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 3, Hash: []byte{1, 2, 3}, Metadata: m,
+	})
+	require.Error(t, err)
+}
+
+func TestApplySnapshotChunk(t *testing.T) {
+	source, teardown := setupBaseAppWithSnapshots(t, 4, 10)
+	defer teardown()
+
+	target, teardown := setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	// Fetch latest snapshot to restore
+	snapshots, err := source.ListSnapshots()
+	require.NoError(t, err)
+	require.NotEmpty(t, snapshots)
+	snapshot := snapshots[0]
+
+	// Make sure the snapshot has at least one chunk
+	require.GreaterOrEqual(t, snapshot.Chunks, uint32(1), "Not enough snapshot chunks")
+
+	// Begin a snapshot restoration in the target
+	err = target.OfferSnapshot(snapshot)
+	require.NoError(t, err)
+
+	// Feed a corrupt chunk, which should be rejected
+	_, err = target.ApplySnapshotChunk([]byte{1, 2, 3})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, snapshottypes.ErrChunkHashMismatch))
+
+	// Fetch each chunk from the source and apply it to the target
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		chunk, err := source.LoadSnapshotChunk(snapshot.Height, snapshot.Format, index)
+		require.NoError(t, err)
+		require.NotEmpty(t, chunk)
+
+		done, err := target.ApplySnapshotChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, index == snapshot.Chunks-1, done)
+	}
+
+	// The target should now have the same hash as the source
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+}
+
+type mockStreamingService struct {
+	storeKeys []sdk.StoreKey
+
+	beginBlocks [][]sdk.StoreKVPair
+	deliverTxs  [][]sdk.StoreKVPair
+	endBlocks   [][]sdk.StoreKVPair
+}
+
+func (s *mockStreamingService) StoreKeys() []sdk.StoreKey { return s.storeKeys }
+
+func (s *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock, changeSet []sdk.StoreKVPair) error {
+	s.beginBlocks = append(s.beginBlocks, changeSet)
+	return nil
+}
+
+func (s *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx, changeSet []sdk.StoreKVPair) error {
+	s.deliverTxs = append(s.deliverTxs, changeSet)
+	return nil
+}
+
+func (s *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock, changeSet []sdk.StoreKVPair) error {
+	s.endBlocks = append(s.endBlocks, changeSet)
+	return nil
+}
+
+func changeSetKeys(changeSet []sdk.StoreKVPair) []string {
+	keys := make([]string, len(changeSet))
+	for i, pair := range changeSet {
+		keys[i] = fmt.Sprintf("%s/%s/%v", pair.StoreKey, pair.Key, pair.Delete)
+	}
+	return keys
+}
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	service := &mockStreamingService{storeKeys: []sdk.StoreKey{capKey1}}
+
+	opts := []func(*BaseApp){
+		SetStreamingService(service),
+		func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) },
+		func(bapp *BaseApp) {
+			bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+		},
+		func(bapp *BaseApp) {
+			bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+				ctx.KVStore(capKey1).Set([]byte("begin"), []byte("1"))
+				ctx.KVStore(capKey2).Set([]byte("begin"), []byte("1"))
+				return abci.ResponseBeginBlock{}
+			})
+		},
+		func(bapp *BaseApp) {
+			bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+				ctx.KVStore(capKey1).Delete([]byte("begin"))
+				return abci.ResponseEndBlock{}
+			})
+		},
+	}
+	app := setupBaseApp(t, opts...)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	// a successful tx, and a tx failing in its handler which only persists the ante handler writes
+	tx := newTxCounter(0, 0)
+	txBytes, err := codec.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	tx = newTxCounter(1, 1)
+	tx.setFailOnHandler(true)
+	txBytes, err = codec.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	require.Len(t, service.beginBlocks, 1)
+	require.Equal(t, []string{"key1/begin/false"}, changeSetKeys(service.beginBlocks[0]))
+	require.Len(t, service.deliverTxs, 2)
+	require.Equal(t, []string{"key1/ante-key/false", "key1/deliver-key/false"}, changeSetKeys(service.deliverTxs[0]))
+	require.Equal(t, []string{"key1/ante-key/false"}, changeSetKeys(service.deliverTxs[1]))
+	require.Len(t, service.endBlocks, 1)
+	require.Equal(t, []string{"key1/begin/true"}, changeSetKeys(service.endBlocks[0]))
+
+	// the listeners are attached to the deliver state of each new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	require.Len(t, service.beginBlocks, 2)
+	require.Equal(t, []string{"key1/begin/false"}, changeSetKeys(service.beginBlocks[1]))
+}
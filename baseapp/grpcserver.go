@@ -0,0 +1,109 @@
+package baseapp
+
+import (
+	"context"
+	"strconv"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// RegisterGRPCServer registers the gRPC query services of the GRPCQueryRouter
+// directly with the gRPC server. Queries are run against the latest committed
+// state, or against the height given in the GRPCBlockHeightHeader of the
+// request, and the height of the state is returned in the same response header.
+func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
+	// the interceptor creates a query sdk.Context at the requested height and
+	// passes it into the query handler
+	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		height, err := blockHeightFromContext(grpcCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		// proofs are not supported by the gRPC server
+		sdkCtx, err := app.createQueryContext(height, false)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if height == 0 {
+			height = app.LastBlockHeight()
+		}
+
+		md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		if err := grpc.SetHeader(grpcCtx, md); err != nil {
+			app.logger.Error("failed to set gRPC header", "err", err)
+		}
+
+		return handler(sdk.WrapSDKContext(sdkCtx.WithContext(grpcCtx)), req)
+	}
+
+	for _, data := range app.grpcQueryRouter.serviceData {
+		desc := data.serviceDesc
+		methods := make([]grpc.MethodDesc, len(desc.Methods))
+
+		for i, method := range desc.Methods {
+			methodHandler := method.Handler
+
+			methods[i] = grpc.MethodDesc{
+				MethodName: method.MethodName,
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					return methodHandler(srv, ctx, app.unpackingDecoder(dec), interceptor)
+				},
+			}
+		}
+
+		server.RegisterService(&grpc.ServiceDesc{
+			ServiceName: desc.ServiceName,
+			HandlerType: desc.HandlerType,
+			Methods:     methods,
+			Streams:     desc.Streams,
+			Metadata:    desc.Metadata,
+		}, data.handler)
+	}
+}
+
+// unpackingDecoder wraps a gRPC request decoder so that the Anys of the
+// decoded requests are unpacked, as they are for ABCI queries.
+func (app *BaseApp) unpackingDecoder(dec func(interface{}) error) func(interface{}) error {
+	return func(i interface{}) error {
+		if err := dec(i); err != nil {
+			return err
+		}
+
+		if anyUnpacker := app.grpcQueryRouter.AnyUnpacker(); anyUnpacker != nil {
+			return types.UnpackInterfaces(i, anyUnpacker)
+		}
+
+		return nil
+	}
+}
+
+// blockHeightFromContext returns the block height of the GRPCBlockHeightHeader
+// of the incoming request, or 0 if the header is not set.
+func blockHeightFromContext(ctx context.Context) (int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	heights := md.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heights) == 0 {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil || height < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid %s header: %s", grpctypes.GRPCBlockHeightHeader, heights[0])
+	}
+
+	return height, nil
+}
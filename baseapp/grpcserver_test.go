@@ -0,0 +1,74 @@
+package baseapp
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+func TestRegisterGRPCServer(t *testing.T) {
+	app := setupBaseApp(t, func(bapp *BaseApp) {
+		bapp.GRPCQueryRouter().SetAnyUnpacker(testdata.NewTestInterfaceRegistry())
+		testdata.RegisterTestServiceServer(bapp.GRPCQueryRouter(), testdata.TestServiceImpl{})
+	})
+
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.Commit()
+	}
+
+	grpcSrv := grpc.NewServer()
+	app.RegisterGRPCServer(grpcSrv)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcSrv.Serve(listener) // nolint: errcheck
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	client := testdata.NewTestServiceClient(conn)
+
+	// queries are run against the latest height by default
+	var header metadata.MD
+	res, err := client.SayHello(context.Background(), &testdata.SayHelloRequest{Name: "foo"}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, "Hello foo!", res.Greeting)
+	require.Equal(t, []string{"3"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+
+	// the height is picked with the block height header
+	for _, height := range []int64{1, 2} {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		_, err = client.SayHello(ctx, &testdata.SayHelloRequest{Name: "foo"}, grpc.Header(&header))
+		require.NoError(t, err)
+		require.Equal(t, []string{strconv.FormatInt(height, 10)}, header.Get(grpctypes.GRPCBlockHeightHeader))
+	}
+
+	for _, height := range []string{"10", "-1", "latest"} {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, height)
+		_, err = client.SayHello(ctx, &testdata.SayHelloRequest{Name: "foo"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), height)
+	}
+
+	// the Anys of the requests are unpacked, TestAny fails otherwise
+	any, err := types.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(t, err)
+	anyRes, err := client.TestAny(context.Background(), &testdata.TestAnyRequest{AnyAnimal: any})
+	require.NoError(t, err)
+	require.Equal(t, any.TypeUrl, anyRes.HasAnimal.Animal.TypeUrl)
+}
@@ -0,0 +1,100 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgServiceRouter routes fully-qualified Msg service methods to their handler.
+type MsgServiceRouter struct {
+	routes map[string]MsgServiceHandler
+}
+
+var _ gogogrpc.Server = &MsgServiceRouter{}
+
+// NewMsgServiceRouter creates a new MsgServiceRouter.
+func NewMsgServiceRouter() *MsgServiceRouter {
+	return &MsgServiceRouter{
+		routes: map[string]MsgServiceHandler{},
+	}
+}
+
+// MsgServiceHandler defines a function type which handles a sdk.Msg through
+// its Msg service method.
+type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
+
+// Handler returns the MsgServiceHandler for a given msg or nil if the msg is
+// not handled by any Msg service.
+func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
+	return msr.routes[MsgTypeURL(msg)]
+}
+
+// RegisterService implements the gRPC Server.RegisterService method. sd is a
+// gRPC service description, handler is an object which implements that gRPC
+// service. Each method of the service is routed by the type URL of its request
+// type, which must be a sdk.Msg.
+func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		methodHandler := method.Handler
+
+		// the request type of the method is only known to its handler: call it
+		// with a decoder capturing the request and an interceptor stopping the
+		// call before it reaches the service
+		var requestTypeURL string
+		_, _ = methodHandler(nil, context.Background(), func(i interface{}) error {
+			msg, ok := i.(sdk.Msg)
+			if !ok {
+				panic(fmt.Errorf("request type of %s does not implement sdk.Msg", fqMethod))
+			}
+
+			requestTypeURL = MsgTypeURL(msg)
+			return nil
+		}, noopInterceptor)
+
+		if _, found := msr.routes[requestTypeURL]; found {
+			panic(fmt.Errorf("msg %s has already been registered", requestTypeURL))
+		}
+
+		msr.routes[requestTypeURL] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// the request is already decoded, the interceptor passes it to the
+			// service in place of the one allocated by the method handler
+			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				return handler(goCtx, req)
+			}
+
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), noopDecoder, interceptor)
+			if err != nil {
+				return nil, err
+			}
+
+			resMsg, ok := res.(proto.Message)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected proto.Message, got %T", res)
+			}
+
+			return sdk.WrapServiceResult(ctx, resMsg, err)
+		}
+	}
+}
+
+// MsgTypeURL returns the type URL of a sdk.Msg, by which it is routed to its
+// Msg service method.
+func MsgTypeURL(msg sdk.Msg) string {
+	return "/" + proto.MessageName(msg)
+}
+
+func noopDecoder(_ interface{}) error { return nil }
+
+func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
+	return nil, nil
+}
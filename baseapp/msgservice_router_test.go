@@ -0,0 +1,81 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgServiceRouter(t *testing.T) {
+	msr := NewMsgServiceRouter()
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	require.Equal(t, "/testdata.MsgCreateDog", MsgTypeURL(msg))
+	require.Nil(t, msr.Handler(msgCounter{}))
+
+	handler := msr.Handler(msg)
+	require.NotNil(t, handler)
+
+	res, err := handler(sdk.Context{}.WithContext(context.Background()), msg)
+	require.NoError(t, err)
+
+	var resData testdata.MsgCreateDogResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &resData))
+	require.Equal(t, "Spot", resData.Name)
+
+	require.Len(t, res.MsgResponses, 1)
+	require.Equal(t, "/testdata.MsgCreateDogResponse", res.MsgResponses[0].TypeUrl)
+	require.Equal(t, &resData, res.MsgResponses[0].GetCachedValue())
+
+	// a msg can only be routed to a single service method
+	require.Panics(t, func() {
+		testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+	})
+}
+
+func TestMsgServiceDeliverTx(t *testing.T) {
+	cdc := codec.New()
+	registerTestCodec(cdc)
+	cdc.RegisterConcrete(&testdata.MsgCreateDog{}, "cosmos-sdk/baseapp/MsgCreateDog", nil)
+
+	decoderOpt := func(bapp *BaseApp) { bapp.txDecoder = testTxDecoder(cdc) }
+	msgServiceOpt := func(bapp *BaseApp) {
+		testdata.RegisterMsgServer(bapp.MsgServiceRouter(), testdata.MsgServerImpl{})
+	}
+
+	app := setupBaseApp(t, decoderOpt, msgServiceOpt)
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	tx := &txTest{Msgs: []sdk.Msg{
+		&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}},
+		&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}},
+	}}
+	txBytes, err := cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.String())
+
+	var txData sdk.TxMsgData
+	require.NoError(t, proto.Unmarshal(res.Data, &txData))
+	require.Len(t, txData.Data, 2)
+	require.Len(t, txData.MsgResponses, 2)
+
+	for i, name := range []string{"Spot", "Rex"} {
+		require.Equal(t, "/testdata.MsgCreateDogResponse", txData.MsgResponses[i].TypeUrl)
+
+		var resData testdata.MsgCreateDogResponse
+		require.NoError(t, proto.Unmarshal(txData.MsgResponses[i].Value, &resData))
+		require.Equal(t, name, resData.Name)
+		require.Equal(t, txData.MsgResponses[i].Value, txData.Data[i].Data)
+	}
+}
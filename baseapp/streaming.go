@@ -0,0 +1,83 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener is the interface used to hook into the ABCI message processing
+// of the BaseApp. Each hook receives the state changes made by the ABCI message
+// to the stores the listener observes, in the order they were written.
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock, changeSet []sdk.StoreKVPair) error
+	// ListenDeliverTx updates the streaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, changeSet []sdk.StoreKVPair) error
+	// ListenEndBlock updates the streaming service with the latest EndBlock messages
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock, changeSet []sdk.StoreKVPair) error
+}
+
+// StreamingService is a sink for the state changes of each block, grouped by
+// the BeginBlock, DeliverTx and EndBlock messages that produced them.
+type StreamingService interface {
+	ABCIListener
+
+	// StoreKeys returns the keys of the stores whose state changes are streamed.
+	StoreKeys() []sdk.StoreKey
+}
+
+// streamingListener pairs a StreamingService with the listener collecting the
+// state changes of its stores.
+type streamingListener struct {
+	service  StreamingService
+	listener *types.MemoryListener
+}
+
+func newStreamingListener(s StreamingService) streamingListener {
+	return streamingListener{service: s, listener: types.NewMemoryListener()}
+}
+
+// addStreamingListeners registers the listeners of all the streaming services
+// on the given deliver state MultiStore. Only writes that reach the deliver
+// state are reported, e.g. the writes of a failed message are discarded.
+func (app *BaseApp) addStreamingListeners(ms sdk.MultiStore) {
+	for _, sl := range app.streamingListeners {
+		for _, key := range sl.service.StoreKeys() {
+			ms.AddListeners(key, []sdk.WriteListener{sl.listener})
+		}
+	}
+}
+
+// streamBeginBlock sends the BeginBlock state changes to the streaming services.
+// Errors are logged rather than returned, as the state machine must not depend
+// on the streaming services.
+func (app *BaseApp) streamBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	for _, sl := range app.streamingListeners {
+		changeSet := sl.listener.PopStateCache()
+		if err := sl.service.ListenBeginBlock(app.deliverState.ctx, req, res, changeSet); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+}
+
+// streamDeliverTx sends the DeliverTx state changes to the streaming services.
+func (app *BaseApp) streamDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	for _, sl := range app.streamingListeners {
+		changeSet := sl.listener.PopStateCache()
+		if err := sl.service.ListenDeliverTx(app.deliverState.ctx, req, res, changeSet); err != nil {
+			app.logger.Error("DeliverTx listening hook failed", "err", err)
+		}
+	}
+}
+
+// streamEndBlock sends the EndBlock state changes to the streaming services.
+func (app *BaseApp) streamEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	for _, sl := range app.streamingListeners {
+		changeSet := sl.listener.PopStateCache()
+		if err := sl.service.ListenEndBlock(app.deliverState.ctx, req, res, changeSet); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+}
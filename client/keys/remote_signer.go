@@ -0,0 +1,94 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const flagSocket = "socket"
+
+// RemoteSignerCommand serves the keys of the keyring to remote keyrings.
+func RemoteSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-signer",
+		Short: "Serve the keys of the keyring to remote keyrings",
+		Long: `Run the reference remote signer, signing with the local and ledger keys of the keyring
+on behalf of remote keyrings. The signer listens on a unix socket until it is interrupted.
+
+Keyrings using the remote backend connect to the keyring-remote/signer.sock socket of their
+home directory, which is the default socket of the signer.
+
+The socket is not authenticated: any process that can connect to it can sign with the keys of
+the signer without a passphrase. The socket is only accessible to the user running the signer,
+so the signer must only run on a host where that user and its processes are trusted.
+`,
+		Args: cobra.NoArgs,
+		RunE: runRemoteSignerCmd,
+	}
+
+	cmd.Flags().String(flagSocket, "", "Unix socket path to listen on (default \"<home>/keyring-remote/signer.sock\")")
+
+	return cmd
+}
+
+func runRemoteSignerCmd(cmd *cobra.Command, _ []string) error {
+	backend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+
+	if backend == keyring.BackendRemote {
+		return errors.New("the remote signer cannot serve the keys of a remote keyring")
+	}
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), backend, homeDir, cmd.InOrStdin())
+	if err != nil {
+		return err
+	}
+
+	socket, _ := cmd.Flags().GetString(flagSocket)
+	if socket == "" {
+		socket = keyring.DefaultRemoteSignerSocket(homeDir)
+	}
+
+	listener, err := keyring.ListenRemoteSigner(socket)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer()
+	keyring.RegisterRemoteSignerServer(server, keyring.NewRemoteSigner(kb))
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	// stop the signer, removing its socket, when interrupted
+	go func() {
+		select {
+		case <-sigs:
+		case <-ctx.Done():
+		}
+		server.GracefulStop()
+	}()
+
+	cmd.PrintErrf("Serving the remote signer on %s\n", socket)
+
+	return server.Serve(listener)
+}
@@ -0,0 +1,77 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runRemoteSignerCmd(t *testing.T) {
+	cmd := RemoteSignerCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+	kbHome, cleanUp := testutil.NewTestCaseDir(t)
+	t.Cleanup(cleanUp)
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn)
+	require.NoError(t, err)
+
+	info, err := kb.NewAccount("keyname1", testutil.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	// the signer cannot serve a remote keyring
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendRemote),
+	})
+	require.Error(t, cmd.Execute())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	go func() {
+		done <- cmd.ExecuteContext(ctx)
+	}()
+
+	socket := keyring.DefaultRemoteSignerSocket(kbHome)
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socket)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the socket is only accessible to the user running the signer
+	fi, err := os.Stat(socket)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	mockIn.Reset("password\npassword\n")
+	remoteKb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendRemote, kbHome, mockIn)
+	require.NoError(t, err)
+
+	msg := []byte("message")
+	sig, pub, err := remoteKb.Sign("keyname1", msg)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// the socket is removed once the signer stops
+	cancel()
+	require.NoError(t, <-done)
+	_, err = os.Stat(socket)
+	require.True(t, os.IsNotExist(err))
+}
@@ -27,6 +27,9 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Delegates signing to an external signer process over a unix socket, such as the
+                remote-signer command. The public keys of the signer are cached locally in an
+                encrypted file-based keystore, which requests a password like the file backend.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -48,10 +51,11 @@ The pass backend requires GnuPG: https://gnupg.org/
 		DeleteKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		RemoteSignerCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
-	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test|remote)")
 	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
 
 	return cmd
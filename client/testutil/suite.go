@@ -54,6 +54,16 @@ func (s *TxConfigTestSuite) TestTxBuilderSetGasLimit() {
 	s.Require().Equal(newGas, feeTx.GetGas())
 }
 
+func (s *TxConfigTestSuite) TestTxBuilderSetFeeGranter() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	txBuilder := s.TxConfig.NewTxBuilder()
+	s.Require().Empty(txBuilder.GetTx().FeeGranter())
+
+	txBuilder.SetFeeGranter(addr)
+	feeTx := txBuilder.GetTx()
+	s.Require().Equal(addr, feeTx.FeeGranter())
+}
+
 func (s *TxConfigTestSuite) TestTxBuilderSetMemo() {
 	const newMemo string = "newfoomemo"
 	txBuilder := s.TxConfig.NewTxBuilder()
@@ -23,6 +23,7 @@ type Factory struct {
 	chainID            string
 	memo               string
 	fees               sdk.Coins
+	feeGranter         sdk.AccAddress
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
@@ -30,6 +31,7 @@ type Factory struct {
 
 const (
 	signModeDirect    = "direct"
+	signModeTextual   = "textual"
 	signModeAminoJSON = "amino-json"
 )
 
@@ -40,6 +42,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	switch signModeStr {
 	case signModeDirect:
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
+	case signModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	case signModeAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
 	}
@@ -69,6 +73,9 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	feesStr, _ := flagSet.GetString(flags.FlagFees)
 	f = f.WithFees(feesStr)
 
+	feeGranterStr, _ := flagSet.GetString(flags.FlagFeeAccount)
+	f = f.WithFeeGranter(feeGranterStr)
+
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
@@ -83,6 +90,7 @@ func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
 func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
+func (f Factory) FeeGranter() sdk.AccAddress                { return f.feeGranter }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 
@@ -125,6 +133,23 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
+// WithFeeGranter returns a copy of the Factory with an updated fee granter.
+// An empty granter means the fee is paid by the first signer.
+func (f Factory) WithFeeGranter(feeGranter string) Factory {
+	if feeGranter == "" {
+		f.feeGranter = nil
+		return f
+	}
+
+	addr, err := sdk.AccAddressFromBech32(feeGranter)
+	if err != nil {
+		panic(err)
+	}
+
+	f.feeGranter = addr
+	return f
+}
+
 // WithGasPrices returns a copy of the Factory with updated gas prices.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
@@ -32,5 +32,6 @@ type (
 		SetMemo(memo string)
 		SetFeeAmount(amount sdk.Coins)
 		SetGasLimit(limit uint64)
+		SetFeeGranter(feeGranter sdk.AccAddress)
 	}
 )
@@ -43,4 +43,8 @@
 // 			be unlocked and it should be use only for testing purposes.
 // 	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 // 			are discarded when the process terminates or the type instance is garbage collected.
+// 	remote	This backend delegates signing to an external signer process listening on a unix
+// 			socket, by default keyring-remote/signer.sock within the apps configuration directory.
+// 			The names and public keys of the remote keys are cached locally, so they can be listed
+// 			while the signer is not running. The reference signer is served by NewRemoteSigner.
 package keyring
@@ -10,4 +10,8 @@ var (
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrUnsupportedRemoteOperation is raised when the caller tries to create,
+	// import, export or delete private keys held by a remote signer.
+	ErrUnsupportedRemoteOperation = errors.New("operation not supported by the remote keyring: keys are managed by the remote signer")
 )
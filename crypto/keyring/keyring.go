@@ -33,12 +33,14 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
-	keyringFileDirName = "keyring-file"
-	keyringTestDirName = "keyring-test"
-	passKeyringPrefix  = "keyring-%s"
+	keyringFileDirName   = "keyring-file"
+	keyringTestDirName   = "keyring-test"
+	keyringRemoteDirName = "keyring-remote"
+	passKeyringPrefix    = "keyring-%s"
 )
 
 var (
@@ -123,6 +125,9 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// unix socket path of the remote signer of the remote keyring, defaults
+	// to keyring-remote/signer.sock in the keyring's root directory
+	RemoteSignerSocket string
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -134,7 +139,7 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "remote", "test".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -156,6 +161,8 @@ func New(
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
 		db, err = keyring.Open(newPassBackendKeyringConfig(appName, rootDir, userInput))
+	case BackendRemote:
+		return newRemoteKeystore(appName, rootDir, userInput, opts...)
 	default:
 		return nil, fmt.Errorf("unknown keyring backend %v", backend)
 	}
@@ -243,7 +250,7 @@ func (ks keystore) ExportPrivateKeyObject(uid string) (tmcrypto.PrivKey, error)
 			return nil, err
 		}
 
-	case ledgerInfo, offlineInfo, multiInfo:
+	case ledgerInfo, offlineInfo, multiInfo, remoteInfo:
 		return nil, errors.New("only works on local private keys")
 	}
 
@@ -324,6 +331,9 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, tmcrypto.PubKey, error)
 
 	case offlineInfo, multiInfo:
 		return nil, info.GetPubKey(), errors.New("cannot sign with offline keys")
+
+	case remoteInfo:
+		return nil, info.GetPubKey(), errors.New("cannot sign with remote keys without their remote signer")
 	}
 
 	sig, err := priv.Sign(msg)
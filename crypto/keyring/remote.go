@@ -0,0 +1,363 @@
+package keyring
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/99designs/keyring"
+	"github.com/pkg/errors"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	remoteSignerSocketName = "signer.sock"
+	remoteSignerTimeout    = time.Minute
+)
+
+var _ Keyring = remoteKeystore{}
+
+// DefaultRemoteSignerSocket returns the default unix socket path of the remote
+// signer of a remote keyring with the given root directory.
+func DefaultRemoteSignerSocket(rootDir string) string {
+	return filepath.Join(rootDir, keyringRemoteDirName, remoteSignerSocketName)
+}
+
+// ListenRemoteSigner listens on the unix socket of a remote signer. The socket
+// is not authenticated: any process able to connect to it can sign with the
+// keys of the signer. Access is therefore restricted to the user running the
+// signer, by creating the socket with mode 0600 in a directory with mode 0700.
+// A stale socket left by a signer that did not stop cleanly is removed, but an
+// error is returned if a signer still listens on it or if the path is not a
+// socket.
+func ListenRemoteSigner(socket string) (net.Listener, error) {
+	dir := filepath.Dir(socket)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	if err := removeStaleSocket(socket); err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(socket, 0600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// removeStaleSocket removes the given unix socket if no process listens on it.
+func removeStaleSocket(socket string) error {
+	fi, err := os.Lstat(socket)
+	switch {
+	case os.IsNotExist(err):
+		return nil
+	case err != nil:
+		return err
+	case fi.Mode()&os.ModeSocket == 0:
+		return fmt.Errorf("%s exists and is not a unix socket", socket)
+	}
+
+	if conn, err := net.Dial("unix", socket); err == nil {
+		conn.Close()
+		return fmt.Errorf("a remote signer is already listening on %s", socket)
+	}
+
+	return os.Remove(socket)
+}
+
+// remoteKeystore is a keystore whose keys are held by an external signer
+// process. The public information of the remote keys is cached in the local
+// keystore, which can also hold offline and multisig keys.
+type remoteKeystore struct {
+	keystore
+	socket string
+}
+
+// newRemoteKeystore opens the cache of the remote keyring and synchronizes it
+// with the keys of the remote signer. If the remote signer is not running,
+// the keys are served from the cache.
+func newRemoteKeystore(appName, rootDir string, userInput io.Reader, opts ...Option) (Keyring, error) {
+	db, err := keyring.Open(newRemoteBackendKeyringConfig(appName, rootDir, userInput))
+	if err != nil {
+		return nil, err
+	}
+
+	ks := remoteKeystore{keystore: newKeystore(db, opts...)}
+
+	ks.socket = ks.options.RemoteSignerSocket
+	if ks.socket == "" {
+		ks.socket = DefaultRemoteSignerSocket(rootDir)
+	}
+
+	if err := ks.sync(); err != nil && status.Code(err) != codes.Unavailable {
+		return nil, errors.Wrap(err, "failed to synchronize the keys of the remote signer")
+	}
+
+	return ks, nil
+}
+
+// withClient dials the remote signer and calls fn with a client of the signer.
+func (ks remoteKeystore) withClient(fn func(ctx context.Context, client RemoteSignerClient) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(
+		ctx, ks.socket,
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return fn(ctx, NewRemoteSignerClient(conn))
+}
+
+// sync replaces the cached remote keys with the keys of the remote signer.
+func (ks remoteKeystore) sync() error {
+	var res *RemoteKeysResponse
+
+	err := ks.withClient(func(ctx context.Context, client RemoteSignerClient) (err error) {
+		res, err = client.Keys(ctx, &RemoteKeysRequest{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	remoteInfos := make(map[string]Info, len(res.Keys))
+	for _, key := range res.Keys {
+		pub, err := cryptoamino.PubKeyFromBytes(key.PubKey)
+		if err != nil {
+			return errors.Wrapf(err, "invalid public key of remote key %s", key.Name)
+		}
+
+		remoteInfos[key.Name] = newRemoteInfo(key.Name, pub, hd.PubKeyType(key.Algo))
+	}
+
+	cached, err := ks.keystore.List()
+	if err != nil {
+		return err
+	}
+
+	// remove the cached remote keys which changed or are no longer held by
+	// the remote signer
+	for _, info := range cached {
+		if info.GetType() != TypeRemote {
+			continue
+		}
+
+		remote, ok := remoteInfos[info.GetName()]
+		if ok && remote.GetPubKey().Equals(info.GetPubKey()) && remote.GetAlgo() == info.GetAlgo() {
+			delete(remoteInfos, info.GetName())
+			continue
+		}
+
+		if err := ks.keystore.Delete(info.GetName()); err != nil {
+			return err
+		}
+	}
+
+	names := make([]string, 0, len(remoteInfos))
+	for name := range remoteInfos {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := ks.writeInfo(remoteInfos[name]); err != nil {
+			return errors.Wrapf(err, "failed to cache remote key %s", name)
+		}
+	}
+
+	return nil
+}
+
+// Sign signs a message with a key of the remote signer. Offline and multisig
+// keys are handled by the local keystore.
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if info.GetType() != TypeRemote {
+		return ks.keystore.Sign(uid, msg)
+	}
+
+	var res *RemoteSignResponse
+
+	err = ks.withClient(func(ctx context.Context, client RemoteSignerClient) (err error) {
+		res, err = client.Sign(ctx, &RemoteSignRequest{Name: uid, Msg: msg})
+		return err
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "remote signer failed to sign with key %s", uid)
+	}
+
+	pub, err := cryptoamino.PubKeyFromBytes(res.PubKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid public key returned by the remote signer")
+	}
+
+	if !pub.Equals(info.GetPubKey()) {
+		return nil, nil, fmt.Errorf("remote signer signed with a different public key than the cached key %s", uid)
+	}
+
+	if !pub.VerifyBytes(msg, res.Signature) {
+		return nil, nil, fmt.Errorf("invalid signature returned by the remote signer for key %s", uid)
+	}
+
+	return res.Signature, pub, nil
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	key, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.Sign(key.GetName(), msg)
+}
+
+func (ks remoteKeystore) Delete(uid string) error {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return err
+	}
+
+	if info.GetType() == TypeRemote {
+		return ErrUnsupportedRemoteOperation
+	}
+
+	return ks.keystore.Delete(uid)
+}
+
+func (ks remoteKeystore) DeleteByAddress(address sdk.Address) error {
+	info, err := ks.KeyByAddress(address)
+	if err != nil {
+		return err
+	}
+
+	return ks.Delete(info.GetName())
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, SignatureAlgo) (Info, string, error) {
+	return nil, "", ErrUnsupportedRemoteOperation
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (Info, error) {
+	return nil, ErrUnsupportedRemoteOperation
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (Info, error) {
+	return nil, ErrUnsupportedRemoteOperation
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrUnsupportedRemoteOperation
+}
+
+func newRemoteBackendKeyringConfig(appName, dir string, buf io.Reader) keyring.Config {
+	fileDir := filepath.Join(dir, keyringRemoteDirName)
+
+	return keyring.Config{
+		AllowedBackends:  []keyring.BackendType{keyring.FileBackend},
+		ServiceName:      appName,
+		FileDir:          fileDir,
+		FilePasswordFunc: newRealPrompt(fileDir, buf),
+	}
+}
+
+// remoteSigner is the reference implementation of the RemoteSignerServer,
+// signing with the local and Ledger keys of a keyring.
+type remoteSigner struct {
+	kr Keyring
+}
+
+var _ RemoteSignerServer = remoteSigner{}
+
+// NewRemoteSigner returns a RemoteSignerServer serving the local and Ledger
+// keys of the given keyring.
+func NewRemoteSigner(kr Keyring) RemoteSignerServer {
+	return remoteSigner{kr: kr}
+}
+
+// Keys implements the RemoteSigner/Keys gRPC method
+func (s remoteSigner) Keys(_ context.Context, _ *RemoteKeysRequest) (*RemoteKeysResponse, error) {
+	infos, err := s.kr.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	keys := make([]RemoteKey, 0, len(infos))
+	for _, info := range infos {
+		if !canRemoteSign(info) {
+			continue
+		}
+
+		keys = append(keys, RemoteKey{
+			Name:   info.GetName(),
+			PubKey: info.GetPubKey().Bytes(),
+			Algo:   string(info.GetAlgo()),
+		})
+	}
+
+	return &RemoteKeysResponse{Keys: keys}, nil
+}
+
+// Sign implements the RemoteSigner/Sign gRPC method
+func (s remoteSigner) Sign(_ context.Context, req *RemoteSignRequest) (*RemoteSignResponse, error) {
+	if req == nil || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "key name cannot be empty")
+	}
+
+	info, err := s.kr.Key(req.Name)
+	if err != nil || !canRemoteSign(info) {
+		return nil, status.Errorf(codes.NotFound, "key %s not found", req.Name)
+	}
+
+	sig, pub, err := s.kr.Sign(req.Name, req.Msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &RemoteSignResponse{Signature: sig, PubKey: pub.Bytes()}, nil
+}
+
+// canRemoteSign returns true if the remote signer serves the given key.
+func canRemoteSign(info Info) bool {
+	switch info.GetType() {
+	case TypeLocal, TypeLedger:
+		return true
+	default:
+		return false
+	}
+}
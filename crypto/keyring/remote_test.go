@@ -0,0 +1,208 @@
+package keyring
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// startRemoteSigner serves the reference remote signer of the signer keyring
+// on the default remote signer socket of the given directory.
+func startRemoteSigner(t *testing.T, signerKr Keyring, dir string) *grpc.Server {
+	listener, err := ListenRemoteSigner(DefaultRemoteSignerSocket(dir))
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, NewRemoteSigner(signerKr))
+	go server.Serve(listener) // nolint: errcheck
+	t.Cleanup(server.Stop)
+
+	return server
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	dir, cleanup := testutil.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	signerKr := NewInMemory()
+	local, _, err := signerKr.NewMnemonic("local", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = signerKr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	server := startRemoteSigner(t, signerKr, dir)
+
+	// the cache is protected by a passphrase, set on first use
+	mockIn := strings.NewReader("password\npassword\n")
+	kr, err := New("cosmos", BackendRemote, dir, mockIn)
+	require.NoError(t, err)
+
+	// only the keys the signer can sign with are served
+	infos, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "local", infos[0].GetName())
+	require.Equal(t, TypeRemote, infos[0].GetType())
+	require.Equal(t, local.GetPubKey(), infos[0].GetPubKey())
+	require.Equal(t, local.GetAlgo(), infos[0].GetAlgo())
+
+	msg := []byte("message")
+	sig, pub, err := kr.Sign("local", msg)
+	require.NoError(t, err)
+	require.Equal(t, local.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	sig, pub, err = kr.SignByAddress(local.GetAddress(), msg)
+	require.NoError(t, err)
+	require.Equal(t, local.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// private keys are managed by the remote signer
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.Equal(t, ErrUnsupportedRemoteOperation, err)
+	_, err = kr.NewAccount("new", "", "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.Equal(t, ErrUnsupportedRemoteOperation, err)
+	require.Equal(t, ErrUnsupportedRemoteOperation, kr.ImportPrivKey("new", "", ""))
+	require.Equal(t, ErrUnsupportedRemoteOperation, kr.Delete("local"))
+	_, err = kr.ExportPrivKeyArmor("local", "passphrase")
+	require.Error(t, err)
+
+	// offline keys can be stored in the remote keyring
+	offline, err := kr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("offline", msg)
+	require.Error(t, err)
+	require.NoError(t, kr.DeleteByAddress(offline.GetAddress()))
+
+	// the cached keys are synchronized with the remote signer
+	_, _, err = signerKr.NewMnemonic("other", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	require.NoError(t, signerKr.Delete("local"))
+
+	mockIn.Reset("password\n")
+	kr, err = New("cosmos", BackendRemote, dir, mockIn)
+	require.NoError(t, err)
+
+	infos, err = kr.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "other", infos[0].GetName())
+
+	// the cached keys are listed while the remote signer is not running, but
+	// signing fails
+	server.Stop()
+
+	mockIn.Reset("password\n")
+	kr, err = New("cosmos", BackendRemote, dir, mockIn)
+	require.NoError(t, err)
+
+	infos, err = kr.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "other", infos[0].GetName())
+
+	_, _, err = kr.Sign("other", msg)
+	require.Error(t, err)
+}
+
+func TestRemoteKeyringSignerSocket(t *testing.T) {
+	dir, cleanup := testutil.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+	signerDir, cleanup := testutil.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	signerKr := NewInMemory()
+	_, _, err := signerKr.NewMnemonic("local", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	startRemoteSigner(t, signerKr, signerDir)
+
+	kr, err := New("cosmos", BackendRemote, dir, strings.NewReader("password\npassword\n"), func(options *Options) {
+		options.RemoteSignerSocket = DefaultRemoteSignerSocket(signerDir)
+	})
+	require.NoError(t, err)
+
+	_, err = kr.Key("local")
+	require.NoError(t, err)
+}
+
+func TestListenRemoteSigner(t *testing.T) {
+	dir, cleanup := testutil.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	socket := DefaultRemoteSignerSocket(dir)
+	listener, err := ListenRemoteSigner(socket)
+	require.NoError(t, err)
+
+	// the socket is only accessible to the user running the signer
+	fi, err := os.Stat(filepath.Dir(socket))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0700), fi.Mode().Perm())
+	fi, err = os.Stat(socket)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	// the socket of a running signer is not replaced
+	_, err = ListenRemoteSigner(socket)
+	require.Error(t, err)
+
+	// the stale socket of a signer that did not stop cleanly is replaced
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, listener.Close())
+	_, err = os.Stat(socket)
+	require.NoError(t, err)
+
+	listener, err = ListenRemoteSigner(socket)
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	// a path that is not a socket is not removed
+	require.NoError(t, ioutil.WriteFile(socket, []byte("file"), 0600))
+	_, err = ListenRemoteSigner(socket)
+	require.Error(t, err)
+	_, err = os.Stat(socket)
+	require.NoError(t, err)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	kr := NewInMemory()
+	local, _, err := kr.NewMnemonic("local", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	signer := NewRemoteSigner(kr)
+
+	res, err := signer.Keys(context.Background(), &RemoteKeysRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []RemoteKey{{
+		Name:   "local",
+		PubKey: local.GetPubKey().Bytes(),
+		Algo:   string(hd.Secp256k1Type),
+	}}, res.Keys)
+
+	msg := []byte("message")
+	signRes, err := signer.Sign(context.Background(), &RemoteSignRequest{Name: "local", Msg: msg})
+	require.NoError(t, err)
+	require.Equal(t, local.GetPubKey().Bytes(), signRes.PubKey)
+	require.True(t, local.GetPubKey().VerifyBytes(msg, signRes.Signature))
+
+	_, err = signer.Sign(context.Background(), &RemoteSignRequest{Msg: msg})
+	require.Error(t, err)
+	_, err = signer.Sign(context.Background(), &RemoteSignRequest{Name: "offline", Msg: msg})
+	require.Error(t, err)
+	_, err = signer.Sign(context.Background(), &RemoteSignRequest{Name: "unknown", Msg: msg})
+	require.Error(t, err)
+}
@@ -50,6 +50,7 @@ const (
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
 	TypeMulti   KeyType = 3
+	TypeRemote  KeyType = 4
 )
 
 var keyTypes = map[KeyType]string{
@@ -57,6 +58,7 @@ var keyTypes = map[KeyType]string{
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
 	TypeMulti:   "multi",
+	TypeRemote:  "remote",
 }
 
 // String implements the stringer interface for KeyType.
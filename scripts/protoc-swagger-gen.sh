@@ -0,0 +1,27 @@
+#!/usr/bin/env bash
+
+set -eo pipefail
+
+mkdir -p ./tmp-swagger-gen
+proto_dirs=$(find ./proto -path -prune -o -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
+for dir in $proto_dirs; do
+  # generate swagger files for the services exposed through the gRPC-gateway
+  gateway_files=$(grep -l "google.api.http" $(find "${dir}" -maxdepth 1 -name '*.proto') || true)
+  if [[ -n "$gateway_files" ]]; then
+    protoc \
+    -I "proto" \
+    -I "third_party/proto" \
+    --swagger_out=./tmp-swagger-gen \
+    --swagger_opt=logtostderr=true \
+    --swagger_opt=fqn_for_swagger_name=true \
+    --swagger_opt=simple_operation_ids=true \
+    $gateway_files
+  fi
+done
+
+# combine the generated swagger files with the legacy REST routes
+swagger-combine ./client/docs/config.json -o ./client/docs/swagger-ui/swagger.yaml -f yaml \
+  --continueOnConflictingPaths true --includeDefinitions true
+
+# clean swagger files
+rm -rf ./tmp-swagger-gen
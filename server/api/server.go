@@ -2,18 +2,23 @@ package api
 
 import (
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/gogo/gateway"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/rakyll/statik/fs"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -24,8 +29,9 @@ import (
 
 // Server defines the server's API interface.
 type Server struct {
-	Router    *mux.Router
-	ClientCtx client.Context
+	Router            *mux.Router
+	GRPCGatewayRouter *runtime.ServeMux
+	ClientCtx         client.Context
 
 	logger   log.Logger
 	metrics  *telemetry.Metrics
@@ -33,8 +39,23 @@ type Server struct {
 }
 
 func New(clientCtx client.Context, logger log.Logger) *Server {
+	// the gogoproto JSON marshaler is required to marshal the gogoproto
+	// generated types of the gRPC services
+	marshalerOption := gatewayMarshaler{
+		JSONPb: &gateway.JSONPb{
+			EmitDefaults: true,
+			Indent:       "  ",
+			OrigName:     true,
+		},
+	}
+
 	return &Server{
-		Router:    mux.NewRouter(),
+		Router: mux.NewRouter(),
+		GRPCGatewayRouter: runtime.NewServeMux(
+			runtime.WithMarshalerOption(runtime.MIMEWildcard, marshalerOption),
+			// marshal the error details of unary requests
+			runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
+		),
 		ClientCtx: clientCtx,
 		logger:    logger,
 	}
@@ -59,6 +80,10 @@ func (s *Server) Start(cfg config.Config) error {
 		s.registerMetrics()
 	}
 
+	// the gRPC-gateway routes are registered last, so that they are only
+	// matched by the requests which do not match any other route
+	s.registerGRPCGatewayRoutes()
+
 	tmCfg := tmrpcserver.DefaultConfig()
 	tmCfg.MaxOpenConnections = int(cfg.API.MaxOpenConnections)
 	tmCfg.ReadTimeout = time.Duration(cfg.API.RPCReadTimeout) * time.Second
@@ -92,7 +117,11 @@ func (s *Server) registerSwaggerUI() {
 	}
 
 	staticServer := http.FileServer(statikFS)
-	s.Router.PathPrefix("/").Handler(staticServer)
+	s.Router.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", staticServer))
+}
+
+func (s *Server) registerGRPCGatewayRoutes() {
+	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
 }
 
 func (s *Server) registerMetrics() {
@@ -111,3 +140,35 @@ func (s *Server) registerMetrics() {
 
 	s.Router.HandleFunc("/metrics", metricsHandler).Methods("GET")
 }
+
+// gatewayMarshaler is the JSON marshaler of the gRPC-gateway. The Anys of
+// the marshaled messages are packed into their JSON encoding beforehand, as
+// the JSON encoding of an Any is provided by its packer.
+type gatewayMarshaler struct {
+	*gateway.JSONPb
+}
+
+var _ runtime.Marshaler = gatewayMarshaler{}
+
+// Marshal implements the runtime.Marshaler.Marshal method
+func (m gatewayMarshaler) Marshal(v interface{}) ([]byte, error) {
+	err := codectypes.UnpackInterfaces(v, codectypes.ProtoJSONPacker{JSONPBMarshaler: (*jsonpb.Marshaler)(m.JSONPb)})
+	if err != nil {
+		return nil, err
+	}
+
+	return m.JSONPb.Marshal(v)
+}
+
+// NewEncoder implements the runtime.Marshaler.NewEncoder method
+func (m gatewayMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v interface{}) error {
+		bz, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(bz)
+		return err
+	})
+}
@@ -6,11 +6,13 @@ import (
 	"os"
 	"path/filepath"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -34,6 +36,15 @@ type (
 		abci.Application
 
 		RegisterAPIRoutes(*api.Server)
+
+		// RegisterGRPCServer registers the gRPC query services of the
+		// application with the gRPC server.
+		RegisterGRPCServer(gogogrpc.Server)
+
+		// RegisterTxService registers the tx gRPC service of the application,
+		// which broadcasts and queries txs through the given client context,
+		// with the gRPC server.
+		RegisterTxService(gogogrpc.Server, client.Context)
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
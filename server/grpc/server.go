@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// Application defines the application methods required to serve its gRPC
+// services.
+type Application interface {
+	RegisterGRPCServer(gogogrpc.Server)
+	RegisterTxService(gogogrpc.Server, client.Context)
+}
+
+// StartGRPCServer starts a gRPC server on the given address, serving the
+// registered query services of the application and its tx service, which
+// broadcasts and queries txs through the given client context.
+func StartGRPCServer(clientCtx client.Context, app Application, address string) (*grpc.Server, error) {
+	grpcSrv := grpc.NewServer()
+	app.RegisterGRPCServer(grpcSrv)
+	app.RegisterTxService(grpcSrv, clientCtx)
+
+	// reflection allows consumers to build dynamic clients that can query any
+	// application without relying on its packages at compile time
+	reflection.Register(grpcSrv)
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	errCh := make(chan error)
+
+	go func() {
+		if err := grpcSrv.Serve(listener); err != nil {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	case <-time.After(5 * time.Second): // assume server started successfully
+		return grpcSrv, nil
+	}
+}
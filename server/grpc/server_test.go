@@ -0,0 +1,98 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+	conn    *grpc.ClientConn
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	s.cfg = network.DefaultConfig()
+	s.cfg.NumValidators = 1
+	s.network = network.New(s.T(), s.cfg)
+
+	_, err := s.network.WaitForHeight(2)
+	s.Require().NoError(err)
+
+	s.conn, err = grpc.Dial(s.network.Validators[0].AppConfig.GRPC.Address, grpc.WithInsecure())
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.conn.Close()
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_BankBalance() {
+	val := s.network.Validators[0]
+	queryClient := banktypes.NewQueryClient(s.conn)
+
+	var header metadata.MD
+	res, err := queryClient.Balance(
+		context.Background(),
+		&banktypes.QueryBalanceRequest{Address: val.Address, Denom: s.cfg.BondDenom},
+		grpc.Header(&header),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+		*res.Balance,
+	)
+	s.Require().NotEmpty(header.Get(grpctypes.GRPCBlockHeightHeader))
+
+	// the height is picked with the block height header
+	ctx := metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, "1")
+	_, err = queryClient.Balance(
+		ctx,
+		&banktypes.QueryBalanceRequest{Address: val.Address, Denom: s.cfg.BondDenom},
+		grpc.Header(&header),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"1"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
+	// the server reflection lists the registered query services and the tx
+	// service
+	stream, err := rpb.NewServerReflectionClient(s.conn).ServerReflectionInfo(context.Background())
+	s.Require().NoError(err)
+	s.Require().NoError(stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}))
+
+	res, err := stream.Recv()
+	s.Require().NoError(err)
+
+	services := make([]string, len(res.GetListServicesResponse().Service))
+	for i, service := range res.GetListServicesResponse().Service {
+		services[i] = service.Name
+	}
+
+	s.Require().Contains(services, "cosmos.bank.Query")
+	s.Require().Contains(services, "cosmos.tx.Service")
+	s.Require().Contains(services, "grpc.reflection.v1alpha.ServerReflection")
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
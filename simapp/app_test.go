@@ -5,10 +5,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -36,6 +42,57 @@ func TestSimAppExport(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+// ensure that the ante handler of the app enforces the global min gas prices
+func TestGlobalMinGasPrices(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000))
+
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0)
+
+	genesisState := NewDefaultGenesisState()
+
+	authParams := authtypes.DefaultParams()
+	authParams.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2)))
+	authGenesis := authtypes.NewGenesisState(authParams, []authtypes.GenesisAccount{authtypes.NewBaseAccount(addr, nil, 0, 0)})
+	genesisState[authtypes.ModuleName] = app.Codec().MustMarshalJSON(authGenesis)
+
+	bankGenesis := banktypes.NewGenesisState(
+		banktypes.DefaultGenesisState().Params, []banktypes.Balance{{Address: addr, Coins: coins}}, coins,
+		[]banktypes.Metadata{}, []banktypes.SendEnabled{},
+	)
+	genesisState[banktypes.ModuleName] = app.Codec().MustMarshalJSON(bankGenesis)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, ConsensusParams: DefaultConsensusParams, AppStateBytes: stateBytes})
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1}})
+
+	txGen := MakeEncodingConfig().TxConfig
+	msg := banktypes.NewMsgSend(addr, secp256k1.GenPrivKey().PubKey().Address().Bytes(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	// the fee is below the global min gas prices, 0.01 per unit of gas
+	tx, err := helpers.GenTx(
+		txGen, []sdk.Msg{msg}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 9999)), 1000000, "", []uint64{0}, []uint64{0}, priv,
+	)
+	require.NoError(t, err)
+
+	_, _, err = app.Deliver(tx)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+
+	// the fee meets the global min gas prices
+	tx, err = helpers.GenTx(
+		txGen, []sdk.Msg{msg}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)), 1000000, "", []uint64{0}, []uint64{0}, priv,
+	)
+	require.NoError(t, err)
+
+	_, _, err = app.Deliver(tx)
+	require.NoError(t, err)
+}
+
 // ensure that blocked addresses are properly set in bank keeper
 func TestBlockedAddrs(t *testing.T) {
 	db := dbm.NewMemDB()
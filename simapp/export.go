@@ -72,8 +72,14 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []str
 
 	// withdraw all delegator rewards
 	dels := app.StakingKeeper.GetAllDelegations(ctx)
+	tokenizedSharesPoolAddr := app.StakingKeeper.GetTokenizedSharesPool(ctx).GetAddress()
 	for _, delegation := range dels {
-		_, _ = app.DistrKeeper.WithdrawDelegationRewards(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+		rewards, _ := app.DistrKeeper.WithdrawDelegationRewards(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+
+		// the rewards of the tokenized shares pool are owed to the share token holders
+		if delegation.DelegatorAddress.Equals(tokenizedSharesPoolAddr) {
+			app.StakingKeeper.AddTokenizeShareRewards(ctx, delegation.ValidatorAddress, delegation.Shares, rewards)
+		}
 	}
 
 	// clear validator slash events
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -25,6 +26,8 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CacheMultiStore = Store{}
@@ -42,6 +45,7 @@ func NewFromKVStore(
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 
 	for key, store := range stores {
@@ -68,7 +72,12 @@ func NewStore(
 func newCacheMultiStoreFromCMS(cms Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
-		stores[k] = v
+		var store types.CacheWrapper = v
+		if cms.ListeningEnabled(k) {
+			// writes of the new branch are reported once they are written back
+			store = listenkv.NewStore(v.(types.KVStore), k, cms.listeners[k])
+		}
+		stores[k] = store
 	}
 
 	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
@@ -102,6 +111,19 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// AddListeners adds listeners for a specific KVStore. The listeners are
+// notified of writes made through GetKVStore as well as of writes flushed from
+// a branch created with CacheMultiStore. Writes to the underlying stores, made
+// by calling Write, are not reported.
+func (cms Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	cms.listeners[key] = append(cms.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore.
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(cms.listeners[key]) != 0
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -144,11 +166,15 @@ func (cms Store) GetStore(key types.StoreKey) types.Store {
 	return cms.stores[key].(types.Store)
 }
 
-// GetKVStore returns an underlying KVStore by key.
+// GetKVStore returns an underlying KVStore by key. If listening is enabled on
+// the KVStore, it is wrapped in a ListenKVStore.
 func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
 	store := cms.stores[key]
 	if key == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
+	if cms.ListeningEnabled(key) {
+		return listenkv.NewStore(store.(types.KVStore), key, cms.listeners[key])
+	}
 	return store.(types.KVStore)
 }
@@ -0,0 +1,102 @@
+package listenkv_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+type failingListener struct{}
+
+func (failingListener) OnWrite(types.StoreKey, []byte, []byte, bool) error {
+	return errors.New("failed")
+}
+
+func newEmptyListenKVStore(listeners ...types.WriteListener) *listenkv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	return listenkv.NewStore(memDB, testStoreKey, listeners)
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store := newEmptyListenKVStore(listener)
+
+	store.Set(keyFmt(1), valFmt(1))
+	store.Set(keyFmt(2), valFmt(2))
+	store.Delete(keyFmt(1))
+
+	require.Nil(t, store.Get(keyFmt(1)))
+	require.Equal(t, valFmt(2), store.Get(keyFmt(2)))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(1), Value: valFmt(1)},
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(2), Value: valFmt(2)},
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(1), Delete: true},
+	}, listener.PopStateCache())
+	require.Empty(t, listener.PopStateCache())
+}
+
+func TestListenKVStoreReadsNotReported(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store := newEmptyListenKVStore(listener)
+	store.Set(keyFmt(1), valFmt(1))
+	listener.PopStateCache()
+
+	require.True(t, store.Has(keyFmt(1)))
+	require.Equal(t, valFmt(1), store.Get(keyFmt(1)))
+
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		require.Equal(t, keyFmt(1), iter.Key())
+	}
+	iter.Close()
+
+	require.Empty(t, listener.PopStateCache())
+}
+
+func TestListenKVStoreMultipleListeners(t *testing.T) {
+	l1, l2 := types.NewMemoryListener(), types.NewMemoryListener()
+	store := newEmptyListenKVStore(l1, l2)
+
+	store.Set(keyFmt(1), valFmt(1))
+
+	require.Len(t, l1.PopStateCache(), 1)
+	require.Len(t, l2.PopStateCache(), 1)
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store := newEmptyListenKVStore(listener)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set(keyFmt(1), valFmt(1))
+	require.Empty(t, listener.PopStateCache())
+
+	cache.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(1), Value: valFmt(1)},
+	}, listener.PopStateCache())
+}
+
+func TestListenKVStoreListenerError(t *testing.T) {
+	store := newEmptyListenKVStore(failingListener{})
+	require.Panics(t, func() { store.Set(keyFmt(1), valFmt(1)) })
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	store := newEmptyListenKVStore()
+	require.Equal(t, types.StoreTypeDB, store.GetStoreType())
+}
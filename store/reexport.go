@@ -25,6 +25,8 @@ type (
 	Type             = types.StoreType
 	Queryable        = types.Queryable
 	TraceContext     = types.TraceContext
+	WriteListener    = types.WriteListener
+	StoreKVPair      = types.StoreKVPair
 	Gas              = types.Gas
 	GasMeter         = types.GasMeter
 	GasConfig        = types.GasConfig
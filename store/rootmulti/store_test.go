@@ -244,6 +244,13 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	require.NotNil(t, rs2)
 	require.Equal(t, v2, rs2.Get(k2))
 
+	// store4 was added and is empty
+	s4, _ := restore.getStoreByName("store4").(types.KVStore)
+	require.NotNil(t, s4)
+
+	k4, v4 := []byte("fourth"), []byte("created")
+	s4.Set(k4, v4)
+
 	// store this migrated data, and load it again without migrations
 	migratedID := restore.Commit()
 	require.Equal(t, migratedID.Version, int64(2))
@@ -262,12 +269,21 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	require.NotNil(t, rl2)
 	require.Equal(t, v2, rl2.Get(k2))
 
+	rl4, _ := reload.getStoreByName("store4").(types.KVStore)
+	require.NotNil(t, rl4)
+	require.Equal(t, v4, rl4.Get(k4))
+
+	// adding an already existing store fails
+	readd, upgrades := newMultiStoreWithModifiedMounts(db, types.PruneNothing)
+	err = readd.LoadLatestVersionAndUpgrade(upgrades)
+	require.Error(t, err)
+
 	// check commitInfo in storage
 	ci, err = getCommitInfo(db, 2)
 	require.NoError(t, err)
 	require.Equal(t, int64(2), ci.Version)
-	require.Equal(t, 3, len(ci.StoreInfos), ci.StoreInfos)
-	checkContains(t, ci.StoreInfos, []string{"store1", "restore2", "store3"})
+	require.Equal(t, 4, len(ci.StoreInfos), ci.StoreInfos)
+	checkContains(t, ci.StoreInfos, []string{"store1", "restore2", "store3", "store4"})
 }
 
 func TestParsePath(t *testing.T) {
@@ -518,6 +534,59 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_Listening(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key1, key2 := ms.keysByName["store1"], ms.keysByName["store2"]
+	rootListener := types.NewMemoryListener()
+	ms.AddListeners(key1, []types.WriteListener{rootListener})
+	require.True(t, ms.ListeningEnabled(key1))
+	require.False(t, ms.ListeningEnabled(key2))
+
+	// direct writes to the root store are reported
+	ms.GetKVStore(key1).Set([]byte("a"), []byte("1"))
+	ms.GetKVStore(key2).Set([]byte("a"), []byte("1"))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("a"), Value: []byte("1")},
+	}, rootListener.PopStateCache())
+
+	// writes of a cache-wrapped store are reported once written back
+	cacheMulti := ms.CacheMultiStore()
+	cacheListener := types.NewMemoryListener()
+	cacheMulti.AddListeners(key1, []types.WriteListener{cacheListener})
+
+	cacheMulti.GetKVStore(key1).Set([]byte("b"), []byte("2"))
+	cacheMulti.GetKVStore(key1).Delete([]byte("a"))
+	require.Empty(t, rootListener.PopStateCache())
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "store1", Key: []byte("a"), Delete: true},
+	}, cacheListener.PopStateCache())
+
+	// writes of a discarded branch are not reported, those of a written one are
+	discarded := cacheMulti.CacheMultiStore()
+	discarded.GetKVStore(key1).Set([]byte("c"), []byte("3"))
+	require.False(t, discarded.ListeningEnabled(key1))
+
+	branch := cacheMulti.CacheMultiStore()
+	branch.GetKVStore(key1).Set([]byte("d"), []byte("4"))
+	require.Empty(t, cacheListener.PopStateCache())
+	branch.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("d"), Value: []byte("4")},
+	}, cacheListener.PopStateCache())
+
+	cacheMulti.Write()
+	require.Empty(t, cacheListener.PopStateCache())
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("a"), Delete: true},
+		{StoreKey: "store1", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "store1", Key: []byte("d"), Value: []byte("4")},
+	}, rootListener.PopStateCache())
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -539,8 +608,10 @@ func newMultiStoreWithModifiedMounts(db dbm.DB, pruningOpts types.PruningOptions
 	store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil)
 	store.MountStoreWithDB(types.NewKVStoreKey("restore2"), types.StoreTypeIAVL, nil)
 	store.MountStoreWithDB(types.NewKVStoreKey("store3"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
 
 	upgrades := &types.StoreUpgrades{
+		Added: []string{"store4"},
 		Renamed: []types.StoreRename{{
 			OldKey: "store2",
 			NewKey: "restore2",
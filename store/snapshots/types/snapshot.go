@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Validate performs basic validation of the snapshot. It does not verify the
+// snapshot contents, only that the metadata is self-consistent.
+func (s Snapshot) Validate() error {
+	if s.Height == 0 {
+		return fmt.Errorf("snapshot height cannot be 0: %w", ErrInvalidMetadata)
+	}
+	if s.Chunks == 0 {
+		return fmt.Errorf("snapshot has no chunks: %w", ErrInvalidMetadata)
+	}
+	if len(s.Hash) == 0 {
+		return fmt.Errorf("snapshot has no hash: %w", ErrInvalidMetadata)
+	}
+	if uint32(len(s.Metadata.ChunkHashes)) != s.Chunks {
+		return fmt.Errorf("snapshot has %v chunk hashes, but %v chunks: %w",
+			len(s.Metadata.ChunkHashes), s.Chunks, ErrInvalidMetadata)
+	}
+	for i, hash := range s.Metadata.ChunkHashes {
+		if len(hash) == 0 {
+			return fmt.Errorf("snapshot chunk %v has no hash: %w", i, ErrInvalidMetadata)
+		}
+	}
+
+	return nil
+}
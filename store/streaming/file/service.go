@@ -0,0 +1,124 @@
+package file
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// StreamingService is a baseapp.StreamingService that writes the state
+// changes of each block to files in a directory. For every ABCI message a
+// file is written containing the length-prefixed protobuf encoded request,
+// followed by the state changes as StoreKVPairs, followed by the response:
+//
+//	{prefix}block-{N}-begin
+//	{prefix}block-{N}-tx-{i}
+//	{prefix}block-{N}-end
+type StreamingService struct {
+	storeKeys  []types.StoreKey // the store keys whose changes are streamed
+	filePrefix string           // optional prefix for each of the generated files
+	writeDir   string           // directory to write files into
+
+	currentBlockNumber int64
+	currentTxIndex     int64
+}
+
+// NewStreamingService creates a new StreamingService writing into writeDir,
+// which must exist and be writable.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	return &StreamingService{
+		storeKeys:  storeKeys,
+		filePrefix: filePrefix,
+		writeDir:   writeDir,
+	}, nil
+}
+
+// StoreKeys implements baseapp.StreamingService.
+func (fss *StreamingService) StoreKeys() []types.StoreKey {
+	return fss.storeKeys
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener. It writes the BeginBlock
+// request, state changes and response to the block's begin file.
+func (fss *StreamingService) ListenBeginBlock(
+	_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock, changeSet []types.StoreKVPair,
+) error {
+	fss.currentBlockNumber = req.Header.Height
+	fss.currentTxIndex = 0
+
+	name := fmt.Sprintf("block-%d-begin", fss.currentBlockNumber)
+	return fss.writeFile(name, &req, &res, changeSet)
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener. It writes the DeliverTx
+// request, state changes and response to the tx's file.
+func (fss *StreamingService) ListenDeliverTx(
+	_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, changeSet []types.StoreKVPair,
+) error {
+	name := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
+	fss.currentTxIndex++
+
+	return fss.writeFile(name, &req, &res, changeSet)
+}
+
+// ListenEndBlock implements baseapp.ABCIListener. It writes the EndBlock
+// request, state changes and response to the block's end file.
+func (fss *StreamingService) ListenEndBlock(
+	_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock, changeSet []types.StoreKVPair,
+) error {
+	name := fmt.Sprintf("block-%d-end", fss.currentBlockNumber)
+	return fss.writeFile(name, &req, &res, changeSet)
+}
+
+// writeFile writes the request, state changes and response as length-prefixed
+// protobuf messages to the named file.
+func (fss *StreamingService) writeFile(name string, req, res proto.Message, changeSet []types.StoreKVPair) error {
+	f, err := os.OpenFile(filepath.Join(fss.writeDir, fss.filePrefix+name), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	w := protoio.NewDelimitedWriter(f)
+	if err := w.WriteMsg(req); err != nil {
+		f.Close()
+		return err
+	}
+	for i := range changeSet {
+		if err := w.WriteMsg(&changeSet[i]); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.WriteMsg(res); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable.
+func isDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := ioutil.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+
+	return os.Remove(f)
+}
@@ -0,0 +1,110 @@
+package file
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	testStoreKey = types.NewKVStoreKey("test")
+
+	testChangeSet = []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("k1"), Value: []byte("v1")},
+		{StoreKey: testStoreKey.Name(), Key: []byte("k2"), Delete: true},
+	}
+)
+
+func setupStreamingService(t *testing.T) (*StreamingService, string) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	fss, err := NewStreamingService(dir, "pre-", []types.StoreKey{testStoreKey})
+	require.NoError(t, err)
+
+	return fss, dir
+}
+
+func readChangeSet(t *testing.T, reader protoio.ReadCloser, n int) []types.StoreKVPair {
+	changeSet := make([]types.StoreKVPair, n)
+	for i := range changeSet {
+		require.NoError(t, reader.ReadMsg(&changeSet[i]))
+	}
+	return changeSet
+}
+
+func openFile(t *testing.T, dir, name string) protoio.ReadCloser {
+	f, err := os.Open(filepath.Join(dir, name))
+	require.NoError(t, err)
+	t.Cleanup(func() { f.Close() })
+
+	return protoio.NewDelimitedReader(f, 1e6)
+}
+
+func TestNewStreamingService(t *testing.T) {
+	_, err := NewStreamingService(filepath.Join(os.TempDir(), "does-not-exist", "x"), "", nil)
+	require.Error(t, err)
+
+	fss, _ := setupStreamingService(t)
+	require.Equal(t, []types.StoreKey{testStoreKey}, fss.StoreKeys())
+}
+
+func TestStreamingService(t *testing.T) {
+	fss, dir := setupStreamingService(t)
+	ctx := sdk.Context{}
+
+	beginReq := abci.RequestBeginBlock{Header: abci.Header{Height: 7}}
+	beginRes := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	require.NoError(t, fss.ListenBeginBlock(ctx, beginReq, beginRes, testChangeSet))
+
+	for i := 0; i < 2; i++ {
+		txReq := abci.RequestDeliverTx{Tx: []byte{byte(i)}}
+		txRes := abci.ResponseDeliverTx{Code: uint32(i)}
+		require.NoError(t, fss.ListenDeliverTx(ctx, txReq, txRes, testChangeSet[i:]))
+	}
+
+	endReq := abci.RequestEndBlock{Height: 7}
+	endRes := abci.ResponseEndBlock{}
+	require.NoError(t, fss.ListenEndBlock(ctx, endReq, endRes, nil))
+
+	// begin block
+	reader := openFile(t, dir, "pre-block-7-begin")
+	var gotBeginReq abci.RequestBeginBlock
+	require.NoError(t, reader.ReadMsg(&gotBeginReq))
+	require.Equal(t, beginReq.Header.Height, gotBeginReq.Header.Height)
+	require.Equal(t, testChangeSet, readChangeSet(t, reader, len(testChangeSet)))
+	var gotBeginRes abci.ResponseBeginBlock
+	require.NoError(t, reader.ReadMsg(&gotBeginRes))
+	require.Equal(t, beginRes, gotBeginRes)
+
+	// txs
+	for i := 0; i < 2; i++ {
+		reader := openFile(t, dir, fmt.Sprintf("pre-block-7-tx-%d", i))
+		var gotTxReq abci.RequestDeliverTx
+		require.NoError(t, reader.ReadMsg(&gotTxReq))
+		require.Equal(t, []byte{byte(i)}, gotTxReq.Tx)
+		require.Equal(t, testChangeSet[i:], readChangeSet(t, reader, len(testChangeSet)-i))
+		var gotTxRes abci.ResponseDeliverTx
+		require.NoError(t, reader.ReadMsg(&gotTxRes))
+		require.Equal(t, uint32(i), gotTxRes.Code)
+	}
+
+	// end block
+	reader = openFile(t, dir, "pre-block-7-end")
+	var gotEndReq abci.RequestEndBlock
+	require.NoError(t, reader.ReadMsg(&gotEndReq))
+	require.Equal(t, endReq, gotEndReq)
+	var gotEndRes abci.ResponseEndBlock
+	require.NoError(t, reader.ReadMsg(&gotEndRes))
+	require.Equal(t, endRes, gotEndRes)
+}
@@ -0,0 +1,41 @@
+package types
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// OnWrite is called on every set or delete of a key in a KVStore.
+	// If delete is true, value is nil.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// MemoryListener listens to the state writes and accumulates the records in
+// memory until they are popped.
+type MemoryListener struct {
+	stateCache []StoreKVPair
+}
+
+var _ WriteListener = (*MemoryListener)(nil)
+
+// NewMemoryListener creates a listener that accumulates the state writes in memory.
+func NewMemoryListener() *MemoryListener {
+	return &MemoryListener{}
+}
+
+// OnWrite implements WriteListener interface.
+func (fl *MemoryListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	fl.stateCache = append(fl.stateCache, StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+
+	return nil
+}
+
+// PopStateCache returns the accumulated state changes and resets the cache.
+func (fl *MemoryListener) PopStateCache() []StoreKVPair {
+	res := fl.stateCache
+	fl.stateCache = nil
+
+	return res
+}
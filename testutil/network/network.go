@@ -22,6 +22,7 @@ import (
 	"github.com/tendermint/tendermint/node"
 	tmclient "github.com/tendermint/tendermint/rpc/client"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -141,6 +142,7 @@ type (
 
 		tmNode *node.Node
 		api    *api.Server
+		grpc   *grpc.Server
 	}
 )
 
@@ -183,6 +185,12 @@ func New(t *testing.T, cfg Config) *Network {
 		appCfg.API.Swagger = false
 		appCfg.Telemetry.Enabled = false
 
+		// every validator serves its gRPC query services as they do not depend
+		// on the Tendermint RPC
+		_, grpcPort, err := server.FreeTCPAddr()
+		require.NoError(t, err)
+		appCfg.GRPC.Address = fmt.Sprintf("0.0.0.0:%s", grpcPort)
+
 		ctx := server.NewDefaultContext()
 		tmCfg := ctx.Config
 		tmCfg.Consensus.TimeoutCommit = cfg.TimeoutCommit
@@ -422,6 +430,10 @@ func (n *Network) Cleanup() {
 		if v.api != nil {
 			_ = v.api.Close()
 		}
+
+		if v.grpc != nil {
+			v.grpc.Stop()
+		}
 	}
 
 	if n.config.CleanupDir {
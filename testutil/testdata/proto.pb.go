@@ -583,6 +583,95 @@ func (m *TestMsg) GetSigners() []github_com_cosmos_cosmos_sdk_types.AccAddress {
 	return nil
 }
 
+// msg type for testing the Msg service
+type MsgCreateDog struct {
+	Dog *Dog `protobuf:"bytes,1,opt,name=dog,proto3" json:"dog,omitempty"`
+}
+
+func (m *MsgCreateDog) Reset()         { *m = MsgCreateDog{} }
+func (m *MsgCreateDog) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDog) ProtoMessage()    {}
+func (*MsgCreateDog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{12}
+}
+func (m *MsgCreateDog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDog.Merge(m, src)
+}
+func (m *MsgCreateDog) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDog) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDog proto.InternalMessageInfo
+
+func (m *MsgCreateDog) GetDog() *Dog {
+	if m != nil {
+		return m.Dog
+	}
+	return nil
+}
+
+type MsgCreateDogResponse struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgCreateDogResponse) Reset()         { *m = MsgCreateDogResponse{} }
+func (m *MsgCreateDogResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDogResponse) ProtoMessage()    {}
+func (*MsgCreateDogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fcc84b9998d60d8, []int{13}
+}
+func (m *MsgCreateDogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDogResponse.Merge(m, src)
+}
+func (m *MsgCreateDogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDogResponse proto.InternalMessageInfo
+
+func (m *MsgCreateDogResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // bad MultiSignature with extra fields
 type BadMultiSignature struct {
 	Signatures       [][]byte `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
@@ -594,7 +683,7 @@ func (m *BadMultiSignature) Reset()         { *m = BadMultiSignature{} }
 func (m *BadMultiSignature) String() string { return proto.CompactTextString(m) }
 func (*BadMultiSignature) ProtoMessage()    {}
 func (*BadMultiSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fcc84b9998d60d8, []int{12}
+	return fileDescriptor_2fcc84b9998d60d8, []int{14}
 }
 func (m *BadMultiSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,50 +739,55 @@ func init() {
 	proto.RegisterType((*TestAnyRequest)(nil), "testdata.TestAnyRequest")
 	proto.RegisterType((*TestAnyResponse)(nil), "testdata.TestAnyResponse")
 	proto.RegisterType((*TestMsg)(nil), "testdata.TestMsg")
+	proto.RegisterType((*MsgCreateDog)(nil), "testdata.MsgCreateDog")
+	proto.RegisterType((*MsgCreateDogResponse)(nil), "testdata.MsgCreateDogResponse")
 	proto.RegisterType((*BadMultiSignature)(nil), "testdata.BadMultiSignature")
 }
 
 func init() { proto.RegisterFile("proto.proto", fileDescriptor_2fcc84b9998d60d8) }
 
 var fileDescriptor_2fcc84b9998d60d8 = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x4e, 0xdc, 0x3e,
-	0x10, 0xc7, 0xf1, 0x6f, 0xf9, 0x3b, 0xbb, 0x62, 0x7f, 0x75, 0x69, 0xb5, 0xe4, 0x10, 0x50, 0xa4,
-	0x8a, 0x3d, 0x94, 0xac, 0x0a, 0xe2, 0xc2, 0xa1, 0x52, 0xa0, 0xb4, 0x2b, 0x55, 0x5c, 0x42, 0xd5,
-	0x43, 0x2f, 0xc8, 0x9b, 0x18, 0xaf, 0x45, 0x62, 0xd3, 0x8c, 0x83, 0x48, 0x9f, 0xa2, 0xaf, 0xd0,
-	0xa7, 0x69, 0x8f, 0x1c, 0x7b, 0xaa, 0x2a, 0x78, 0x8b, 0x9e, 0xaa, 0xfc, 0xdf, 0x22, 0x84, 0xb8,
-	0x24, 0x33, 0xe3, 0xef, 0x7c, 0x6c, 0xcf, 0xd7, 0xd0, 0xbd, 0x48, 0xb4, 0xd1, 0x6e, 0xf1, 0xa5,
-	0xcb, 0x86, 0xa3, 0x09, 0x99, 0x61, 0xd6, 0xba, 0xd0, 0x5a, 0x44, 0x7c, 0x54, 0xd4, 0x27, 0xe9,
-	0xd9, 0x88, 0xa9, 0xac, 0x14, 0x59, 0x6b, 0x42, 0x0b, 0x5d, 0x84, 0xa3, 0x3c, 0x2a, 0xab, 0xce,
-	0x36, 0x74, 0xde, 0x68, 0x41, 0x29, 0xcc, 0xa3, 0xfc, 0xc2, 0x07, 0x64, 0x93, 0x0c, 0x57, 0xfc,
-	0x22, 0xce, 0x6b, 0x8a, 0xc5, 0x7c, 0xf0, 0x5f, 0x59, 0xcb, 0x63, 0x67, 0x0f, 0x3a, 0x87, 0xcc,
-	0xd0, 0x01, 0x2c, 0xc5, 0x5a, 0xc9, 0x73, 0x9e, 0x54, 0x1d, 0x75, 0x4a, 0xd7, 0x60, 0x21, 0x92,
-	0x97, 0x1c, 0x8b, 0xae, 0x05, 0xbf, 0x4c, 0x9c, 0x77, 0xb0, 0x32, 0x66, 0xe8, 0x29, 0x19, 0xb3,
-	0x88, 0xbe, 0x84, 0x45, 0x56, 0x44, 0x45, 0x6f, 0x77, 0x67, 0xcd, 0x2d, 0x0f, 0xed, 0xd6, 0x87,
-	0x76, 0x3d, 0x95, 0xf9, 0x95, 0x86, 0xf6, 0x80, 0x5c, 0x15, 0xb0, 0x8e, 0x4f, 0xae, 0x9c, 0x43,
-	0xe8, 0x8d, 0x19, 0xb6, 0xac, 0x5d, 0x80, 0x29, 0xc3, 0xd3, 0x47, 0xf0, 0x56, 0xa6, 0x75, 0x93,
-	0x73, 0x0c, 0xfd, 0x12, 0xd2, 0x72, 0xf6, 0x61, 0x35, 0xe7, 0x3c, 0x92, 0xd5, 0x9b, 0xce, 0xf4,
-	0x3a, 0x5b, 0xd0, 0x3d, 0x0a, 0xa6, 0xda, 0xe7, 0x9f, 0x53, 0x8e, 0xe5, 0x6c, 0x38, 0x22, 0x13,
-	0xbc, 0x99, 0x4d, 0x99, 0x3a, 0x43, 0xe8, 0x95, 0x42, 0xbc, 0xd0, 0x0a, 0xf9, 0x03, 0xca, 0x17,
-	0xd0, 0x3f, 0x61, 0xd9, 0x98, 0x47, 0x51, 0x83, 0xad, 0xdd, 0x20, 0x33, 0x6e, 0xb8, 0xf0, 0x7f,
-	0x2b, 0xab, 0xa0, 0x16, 0x2c, 0x8b, 0x84, 0x73, 0x23, 0x95, 0xa8, 0xb4, 0x4d, 0xee, 0x1c, 0xc1,
-	0xea, 0x07, 0x8e, 0x26, 0xbf, 0x42, 0x45, 0xdd, 0x05, 0x60, 0x2a, 0x7b, 0xd4, 0xfc, 0x98, 0xca,
-	0xaa, 0x0b, 0x1f, 0x41, 0xbf, 0xc1, 0x54, 0xbb, 0xee, 0xdc, 0xe3, 0xc3, 0x53, 0xb7, 0x7e, 0x96,
-	0x6e, 0x33, 0xac, 0x59, 0x1b, 0x3e, 0xc2, 0x52, 0x8e, 0x39, 0x46, 0x41, 0xdf, 0xc3, 0x12, 0x4a,
-	0xa1, 0x78, 0x82, 0x03, 0xb2, 0xd9, 0x19, 0xf6, 0x0e, 0x5e, 0xfd, 0xf9, 0xb5, 0xb1, 0x2d, 0xa4,
-	0x99, 0xa6, 0x13, 0x37, 0xd0, 0xf1, 0x28, 0xd0, 0x18, 0x6b, 0xac, 0x7e, 0xdb, 0x18, 0x9e, 0x8f,
-	0x4c, 0x76, 0xc1, 0xd1, 0xf5, 0x82, 0xc0, 0x0b, 0xc3, 0x84, 0x23, 0xfa, 0x35, 0xc1, 0x99, 0xc0,
-	0x93, 0x03, 0x16, 0x1e, 0xa7, 0x91, 0x91, 0x27, 0x52, 0x28, 0x66, 0xd2, 0x84, 0x53, 0x1b, 0x00,
-	0xeb, 0xa4, 0xda, 0xc4, 0x9f, 0xa9, 0xd0, 0x2d, 0xe8, 0xc7, 0x2c, 0x92, 0x81, 0xd4, 0x29, 0x9e,
-	0x9e, 0x49, 0x1e, 0x85, 0x83, 0x85, 0x4d, 0x32, 0xec, 0xf9, 0xab, 0x4d, 0xf9, 0x6d, 0x5e, 0xdd,
-	0x9f, 0xbf, 0xfe, 0xb6, 0x41, 0x76, 0xbe, 0x13, 0xe8, 0xe6, 0x87, 0x3f, 0xe1, 0xc9, 0xa5, 0x0c,
-	0x38, 0xdd, 0x83, 0xf9, 0xdc, 0x5a, 0xfa, 0xac, 0xbd, 0xf3, 0xcc, 0x9b, 0xb0, 0x9e, 0xdf, 0x2d,
-	0x57, 0x63, 0xf3, 0x60, 0xb9, 0x36, 0x90, 0xae, 0xb7, 0x9a, 0x3b, 0xde, 0x5b, 0xd6, 0x7d, 0x4b,
-	0x15, 0xe2, 0x75, 0x39, 0x45, 0x4f, 0x65, 0x74, 0xd0, 0xca, 0xfe, 0xb5, 0xd9, 0x5a, 0xbf, 0x67,
-	0xa5, 0xec, 0x3f, 0x18, 0xff, 0xb8, 0xb1, 0xc9, 0xf5, 0x8d, 0x4d, 0x7e, 0xdf, 0xd8, 0xe4, 0xeb,
-	0xad, 0x3d, 0x77, 0x7d, 0x6b, 0xcf, 0xfd, 0xbc, 0xb5, 0xe7, 0x3e, 0xb9, 0x0f, 0xcf, 0x9f, 0xa3,
-	0x49, 0x8d, 0x8c, 0x46, 0x35, 0x79, 0xb2, 0x58, 0xbc, 0x97, 0xdd, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x5b, 0x22, 0x19, 0xf5, 0x9b, 0x04, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xad, 0xbf, 0xf4, 0x2f, 0x37, 0xf9, 0x1a, 0x18, 0x02, 0x4a, 0xbd, 0x70, 0x2b, 0x4b, 0xa8,
+	0x11, 0xa2, 0xb6, 0x48, 0xd5, 0x4d, 0x17, 0x48, 0x69, 0x5a, 0x88, 0x40, 0xd9, 0xb8, 0x88, 0x05,
+	0x9b, 0x6a, 0x62, 0x4f, 0x27, 0xa3, 0x3a, 0x9e, 0xe2, 0x3b, 0xae, 0x6a, 0x9e, 0x82, 0x57, 0xe0,
+	0x69, 0x60, 0xd9, 0x25, 0x2b, 0x84, 0xda, 0xb7, 0x60, 0x85, 0xfc, 0x6f, 0xaa, 0xa8, 0xea, 0x26,
+	0xb9, 0xf7, 0xcc, 0xb9, 0xc7, 0xf7, 0xe7, 0x40, 0xeb, 0x22, 0x94, 0x4a, 0x5a, 0xe9, 0x2f, 0x59,
+	0x57, 0x0c, 0x95, 0x47, 0x15, 0xd5, 0x37, 0xb9, 0x94, 0xdc, 0x67, 0x76, 0x8a, 0x4f, 0xa3, 0x33,
+	0x9b, 0x06, 0x71, 0x46, 0xd2, 0xbb, 0x5c, 0x72, 0x99, 0x86, 0x76, 0x12, 0x65, 0xa8, 0xb9, 0x0b,
+	0x8d, 0x23, 0xc9, 0x09, 0x81, 0x65, 0x14, 0x5f, 0x58, 0x4f, 0xdb, 0xd6, 0xfa, 0x4d, 0x27, 0x8d,
+	0x13, 0x2c, 0xa0, 0x73, 0xd6, 0xfb, 0x2f, 0xc3, 0x92, 0xd8, 0xdc, 0x87, 0xc6, 0x88, 0x2a, 0xd2,
+	0x83, 0xb5, 0xb9, 0x0c, 0xc4, 0x39, 0x0b, 0xf3, 0x8a, 0x22, 0x25, 0x5d, 0x58, 0xf1, 0xc5, 0x25,
+	0xc3, 0xb4, 0x6a, 0xc5, 0xc9, 0x12, 0xf3, 0x2d, 0x34, 0xc7, 0x14, 0x87, 0x81, 0x98, 0x53, 0x9f,
+	0xbc, 0x84, 0x55, 0x9a, 0x46, 0x69, 0x6d, 0x6b, 0xd0, 0xb5, 0xb2, 0xa6, 0xad, 0xa2, 0x69, 0x6b,
+	0x18, 0xc4, 0x4e, 0xce, 0x21, 0x6d, 0xd0, 0xae, 0x52, 0xb1, 0x86, 0xa3, 0x5d, 0x99, 0x23, 0x68,
+	0x8f, 0x29, 0x56, 0x5a, 0x7b, 0x00, 0x33, 0x8a, 0xa7, 0x0f, 0xd0, 0x6b, 0xce, 0x8a, 0x22, 0x73,
+	0x02, 0x9d, 0x4c, 0xa4, 0xd2, 0x39, 0x80, 0x8d, 0x44, 0xe7, 0x81, 0x5a, 0xed, 0x59, 0xad, 0xd6,
+	0xdc, 0x81, 0xd6, 0xb1, 0x3b, 0x93, 0x0e, 0xfb, 0x1c, 0x31, 0xcc, 0x76, 0xc3, 0x10, 0x29, 0x67,
+	0xe5, 0x6e, 0xb2, 0xd4, 0xec, 0x43, 0x3b, 0x23, 0xe2, 0x85, 0x0c, 0x90, 0xdd, 0xc3, 0x7c, 0x0e,
+	0x9d, 0x13, 0x1a, 0x8f, 0x99, 0xef, 0x97, 0xb2, 0xc5, 0x35, 0xb4, 0xda, 0x35, 0x2c, 0x78, 0x54,
+	0xd1, 0x72, 0x51, 0x1d, 0xd6, 0x79, 0xc8, 0x98, 0x12, 0x01, 0xcf, 0xb9, 0x65, 0x6e, 0x1e, 0xc3,
+	0xc6, 0x07, 0x86, 0x2a, 0x19, 0x21, 0x57, 0xdd, 0x03, 0xa0, 0x41, 0xfc, 0xa0, 0xfd, 0xd1, 0x20,
+	0xce, 0x07, 0x3e, 0x86, 0x4e, 0x29, 0x93, 0x7f, 0x75, 0xb0, 0xe0, 0x0e, 0x4f, 0xac, 0xc2, 0x96,
+	0x56, 0xb9, 0xac, 0xfa, 0x19, 0x3e, 0xc2, 0x5a, 0x22, 0x33, 0x41, 0x4e, 0xde, 0xc3, 0x1a, 0x0a,
+	0x1e, 0xb0, 0x10, 0x7b, 0xda, 0x76, 0xa3, 0xdf, 0x3e, 0x7c, 0xf5, 0xe7, 0xd7, 0xd6, 0x2e, 0x17,
+	0x6a, 0x16, 0x4d, 0x2d, 0x57, 0xce, 0x6d, 0x57, 0xe2, 0x5c, 0x62, 0xfe, 0xb7, 0x8b, 0xde, 0xb9,
+	0xad, 0xe2, 0x0b, 0x86, 0xd6, 0xd0, 0x75, 0x87, 0x9e, 0x17, 0x32, 0x44, 0xa7, 0x50, 0x30, 0x6d,
+	0x68, 0x4f, 0x90, 0x8f, 0x42, 0x46, 0x15, 0x4b, 0xbc, 0xbd, 0x05, 0x0d, 0x4f, 0xf2, 0xbc, 0xa9,
+	0xff, 0xab, 0xa6, 0x8e, 0x24, 0x77, 0x92, 0x17, 0xf3, 0x05, 0x74, 0xeb, 0x05, 0xe5, 0x50, 0x8b,
+	0x56, 0x3e, 0x85, 0xc7, 0x87, 0xd4, 0x9b, 0x44, 0xbe, 0x12, 0x27, 0x82, 0x07, 0x54, 0x45, 0x21,
+	0x23, 0x06, 0x00, 0x16, 0x49, 0x3e, 0x81, 0x53, 0x43, 0xc8, 0x0e, 0x74, 0xe6, 0xd4, 0x17, 0xae,
+	0x90, 0x11, 0x9e, 0x9e, 0x09, 0xe6, 0x7b, 0xbd, 0x95, 0x6d, 0xad, 0xdf, 0x76, 0x36, 0x4a, 0xf8,
+	0x4d, 0x82, 0x1e, 0x2c, 0x5f, 0x7f, 0xdb, 0xd2, 0x06, 0xdf, 0x35, 0x68, 0x25, 0x9b, 0x39, 0x61,
+	0xe1, 0xa5, 0x70, 0x19, 0xd9, 0x87, 0xe5, 0xc4, 0x37, 0xe4, 0x69, 0xd5, 0x7b, 0xcd, 0x70, 0xfa,
+	0xb3, 0xbb, 0x70, 0xde, 0xfe, 0x10, 0xd6, 0x0b, 0x77, 0x90, 0xcd, 0x8a, 0x73, 0xc7, 0x58, 0xba,
+	0xbe, 0xe8, 0x29, 0x97, 0x78, 0x9d, 0x9d, 0x68, 0x18, 0xc4, 0xa4, 0x57, 0xd1, 0xfe, 0xf5, 0x90,
+	0xbe, 0xb9, 0xe0, 0x25, 0xab, 0x1f, 0xbc, 0x83, 0x46, 0x72, 0xde, 0x11, 0x34, 0xab, 0x73, 0xd4,
+	0xda, 0xad, 0x6f, 0x5d, 0x37, 0x16, 0xe3, 0x85, 0xd6, 0xe1, 0xf8, 0xc7, 0x8d, 0xa1, 0x5d, 0xdf,
+	0x18, 0xda, 0xef, 0x1b, 0x43, 0xfb, 0x7a, 0x6b, 0x2c, 0x5d, 0xdf, 0x1a, 0x4b, 0x3f, 0x6f, 0x8d,
+	0xa5, 0x4f, 0xd6, 0xfd, 0x46, 0x61, 0xa8, 0x22, 0x25, 0x7c, 0xbb, 0x90, 0x9f, 0xae, 0xa6, 0xc6,
+	0xde, 0xfb, 0x3b, 0x00, 0x1b, 0x9f, 0x2e, 0xab, 0x44, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -848,6 +942,78 @@ var _TestService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "proto.proto",
 }
 
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateDog(ctx context.Context, in *MsgCreateDog, opts ...grpc.CallOption) (*MsgCreateDogResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateDog(ctx context.Context, in *MsgCreateDog, opts ...grpc.CallOption) (*MsgCreateDogResponse, error) {
+	out := new(MsgCreateDogResponse)
+	err := c.cc.Invoke(ctx, "/testdata.Msg/CreateDog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateDog(context.Context, *MsgCreateDog) (*MsgCreateDogResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateDog(ctx context.Context, req *MsgCreateDog) (*MsgCreateDogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDog not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateDog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDog)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testdata.Msg/CreateDog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDog(ctx, req.(*MsgCreateDog))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "testdata.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDog",
+			Handler:    _Msg_CreateDog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto.proto",
+}
+
 func (m *Dog) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1252,6 +1418,71 @@ func (m *TestMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateDog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dog != nil {
+		{
+			size, err := m.Dog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProto(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProto(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BadMultiSignature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1474,6 +1705,32 @@ func (m *TestMsg) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateDog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dog != nil {
+		l = m.Dog.Size()
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProto(uint64(l))
+	}
+	return n
+}
+
 func (m *BadMultiSignature) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2612,6 +2869,180 @@ func (m *TestMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateDog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dog == nil {
+				m.Dog = &Dog{}
+			}
+			if err := m.Dog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProto
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProto
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProto
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProto(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthProto
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BadMultiSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
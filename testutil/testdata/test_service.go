@@ -39,6 +39,14 @@ func (e TestServiceImpl) SayHello(_ context.Context, request *SayHelloRequest) (
 
 var _ TestServiceServer = TestServiceImpl{}
 
+type MsgServerImpl struct{}
+
+func (m MsgServerImpl) CreateDog(_ context.Context, msg *MsgCreateDog) (*MsgCreateDogResponse, error) {
+	return &MsgCreateDogResponse{Name: msg.Dog.Name}, nil
+}
+
+var _ MsgServer = MsgServerImpl{}
+
 var _ types.UnpackInterfacesMessage = &TestAnyRequest{}
 
 func (m *TestAnyRequest) UnpackInterfaces(unpacker types.AnyUnpacker) error {
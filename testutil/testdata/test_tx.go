@@ -46,3 +46,11 @@ func (msg *TestMsg) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 func (msg *TestMsg) ValidateBasic() error { return nil }
+
+var _ sdk.Msg = (*MsgCreateDog)(nil)
+
+func (msg *MsgCreateDog) Route() string                { return "testdata" }
+func (msg *MsgCreateDog) Type() string                 { return "create_dog" }
+func (msg *MsgCreateDog) GetSignBytes() []byte         { return sdk.MustSortJSON([]byte(`{}`)) }
+func (msg *MsgCreateDog) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{} }
+func (msg *MsgCreateDog) ValidateBasic() error         { return nil }
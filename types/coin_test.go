@@ -69,6 +69,7 @@ func TestCoinIsValid(t *testing.T) {
 		{Coin{"a very long coin denom", NewInt(1)}, false},
 		{Coin{"atOm", NewInt(1)}, false},
 		{Coin{"     ", NewInt(1)}, false},
+		{Coin{"ibc/7f1d3fcf4ae79e1554d670d1ad949a9ba4e4a3c76c63093e17e446a46061a7a2", NewInt(1)}, true},
 	}
 
 	for i, tc := range cases {
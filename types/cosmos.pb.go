@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -305,6 +306,9 @@ type Result struct {
 	// Events contains a slice of Event objects that were emitted during message or
 	// handler execution.
 	Events []types.Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
+	// msg_responses contains the typed responses of the Msg service methods
+	// executed by the message, packed in Anys.
+	MsgResponses []*types1.Any `protobuf:"bytes,4,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
 }
 
 func (m *Result) Reset()      { *m = Result{} }
@@ -437,23 +441,26 @@ func (m *MsgData) GetData() []byte {
 	return nil
 }
 
-// TxData defines a list of MsgData. A transaction will have a MsgData object for
-// each message.
-type TxData struct {
+// TxMsgData defines a list of MsgData. A transaction will have a MsgData object
+// for each message.
+type TxMsgData struct {
 	Data []*MsgData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// msg_responses contains the typed Msg service response of each message,
+	// packed in an Any.
+	MsgResponses []*types1.Any `protobuf:"bytes,2,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
 }
 
-func (m *TxData) Reset()      { *m = TxData{} }
-func (*TxData) ProtoMessage() {}
-func (*TxData) Descriptor() ([]byte, []int) {
+func (m *TxMsgData) Reset()      { *m = TxMsgData{} }
+func (*TxMsgData) ProtoMessage() {}
+func (*TxMsgData) Descriptor() ([]byte, []int) {
 	return fileDescriptor_809e58c688fefd51, []int{9}
 }
-func (m *TxData) XXX_Unmarshal(b []byte) error {
+func (m *TxMsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TxData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxMsgData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TxData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxMsgData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -463,25 +470,32 @@ func (m *TxData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TxData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxData.Merge(m, src)
+func (m *TxMsgData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxMsgData.Merge(m, src)
 }
-func (m *TxData) XXX_Size() int {
+func (m *TxMsgData) XXX_Size() int {
 	return m.Size()
 }
-func (m *TxData) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxData.DiscardUnknown(m)
+func (m *TxMsgData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxMsgData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxData proto.InternalMessageInfo
+var xxx_messageInfo_TxMsgData proto.InternalMessageInfo
 
-func (m *TxData) GetData() []*MsgData {
+func (m *TxMsgData) GetData() []*MsgData {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
+func (m *TxMsgData) GetMsgResponses() []*types1.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
 // TxResponse defines a structure containing relevant tx data and metadata. The
 // tags are stringified and the log is JSON decoded.
 type TxResponse struct {
@@ -709,7 +723,7 @@ func init() {
 	proto.RegisterType((*Result)(nil), "cosmos.Result")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.MsgData")
-	proto.RegisterType((*TxData)(nil), "cosmos.TxData")
+	proto.RegisterType((*TxMsgData)(nil), "cosmos.TxMsgData")
 	proto.RegisterType((*TxResponse)(nil), "cosmos.TxResponse")
 	proto.RegisterType((*ABCIMessageLog)(nil), "cosmos.ABCIMessageLog")
 	proto.RegisterType((*StringEvent)(nil), "cosmos.StringEvent")
@@ -719,63 +733,65 @@ func init() {
 func init() { proto.RegisterFile("cosmos/cosmos.proto", fileDescriptor_809e58c688fefd51) }
 
 var fileDescriptor_809e58c688fefd51 = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0x7a, 0xb7, 0x6b, 0xfb, 0xb5, 0x9b, 0xd0, 0x49, 0x68, 0x37, 0xa5, 0xf5, 0x9a, 0x2d,
-	0x42, 0x41, 0x82, 0xb5, 0x94, 0x56, 0x42, 0xf2, 0x01, 0x29, 0x1b, 0xa3, 0x62, 0xd4, 0x4a, 0x68,
-	0x1b, 0x40, 0xe2, 0x12, 0xc6, 0xde, 0xc9, 0x78, 0x55, 0xef, 0x8c, 0xe5, 0x19, 0x37, 0xf6, 0xad,
-	0x07, 0x0e, 0x3d, 0xf2, 0x13, 0x7a, 0xe6, 0x97, 0xf4, 0x98, 0x63, 0x85, 0x90, 0x01, 0xe7, 0xc2,
-	0xb9, 0x47, 0x4e, 0x68, 0x66, 0xd6, 0x6b, 0x3b, 0xe2, 0xd4, 0x4b, 0xf2, 0x7e, 0x7f, 0x3c, 0xef,
-	0xe3, 0x59, 0xd8, 0x1b, 0x70, 0x91, 0x71, 0xd1, 0x36, 0xff, 0xc2, 0xf1, 0x84, 0x4b, 0x8e, 0x5c,
-	0xa3, 0xdd, 0xdd, 0xa7, 0x9c, 0x72, 0x6d, 0x6a, 0x2b, 0xc9, 0x78, 0xef, 0x7e, 0x2c, 0x09, 0x4b,
-	0xc8, 0x24, 0x4b, 0x99, 0x6c, 0xe3, 0xfe, 0x20, 0x6d, 0xcb, 0xf9, 0x98, 0x08, 0xf3, 0x37, 0x0f,
-	0x39, 0xa0, 0x9c, 0xd3, 0x11, 0x69, 0x6b, 0xad, 0x3f, 0x3d, 0x6f, 0x63, 0x36, 0x37, 0xae, 0xe0,
-	0x31, 0x38, 0x27, 0x3c, 0x65, 0x68, 0x1f, 0x6e, 0x24, 0x84, 0xf1, 0xcc, 0xb3, 0x5a, 0xd6, 0x61,
-	0x2d, 0x36, 0x0a, 0x7a, 0x00, 0x2e, 0xce, 0xf8, 0x94, 0x49, 0xaf, 0xac, 0xcc, 0x51, 0xfd, 0xcd,
-	0xc2, 0x2f, 0xfd, 0xbe, 0xf0, 0xed, 0x1e, 0x93, 0x71, 0xee, 0xea, 0x38, 0xff, 0xbc, 0xf6, 0xad,
-	0xe0, 0x5b, 0xa8, 0x74, 0xc9, 0xe0, 0x7d, 0x6a, 0x75, 0xc9, 0xe0, 0x5a, 0xad, 0xcf, 0xa0, 0xda,
-	0x63, 0xf2, 0x3b, 0xbd, 0xfc, 0x7d, 0xb0, 0x53, 0x26, 0x4d, 0xa9, 0xed, 0xfe, 0xca, 0xae, 0x42,
-	0xbb, 0x64, 0x50, 0x84, 0x26, 0x64, 0x70, 0x3d, 0x54, 0x95, 0x57, 0xf6, 0x20, 0x82, 0xc6, 0x0f,
-	0x78, 0x74, 0x9c, 0x24, 0x13, 0x22, 0x04, 0x11, 0xe8, 0x73, 0xa8, 0xe1, 0x95, 0xe2, 0x59, 0x2d,
-	0xfb, 0xb0, 0x11, 0xed, 0xfc, 0xbb, 0xf0, 0x61, 0x1d, 0x14, 0xaf, 0x03, 0x3a, 0xce, 0xcb, 0x3f,
-	0x5a, 0x56, 0xc0, 0xa1, 0xf2, 0x18, 0x8b, 0x1e, 0x3b, 0xe7, 0xe8, 0x11, 0x00, 0xc5, 0xe2, 0xec,
-	0x02, 0x33, 0x49, 0x12, 0xdd, 0xd4, 0x89, 0x3e, 0x7c, 0xb7, 0xf0, 0x6f, 0xcd, 0x71, 0x36, 0xea,
-	0x04, 0x6b, 0x5f, 0x10, 0xd7, 0x28, 0x16, 0x3f, 0x6a, 0x19, 0x85, 0x50, 0x55, 0x9e, 0xa9, 0x20,
-	0x89, 0xc6, 0xc1, 0x89, 0xf6, 0xde, 0x2d, 0xfc, 0xdd, 0x75, 0x8e, 0xf2, 0x04, 0x71, 0x85, 0x62,
-	0xf1, 0xbd, 0x92, 0xc6, 0xe0, 0xc6, 0x44, 0x4c, 0x47, 0x12, 0x21, 0x70, 0x12, 0x2c, 0xb1, 0xee,
-	0xd4, 0x88, 0xb5, 0x8c, 0x3e, 0x00, 0x7b, 0xc4, 0xa9, 0x01, 0x34, 0x56, 0x22, 0xea, 0x80, 0x4b,
-	0x5e, 0x10, 0x26, 0x85, 0x67, 0xb7, 0xec, 0xc3, 0xfa, 0xd1, 0xbd, 0x70, 0x4d, 0x8f, 0x50, 0xd1,
-	0x23, 0x34, 0xc4, 0xf8, 0x5a, 0x05, 0x45, 0x8e, 0x02, 0x29, 0xce, 0x33, 0x3a, 0xce, 0xab, 0xd7,
-	0x7e, 0x29, 0x98, 0x00, 0x7a, 0x96, 0x66, 0xd3, 0x11, 0x96, 0x29, 0x67, 0x31, 0x11, 0x63, 0xce,
-	0x04, 0x41, 0x8f, 0xcc, 0xdc, 0x29, 0x3b, 0xe7, 0x7a, 0x82, 0xfa, 0xd1, 0x6e, 0x98, 0x93, 0x34,
-	0x07, 0x24, 0xaa, 0xaa, 0x62, 0x97, 0x0b, 0xdf, 0xd2, 0xd3, 0x6b, 0x8c, 0x3e, 0x05, 0x77, 0xa2,
-	0xa7, 0xd7, 0x23, 0xd6, 0x8f, 0x76, 0x56, 0x39, 0x66, 0xa7, 0x38, 0xf7, 0x06, 0x5f, 0x41, 0xe5,
-	0xa9, 0xa0, 0x5d, 0xb5, 0xd2, 0x01, 0x54, 0x33, 0x41, 0xcf, 0xd4, 0x94, 0x39, 0x7f, 0x2a, 0x99,
-	0xa0, 0xa7, 0xf3, 0x31, 0x29, 0x10, 0x28, 0xaf, 0x11, 0xc8, 0xcf, 0xf2, 0x10, 0xdc, 0xd3, 0x99,
-	0x4e, 0x7f, 0x50, 0xa0, 0x64, 0x6f, 0xce, 0x98, 0x57, 0xdf, 0x4a, 0xfa, 0xc5, 0x06, 0x38, 0x9d,
-	0x15, 0x1b, 0xde, 0x06, 0x77, 0x48, 0x52, 0x3a, 0x34, 0x5c, 0xb3, 0xe3, 0x5c, 0x43, 0x01, 0xb8,
-	0x72, 0x36, 0xc4, 0x62, 0x98, 0xf3, 0x16, 0x96, 0x0b, 0xdf, 0x3d, 0x9d, 0x7d, 0x83, 0xc5, 0x30,
-	0xce, 0x3d, 0xe8, 0x1e, 0xd4, 0x06, 0x3c, 0x21, 0x62, 0x8c, 0x07, 0xc4, 0xb3, 0xf5, 0xd4, 0x6b,
-	0x83, 0x9a, 0x5b, 0x29, 0x9e, 0xd3, 0xb2, 0x0e, 0x6f, 0xc6, 0x5a, 0x2e, 0x76, 0xb9, 0xa1, 0x83,
-	0xcd, 0x35, 0xef, 0x40, 0x65, 0x82, 0x2f, 0xce, 0xd4, 0x45, 0x5d, 0x6d, 0x76, 0x27, 0xf8, 0xe2,
-	0x09, 0xa7, 0xe8, 0x04, 0x9c, 0x11, 0xa7, 0xc2, 0xab, 0xe8, 0xa5, 0x6e, 0xaf, 0x96, 0x3a, 0x8e,
-	0x4e, 0x7a, 0x4f, 0x89, 0x10, 0x98, 0x92, 0x27, 0x9c, 0x46, 0x77, 0x14, 0xfe, 0xbf, 0xfd, 0xe9,
-	0xef, 0x6e, 0xdb, 0x45, 0xac, 0x93, 0x55, 0x47, 0x7d, 0xbd, 0xaa, 0xe9, 0xa8, 0x64, 0x74, 0x7f,
-	0x8b, 0xc3, 0x35, 0xbd, 0xf7, 0x06, 0x59, 0x0f, 0x36, 0xc8, 0x0a, 0xda, 0xb9, 0xe2, 0x25, 0xfa,
-	0x04, 0xca, 0x72, 0xe6, 0xd5, 0xf5, 0x55, 0xf7, 0x43, 0xf3, 0xbe, 0x84, 0xab, 0xf7, 0x25, 0x3c,
-	0x66, 0xf3, 0xb8, 0x2c, 0x67, 0x0a, 0x17, 0x99, 0x66, 0x44, 0x48, 0x9c, 0x8d, 0xbd, 0x86, 0xc1,
-	0xa5, 0x30, 0xe4, 0x7c, 0x7b, 0x65, 0xc1, 0xce, 0xf6, 0xc4, 0xe8, 0x23, 0xa8, 0x29, 0x0e, 0xa4,
-	0x2c, 0x21, 0x33, 0x7d, 0x8d, 0x9b, 0xb1, 0x22, 0x45, 0x4f, 0xe9, 0xff, 0xc3, 0xf9, 0xe3, 0x6b,
-	0x9c, 0xdf, 0x5b, 0x01, 0xf4, 0x4c, 0x4e, 0x52, 0x46, 0x0d, 0xd5, 0xf7, 0x73, 0x74, 0x1a, 0x1b,
-	0x46, 0xb1, 0xa6, 0xbe, 0x66, 0xc4, 0xcf, 0x50, 0xdf, 0xf0, 0x2a, 0xc4, 0x36, 0x68, 0xa8, 0x65,
-	0xf4, 0x25, 0x00, 0x96, 0x72, 0x92, 0xf6, 0xa7, 0x92, 0x08, 0xaf, 0xac, 0xfb, 0xdd, 0x2a, 0x0e,
-	0xb2, 0xf2, 0xe4, 0x3f, 0xac, 0x8d, 0xd0, 0x82, 0xa8, 0xb5, 0x22, 0x48, 0x6d, 0xf2, 0x9c, 0xcc,
-	0xf3, 0xf2, 0x4a, 0x54, 0x2f, 0xe7, 0x0b, 0x3c, 0x9a, 0x92, 0x7c, 0x3b, 0xa3, 0x44, 0xdd, 0xb7,
-	0x7f, 0x37, 0x4b, 0x2f, 0x97, 0xcd, 0xd2, 0x9b, 0x65, 0xd3, 0xba, 0x5c, 0x36, 0xad, 0xbf, 0x96,
-	0x4d, 0xeb, 0xd7, 0xab, 0x66, 0xe9, 0xf2, 0xaa, 0x59, 0x7a, 0x7b, 0xd5, 0x2c, 0xfd, 0x14, 0xd0,
-	0x54, 0x0e, 0xa7, 0xfd, 0x70, 0xc0, 0xb3, 0xf6, 0xd6, 0x17, 0xe4, 0x0b, 0x91, 0x3c, 0x37, 0x9f,
-	0x82, 0xbe, 0xab, 0xaf, 0xf3, 0xf0, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x86, 0x78, 0x6d,
-	0x63, 0x06, 0x00, 0x00,
+	// 915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x7a, 0xb7, 0x6b, 0xfb, 0xd9, 0x49, 0xe8, 0x24, 0xb4, 0x9b, 0xd2, 0x7a, 0xcd, 0x16,
+	0xa1, 0x20, 0xc1, 0x5a, 0x4a, 0x2b, 0x21, 0x7c, 0xcb, 0xc6, 0xa8, 0x18, 0xb5, 0x12, 0xda, 0x06,
+	0x90, 0xb8, 0x84, 0xb1, 0x77, 0x32, 0x5e, 0xd5, 0xbb, 0x63, 0x76, 0xc6, 0x8d, 0x7d, 0xeb, 0x81,
+	0x43, 0x8f, 0xfc, 0x09, 0x3d, 0xc3, 0x3f, 0xd2, 0x63, 0x8e, 0x15, 0x42, 0x06, 0x9c, 0x0b, 0xe7,
+	0x1e, 0x39, 0xa1, 0x99, 0x9d, 0xf5, 0x8f, 0x06, 0x24, 0xd4, 0x4b, 0xf2, 0x7e, 0xcd, 0xbc, 0xf9,
+	0xbe, 0xf7, 0x3d, 0x2f, 0xec, 0x0e, 0x18, 0x4f, 0x18, 0x6f, 0xe7, 0xff, 0xfc, 0x71, 0xc6, 0x04,
+	0x43, 0x76, 0xee, 0xdd, 0xda, 0xa3, 0x8c, 0x32, 0x15, 0x6a, 0x4b, 0x2b, 0xcf, 0xde, 0x7a, 0x5f,
+	0x90, 0x34, 0x22, 0x59, 0x12, 0xa7, 0xa2, 0x8d, 0xfb, 0x83, 0xb8, 0x2d, 0x66, 0x63, 0xc2, 0xf3,
+	0xbf, 0xba, 0x64, 0x9f, 0x32, 0x46, 0x47, 0xa4, 0xad, 0xbc, 0xfe, 0xe4, 0xac, 0x8d, 0xd3, 0x59,
+	0x9e, 0xf2, 0x1e, 0x80, 0x75, 0xcc, 0xe2, 0x14, 0xed, 0xc1, 0xb5, 0x88, 0xa4, 0x2c, 0x71, 0x8c,
+	0x96, 0x71, 0x50, 0x0b, 0x73, 0x07, 0xdd, 0x05, 0x1b, 0x27, 0x6c, 0x92, 0x0a, 0xa7, 0x2c, 0xc3,
+	0x41, 0xfd, 0xe5, 0xdc, 0x2d, 0xfd, 0x3a, 0x77, 0xcd, 0x5e, 0x2a, 0x42, 0x9d, 0xea, 0x58, 0x7f,
+	0xbd, 0x70, 0x0d, 0xef, 0x4b, 0xa8, 0x74, 0xc9, 0xe0, 0x6d, 0xee, 0xea, 0x92, 0xc1, 0x1b, 0x77,
+	0x7d, 0x04, 0xd5, 0x5e, 0x2a, 0xbe, 0x52, 0xe0, 0xef, 0x80, 0x19, 0xa7, 0xc2, 0x31, 0x36, 0xcf,
+	0xc8, 0xfe, 0x32, 0x2e, 0x4b, 0xbb, 0x64, 0xb0, 0x2c, 0x8d, 0xc8, 0xc0, 0x31, 0xae, 0x5e, 0x2f,
+	0xe3, 0x5e, 0x00, 0x8d, 0x6f, 0xf0, 0xe8, 0x28, 0x8a, 0x32, 0xc2, 0x39, 0xe1, 0xe8, 0x63, 0xa8,
+	0xe1, 0xc2, 0x71, 0x8c, 0x96, 0x79, 0xd0, 0x08, 0xb6, 0xff, 0x9e, 0xbb, 0xb0, 0x2a, 0x0a, 0x57,
+	0x05, 0x1d, 0xeb, 0xd9, 0x6f, 0x2d, 0xc3, 0x63, 0x50, 0x79, 0x80, 0x79, 0x2f, 0x3d, 0x63, 0xe8,
+	0x3e, 0x00, 0xc5, 0xfc, 0xf4, 0x1c, 0xa7, 0x82, 0x44, 0xaa, 0xa9, 0x15, 0xbc, 0xfb, 0x7a, 0xee,
+	0x5e, 0x9f, 0xe1, 0x64, 0xd4, 0xf1, 0x56, 0x39, 0x2f, 0xac, 0x51, 0xcc, 0xbf, 0x55, 0x36, 0xf2,
+	0xa1, 0x2a, 0x33, 0x13, 0x4e, 0x22, 0xc5, 0x83, 0x15, 0xec, 0xbe, 0x9e, 0xbb, 0x3b, 0xab, 0x33,
+	0x32, 0xe3, 0x85, 0x15, 0x8a, 0xf9, 0xd7, 0xd2, 0xfa, 0xc5, 0x00, 0x3b, 0x24, 0x7c, 0x32, 0x12,
+	0x08, 0x81, 0x15, 0x61, 0x81, 0x55, 0xab, 0x46, 0xa8, 0x6c, 0xf4, 0x0e, 0x98, 0x23, 0x46, 0x73,
+	0x46, 0x43, 0x69, 0xa2, 0x0e, 0xd8, 0xe4, 0x29, 0x49, 0x05, 0x77, 0xcc, 0x96, 0x79, 0x50, 0x3f,
+	0xbc, 0xed, 0xaf, 0xf4, 0xe1, 0x4b, 0x7d, 0xf8, 0xb9, 0x32, 0x3e, 0x97, 0x45, 0x81, 0x25, 0x59,
+	0x0a, 0xf5, 0x09, 0xf4, 0x19, 0x6c, 0x25, 0x9c, 0x9e, 0x66, 0x84, 0x8f, 0x59, 0x2a, 0x59, 0xb1,
+	0xd4, 0x15, 0x7b, 0x7e, 0xae, 0x1f, 0xbf, 0xd0, 0x8f, 0x7f, 0x94, 0xce, 0xc2, 0x46, 0xc2, 0x69,
+	0x58, 0x54, 0x76, 0xac, 0xe7, 0x2f, 0xdc, 0x92, 0x97, 0x01, 0x7a, 0x1c, 0x27, 0x93, 0x11, 0x16,
+	0x31, 0x4b, 0x8b, 0x24, 0xba, 0x9f, 0x63, 0x8e, 0xd3, 0x33, 0xa6, 0x1e, 0x5f, 0x3f, 0xdc, 0xf1,
+	0xb5, 0xc0, 0x35, 0x99, 0x41, 0x55, 0xbe, 0xe3, 0x62, 0xee, 0x1a, 0x0a, 0xb9, 0xe2, 0xf7, 0x43,
+	0xb0, 0x33, 0x05, 0x5c, 0xa1, 0xab, 0x1f, 0x6e, 0x17, 0x67, 0x72, 0x3a, 0x42, 0x9d, 0xf5, 0x02,
+	0xa8, 0x3c, 0xe2, 0xb4, 0x2b, 0xd9, 0xd8, 0x87, 0xaa, 0x7c, 0xbf, 0x04, 0xa8, 0xb5, 0x57, 0x49,
+	0x38, 0x3d, 0x99, 0x8d, 0xc9, 0x92, 0xbc, 0xf2, 0x8a, 0xbc, 0x4e, 0x55, 0x8a, 0x4d, 0x8d, 0xf5,
+	0x07, 0xa8, 0x9d, 0x4c, 0x8b, 0x5b, 0xee, 0x2e, 0x79, 0x36, 0xd7, 0x9f, 0xaa, 0xd3, 0x9a, 0xf8,
+	0x2b, 0x54, 0x95, 0xff, 0x3f, 0x55, 0xaa, 0xe5, 0x8f, 0x26, 0xc0, 0xc9, 0x74, 0xc9, 0xd1, 0x0d,
+	0xb0, 0x87, 0x24, 0xa6, 0xc3, 0x5c, 0xe9, 0x66, 0xa8, 0x3d, 0xe4, 0x81, 0x2d, 0xa6, 0x43, 0xcc,
+	0x87, 0x7a, 0x6b, 0x60, 0x31, 0x77, 0xed, 0x93, 0xe9, 0x17, 0x98, 0x0f, 0x43, 0x9d, 0x41, 0xb7,
+	0xa1, 0x36, 0x60, 0x11, 0xe1, 0x63, 0x3c, 0x20, 0x8e, 0xa9, 0x70, 0xaf, 0x02, 0x12, 0xb9, 0x74,
+	0x1c, 0xab, 0x65, 0x1c, 0x6c, 0x85, 0xca, 0x5e, 0xb2, 0x71, 0x4d, 0x15, 0xe7, 0x88, 0x6e, 0x42,
+	0x25, 0xc3, 0xe7, 0xa7, 0x52, 0x4e, 0xb6, 0x0a, 0xdb, 0x19, 0x3e, 0x7f, 0xc8, 0x28, 0x3a, 0x06,
+	0x6b, 0xc4, 0x28, 0x77, 0x2a, 0x0a, 0xe1, 0x8d, 0x82, 0x8f, 0xa3, 0xe0, 0xb8, 0xf7, 0x88, 0x70,
+	0x8e, 0x29, 0x79, 0xc8, 0x68, 0x70, 0x53, 0x4e, 0xf0, 0xe7, 0xdf, 0xdd, 0x9d, 0xcd, 0x38, 0x0f,
+	0xd5, 0x61, 0xd9, 0x51, 0xcd, 0xbf, 0x9a, 0x77, 0x94, 0x36, 0xba, 0xb3, 0xb1, 0x41, 0x35, 0x85,
+	0x7b, 0x6d, 0x55, 0xf6, 0xd7, 0x56, 0x05, 0x54, 0xb2, 0xd8, 0x0a, 0xf4, 0x01, 0x94, 0xc5, 0xd4,
+	0xa9, 0xb7, 0x8c, 0xff, 0xa4, 0xbc, 0x2c, 0xa6, 0x92, 0x17, 0x11, 0x27, 0x84, 0x0b, 0x9c, 0x8c,
+	0x9d, 0x46, 0xce, 0xcb, 0x32, 0xa0, 0x15, 0xfb, 0xdc, 0x80, 0xed, 0xcd, 0x17, 0xa3, 0xf7, 0xa0,
+	0x26, 0x47, 0x1b, 0xa7, 0x11, 0x99, 0xaa, 0x69, 0x6c, 0x85, 0x52, 0x56, 0x3d, 0xe9, 0xff, 0xcb,
+	0xc2, 0x1d, 0xbd, 0xb1, 0x70, 0xbb, 0x05, 0x41, 0x8f, 0x45, 0x16, 0xa7, 0x34, 0xdf, 0xb3, 0x3d,
+	0xcd, 0x4e, 0x63, 0x2d, 0xc8, 0x8b, 0xbd, 0xd3, 0x8a, 0xf8, 0x1e, 0xea, 0x6b, 0x59, 0xc9, 0xd8,
+	0x9a, 0x90, 0x95, 0x8d, 0x3e, 0x05, 0xc0, 0x42, 0x64, 0x71, 0x7f, 0x22, 0x96, 0x92, 0xbb, 0xbe,
+	0x1c, 0x48, 0x91, 0xd1, 0x5b, 0xbd, 0x56, 0xaa, 0x3b, 0xdc, 0x83, 0xda, 0xb2, 0x48, 0x22, 0x79,
+	0x42, 0x66, 0xfa, 0x7a, 0x69, 0xca, 0xdf, 0xed, 0xa7, 0x78, 0x34, 0x21, 0x1a, 0x5d, 0xee, 0x04,
+	0xdd, 0x57, 0x7f, 0x36, 0x4b, 0xcf, 0x16, 0xcd, 0xd2, 0xcb, 0x45, 0xd3, 0xb8, 0x58, 0x34, 0x8d,
+	0x3f, 0x16, 0x4d, 0xe3, 0xa7, 0xcb, 0x66, 0xe9, 0xe2, 0xb2, 0x59, 0x7a, 0x75, 0xd9, 0x2c, 0x7d,
+	0xe7, 0xd1, 0x58, 0x0c, 0x27, 0x7d, 0x7f, 0xc0, 0x92, 0xf6, 0xc6, 0xf7, 0xeb, 0x13, 0x1e, 0x3d,
+	0xc9, 0x3f, 0x44, 0x7d, 0x5b, 0x4d, 0xe7, 0xde, 0x3f, 0x03, 0x00, 0x50, 0x45, 0xaf, 0x56, 0xe1,
+	0x06, 0x00, 0x00,
 }
 
 func (this *Coin) Equal(that interface{}) bool {
@@ -832,6 +848,33 @@ func (this *DecCoin) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgData)
+	if !ok {
+		that2, ok := that.(MsgData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgType != that1.MsgType {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
 func (m *Coin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1063,6 +1106,20 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Events) > 0 {
 		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1176,7 +1233,7 @@ func (m *MsgData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TxData) Marshal() (dAtA []byte, err error) {
+func (m *TxMsgData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1186,16 +1243,30 @@ func (m *TxData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxData) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxMsgData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxMsgData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1567,6 +1638,12 @@ func (m *Result) Size() (n int) {
 			n += 1 + l + sovCosmos(uint64(l))
 		}
 	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1602,7 +1679,7 @@ func (m *MsgData) Size() (n int) {
 	return n
 }
 
-func (m *TxData) Size() (n int) {
+func (m *TxMsgData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1614,6 +1691,12 @@ func (m *TxData) Size() (n int) {
 			n += 1 + l + sovCosmos(uint64(l))
 		}
 	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1757,7 +1840,7 @@ func (this *MsgData) String() string {
 	}, "")
 	return s
 }
-func (this *TxData) String() string {
+func (this *TxMsgData) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -1766,8 +1849,14 @@ func (this *TxData) String() string {
 		repeatedStringForData += strings.Replace(f.String(), "MsgData", "MsgData", 1) + ","
 	}
 	repeatedStringForData += "}"
-	s := strings.Join([]string{`&TxData{`,
+	repeatedStringForMsgResponses := "[]*Any{"
+	for _, f := range this.MsgResponses {
+		repeatedStringForMsgResponses += strings.Replace(fmt.Sprintf("%v", f), "Any", "types1.Any", 1) + ","
+	}
+	repeatedStringForMsgResponses += "}"
+	s := strings.Join([]string{`&TxMsgData{`,
 		`Data:` + repeatedStringForData + `,`,
+		`MsgResponses:` + repeatedStringForMsgResponses + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2530,6 +2619,40 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types1.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosmos(dAtA[iNdEx:])
@@ -2795,7 +2918,7 @@ func (m *MsgData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxData) Unmarshal(dAtA []byte) error {
+func (m *TxMsgData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2818,10 +2941,10 @@ func (m *TxData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxData: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxMsgData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxMsgData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2858,6 +2981,40 @@ func (m *TxData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types1.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosmos(dAtA[iNdEx:])
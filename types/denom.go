@@ -62,3 +62,29 @@ func ConvertCoin(coin Coin, denom string) (Coin, error) {
 
 	return NewCoin(denom, coin.Amount.ToDec().Mul(srcUnit.Quo(dstUnit)).TruncateInt()), nil
 }
+
+// ConvertDecCoin attempts to convert a decimal coin to a given denomination.
+// Unlike ConvertCoin, the converted amount is not truncated, which allows to
+// format a coin in a larger display denomination. If the given denomination is
+// invalid or if neither denomination is registered, an error is returned.
+func ConvertDecCoin(coin DecCoin, denom string) (DecCoin, error) {
+	if err := ValidateDenom(denom); err != nil {
+		return DecCoin{}, err
+	}
+
+	srcUnit, ok := GetDenomUnit(coin.Denom)
+	if !ok {
+		return DecCoin{}, fmt.Errorf("source denom not registered: %s", coin.Denom)
+	}
+
+	dstUnit, ok := GetDenomUnit(denom)
+	if !ok {
+		return DecCoin{}, fmt.Errorf("destination denom not registered: %s", denom)
+	}
+
+	if srcUnit.Equal(dstUnit) {
+		return NewDecCoinFromDec(denom, coin.Amount), nil
+	}
+
+	return NewDecCoinFromDec(denom, coin.Amount.Mul(srcUnit).Quo(dstUnit)), nil
+}
@@ -81,3 +81,42 @@ func TestConvertCoins(t *testing.T) {
 	// reset registration
 	denomUnits = map[string]Dec{}
 }
+
+func TestConvertDecCoins(t *testing.T) {
+	atomUnit := OneDec() // 1 (base denom unit)
+	require.NoError(t, RegisterDenom(atom, atomUnit))
+
+	uatomUnit := NewDecWithPrec(1, 6) // 10^-6 (micro)
+	require.NoError(t, RegisterDenom(uatom, uatomUnit))
+
+	testCases := []struct {
+		input  DecCoin
+		denom  string
+		result DecCoin
+		expErr bool
+	}{
+		{NewDecCoin("foo", ZeroInt()), atom, DecCoin{}, true},
+		{NewDecCoin(atom, ZeroInt()), "foo", DecCoin{}, true},
+		{NewDecCoin(atom, ZeroInt()), "FOO", DecCoin{}, true},
+
+		{NewDecCoin(uatom, NewInt(1500000)), atom, NewDecCoinFromDec(atom, NewDecWithPrec(15, 1)), false},  // uatom => atom
+		{NewDecCoin(uatom, NewInt(1)), atom, NewDecCoinFromDec(atom, NewDecWithPrec(1, 6)), false},         // uatom => atom
+		{NewDecCoinFromDec(atom, NewDecWithPrec(25, 1)), uatom, NewDecCoin(uatom, NewInt(2500000)), false}, // atom => uatom
+		{NewDecCoin(atom, NewInt(5)), atom, NewDecCoin(atom, NewInt(5)), false},                            // atom => atom
+	}
+
+	for i, tc := range testCases {
+		res, err := ConvertDecCoin(tc.input, tc.denom)
+		require.Equal(
+			t, tc.expErr, err != nil,
+			"unexpected error; tc: #%d, input: %s, denom: %s", i+1, tc.input, tc.denom,
+		)
+		require.Equal(
+			t, tc.result, res,
+			"invalid result; tc: #%d, input: %s, denom: %s", i+1, tc.input, tc.denom,
+		)
+	}
+
+	// reset registration
+	denomUnits = map[string]Dec{}
+}
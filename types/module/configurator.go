@@ -0,0 +1,113 @@
+package module
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MigrationHandler is the migration function that each module registers for
+// a consensus version. It migrates the module's store in place.
+type MigrationHandler func(sdk.Context) error
+
+// VersionMap is a map of moduleName -> consensus version
+type VersionMap map[string]uint64
+
+// Configurator provides the hooks to allow modules to register their in-place
+// store migrations.
+type Configurator interface {
+	// RegisterMigration registers an in-place store migration for a module. The
+	// handler is a migration script to perform in-place migrations from version
+	// `forVersion` to version `forVersion+1`.
+	//
+	// EACH TIME a module's ConsensusVersion increments, a new migration MUST
+	// be registered using this function. If a migration handler is missing for
+	// a particular function, the upgrade logic (see RunMigrations function)
+	// will fail. If the ConsensusVersion bump does not introduce any store
+	// changes, then a no-op function must be registered here.
+	RegisterMigration(moduleName string, forVersion uint64, handler MigrationHandler) error
+}
+
+type configurator struct {
+	cdc codec.JSONMarshaler
+
+	// migrations is a map of moduleName -> forVersion -> migration script handler
+	migrations map[string]map[uint64]MigrationHandler
+}
+
+// NewConfigurator returns a new Configurator instance. The codec is used to
+// initialize the genesis state of the modules added by an upgrade.
+func NewConfigurator(cdc codec.JSONMarshaler) Configurator {
+	return configurator{
+		cdc:        cdc,
+		migrations: map[string]map[uint64]MigrationHandler{},
+	}
+}
+
+var _ Configurator = configurator{}
+
+// RegisterMigration implements the Configurator.RegisterMigration method
+func (c configurator) RegisterMigration(moduleName string, forVersion uint64, handler MigrationHandler) error {
+	if forVersion == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidVersion, "module migration versions should start at 1")
+	}
+
+	if c.migrations[moduleName] == nil {
+		c.migrations[moduleName] = map[uint64]MigrationHandler{}
+	}
+
+	if c.migrations[moduleName][forVersion] != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrConflict, "another migration for module %s and version %d already exists", moduleName, forVersion)
+	}
+
+	c.migrations[moduleName][forVersion] = handler
+
+	return nil
+}
+
+// runModuleMigrations runs all in-place store migrations for one given module from a
+// version to another version.
+func (c configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) error {
+	// No-op if toVersion is the initial version or if the version is unchanged.
+	if toVersion <= 1 || fromVersion == toVersion {
+		return nil
+	}
+
+	moduleMigrationsMap, found := c.migrations[moduleName]
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no migrations found for module %s", moduleName)
+	}
+
+	// Run in-place migrations for the module sequentially until toVersion.
+	for i := fromVersion; i < toVersion; i++ {
+		migrateFn, found := moduleMigrationsMap[i]
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no migration found for module %s from version %d to version %d", moduleName, i, i+1)
+		}
+
+		if err := migrateFn(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MigrationModule is an interface that modules can implement in order to
+// register their in-place store migrations with a Configurator.
+type MigrationModule interface {
+	RegisterMigrations(cfg Configurator)
+}
+
+// RegisterMigrations calls RegisterMigrations with the configurator parameter
+// on all of the modules which implement MigrationModule in the manager.
+func (m *Manager) RegisterMigrations(cfg Configurator) {
+	for _, moduleName := range m.moduleNames() {
+		mm, ok := m.Modules[moduleName].(MigrationModule)
+		if !ok {
+			continue
+		}
+
+		mm.RegisterMigrations(cfg)
+	}
+}
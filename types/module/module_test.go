@@ -7,9 +7,11 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -34,6 +36,7 @@ func TestBasicManager(t *testing.T) {
 	mockAppModuleBasic1.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(``))
 	mockAppModuleBasic1.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Eq(wantDefaultGenesis["mockAppModuleBasic1"])).Times(1).Return(errFoo)
 	mockAppModuleBasic1.EXPECT().RegisterRESTRoutes(gomock.Eq(client.Context{}), gomock.Eq(&mux.Router{})).Times(1)
+	mockAppModuleBasic1.EXPECT().RegisterGRPCGatewayRoutes(gomock.Eq(client.Context{}), gomock.Eq(&runtime.ServeMux{})).Times(1)
 	mockAppModuleBasic1.EXPECT().RegisterCodec(gomock.Eq(cdc)).Times(1)
 	mockAppModuleBasic1.EXPECT().GetTxCmd().Times(1).Return(nil)
 	mockAppModuleBasic1.EXPECT().GetQueryCmd().Times(1).Return(nil)
@@ -51,6 +54,7 @@ func TestBasicManager(t *testing.T) {
 	require.True(t, errors.Is(errFoo, mm.ValidateGenesis(cdc, wantDefaultGenesis)))
 
 	mm.RegisterRESTRoutes(client.Context{}, &mux.Router{})
+	mm.RegisterGRPCGatewayRoutes(client.Context{}, &runtime.ServeMux{})
 
 	mockCmd := &cobra.Command{Use: "root"}
 	mm.AddTxCommands(mockCmd)
@@ -106,6 +110,11 @@ func TestManagerOrderSetters(t *testing.T) {
 	require.Equal(t, []string{"module1", "module2"}, mm.OrderEndBlockers)
 	mm.SetOrderEndBlockers("module2", "module1")
 	require.Equal(t, []string{"module2", "module1"}, mm.OrderEndBlockers)
+
+	require.Nil(t, mm.OrderMigrations)
+	mm.SetOrderMigrations("module2", "module1")
+	require.Equal(t, []string{"module2", "module1"}, mm.OrderMigrations)
+	require.Panics(t, func() { mm.SetOrderMigrations("module2") })
 }
 
 func TestManager_RegisterInvariants(t *testing.T) {
@@ -272,3 +281,88 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+func TestConfigurator_RegisterMigration(t *testing.T) {
+	cfg := module.NewConfigurator(codec.New())
+	noop := func(sdk.Context) error { return nil }
+
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 2, noop))
+	require.NoError(t, cfg.RegisterMigration("module2", 1, noop))
+
+	// versions start at 1
+	require.Error(t, cfg.RegisterMigration("module1", 0, noop))
+	// a version can only be migrated once
+	require.Error(t, cfg.RegisterMigration("module1", 1, noop))
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	cdc := codec.New()
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	var migrated []uint64
+	cfg := module.NewConfigurator(cdc)
+	for _, version := range []uint64{1, 2} {
+		version := version
+		require.NoError(t, cfg.RegisterMigration("module1", version, func(sdk.Context) error {
+			migrated = append(migrated, version)
+			return nil
+		}))
+	}
+
+	// module1 is migrated from version 1 to 3, module2 is unchanged
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, migrated)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Equal(t, vm, mm.GetVersionMap())
+
+	// module2 is a new module initialized with its default genesis
+	migrated = nil
+	genesis := json.RawMessage(`{"key": "value"}`)
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(genesis)
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis)).Times(1).Return(nil)
+	vm, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, migrated)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+
+	// new modules cannot update the validator set
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(genesis)
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis)).Times(1).Return([]abci.ValidatorUpdate{{}})
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3})
+	require.Error(t, err)
+
+	// a missing migration fails
+	_, err = mm.RunMigrations(ctx, module.NewConfigurator(cdc), module.VersionMap{"module1": 1, "module2": 1})
+	require.Error(t, err)
+
+	// a failing migration fails
+	failCfg := module.NewConfigurator(cdc)
+	require.NoError(t, failCfg.RegisterMigration("module1", 2, func(sdk.Context) error { return errFoo }))
+	_, err = mm.RunMigrations(ctx, failCfg, module.VersionMap{"module1": 2, "module2": 1})
+	require.Equal(t, errFoo, err)
+}
+
+func TestDefaultMigrationsOrder(t *testing.T) {
+	require.Equal(t,
+		[]string{"bank", "staking", "upgrade", "auth"},
+		module.DefaultMigrationsOrder([]string{"upgrade", "auth", "staking", "bank"}),
+	)
+	require.Equal(t,
+		[]string{"bank", "staking"},
+		module.DefaultMigrationsOrder([]string{"staking", "bank"}),
+	)
+}
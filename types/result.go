@@ -7,8 +7,10 @@ import (
 	"math"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	yaml "gopkg.in/yaml.v2"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -36,6 +38,37 @@ func (r Result) GetEvents() Events {
 	return events
 }
 
+// WrapServiceResult wraps the response of a Msg service method in a Result.
+// The response is proto marshaled in the Result's data and packed in its
+// MsgResponses, and the events emitted on the context's EventManager are
+// attached to the Result.
+func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	any, err := types.NewAnyWithValue(res)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []abci.Event
+	if evtMgr := ctx.EventManager(); evtMgr != nil {
+		events = evtMgr.ABCIEvents()
+	}
+
+	return &Result{
+		Data:         data,
+		Events:       events,
+		MsgResponses: []*types.Any{any},
+	}, nil
+}
+
 // ABCIMessageLogs represents a slice of ABCIMessageLog.
 type ABCIMessageLogs []ABCIMessageLog
 
@@ -241,7 +274,10 @@ func ParseABCILogs(logs string) (res ABCIMessageLogs, err error) {
 	return res, err
 }
 
-var _, _ types.UnpackInterfacesMessage = SearchTxsResult{}, TxResponse{}
+var (
+	_, _ types.UnpackInterfacesMessage = SearchTxsResult{}, TxResponse{}
+	_, _ types.UnpackInterfacesMessage = Result{}, SimulationResponse{}
+)
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 //
@@ -273,3 +309,22 @@ func (r TxResponse) GetTx() Tx {
 	}
 	return nil
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r Result) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range r.MsgResponses {
+		var res proto.Message
+		if err := unpacker.UnpackAny(any, &res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s SimulationResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if s.Result != nil {
+		return s.Result.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
@@ -150,6 +150,15 @@ type KVPair = types.KVPair
 // every trace operation.
 type TraceContext = types.TraceContext
 
+//----------------------------------------
+
+// WriteListener is notified of every write made to a KVStore it listens to.
+type WriteListener = types.WriteListener
+
+// StoreKVPair is a typed set or delete of a key in a KVStore, as reported to
+// a WriteListener.
+type StoreKVPair = types.StoreKVPair
+
 // --------------------------------------
 
 type (
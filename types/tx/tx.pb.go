@@ -658,6 +658,10 @@ type Fee struct {
 	// gas_limit is the maximum gas that can be used in transaction processing
 	// before an out of gas error occurs
 	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// granter is the account whose fee allowance pays the fee instead of the
+	// first signer. The granter must have granted a fee allowance to the first
+	// signer of the transaction.
+	Granter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=granter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"granter,omitempty"`
 }
 
 func (m *Fee) Reset()         { *m = Fee{} }
@@ -707,6 +711,13 @@ func (m *Fee) GetGasLimit() uint64 {
 	return 0
 }
 
+func (m *Fee) GetGranter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.TxRaw")
@@ -723,57 +734,59 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/tx.proto", fileDescriptor_9b35c9d5d6b7bce8) }
 
 var fileDescriptor_9b35c9d5d6b7bce8 = []byte{
-	// 795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xe3, 0x24, 0x8d, 0x5f, 0xff, 0xed, 0xce, 0x2e, 0x92, 0x9b, 0x0a, 0x37, 0x8a, 0x54,
-	0x14, 0x0e, 0xd8, 0xa5, 0x20, 0xf1, 0xe7, 0x82, 0x9a, 0xc2, 0xaa, 0x2b, 0x58, 0x40, 0x93, 0x8a,
-	0xc3, 0x5e, 0x2c, 0xdb, 0x99, 0x38, 0xa3, 0x8d, 0x67, 0x82, 0x67, 0xac, 0xda, 0x48, 0x7c, 0x07,
-	0x2e, 0x7c, 0x09, 0x0e, 0x7c, 0x03, 0xee, 0x7b, 0xdc, 0x23, 0x27, 0x40, 0xed, 0x07, 0x01, 0xcd,
-	0x78, 0x9c, 0x0d, 0xab, 0xee, 0xf6, 0xb2, 0xa7, 0xbc, 0xf9, 0xbd, 0xdf, 0x7b, 0xbf, 0x97, 0xf7,
-	0xc7, 0x80, 0x12, 0x2e, 0x32, 0x2e, 0x02, 0x59, 0x06, 0xb2, 0xf4, 0x57, 0x39, 0x97, 0x1c, 0x39,
-	0x35, 0xe6, 0xcb, 0x72, 0xf0, 0x30, 0xe5, 0x29, 0xd7, 0x68, 0xa0, 0xac, 0x9a, 0x30, 0x18, 0x98,
-	0xa0, 0x24, 0xaf, 0x56, 0x92, 0x9b, 0x1f, 0xe3, 0x7b, 0xd0, 0xf8, 0xea, 0x1c, 0x35, 0x78, 0xf4,
-	0x52, 0x45, 0xd0, 0x94, 0x51, 0x96, 0x36, 0xbf, 0x86, 0x70, 0x90, 0x72, 0x9e, 0x2e, 0x49, 0xa0,
-	0x5f, 0x71, 0x31, 0x0f, 0x22, 0x56, 0xd5, 0xae, 0xd1, 0xcf, 0xd0, 0xbe, 0x2c, 0xd1, 0x31, 0x74,
-	0x62, 0x3e, 0xab, 0x5c, 0x6b, 0x68, 0x8d, 0xb7, 0x4f, 0xef, 0xfb, 0xeb, 0x12, 0xfd, 0xcb, 0x72,
-	0xc2, 0x67, 0x15, 0xd6, 0x6e, 0x74, 0x02, 0x4e, 0x54, 0xc8, 0x45, 0x48, 0xd9, 0x9c, 0xbb, 0x6d,
-	0xcd, 0x7d, 0xb0, 0xc1, 0x3d, 0x2b, 0xe4, 0xe2, 0x31, 0x9b, 0x73, 0xdc, 0x8f, 0x8c, 0x85, 0x3c,
-	0x00, 0x55, 0x4a, 0x24, 0x8b, 0x9c, 0x08, 0xd7, 0x1e, 0xda, 0xe3, 0x1d, 0xbc, 0x81, 0x8c, 0x18,
-	0x74, 0x2f, 0x4b, 0x1c, 0x5d, 0xa1, 0x77, 0x01, 0x94, 0x44, 0x18, 0x57, 0x92, 0x08, 0x5d, 0xc7,
-	0x0e, 0x76, 0x14, 0x32, 0x51, 0x00, 0x7a, 0x0f, 0xf6, 0xd7, 0xca, 0x86, 0xd3, 0xd6, 0x9c, 0xdd,
-	0x46, 0xaa, 0xe6, 0xdd, 0xa5, 0xf7, 0x87, 0x05, 0x5b, 0x53, 0x9a, 0xb2, 0x2f, 0x79, 0xf2, 0xb6,
-	0x24, 0x0f, 0xa0, 0x9f, 0x2c, 0x22, 0xca, 0x42, 0x3a, 0x73, 0xed, 0xa1, 0x35, 0x76, 0xf0, 0x96,
-	0x7e, 0x3f, 0x9e, 0xa1, 0x63, 0xd8, 0x8b, 0x92, 0x84, 0x17, 0x4c, 0x86, 0xac, 0xc8, 0x62, 0x92,
-	0xbb, 0x9d, 0xa1, 0x35, 0xee, 0xe0, 0x5d, 0x83, 0x7e, 0xab, 0x41, 0xf4, 0x3e, 0xdc, 0x6b, 0x68,
-	0x82, 0xfc, 0x58, 0x10, 0x96, 0x10, 0xb7, 0xab, 0x89, 0xfb, 0x06, 0x9f, 0x1a, 0x78, 0xf4, 0x6b,
-	0x1b, 0x7a, 0xf5, 0x48, 0xd0, 0x09, 0xf4, 0x33, 0x22, 0x44, 0x94, 0xea, 0xe2, 0xed, 0xf1, 0xf6,
-	0xe9, 0x43, 0xbf, 0x9e, 0xb3, 0xdf, 0xcc, 0xd9, 0x3f, 0x63, 0x15, 0x5e, 0xb3, 0x10, 0x82, 0x4e,
-	0x46, 0xb2, 0x7a, 0x72, 0x0e, 0xd6, 0xb6, 0x2a, 0x51, 0xd2, 0x8c, 0xf0, 0x42, 0x86, 0x0b, 0x42,
-	0xd3, 0x85, 0xd4, 0xff, 0xc1, 0xc6, 0xbb, 0x06, 0xbd, 0xd0, 0x20, 0x9a, 0xc0, 0x7d, 0x52, 0x4a,
-	0xc2, 0x04, 0xe5, 0x2c, 0xe4, 0x2b, 0x49, 0x39, 0x13, 0xee, 0xbf, 0x5b, 0x6f, 0x90, 0xbd, 0xb7,
-	0xe6, 0x7f, 0x57, 0xd3, 0xd1, 0x53, 0xf0, 0x18, 0x67, 0x61, 0x92, 0x53, 0x49, 0x93, 0x68, 0x19,
-	0xde, 0x92, 0x70, 0xff, 0x0d, 0x09, 0x0f, 0x19, 0x67, 0xe7, 0x26, 0xf6, 0xab, 0x57, 0x72, 0x8f,
-	0xe6, 0xd0, 0x6f, 0xb6, 0x0f, 0x7d, 0x0a, 0x3b, 0x6a, 0xe2, 0x24, 0xd7, 0xa3, 0x6b, 0x9a, 0xf3,
-	0xce, 0xc6, 0xa2, 0x4e, 0xb5, 0x5b, 0xaf, 0xea, 0xb6, 0x58, 0xdb, 0x02, 0x0d, 0xc1, 0x9e, 0x13,
-	0x62, 0x36, 0x7b, 0x6f, 0x23, 0xe0, 0x11, 0x21, 0x58, 0xb9, 0x46, 0x57, 0x00, 0x2f, 0x83, 0xd1,
-	0x27, 0x00, 0xab, 0x22, 0x5e, 0xd2, 0x24, 0x7c, 0x46, 0x9a, 0xe3, 0x71, 0x9b, 0x30, 0x73, 0xb7,
-	0xdf, 0x6b, 0xc2, 0xd7, 0xa4, 0xc2, 0xce, 0xaa, 0x31, 0xd5, 0x21, 0x65, 0x7c, 0x46, 0x5e, 0x77,
-	0x48, 0x4f, 0xf8, 0x8c, 0xd4, 0x87, 0x94, 0x19, 0x6b, 0xf4, 0x7b, 0x1b, 0xfa, 0x0d, 0x8c, 0x3e,
-	0x86, 0x9e, 0xa0, 0x2c, 0x5d, 0x12, 0xa3, 0x39, 0xb8, 0x25, 0xd6, 0x9f, 0x6a, 0xc6, 0x45, 0x0b,
-	0x1b, 0x2e, 0xfa, 0x10, 0xba, 0x59, 0xb1, 0x94, 0xd4, 0x08, 0x1e, 0xdc, 0x16, 0xf4, 0x44, 0x11,
-	0x2e, 0x5a, 0xb8, 0x66, 0x0e, 0x3e, 0x83, 0x5e, 0x9d, 0x06, 0x05, 0xd0, 0x51, 0xb5, 0x68, 0xc1,
-	0xbd, 0xd3, 0xc3, 0x8d, 0xd8, 0xe6, 0x53, 0xa3, 0xfa, 0xa2, 0xf2, 0x60, 0x4d, 0x1c, 0x5c, 0x41,
-	0x57, 0x27, 0x43, 0x9f, 0x43, 0x3f, 0xa6, 0x32, 0xca, 0xf3, 0xa8, 0x69, 0x91, 0xf7, 0x4a, 0x8b,
-	0xce, 0x79, 0xb6, 0x8a, 0x12, 0x39, 0xa1, 0xf2, 0x4c, 0xb1, 0xf0, 0x9a, 0x8f, 0x4e, 0x01, 0xd6,
-	0x7d, 0x52, 0xe7, 0x67, 0xbf, 0xae, 0x51, 0x4e, 0xd3, 0x28, 0x31, 0xe9, 0x82, 0x2d, 0x8a, 0x6c,
-	0xf4, 0x13, 0xd8, 0x8f, 0x08, 0x41, 0x3f, 0x40, 0x2f, 0xca, 0xd4, 0x09, 0x99, 0x35, 0xd8, 0x69,
-	0xa2, 0xcf, 0x39, 0x65, 0x93, 0x93, 0xe7, 0x7f, 0x1d, 0xb5, 0x7e, 0xfb, 0xfb, 0x68, 0x9c, 0x52,
-	0xb9, 0x28, 0x62, 0x3f, 0xe1, 0x59, 0xf0, 0xbf, 0x2f, 0xec, 0x07, 0x62, 0xf6, 0x2c, 0x90, 0xd5,
-	0x8a, 0xd4, 0x01, 0x02, 0x9b, 0x6c, 0xe8, 0x10, 0x9c, 0x34, 0x12, 0xe1, 0x92, 0x66, 0x54, 0xea,
-	0x86, 0x76, 0x70, 0x3f, 0x8d, 0xc4, 0x37, 0xea, 0x3d, 0xf9, 0xe2, 0xf9, 0xb5, 0x67, 0xbd, 0xb8,
-	0xf6, 0xac, 0x7f, 0xae, 0x3d, 0xeb, 0x97, 0x1b, 0xaf, 0xf5, 0xe2, 0xc6, 0x6b, 0xfd, 0x79, 0xe3,
-	0xb5, 0x9e, 0x1e, 0xdf, 0x2d, 0x14, 0xc8, 0x32, 0xee, 0xe9, 0xcd, 0xff, 0xe8, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x40, 0xba, 0x1f, 0xb6, 0x40, 0x06, 0x00, 0x00,
+	// 825 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x45, 0x49, 0x96, 0xc6, 0xb2, 0x9d, 0x6c, 0x52, 0x40, 0x96, 0x51, 0x5a, 0x10, 0xe0,
+	0x42, 0x3d, 0x84, 0x74, 0xdc, 0x02, 0xfd, 0xb9, 0x14, 0x92, 0xdb, 0xc0, 0x41, 0x9a, 0xb6, 0x58,
+	0x1b, 0x3d, 0xe4, 0x42, 0x50, 0xe4, 0x8a, 0x5a, 0x44, 0xdc, 0x55, 0xb9, 0x4b, 0x58, 0x3c, 0xf4,
+	0x1d, 0x7a, 0xe9, 0x4b, 0xf4, 0xd0, 0x37, 0xe8, 0xb9, 0x39, 0xe6, 0xd8, 0x53, 0x5a, 0xd8, 0x6f,
+	0xd1, 0x4b, 0x8b, 0x5d, 0xee, 0x2a, 0x6a, 0xe0, 0x24, 0x3d, 0xe4, 0xa4, 0xd9, 0x6f, 0xbe, 0x99,
+	0x6f, 0x34, 0x3f, 0x04, 0x14, 0x73, 0x91, 0x71, 0x11, 0xc8, 0x55, 0x20, 0x57, 0xfe, 0x32, 0xe7,
+	0x92, 0xa3, 0x4e, 0x85, 0xf9, 0x72, 0xd5, 0xbf, 0x9b, 0xf2, 0x94, 0x6b, 0x34, 0x50, 0x56, 0x45,
+	0xe8, 0xf7, 0x4d, 0x50, 0x9c, 0x97, 0x4b, 0xc9, 0xcd, 0x8f, 0xf1, 0xdd, 0xb1, 0xbe, 0x2a, 0x47,
+	0x05, 0x1e, 0xbe, 0x54, 0x11, 0x34, 0x65, 0x94, 0xa5, 0xf6, 0xd7, 0x10, 0xf6, 0x53, 0xce, 0xd3,
+	0x05, 0x09, 0xf4, 0x6b, 0x5a, 0xcc, 0x82, 0x88, 0x95, 0x95, 0x6b, 0xf8, 0x23, 0xd4, 0x2f, 0x56,
+	0xe8, 0x08, 0x1a, 0x53, 0x9e, 0x94, 0x3d, 0x67, 0xe0, 0x8c, 0xb6, 0x4f, 0x6e, 0xfb, 0xeb, 0x12,
+	0xfd, 0x8b, 0xd5, 0x84, 0x27, 0x25, 0xd6, 0x6e, 0x74, 0x0c, 0x9d, 0xa8, 0x90, 0xf3, 0x90, 0xb2,
+	0x19, 0xef, 0xd5, 0x35, 0xf7, 0xce, 0x06, 0x77, 0x5c, 0xc8, 0xf9, 0x43, 0x36, 0xe3, 0xb8, 0x1d,
+	0x19, 0x0b, 0x79, 0x00, 0xaa, 0x94, 0x48, 0x16, 0x39, 0x11, 0x3d, 0x77, 0xe0, 0x8e, 0xba, 0x78,
+	0x03, 0x19, 0x32, 0x68, 0x5e, 0xac, 0x70, 0x74, 0x89, 0xde, 0x07, 0x50, 0x12, 0xe1, 0xb4, 0x94,
+	0x44, 0xe8, 0x3a, 0xba, 0xb8, 0xa3, 0x90, 0x89, 0x02, 0xd0, 0x07, 0xb0, 0xb7, 0x56, 0x36, 0x9c,
+	0xba, 0xe6, 0xec, 0x58, 0xa9, 0x8a, 0xf7, 0x36, 0xbd, 0xdf, 0x1c, 0xd8, 0x3a, 0xa7, 0x29, 0xfb,
+	0x92, 0xc7, 0xef, 0x4a, 0x72, 0x1f, 0xda, 0xf1, 0x3c, 0xa2, 0x2c, 0xa4, 0x49, 0xcf, 0x1d, 0x38,
+	0xa3, 0x0e, 0xde, 0xd2, 0xef, 0x87, 0x09, 0x3a, 0x82, 0xdd, 0x28, 0x8e, 0x79, 0xc1, 0x64, 0xc8,
+	0x8a, 0x6c, 0x4a, 0xf2, 0x5e, 0x63, 0xe0, 0x8c, 0x1a, 0x78, 0xc7, 0xa0, 0xdf, 0x68, 0x10, 0x7d,
+	0x08, 0xb7, 0x2c, 0x4d, 0x90, 0x1f, 0x0a, 0xc2, 0x62, 0xd2, 0x6b, 0x6a, 0xe2, 0x9e, 0xc1, 0xcf,
+	0x0d, 0x3c, 0xfc, 0xb9, 0x0e, 0xad, 0x6a, 0x24, 0xe8, 0x18, 0xda, 0x19, 0x11, 0x22, 0x4a, 0x75,
+	0xf1, 0xee, 0x68, 0xfb, 0xe4, 0xae, 0x5f, 0xcd, 0xd9, 0xb7, 0x73, 0xf6, 0xc7, 0xac, 0xc4, 0x6b,
+	0x16, 0x42, 0xd0, 0xc8, 0x48, 0x56, 0x4d, 0xae, 0x83, 0xb5, 0xad, 0x4a, 0x94, 0x34, 0x23, 0xbc,
+	0x90, 0xe1, 0x9c, 0xd0, 0x74, 0x2e, 0xf5, 0x7f, 0x70, 0xf1, 0x8e, 0x41, 0xcf, 0x34, 0x88, 0x26,
+	0x70, 0x9b, 0xac, 0x24, 0x61, 0x82, 0x72, 0x16, 0xf2, 0xa5, 0xa4, 0x9c, 0x89, 0xde, 0x3f, 0x5b,
+	0x6f, 0x90, 0xbd, 0xb5, 0xe6, 0x7f, 0x5b, 0xd1, 0xd1, 0x13, 0xf0, 0x18, 0x67, 0x61, 0x9c, 0x53,
+	0x49, 0xe3, 0x68, 0x11, 0xde, 0x90, 0x70, 0xef, 0x0d, 0x09, 0x0f, 0x18, 0x67, 0xa7, 0x26, 0xf6,
+	0xab, 0x57, 0x72, 0x0f, 0x67, 0xd0, 0xb6, 0xdb, 0x87, 0x3e, 0x85, 0xae, 0x9a, 0x38, 0xc9, 0xf5,
+	0xe8, 0x6c, 0x73, 0xde, 0xdb, 0x58, 0xd4, 0x73, 0xed, 0xd6, 0xab, 0xba, 0x2d, 0xd6, 0xb6, 0x40,
+	0x03, 0x70, 0x67, 0x84, 0x98, 0xcd, 0xde, 0xdd, 0x08, 0x78, 0x40, 0x08, 0x56, 0xae, 0xe1, 0x25,
+	0xc0, 0xcb, 0x60, 0xf4, 0x09, 0xc0, 0xb2, 0x98, 0x2e, 0x68, 0x1c, 0x3e, 0x25, 0xf6, 0x78, 0x7a,
+	0x36, 0xcc, 0xdc, 0xed, 0x77, 0x9a, 0xf0, 0x88, 0x94, 0xb8, 0xb3, 0xb4, 0xa6, 0x3a, 0xa4, 0x8c,
+	0x27, 0xe4, 0x75, 0x87, 0xf4, 0x98, 0x27, 0xa4, 0x3a, 0xa4, 0xcc, 0x58, 0xc3, 0x5f, 0xeb, 0xd0,
+	0xb6, 0x30, 0xfa, 0x18, 0x5a, 0x82, 0xb2, 0x74, 0x41, 0x8c, 0x66, 0xff, 0x86, 0x58, 0xff, 0x5c,
+	0x33, 0xce, 0x6a, 0xd8, 0x70, 0xd1, 0x7d, 0x68, 0x66, 0xc5, 0x42, 0x52, 0x23, 0xb8, 0x7f, 0x53,
+	0xd0, 0x63, 0x45, 0x38, 0xab, 0xe1, 0x8a, 0xd9, 0xff, 0x0c, 0x5a, 0x55, 0x1a, 0x14, 0x40, 0x43,
+	0xd5, 0xa2, 0x05, 0x77, 0x4f, 0x0e, 0x36, 0x62, 0xed, 0xa7, 0x46, 0xf5, 0x45, 0xe5, 0xc1, 0x9a,
+	0xd8, 0xbf, 0x84, 0xa6, 0x4e, 0x86, 0x3e, 0x87, 0xf6, 0x94, 0xca, 0x28, 0xcf, 0x23, 0xdb, 0x22,
+	0xef, 0x95, 0x16, 0x9d, 0xf2, 0x6c, 0x19, 0xc5, 0x72, 0x42, 0xe5, 0x58, 0xb1, 0xf0, 0x9a, 0x8f,
+	0x4e, 0x00, 0xd6, 0x7d, 0x52, 0xe7, 0xe7, 0xbe, 0xae, 0x51, 0x1d, 0xdb, 0x28, 0x31, 0x69, 0x82,
+	0x2b, 0x8a, 0x6c, 0xf8, 0xbb, 0x03, 0xee, 0x03, 0x42, 0xd0, 0xf7, 0xd0, 0x8a, 0x32, 0x75, 0x43,
+	0x66, 0x0f, 0xba, 0x36, 0xfc, 0x94, 0x53, 0x36, 0x39, 0x7e, 0xf6, 0xe2, 0xb0, 0xf6, 0xcb, 0x9f,
+	0x87, 0xa3, 0x94, 0xca, 0x79, 0x31, 0xf5, 0x63, 0x9e, 0x05, 0xff, 0xf9, 0xc4, 0xde, 0x13, 0xc9,
+	0xd3, 0x40, 0x96, 0x4b, 0x52, 0x05, 0x08, 0x6c, 0xb2, 0xa1, 0x03, 0xe8, 0xa4, 0x91, 0x08, 0x17,
+	0x34, 0xa3, 0x52, 0x77, 0xb4, 0x81, 0xdb, 0x69, 0x24, 0xbe, 0x56, 0x6f, 0xf4, 0x08, 0xb6, 0xd2,
+	0x3c, 0x62, 0x92, 0xe4, 0xfa, 0x9c, 0xba, 0x93, 0xfb, 0x7f, 0xbf, 0x38, 0xbc, 0xf7, 0x3f, 0x34,
+	0xc6, 0x71, 0x3c, 0x4e, 0x92, 0x9c, 0x08, 0x81, 0x6d, 0x86, 0xc9, 0x17, 0xcf, 0xae, 0x3c, 0xe7,
+	0xf9, 0x95, 0xe7, 0xfc, 0x75, 0xe5, 0x39, 0x3f, 0x5d, 0x7b, 0xb5, 0xe7, 0xd7, 0x5e, 0xed, 0x8f,
+	0x6b, 0xaf, 0xf6, 0xe4, 0xe8, 0xed, 0x19, 0x03, 0xb9, 0x9a, 0xb6, 0xf4, 0x1d, 0x7d, 0xf4, 0xef,
+	0x00, 0x5b, 0x24, 0x4c, 0x91, 0x8e, 0x06, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1280,6 +1293,13 @@ func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasLimit != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
 		i--
@@ -1538,6 +1558,10 @@ func (m *Fee) Size() (n int) {
 	if m.GasLimit != 0 {
 		n += 1 + sovTx(uint64(m.GasLimit))
 	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2902,6 +2926,40 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -26,3 +26,42 @@ func (m *TxBody) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	}
 	return nil
 }
+
+var (
+	_, _ codectypes.UnpackInterfacesMessage = &SimulateRequest{}, &SimulateResponse{}
+	_, _ codectypes.UnpackInterfacesMessage = &GetTxResponse{}, &GetTxsEventResponse{}
+)
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *SimulateRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Tx != nil {
+		return m.Tx.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *SimulateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Result != nil {
+		return m.Result.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *GetTxResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Tx != nil {
+		return m.Tx.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *GetTxsEventResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, tx := range m.Txs {
+		if err := tx.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
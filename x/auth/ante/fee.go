@@ -3,6 +3,8 @@ package ante
 import (
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -58,8 +60,90 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// GlobalMinGasPriceDecorator will check if the transaction's fee is at least as
+// large as the global minimum gas prices, defined by the MinGasPrices auth param.
+// Unlike the MempoolFeeDecorator, the check applies in both CheckTx and
+// DeliverTx, so that blocks cannot include transactions paying lower fees.
+// The transactions whose messages are all of the BypassMinFeeMsgTypes param and
+// whose gas limit is at most the MaxTotalBypassMinFeeMsgGasUsage param, the
+// genesis transactions and the simulations are exempt from the check.
+// CONTRACT: Tx must implement FeeTx to use GlobalMinGasPriceDecorator
+type GlobalMinGasPriceDecorator struct {
+	ak AccountKeeper
+}
+
+func NewGlobalMinGasPriceDecorator(ak AccountKeeper) GlobalMinGasPriceDecorator {
+	return GlobalMinGasPriceDecorator{
+		ak: ak,
+	}
+}
+
+func (gmd GlobalMinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	// like the local min gas prices check, the global check is not charged gas
+	params := gmd.ak.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	if params.MinGasPrices.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// the gas of the bypass messages is capped, so that they can't be used to
+	// fill blocks for free
+	if bypassMinFee(params.BypassMinFeeMsgTypes, feeTx.GetMsgs()) && feeTx.GetGas() <= params.MaxTotalBypassMinFeeMsgGasUsage {
+		return next(ctx, tx, simulate)
+	}
+
+	feeCoins := feeTx.GetFee()
+	requiredFees := make(sdk.Coins, len(params.MinGasPrices))
+
+	// Determine the required fees by multiplying each required minimum gas
+	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+	glDec := sdk.NewDec(int64(feeTx.GetGas()))
+	for i, gp := range params.MinGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	if !feeCoins.IsAnyGTE(requiredFees) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee, "insufficient fees for the global min gas prices; got: %s required: %s", feeCoins, requiredFees,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// bypassMinFee returns true if all the messages are of the given bypass message
+// type URLs.
+func bypassMinFee(bypassMsgTypes []string, msgs []sdk.Msg) bool {
+	if len(bypassMsgTypes) == 0 || len(msgs) == 0 {
+		return false
+	}
+
+	bypass := make(map[string]bool, len(bypassMsgTypes))
+	for _, msgType := range bypassMsgTypes {
+		bypass[msgType] = true
+	}
+
+	for _, msg := range msgs {
+		if !bypass["/"+proto.MessageName(msg)] {
+			return false
+		}
+	}
+
+	return true
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
+// Txs setting a fee granter are rejected, as fee grants require the feegrant module's decorator
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
@@ -84,6 +168,10 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	if feeGranter := feeTx.FeeGranter(); !feeGranter.Empty() {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "fee grants are not enabled; fee granter: %s", feeGranter)
+	}
+
 	feePayer := feeTx.FeePayer()
 	feePayerAcc := dfd.ak.GetAccount(ctx, feePayer)
 
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -10,7 +11,9 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
@@ -64,6 +67,79 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	suite.Require().Nil(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func (suite *AnteTestSuite) TestGlobalMinGasPrices() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	gmd := ante.NewGlobalMinGasPriceDecorator(suite.app.AccountKeeper)
+	antehandler := sdk.ChainAnteDecorators(gmd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.txBuilder.SetMsgs(msg)
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+
+	// no fee is required without global min gas prices
+	_, err := antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// Set high global gas price so standard test fee fails
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(200).Quo(sdk.NewDec(100000))))
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	// antehandler errors with insufficient fees in both DeliverTx and CheckTx
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrInsufficientFee), "Decorator should have errored on too low fee for global gasPrice")
+
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true), tx, false)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrInsufficientFee), "Decorator should have errored on too low fee for global gasPrice")
+
+	// simulations and genesis transactions are exempt
+	_, err = antehandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx.WithBlockHeight(0), tx, false)
+	suite.Require().NoError(err)
+
+	// transactions of bypass messages are exempt
+	params.BypassMinFeeMsgTypes = []string{"/testdata.TestMsg"}
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// unless their gas limit exceeds the max total gas of bypass messages
+	params.MaxTotalBypassMinFeeMsgGasUsage = gasLimit - 1
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrInsufficientFee), "Decorator should have errored on bypass tx above the max gas")
+
+	params.MaxTotalBypassMinFeeMsgGasUsage = gasLimit
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// fees meeting the global gas price are accepted
+	params.BypassMinFeeMsgTypes = types.DefaultBypassMinFeeMsgTypes()
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(150).Quo(sdk.NewDec(100000))))
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+}
+
 func (suite *AnteTestSuite) TestDeductFees() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -101,6 +177,15 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	_, err = antehandler(suite.ctx, tx, false)
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
+
+	// fee grants are not supported by the auth module
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	suite.txBuilder.SetFeeGranter(addr2)
+	tx = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+
+	_, err = antehandler(suite.ctx, tx, false)
+
+	suite.Require().NotNil(err, "Tx did not error when setting a fee granter")
 }
 
 func TestAnteFeeTestSuite(t *testing.T) {
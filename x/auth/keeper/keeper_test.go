@@ -118,6 +118,31 @@ func TestGetSetParams(t *testing.T) {
 	require.Equal(t, params, actualParams)
 }
 
+func TestGetParamsGlobalMinGasPricesGas(t *testing.T) {
+	app, ctx := createTestApp(true)
+	params := types.DefaultParams()
+	app.AccountKeeper.SetParams(ctx, params)
+
+	getParamsGas := func() sdk.Gas {
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		app.AccountKeeper.GetParams(gasCtx)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+	gas := getParamsGas()
+
+	// the global min gas prices params are not charged gas
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3)))
+	params.BypassMinFeeMsgTypes = append(params.BypassMinFeeMsgTypes, "/cosmos.bank.v1beta1.MsgSend")
+	params.MaxTotalBypassMinFeeMsgGasUsage = 123456789
+	app.AccountKeeper.SetParams(ctx, params)
+	require.Equal(t, gas, getParamsGas())
+
+	// unlike the other params
+	params.MaxMemoCharacters = 123456789
+	app.AccountKeeper.SetParams(ctx, params)
+	require.Greater(t, uint64(getParamsGas()), uint64(gas))
+}
+
 func TestSupply_ValidatePermissions(t *testing.T) {
 	app, _ := createTestApp(true)
 
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrator is a struct for handling in-place store migrations of the auth
+// module.
+type Migrator struct {
+	keeper AccountKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper AccountKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the auth store from consensus version 1 to 2. Version 2
+// adds the MinGasPrices, BypassMinFeeMsgTypes and MaxTotalBypassMinFeeMsgGasUsage
+// params, which are set to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	subspace := m.keeper.paramSubspace
+
+	if !subspace.Has(ctx, types.KeyMinGasPrices) {
+		subspace.Set(ctx, types.KeyMinGasPrices, types.DefaultMinGasPrices())
+	}
+
+	if !subspace.Has(ctx, types.KeyBypassMinFeeMsgTypes) {
+		subspace.Set(ctx, types.KeyBypassMinFeeMsgTypes, types.DefaultBypassMinFeeMsgTypes())
+	}
+
+	if !subspace.Has(ctx, types.KeyMaxTotalBypassMinFeeMsgGasUsage) {
+		subspace.Set(ctx, types.KeyMaxTotalBypassMinFeeMsgGasUsage, types.DefaultMaxTotalBypassMinFeeMsgGasUsage)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	// remove the params added by version 2
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	store.Delete(types.KeyMinGasPrices)
+	store.Delete(types.KeyBypassMinFeeMsgTypes)
+	store.Delete(types.KeyMaxTotalBypassMinFeeMsgGasUsage)
+	require.Panics(t, func() { app.AccountKeeper.GetParams(ctx) })
+
+	migrator := keeper.NewMigrator(app.AccountKeeper)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+	require.Equal(t, types.DefaultParams(), app.AccountKeeper.GetParams(ctx))
+
+	// migrating keeps the params already set
+	params := types.DefaultParams()
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3)))
+	app.AccountKeeper.SetParams(ctx, params)
+
+	require.NoError(t, migrator.Migrate1to2(ctx))
+	require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
+}
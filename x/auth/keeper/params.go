@@ -1,17 +1,48 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// globalMinGasPriceParamKeys are the keys of the params of the global min gas
+// prices, which are read without charging gas.
+var globalMinGasPriceParamKeys = [][]byte{
+	types.KeyMinGasPrices,
+	types.KeyBypassMinFeeMsgTypes,
+	types.KeyMaxTotalBypassMinFeeMsgGasUsage,
+}
+
 // SetParams sets the auth module's parameters.
 func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
 	ak.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. Like the local min gas prices
+// check, the global min gas prices params are not charged gas, so that reading
+// the params in the ante handler costs the same gas as without them.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
+	freeCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	for _, pair := range params.ParamSetPairs() {
+		if isGlobalMinGasPriceParamKey(pair.Key) {
+			ak.paramSubspace.Get(freeCtx, pair.Key, pair.Value)
+		} else {
+			ak.paramSubspace.Get(ctx, pair.Key, pair.Value)
+		}
+	}
+
 	return
 }
+
+func isGlobalMinGasPriceParamKey(key []byte) bool {
+	for _, paramKey := range globalMinGasPriceParamKeys {
+		if bytes.Equal(key, paramKey) {
+			return true
+		}
+	}
+
+	return false
+}
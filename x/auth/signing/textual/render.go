@@ -0,0 +1,366 @@
+package textual
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxLineLength is the maximum number of characters of a rendered line,
+	// excluding its indentation. Longer lines are wrapped on continuation lines.
+	MaxLineLength = 64
+
+	// Indentation is prepended to a line once per nesting level.
+	Indentation = "  "
+
+	// ContinuationPrefix starts the continuation lines of a wrapped line.
+	ContinuationPrefix = "> "
+)
+
+var (
+	addressType   = reflect.TypeOf((*sdk.Address)(nil)).Elem()
+	stringerType  = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	anyType       = reflect.TypeOf(codectypes.Any{})
+	coinType      = reflect.TypeOf(sdk.Coin{})
+	coinsType     = reflect.TypeOf(sdk.Coins{})
+	decCoinType   = reflect.TypeOf(sdk.DecCoin{})
+	decCoinsType  = reflect.TypeOf(sdk.DecCoins{})
+	intType       = reflect.TypeOf(sdk.Int{})
+	decType       = reflect.TypeOf(sdk.Dec{})
+	timeType      = reflect.TypeOf(time.Time{})
+	timestampType = reflect.TypeOf(gogotypes.Timestamp{})
+	durationType  = reflect.TypeOf(time.Duration(0))
+)
+
+// line is a rendered line before its indentation and wrapping
+type line struct {
+	indent int
+	text   string
+}
+
+// renderer renders protobuf messages into human-readable lines. Every field
+// is rendered as "Title: value", or as a "Title:" line followed by its nested
+// fields one level deeper. Empty fields are omitted.
+type renderer struct {
+	lines []line
+}
+
+func (r *renderer) add(indent int, text string) {
+	r.lines = append(r.lines, line{indent: indent, text: text})
+}
+
+// renderFields renders the protobuf fields of a message struct.
+func (r *renderer) renderFields(indent int, v reflect.Value) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+
+		if field.PkgPath != "" || fv.IsZero() {
+			continue
+		}
+
+		// the value of a oneof is a pointer to a wrapper struct holding the
+		// set field
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			if err := r.renderFields(indent, fv.Elem().Elem()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := protoFieldName(field)
+		if name == "" {
+			continue
+		}
+
+		if err := r.renderField(indent, fieldTitle(name), fv); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renderField renders a value with the given title.
+func (r *renderer) renderField(indent int, title string, v reflect.Value) error {
+	if s, ok := renderScalar(v); ok {
+		r.add(indent, fmt.Sprintf("%s: %s", title, s))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			r.add(indent, fmt.Sprintf("%s: <nil>", title))
+			return nil
+		}
+
+		return r.renderField(indent, title, v.Elem())
+
+	case reflect.Slice, reflect.Array:
+		n := v.Len()
+		for i := 0; i < n; i++ {
+			if err := r.renderField(indent, fmt.Sprintf("%s (%d/%d)", title, i+1, n), v.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case reflect.Map:
+		keys := make([]string, 0, v.Len())
+		values := make(map[string]reflect.Value, v.Len())
+
+		for _, key := range v.MapKeys() {
+			s, ok := renderScalar(key)
+			if !ok {
+				return fmt.Errorf("cannot render map key of type %s", key.Type())
+			}
+
+			keys = append(keys, s)
+			values[s] = v.MapIndex(key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if err := r.renderField(indent, fmt.Sprintf("%s (%s)", title, key), values[key]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case reflect.Struct:
+		if v.Type() == anyType {
+			any := v.Interface().(codectypes.Any)
+			return r.renderAny(indent, title, &any)
+		}
+
+		r.add(indent, fmt.Sprintf("%s:", title))
+		return r.renderFields(indent+1, v)
+
+	default:
+		return fmt.Errorf("cannot render value of type %s", v.Type())
+	}
+}
+
+// renderAny renders the type URL of an Any followed by the fields of its
+// cached value, or its raw value if it was not unpacked.
+func (r *renderer) renderAny(indent int, title string, any *codectypes.Any) error {
+	r.add(indent, fmt.Sprintf("%s: %s", title, any.TypeUrl))
+
+	cached := any.GetCachedValue()
+	if cached == nil {
+		if len(any.Value) > 0 {
+			r.add(indent+1, fmt.Sprintf("Value: %s", strings.ToUpper(hex.EncodeToString(any.Value))))
+		}
+
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(cached))
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot render Any value of type %T", cached)
+	}
+
+	return r.renderFields(indent+1, v)
+}
+
+// format indents the rendered lines and wraps the lines longer than
+// MaxLineLength.
+func (r *renderer) format() []string {
+	lines := make([]string, 0, len(r.lines))
+
+	for _, l := range r.lines {
+		indent := strings.Repeat(Indentation, l.indent)
+		text := []rune(l.text)
+
+		n := MaxLineLength
+		if len(text) < n {
+			n = len(text)
+		}
+
+		lines = append(lines, indent+string(text[:n]))
+		text = text[n:]
+
+		for len(text) > 0 {
+			n = MaxLineLength - len(ContinuationPrefix)
+			if len(text) < n {
+				n = len(text)
+			}
+
+			lines = append(lines, indent+ContinuationPrefix+string(text[:n]))
+			text = text[n:]
+		}
+	}
+
+	return lines
+}
+
+// renderScalar renders the values displayed on a single line: coins,
+// numbers, timestamps, durations, addresses, strings, bytes and enums.
+func renderScalar(v reflect.Value) (string, bool) {
+	t := v.Type()
+
+	switch t {
+	case coinType:
+		return formatCoin(v.Interface().(sdk.Coin)), true
+
+	case coinsType:
+		coins := v.Interface().(sdk.Coins)
+		strs := make([]string, len(coins))
+		for i, coin := range coins {
+			strs[i] = formatCoin(coin)
+		}
+
+		return strings.Join(strs, ", "), true
+
+	case decCoinType:
+		return formatDecCoin(v.Interface().(sdk.DecCoin)), true
+
+	case decCoinsType:
+		coins := v.Interface().(sdk.DecCoins)
+		strs := make([]string, len(coins))
+		for i, coin := range coins {
+			strs[i] = formatDecCoin(coin)
+		}
+
+		return strings.Join(strs, ", "), true
+
+	case intType:
+		return v.Interface().(sdk.Int).String(), true
+
+	case decType:
+		return formatDec(v.Interface().(sdk.Dec)), true
+
+	case timeType:
+		return formatTime(v.Interface().(time.Time)), true
+
+	case timestampType:
+		ts := v.Interface().(gogotypes.Timestamp)
+		tm, err := gogotypes.TimestampFromProto(&ts)
+		if err != nil {
+			return ts.String(), true
+		}
+
+		return formatTime(tm), true
+
+	case durationType:
+		return v.Interface().(time.Duration).String(), true
+	}
+
+	if t.Kind() != reflect.Ptr && t.Implements(addressType) {
+		return v.Interface().(sdk.Address).String(), true
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// protobuf enums are rendered by name
+		if t.Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String(), true
+		}
+
+		return strconv.FormatInt(v.Int(), 10), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), true
+
+	case reflect.String:
+		// strings are quoted so that they cannot forge additional lines
+		return strconv.QuoteToASCII(v.String()), true
+
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return strings.ToUpper(hex.EncodeToString(v.Bytes())), true
+		}
+
+	case reflect.Struct:
+		// non-message structs, such as custom numeric types, are rendered by
+		// their String method
+		if !hasProtoFields(t) && t.Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String(), true
+		}
+	}
+
+	return "", false
+}
+
+func formatCoin(coin sdk.Coin) string {
+	return fmt.Sprintf("%s %s", coin.Amount, coin.Denom)
+}
+
+func formatDecCoin(coin sdk.DecCoin) string {
+	return fmt.Sprintf("%s %s", formatDec(coin.Amount), coin.Denom)
+}
+
+// formatDec renders a decimal without its trailing zeros.
+func formatDec(dec sdk.Dec) string {
+	if dec.IsNil() {
+		return "0"
+	}
+
+	s := dec.String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// protoFieldName returns the protobuf name of a struct field, or an empty
+// string if the field is not a protobuf field.
+func protoFieldName(field reflect.StructField) string {
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
+
+// hasProtoFields returns true if the struct type has protobuf fields.
+func hasProtoFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if protoFieldName(field) != "" {
+			return true
+		}
+
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldTitle turns a protobuf field name into a human-readable title,
+// e.g. from_address into "From address".
+func fieldTitle(name string) string {
+	title := strings.ReplaceAll(name, "_", " ")
+	return strings.ToUpper(title[:1]) + title[1:]
+}
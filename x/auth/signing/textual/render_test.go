@@ -0,0 +1,120 @@
+package textual
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type testNested struct {
+	Enabled bool  `protobuf:"varint,1,opt,name=enabled,proto3"`
+	Count   int64 `protobuf:"varint,2,opt,name=count,proto3"`
+}
+
+type testMessage struct {
+	Sender    sdk.AccAddress        `protobuf:"bytes,1,opt,name=sender,proto3"`
+	Validator sdk.ValAddress        `protobuf:"bytes,2,opt,name=validator,proto3"`
+	Amount    sdk.Coins             `protobuf:"bytes,3,rep,name=amount,proto3"`
+	Rewards   sdk.DecCoins          `protobuf:"bytes,4,rep,name=rewards,proto3"`
+	Rate      sdk.Dec               `protobuf:"bytes,5,opt,name=rate,proto3"`
+	Shares    sdk.Int               `protobuf:"bytes,6,opt,name=shares,proto3"`
+	Time      time.Time             `protobuf:"bytes,7,opt,name=time,proto3"`
+	Period    time.Duration         `protobuf:"bytes,8,opt,name=period,proto3"`
+	Mode      signingtypes.SignMode `protobuf:"varint,9,opt,name=mode,proto3"`
+	Data      []byte                `protobuf:"bytes,10,opt,name=data,proto3"`
+	Nested    *testNested           `protobuf:"bytes,11,opt,name=nested,proto3"`
+	Items     []testNested          `protobuf:"bytes,12,rep,name=items,proto3"`
+	Labels    map[string]uint32     `protobuf:"bytes,13,rep,name=labels,proto3"`
+	Animal    *codectypes.Any       `protobuf:"bytes,14,opt,name=animal,proto3"`
+	Empty     string                `protobuf:"bytes,15,opt,name=empty,proto3"`
+	Raw       *codectypes.Any       `protobuf:"bytes,16,opt,name=raw,proto3"`
+	Ignored   string                `json:"ignored"`
+	Weights   []sdk.Dec             `protobuf:"bytes,18,rep,name=weights,proto3"`
+}
+
+func TestRenderFields(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	valAddr := sdk.ValAddress("val_________________")
+
+	animal, err := codectypes.NewAnyWithValue(&testdata.Dog{Name: "Spot", Size_: "small"})
+	require.NoError(t, err)
+
+	msg := testMessage{
+		Sender:    addr,
+		Validator: valAddr,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 5)),
+		Rewards:   sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 1))),
+		Rate:      sdk.NewDecWithPrec(1, 1),
+		Shares:    sdk.NewInt(100),
+		Time:      time.Date(2020, 7, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600)),
+		Period:    90 * time.Minute,
+		Mode:      signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+		Data:      []byte{0xca, 0xfe},
+		Nested:    &testNested{Enabled: true},
+		Items:     []testNested{{Count: 1}, {Count: -2}},
+		Labels:    map[string]uint32{"b": 2, "a": 1},
+		Animal:    animal,
+		Raw:       &codectypes.Any{TypeUrl: "/unknown", Value: []byte{0x01}},
+		Ignored:   "ignored",
+		Weights:   []sdk.Dec{sdk.OneDec(), sdk.NewDecWithPrec(25, 2)},
+	}
+
+	r := &renderer{}
+	require.NoError(t, r.renderFields(0, reflect.ValueOf(msg)))
+	require.Equal(t, []string{
+		"Sender: " + addr.String(),
+		"Validator: " + valAddr.String(),
+		"Amount: 10 atom, 5 stake",
+		"Rewards: 1.5 atom",
+		"Rate: 0.1",
+		"Shares: 100",
+		"Time: 2020-07-01T11:30:00Z",
+		"Period: 1h30m0s",
+		"Mode: SIGN_MODE_TEXTUAL",
+		"Data: CAFE",
+		"Nested:",
+		"  Enabled: true",
+		"Items (1/2):",
+		"  Count: 1",
+		"Items (2/2):",
+		"  Count: -2",
+		`Labels ("a"): 1`,
+		`Labels ("b"): 2`,
+		"Animal: /testdata.Dog",
+		`  Size: "small"`,
+		`  Name: "Spot"`,
+		"Raw: /unknown",
+		"  Value: 01",
+		"Weights (1/2): 1",
+		"Weights (2/2): 0.25",
+	}, r.format())
+}
+
+func TestFormat(t *testing.T) {
+	r := &renderer{}
+	r.add(0, "Short: line")
+	r.add(2, "Long: "+strings.Repeat("x", 2*MaxLineLength))
+
+	lines := r.format()
+	require.Len(t, lines, 4)
+	require.Equal(t, "Short: line", lines[0])
+	require.Len(t, lines[1], 2*len(Indentation)+MaxLineLength)
+	require.Len(t, lines[2], 2*len(Indentation)+MaxLineLength)
+	require.Equal(t, "    "+ContinuationPrefix, lines[2][:4+len(ContinuationPrefix)])
+	// the continuation lines hold the characters left after the first two lines
+	rest := len("Long: ") + 2*MaxLineLength - MaxLineLength - (MaxLineLength - len(ContinuationPrefix))
+	require.Equal(t, "    "+ContinuationPrefix+strings.Repeat("x", rest), lines[3])
+}
+
+func TestFieldTitle(t *testing.T) {
+	require.Equal(t, "From address", fieldTitle("from_address"))
+	require.Equal(t, "Amount", fieldTitle("amount"))
+}
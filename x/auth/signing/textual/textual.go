@@ -0,0 +1,101 @@
+package textual
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/direct"
+)
+
+// ProtoTx defines an interface which protobuf transactions must implement for
+// signature verification via SignModeTextual
+type ProtoTx interface {
+	direct.ProtoTx
+
+	// GetBody returns the decoded TxBody
+	GetBody() *types.TxBody
+
+	// GetAuthInfo returns the decoded AuthInfo
+	GetAuthInfo() *types.AuthInfo
+}
+
+// ModeHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. The sign bytes are
+// the newline-separated lines of the textual rendering of the transaction,
+// which can be displayed as such by hardware wallets.
+type ModeHandler struct{}
+
+var _ signing.SignModeHandler = ModeHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (ModeHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (ModeHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h ModeHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	lines, err := h.Render(data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// Render returns the textual rendering of a transaction for the provided
+// SignerData. It is a deterministic list of lines of at most MaxLineLength
+// characters, excluding their indentation: the chain ID, account number and
+// sequence of the signer, the fields of the TxBody, including the fields of
+// each message, the fields of the AuthInfo, and finally the hash of the
+// SIGN_MODE_DIRECT sign bytes, which binds the signature to the binary
+// encoding of the transaction.
+func (ModeHandler) Render(data signing.SignerData, tx sdk.Tx) ([]string, error) {
+	protoTx, ok := tx.(ProtoTx)
+	if !ok {
+		return nil, fmt.Errorf("can only get textual sign bytes for a ProtoTx, got %T", tx)
+	}
+
+	r := &renderer{}
+	r.add(0, fmt.Sprintf("Chain ID: %s", data.ChainID))
+	r.add(0, fmt.Sprintf("Account number: %d", data.AccountNumber))
+	r.add(0, fmt.Sprintf("Sequence: %d", data.AccountSequence))
+
+	if body := protoTx.GetBody(); body != nil {
+		if err := r.renderFields(0, reflect.ValueOf(body).Elem()); err != nil {
+			return nil, err
+		}
+	}
+
+	if authInfo := protoTx.GetAuthInfo(); authInfo != nil {
+		if err := r.renderFields(0, reflect.ValueOf(authInfo).Elem()); err != nil {
+			return nil, err
+		}
+	}
+
+	signBz, err := direct.SignBytes(
+		protoTx.GetBodyBytes(), protoTx.GetAuthInfoBytes(), data.ChainID, data.AccountNumber, data.AccountSequence,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(signBz)
+	r.add(0, fmt.Sprintf("Hash of raw bytes: %s", strings.ToUpper(hex.EncodeToString(hash[:]))))
+
+	return r.format(), nil
+}
@@ -0,0 +1,152 @@
+package textual_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/direct"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	pubKeyCdc := std.DefaultPublicKeyCodec{}
+
+	txGen := tx.NewTxConfig(marshaler, pubKeyCdc, tx.DefaultSignModeHandler())
+	txBuilder := txGen.NewTxBuilder()
+
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo\nFee: 0atom")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+	}
+	sig := signingtypes.SignatureV2{
+		PubKey: pubkey,
+		Data:   sigData,
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	t.Log("verify modes and default-mode")
+	textualModeHandler := textual.ModeHandler{}
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, textualModeHandler.DefaultMode())
+	require.Len(t, textualModeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		ChainID:         "test-chain",
+		AccountNumber:   1,
+		AccountSequence: 2,
+	}
+
+	t.Log("verify the rendering of the transaction")
+	lines, err := textualModeHandler.Render(signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	pk, err := pubKeyCdc.Encode(pubkey)
+	require.NoError(t, err)
+	directBytes, err := direct.ModeHandler{}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	hash := fmt.Sprintf("%X", sha256.Sum256(directBytes))
+	pkHex := fmt.Sprintf("%X", pk.GetSecp256K1())
+
+	require.Equal(t, []string{
+		"Chain ID: test-chain",
+		"Account number: 1",
+		"Sequence: 2",
+		"Messages (1/1): /testdata.TestMsg",
+		fmt.Sprintf("  Signers (1/1): %s", addr),
+		// the newline of the memo is escaped so that it cannot forge a line
+		`Memo: "sometestmemo\nFee: 0atom"`,
+		"Signer infos (1/1):",
+		"  Public key:",
+		// long lines are wrapped
+		"    Secp256k1: " + pkHex[:textual.MaxLineLength-len("Secp256k1: ")],
+		"    > " + pkHex[textual.MaxLineLength-len("Secp256k1: "):],
+		"  Mode info:",
+		"    Single:",
+		"      Mode: SIGN_MODE_TEXTUAL",
+		"Fee:",
+		"  Amount: 150 atom",
+		"  Gas limit: 20000",
+		"Hash of raw bytes: " + hash[:textual.MaxLineLength-len("Hash of raw bytes: ")],
+		"> " + hash[textual.MaxLineLength-len("Hash of raw bytes: "):],
+	}, lines)
+
+	signBytes, err := textualModeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, []byte(strings.Join(lines, "\n")), signBytes)
+
+	t.Log("verify the signature over the rendering")
+	sigData.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	signBytes2, err := textualModeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, signBytes2)
+
+	require.NoError(t, signing.VerifySignature(pubkey, signingData, sigData, txGen.SignModeHandler(), txBuilder.GetTx()))
+
+	t.Log("verify the signature over a decoded transaction")
+	txBz, err := txGen.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	decoded, err := txGen.TxDecoder()(txBz)
+	require.NoError(t, err)
+	require.NoError(t, signing.VerifySignature(pubkey, signingData, sigData, txGen.SignModeHandler(), decoded))
+
+	t.Log("verify the signature fails over a different rendering")
+	signingData.AccountSequence = 3
+	require.Error(t, signing.VerifySignature(pubkey, signingData, sigData, txGen.SignModeHandler(), txBuilder.GetTx()))
+}
+
+func TestTextualModeHandler_nonTEXTUAL_MODE(t *testing.T) {
+	invalidModes := []signingtypes.SignMode{
+		signingtypes.SignMode_SIGN_MODE_DIRECT,
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingtypes.SignMode_SIGN_MODE_UNSPECIFIED,
+	}
+	for _, invalidMode := range invalidModes {
+		t.Run(invalidMode.String(), func(t *testing.T) {
+			var th textual.ModeHandler
+			var signingData signing.SignerData
+			_, err := th.GetSignBytes(invalidMode, signingData, nil)
+			require.Error(t, err)
+			wantErr := fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, invalidMode)
+			require.Equal(t, err, wantErr)
+		})
+	}
+}
+
+type nonProtoTx int
+
+func (npt *nonProtoTx) GetMsgs() []sdk.Msg   { return nil }
+func (npt *nonProtoTx) ValidateBasic() error { return nil }
+
+var _ sdk.Tx = (*nonProtoTx)(nil)
+
+func TestTextualModeHandler_nonProtoTx(t *testing.T) {
+	var th textual.ModeHandler
+	var signingData signing.SignerData
+	tx := new(nonProtoTx)
+	_, err := th.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.Error(t, err)
+	wantErr := fmt.Errorf("can only get textual sign bytes for a ProtoTx, got %T", tx)
+	require.Equal(t, err, wantErr)
+}
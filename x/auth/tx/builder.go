@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing/direct"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
 
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
@@ -45,6 +46,8 @@ var (
 	_ authsigning.SigFeeMemoTx = &builder{}
 	_ client.TxBuilder         = &builder{}
 	_ direct.ProtoTx           = &builder{}
+	_ textual.ProtoTx          = &builder{}
+	_ protoTxProvider          = &builder{}
 )
 
 func newBuilder(marshaler codec.Marshaler, pubkeyCodec types.PublicKeyCodec) *builder {
@@ -148,6 +151,18 @@ func (t *builder) GetAuthInfoBytes() []byte {
 	return t.authInfoBz
 }
 
+func (t *builder) GetBody() *tx.TxBody {
+	return t.tx.Body
+}
+
+func (t *builder) GetAuthInfo() *tx.AuthInfo {
+	return t.tx.AuthInfo
+}
+
+func (t *builder) GetProtoTx() *tx.Tx {
+	return t.tx
+}
+
 func (t *builder) GetSigners() []sdk.AccAddress {
 	var signers []sdk.AccAddress
 	seen := map[string]bool{}
@@ -198,6 +213,10 @@ func (t *builder) FeePayer() sdk.AccAddress {
 	return t.GetSigners()[0]
 }
 
+func (t *builder) FeeGranter() sdk.AccAddress {
+	return t.tx.AuthInfo.Fee.Granter
+}
+
 func (t *builder) GetMemo() string {
 	return t.tx.Body.Memo
 }
@@ -276,6 +295,17 @@ func (t *builder) SetFeeAmount(coins sdk.Coins) {
 	t.authInfoBz = nil
 }
 
+func (t *builder) SetFeeGranter(feeGranter sdk.AccAddress) {
+	if t.tx.AuthInfo.Fee == nil {
+		t.tx.AuthInfo.Fee = &tx.Fee{}
+	}
+
+	t.tx.AuthInfo.Fee.Granter = feeGranter
+
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	t.authInfoBz = nil
+}
+
 func (t *builder) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
@@ -0,0 +1,260 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// defaultLimit is the default number of txs returned by GetTxsEvent when the
+// request does not supply a limit
+const defaultLimit = 100
+
+// BaseAppSimulateFn is the signature of the BaseApp.Simulate function.
+type BaseAppSimulateFn func(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error)
+
+// protoTxProvider is a transaction wrapping a protobuf Tx.
+type protoTxProvider interface {
+	GetProtoTx() *txtypes.Tx
+}
+
+// txServer is the server for the cosmos.tx Service. Transactions are
+// broadcasted and queried through the Tendermint node of the client context,
+// and decoded with its TxConfig.
+type txServer struct {
+	clientCtx client.Context
+	simulate  BaseAppSimulateFn
+}
+
+var _ txtypes.ServiceServer = txServer{}
+
+// NewTxServer returns a new cosmos.tx ServiceServer.
+func NewTxServer(clientCtx client.Context, simulate BaseAppSimulateFn) txtypes.ServiceServer {
+	return txServer{
+		clientCtx: clientCtx,
+		simulate:  simulate,
+	}
+}
+
+// RegisterTxService registers the cosmos.tx Service on the given gRPC server.
+//
+// NOTE: the service must not be registered on the app's GRPCQueryRouter, as
+// broadcasting a tx from an ABCI query would block on the ABCI connection.
+func RegisterTxService(server gogogrpc.Server, clientCtx client.Context, simulate BaseAppSimulateFn) {
+	txtypes.RegisterServiceServer(server, NewTxServer(clientCtx, simulate))
+}
+
+// RegisterGRPCGatewayRoutes mounts the cosmos.tx Service's gRPC-gateway routes
+// on the given mux.
+func RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux, simulate BaseAppSimulateFn) {
+	if err := txtypes.RegisterServiceHandlerServer(context.Background(), mux, NewTxServer(clientCtx, simulate)); err != nil {
+		panic(err)
+	}
+}
+
+// Simulate implements the Service/Simulate gRPC method
+func (s txServer) Simulate(_ context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
+	if req == nil || req.Tx == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+	}
+
+	txBytes, err := req.Tx.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tx, err := s.clientCtx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	gasInfo, result, err := s.simulate(txBytes, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.SimulateResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+	}, nil
+}
+
+// GetTx implements the Service/GetTx gRPC method
+func (s txServer) GetTx(_ context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	if req == nil || req.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "tx hash cannot be empty")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %s: %s", req.Hash, err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTx, err := node.Tx(hash, !s.clientCtx.TrustNode)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tx %s not found: %s", req.Hash, err)
+	}
+
+	protoTxs, txResponses, err := s.formatTxResults([]*ctypes.ResultTx{resTx})
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetTxResponse{
+		Tx:         protoTxs[0],
+		TxResponse: txResponses[0],
+	}, nil
+}
+
+// BroadcastTx implements the Service/BroadcastTx gRPC method
+func (s txServer) BroadcastTx(_ context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+	}
+
+	clientCtx := s.clientCtx
+
+	switch req.Mode {
+	case txtypes.BroadcastMode_BROADCAST_MODE_BLOCK:
+		clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastBlock)
+
+	case txtypes.BroadcastMode_BROADCAST_MODE_SYNC:
+		clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastSync)
+
+	case txtypes.BroadcastMode_BROADCAST_MODE_ASYNC:
+		clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastAsync)
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported broadcast mode %s", req.Mode)
+	}
+
+	res, err := clientCtx.BroadcastTx(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.BroadcastTxResponse{TxResponse: res}, nil
+}
+
+// GetTxsEvent implements the Service/GetTxsEvent gRPC method
+func (s txServer) GetTxsEvent(_ context.Context, req *txtypes.GetTxsEventRequest) (*txtypes.GetTxsEventResponse, error) {
+	if req == nil || len(req.Events) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "must declare at least one event to search")
+	}
+
+	page, limit, err := parsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTxs, err := node.TxSearch(strings.Join(req.Events, " AND "), !s.clientCtx.TrustNode, page, limit, "")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	protoTxs, txResponses, err := s.formatTxResults(resTxs.Txs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetTxsEventResponse{
+		Txs:         protoTxs,
+		TxResponses: txResponses,
+		Pagination:  &query.PageResponse{Total: uint64(resTxs.TotalCount)},
+	}, nil
+}
+
+// formatTxResults verifies the indexed txs if the node is not trusted and
+// decodes them into protobuf txs and TxResponses.
+func (s txServer) formatTxResults(resTxs []*ctypes.ResultTx) ([]*txtypes.Tx, []*sdk.TxResponse, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protoTxs := make([]*txtypes.Tx, len(resTxs))
+	txResponses := make([]*sdk.TxResponse, len(resTxs))
+	resBlocks := make(map[int64]*ctypes.ResultBlock)
+
+	for i, resTx := range resTxs {
+		if err := authclient.ValidateTxResult(s.clientCtx, resTx); err != nil {
+			return nil, nil, err
+		}
+
+		resBlock, ok := resBlocks[resTx.Height]
+		if !ok {
+			resBlock, err = node.Block(&resTx.Height)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			resBlocks[resTx.Height] = resBlock
+		}
+
+		tx, err := s.clientCtx.TxConfig.TxDecoder()(resTx.Tx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		provider, ok := tx.(protoTxProvider)
+		if !ok {
+			return nil, nil, status.Errorf(codes.Unimplemented, "tx %X is not a protobuf tx", resTx.Hash)
+		}
+
+		// the tx is returned next to its TxResponse rather than packed in it
+		txResponse := sdk.NewResponseResultTx(resTx, tx, resBlock.Block.Time.Format(time.RFC3339))
+		txResponse.Tx = nil
+
+		protoTxs[i] = provider.GetProtoTx()
+		txResponses[i] = txResponse
+	}
+
+	return protoTxs, txResponses, nil
+}
+
+// parsePagination converts the offset and limit of a PageRequest into the
+// page and the number of txs per page of a Tendermint tx search.
+func parsePagination(pageReq *query.PageRequest) (page, limit int, err error) {
+	if pageReq == nil {
+		return 1, defaultLimit, nil
+	}
+
+	if len(pageReq.Key) > 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "key pagination is not supported, use offset instead")
+	}
+
+	limit = int(pageReq.Limit)
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	if pageReq.Offset%uint64(limit) != 0 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "offset %d must be a multiple of the limit %d", pageReq.Offset, limit)
+	}
+
+	return int(pageReq.Offset)/limit + 1, limit, nil
+}
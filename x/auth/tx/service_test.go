@@ -0,0 +1,255 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// mockNode is a Tendermint RPC client serving a single indexed tx.
+type mockNode struct {
+	rpcclient.Client
+
+	resTx       *ctypes.ResultTx
+	blockTime   time.Time
+	searches    []string
+	broadcasted []string
+}
+
+func (n *mockNode) Tx(hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	if string(hash) != string(n.resTx.Hash) {
+		return nil, status.Error(codes.NotFound, "tx not found")
+	}
+
+	return n.resTx, nil
+}
+
+func (n *mockNode) TxSearch(query string, _ bool, page, perPage int, _ string) (*ctypes.ResultTxSearch, error) {
+	n.searches = append(n.searches, query)
+
+	res := &ctypes.ResultTxSearch{TotalCount: 1}
+	if page == 1 {
+		res.Txs = []*ctypes.ResultTx{n.resTx}
+	}
+
+	return res, nil
+}
+
+func (n *mockNode) Block(height *int64) (*ctypes.ResultBlock, error) {
+	return &ctypes.ResultBlock{
+		Block: &tmtypes.Block{Header: tmtypes.Header{Height: *height, Time: n.blockTime}},
+	}, nil
+}
+
+func (n *mockNode) BroadcastTxCommit(tx tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	n.broadcasted = append(n.broadcasted, "block")
+	return &ctypes.ResultBroadcastTxCommit{Hash: tx.Hash(), Height: 5}, nil
+}
+
+func (n *mockNode) BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	n.broadcasted = append(n.broadcasted, "sync")
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (n *mockNode) BroadcastTxAsync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	n.broadcasted = append(n.broadcasted, "async")
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func setupTxServer(t *testing.T) (txtypes.ServiceServer, *mockNode, client.TxConfig, []byte) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	txConfig := NewTxConfig(codec.NewProtoCodec(interfaceRegistry), std.DefaultPublicKeyCodec{}, DefaultSignModeHandler())
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetMemo("memo")
+
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	node := &mockNode{
+		resTx: &ctypes.ResultTx{
+			Hash:     tmhash.Sum(txBytes),
+			Height:   3,
+			Tx:       txBytes,
+			TxResult: abci.ResponseDeliverTx{GasWanted: 10, GasUsed: 5},
+		},
+		blockTime: time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	clientCtx := client.Context{}.
+		WithTxConfig(txConfig).
+		WithClient(node).
+		WithTrustNode(true)
+
+	simulate := func(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
+		return sdk.GasInfo{GasWanted: tx.(sdk.FeeTx).GetGas(), GasUsed: uint64(len(txBytes))}, &sdk.Result{Log: "simulated"}, nil
+	}
+
+	return NewTxServer(clientCtx, simulate), node, txConfig, txBytes
+}
+
+func TestTxServerSimulate(t *testing.T) {
+	server, _, txConfig, txBytes := setupTxServer(t)
+
+	tx, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	res, err := server.Simulate(context.Background(), &txtypes.SimulateRequest{Tx: tx.(protoTxProvider).GetProtoTx()})
+	require.NoError(t, err)
+	require.Equal(t, &sdk.GasInfo{GasWanted: testdata.NewTestGasLimit(), GasUsed: uint64(len(txBytes))}, res.GasInfo)
+	require.Equal(t, "simulated", res.Result.Log)
+
+	_, err = server.Simulate(context.Background(), &txtypes.SimulateRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTxServerBroadcastTx(t *testing.T) {
+	server, node, _, txBytes := setupTxServer(t)
+
+	for _, mode := range []txtypes.BroadcastMode{
+		txtypes.BroadcastMode_BROADCAST_MODE_BLOCK,
+		txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+		txtypes.BroadcastMode_BROADCAST_MODE_ASYNC,
+	} {
+		res, err := server.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{TxBytes: txBytes, Mode: mode})
+		require.NoError(t, err)
+		require.Equal(t, node.resTx.Hash.String(), res.TxResponse.TxHash)
+	}
+	require.Equal(t, []string{"block", "sync", "async"}, node.broadcasted)
+
+	_, err := server.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{TxBytes: txBytes})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{Mode: txtypes.BroadcastMode_BROADCAST_MODE_SYNC})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTxServerGetTx(t *testing.T) {
+	server, node, _, _ := setupTxServer(t)
+
+	res, err := server.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: node.resTx.Hash.String()})
+	require.NoError(t, err)
+	require.Equal(t, "memo", res.Tx.Body.Memo)
+	require.Equal(t, node.resTx.Hash.String(), res.TxResponse.TxHash)
+	require.Equal(t, int64(3), res.TxResponse.Height)
+	require.Equal(t, int64(5), res.TxResponse.GasUsed)
+	require.Equal(t, "2020-07-01T00:00:00Z", res.TxResponse.Timestamp)
+	require.Nil(t, res.TxResponse.Tx)
+
+	_, err = server.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: hex.EncodeToString(tmhash.Sum([]byte("other")))})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTxServerGetTxsEvent(t *testing.T) {
+	server, node, _, _ := setupTxServer(t)
+
+	events := []string{"message.action='send'", "message.sender='cosmos1'"}
+	res, err := server.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{Events: events})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, "memo", res.Txs[0].Body.Memo)
+	require.Len(t, res.TxResponses, 1)
+	require.Equal(t, node.resTx.Hash.String(), res.TxResponses[0].TxHash)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+	require.Equal(t, []string{"message.action='send' AND message.sender='cosmos1'"}, node.searches)
+
+	// the second page is empty
+	res, err = server.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+		Events:     events,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Txs)
+
+	_, err = server.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+		Events:     events,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 2},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{
+		Events:     events,
+		Pagination: &query.PageRequest{Key: []byte("key")},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTxServerGRPCGateway(t *testing.T) {
+	server, node, txConfig, txBytes := setupTxServer(t)
+
+	mux := api.New(client.Context{}, log.NewNopLogger()).GRPCGatewayRouter
+	require.NoError(t, txtypes.RegisterServiceHandlerServer(context.Background(), mux, server))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cosmos/tx/txs/"+node.resTx.Hash.String(), nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var res struct {
+		Tx struct {
+			Body struct {
+				Memo string `json:"memo"`
+			} `json:"body"`
+		} `json:"tx"`
+		TxResponse struct {
+			TxHash string `json:"txhash"`
+		} `json:"tx_response"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, "memo", res.Tx.Body.Memo)
+	require.Equal(t, node.resTx.Hash.String(), res.TxResponse.TxHash)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cosmos/tx/txs/invalid", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// the tx to simulate is posted in JSON
+	tx, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	reqBz, err := codec.ProtoMarshalJSON(&txtypes.SimulateRequest{Tx: tx.(protoTxProvider).GetProtoTx()})
+	require.NoError(t, err)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/cosmos/tx/simulate", bytes.NewReader(reqBz)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var simRes struct {
+		GasInfo struct {
+			GasWanted string `json:"gas_wanted"`
+		} `json:"gas_info"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &simRes))
+	require.Equal(t, fmt.Sprint(testdata.NewTestGasLimit()), simRes.GasInfo.GasWanted)
+}
@@ -39,7 +39,11 @@ func (LegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode, data sign
 		return nil, fmt.Errorf("expected TxWithMemo, got %T", tx)
 	}
 
+	// the fee granter is part of the signed fee, so that the granter paying the
+	// fee can't be added, swapped or removed once the tx is signed
+	fee := StdFee{Amount: feeTx.GetFee(), Gas: feeTx.GetGas(), Granter: feeTx.FeeGranter()}
+
 	return StdSignBytes(
-		data.ChainID, data.AccountNumber, data.AccountSequence, StdFee{Amount: feeTx.GetFee(), Gas: feeTx.GetGas()}, tx.GetMsgs(), memoTx.GetMemo(),
+		data.ChainID, data.AccountNumber, data.AccountSequence, fee, tx.GetMsgs(), memoTx.GetMemo(),
 	), nil
 }
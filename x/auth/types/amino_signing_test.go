@@ -67,6 +67,57 @@ func TestLegacyAminoJSONHandler_GetSignBytes(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestLegacyAminoJSONHandler_GetSignBytesFeeGranter(t *testing.T) {
+	priv1 := secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: addr1,
+			ToAddress:   addr2,
+			Amount:      coins,
+		},
+	}
+
+	tx := types.StdTx{
+		Msgs: msgs,
+		Fee:  types.StdFee{Amount: coins, Gas: 10000, Granter: addr2},
+		Memo: "foo",
+	}
+
+	handler := types.LegacyAminoJSONHandler{}
+	signingData := signing.SignerData{ChainID: "test-chain", AccountNumber: 7, AccountSequence: 7}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.NoError(t, err)
+	require.Equal(t, types.StdSignBytes("test-chain", 7, 7, tx.Fee, msgs, "foo"), signBz)
+
+	sig, err := priv1.Sign(signBz)
+	require.NoError(t, err)
+
+	// the signature no longer verifies once the granter is swapped or removed
+	for _, granter := range []sdk.AccAddress{addr3, nil} {
+		tx.Fee.Granter = granter
+		signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+		require.NoError(t, err)
+		require.False(t, priv1.PubKey().VerifyBytes(signBz, sig))
+	}
+
+	// nor once a granter is added to a tx signed without one
+	tx.Fee.Granter = nil
+	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.NoError(t, err)
+	sig, err = priv1.Sign(signBz)
+	require.NoError(t, err)
+
+	tx.Fee.Granter = addr2
+	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.NoError(t, err)
+	require.False(t, priv1.PubKey().VerifyBytes(signBz, sig))
+}
+
 func TestLegacyAminoJSONHandler_DefaultMode(t *testing.T) {
 	handler := types.LegacyAminoJSONHandler{}
 	require.Equal(t, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, handler.DefaultMode())
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -113,6 +114,15 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	// min_gas_prices are the global minimum gas prices which the fees of the
+	// transactions must meet, in both CheckTx and DeliverTx.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices" yaml:"min_gas_prices"`
+	// bypass_min_fee_msg_types are the type URLs of the messages of the
+	// transactions which are exempt from the global minimum gas prices.
+	BypassMinFeeMsgTypes []string `protobuf:"bytes,7,rep,name=bypass_min_fee_msg_types,json=bypassMinFeeMsgTypes,proto3" json:"bypass_min_fee_msg_types,omitempty" yaml:"bypass_min_fee_msg_types"`
+	// max_total_bypass_min_fee_msg_gas_usage is the maximum gas limit of the
+	// transactions exempt from the global minimum gas prices by their messages.
+	MaxTotalBypassMinFeeMsgGasUsage uint64 `protobuf:"varint,8,opt,name=max_total_bypass_min_fee_msg_gas_usage,json=maxTotalBypassMinFeeMsgGasUsage,proto3" json:"max_total_bypass_min_fee_msg_gas_usage,omitempty" yaml:"max_total_bypass_min_fee_msg_gas_usage"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,6 +192,27 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetBypassMinFeeMsgTypes() []string {
+	if m != nil {
+		return m.BypassMinFeeMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTotalBypassMinFeeMsgGasUsage() uint64 {
+	if m != nil {
+		return m.MaxTotalBypassMinFeeMsgGasUsage
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.ModuleAccount")
@@ -191,48 +222,58 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/auth.proto", fileDescriptor_ec2401f40a84da7e) }
 
 var fileDescriptor_ec2401f40a84da7e = []byte{
-	// 650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0x8d, 0x49, 0x7e, 0xfc, 0xd9, 0x00, 0x12, 0x26, 0x80, 0xc9, 0xaf, 0xf2, 0x46, 0x3e, 0x51,
-	0xa9, 0x09, 0x82, 0x8a, 0x4a, 0xe4, 0x50, 0x15, 0xd3, 0x56, 0x42, 0x14, 0x84, 0x16, 0xa9, 0xaa,
-	0x7a, 0xb1, 0xd6, 0xce, 0x36, 0x58, 0x64, 0xb3, 0xc6, 0xbb, 0xae, 0x62, 0x3e, 0x41, 0x8f, 0x3d,
-	0x55, 0x3d, 0xf2, 0x21, 0x7a, 0xeb, 0x17, 0xe8, 0x11, 0xf5, 0xd4, 0x93, 0x5b, 0x85, 0x4b, 0xd5,
-	0xa3, 0x8f, 0x3d, 0x55, 0xde, 0x35, 0xc1, 0x41, 0xb4, 0x97, 0x64, 0xe7, 0xcd, 0xbc, 0x37, 0xe3,
-	0x37, 0xda, 0x05, 0xcb, 0x1e, 0xe3, 0x94, 0xf1, 0x75, 0x1c, 0x89, 0x13, 0xf9, 0xd3, 0x0a, 0x42,
-	0x26, 0x98, 0x5e, 0x55, 0x78, 0x2b, 0x83, 0xea, 0xab, 0x2a, 0x70, 0x64, 0x6a, 0x3d, 0xcf, 0xc8,
-	0xa0, 0x5e, 0xeb, 0xb2, 0x2e, 0x53, 0x78, 0x76, 0x52, 0xa8, 0xf5, 0x61, 0x02, 0x54, 0x6d, 0xcc,
-	0xc9, 0x8e, 0xe7, 0xb1, 0xa8, 0x2f, 0xf4, 0x7d, 0x30, 0x85, 0x3b, 0x9d, 0x90, 0x70, 0x6e, 0x68,
-	0x0d, 0x6d, 0x6d, 0xd6, 0xde, 0xf8, 0x9d, 0xc0, 0x66, 0xd7, 0x17, 0x27, 0x91, 0xdb, 0xf2, 0x18,
-	0xcd, 0x35, 0xf3, 0xbf, 0x26, 0xef, 0x9c, 0xae, 0x8b, 0x38, 0x20, 0xbc, 0xb5, 0xe3, 0x79, 0x3b,
-	0x8a, 0x88, 0xae, 0x15, 0xf4, 0xe7, 0x60, 0x2a, 0x88, 0x5c, 0xe7, 0x94, 0xc4, 0xc6, 0x84, 0x14,
-	0x6b, 0xfe, 0x4a, 0x60, 0x2d, 0x88, 0xdc, 0x9e, 0xef, 0x65, 0xe8, 0x03, 0x46, 0x7d, 0x41, 0x68,
-	0x20, 0xe2, 0x34, 0x81, 0x0b, 0x31, 0xa6, 0xbd, 0xb6, 0x75, 0x93, 0xb5, 0xd0, 0x64, 0x10, 0xb9,
-	0xfb, 0x24, 0xd6, 0x9f, 0x80, 0x79, 0xac, 0xe6, 0x73, 0xfa, 0x11, 0x75, 0x49, 0x68, 0x94, 0x1b,
-	0xda, 0x5a, 0xc5, 0x5e, 0x4d, 0x13, 0xb8, 0xa4, 0x68, 0xe3, 0x79, 0x0b, 0xcd, 0xe5, 0xc0, 0xa1,
-	0x8c, 0xf5, 0x3a, 0x98, 0xe6, 0xe4, 0x2c, 0x22, 0x7d, 0x8f, 0x18, 0x95, 0x8c, 0x8b, 0x46, 0x71,
-	0xbb, 0xf6, 0xee, 0x02, 0x96, 0x3e, 0x5e, 0xc0, 0xd2, 0xd7, 0x4f, 0xcd, 0xe9, 0xdc, 0x87, 0x3d,
-	0xeb, 0xb3, 0x06, 0xe6, 0x0e, 0x58, 0x27, 0xea, 0x8d, 0xac, 0x79, 0x05, 0x66, 0x5d, 0xcc, 0x89,
-	0x93, 0x2b, 0x4b, 0x7f, 0xaa, 0x9b, 0x46, 0xab, 0xe0, 0x7f, 0xab, 0x60, 0xa5, 0xfd, 0xff, 0x65,
-	0x02, 0xb5, 0x34, 0x81, 0x8b, 0x6a, 0xc2, 0x22, 0xd7, 0x42, 0x55, 0xb7, 0x60, 0xba, 0x0e, 0x2a,
-	0x7d, 0x4c, 0x89, 0x34, 0x69, 0x06, 0xc9, 0xb3, 0xde, 0x00, 0xd5, 0x80, 0x84, 0xd4, 0xe7, 0xdc,
-	0x67, 0x7d, 0x6e, 0x94, 0x1b, 0xe5, 0xb5, 0x19, 0x54, 0x84, 0xda, 0xf5, 0xc2, 0xdc, 0xf3, 0x63,
-	0xa3, 0xee, 0x59, 0xdf, 0xcb, 0x60, 0xf2, 0x08, 0x87, 0x98, 0x72, 0xfd, 0x10, 0x2c, 0x52, 0x3c,
-	0x70, 0x28, 0xa1, 0xcc, 0xf1, 0x4e, 0x70, 0x88, 0x3d, 0x41, 0x42, 0xb5, 0xdd, 0x8a, 0x6d, 0xa6,
-	0x09, 0xac, 0xab, 0xf9, 0xee, 0x28, 0xb2, 0xd0, 0x02, 0xc5, 0x83, 0x03, 0x42, 0xd9, 0xee, 0x08,
-	0xd3, 0xb7, 0xc1, 0xac, 0x18, 0x38, 0xdc, 0xef, 0x3a, 0x3d, 0x9f, 0xfa, 0x42, 0x0e, 0x5d, 0xb1,
-	0x57, 0x6e, 0x3e, 0xb4, 0x98, 0xb5, 0x10, 0x10, 0x83, 0x63, 0xbf, 0xfb, 0x22, 0x0b, 0x74, 0x04,
-	0x96, 0x64, 0xf2, 0x9c, 0x38, 0x1e, 0xe3, 0xc2, 0x09, 0x48, 0xe8, 0xb8, 0xb1, 0x20, 0xf9, 0x3a,
-	0x1b, 0x69, 0x02, 0xef, 0x15, 0x34, 0x6e, 0x97, 0x59, 0x68, 0x21, 0x13, 0x3b, 0x27, 0xbb, 0x8c,
-	0x8b, 0x23, 0x12, 0xda, 0xb1, 0x20, 0xfa, 0x19, 0x58, 0xc9, 0xba, 0xbd, 0x25, 0xa1, 0xff, 0x26,
-	0x56, 0xf5, 0xa4, 0xb3, 0xb9, 0xb5, 0xb5, 0xb1, 0xad, 0x16, 0x6d, 0xb7, 0x87, 0x09, 0xac, 0x1d,
-	0xfb, 0xdd, 0x97, 0xb2, 0x22, 0xa3, 0x3e, 0x7b, 0x2a, 0xf3, 0x69, 0x02, 0x4d, 0xd5, 0xed, 0x2f,
-	0x02, 0x16, 0xaa, 0xf1, 0x31, 0x9e, 0x82, 0xf5, 0x18, 0xac, 0xde, 0x66, 0x70, 0xe2, 0x05, 0x9b,
-	0x5b, 0x8f, 0x4e, 0x37, 0x8c, 0xff, 0x64, 0xd3, 0xc7, 0xc3, 0x04, 0x2e, 0x8f, 0x35, 0x3d, 0xbe,
-	0xae, 0x48, 0x13, 0xd8, 0xb8, 0xbb, 0xed, 0x48, 0xc4, 0x42, 0xcb, 0xfc, 0x4e, 0x6e, 0x7b, 0x3a,
-	0xdb, 0xf7, 0xcf, 0x0b, 0xa8, 0xd9, 0xbb, 0x5f, 0x86, 0xa6, 0x76, 0x39, 0x34, 0xb5, 0x1f, 0x43,
-	0x53, 0x7b, 0x7f, 0x65, 0x96, 0x2e, 0xaf, 0xcc, 0xd2, 0xb7, 0x2b, 0xb3, 0xf4, 0xfa, 0xfe, 0x3f,
-	0x6f, 0xeb, 0x40, 0x3d, 0x20, 0xf2, 0xd2, 0xba, 0x93, 0xf2, 0x11, 0x78, 0xf8, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x10, 0x68, 0x06, 0x78, 0x5c, 0x04, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x36, 0xc6, 0x49, 0xc7, 0x69, 0x50, 0x36, 0x6e, 0xba, 0x31, 0xc8, 0x63, 0x2d, 0x12,
+	0x0a, 0x02, 0x3b, 0x4a, 0x50, 0x90, 0xea, 0x03, 0x22, 0x9b, 0xd2, 0xaa, 0x6a, 0x53, 0x45, 0x9b,
+	0x82, 0x10, 0x1c, 0x56, 0xb3, 0xeb, 0xd7, 0xcd, 0x28, 0x9e, 0x9d, 0xed, 0xce, 0x2c, 0xf2, 0xf6,
+	0x08, 0x17, 0x8e, 0x9c, 0x10, 0xc7, 0x9c, 0x39, 0x73, 0xe3, 0x07, 0xd0, 0x63, 0xc4, 0x89, 0xd3,
+	0x82, 0x9c, 0x0b, 0xe2, 0xe8, 0x23, 0x27, 0x34, 0xb3, 0x9b, 0x64, 0x5d, 0x39, 0xd0, 0x8b, 0xe5,
+	0xf7, 0xbd, 0xf7, 0x7d, 0xef, 0xcd, 0x37, 0x6f, 0x16, 0xad, 0x07, 0x5c, 0x30, 0x2e, 0xb6, 0x48,
+	0x2a, 0x8f, 0xf5, 0x4f, 0x3f, 0x4e, 0xb8, 0xe4, 0x66, 0xb3, 0xc0, 0xfb, 0x0a, 0x6a, 0x6f, 0x14,
+	0x81, 0xa7, 0x53, 0x5b, 0x65, 0x46, 0x07, 0xed, 0x56, 0xc8, 0x43, 0x5e, 0xe0, 0xea, 0x5f, 0x89,
+	0xae, 0x95, 0xaa, 0xd5, 0x52, 0xfb, 0x87, 0x1b, 0xa8, 0xe9, 0x10, 0x01, 0x7b, 0x41, 0xc0, 0xd3,
+	0x48, 0x9a, 0x8f, 0xd0, 0x22, 0x19, 0x0e, 0x13, 0x10, 0xc2, 0x32, 0xba, 0xc6, 0xe6, 0xb2, 0xb3,
+	0xfd, 0x4f, 0x8e, 0x7b, 0x21, 0x95, 0xc7, 0xa9, 0xdf, 0x0f, 0x38, 0xdb, 0x9a, 0x11, 0xe9, 0x89,
+	0xe1, 0xc9, 0x96, 0xcc, 0x62, 0x10, 0xfd, 0xbd, 0x20, 0xd8, 0x2b, 0x88, 0xee, 0x85, 0x82, 0x79,
+	0x1f, 0x2d, 0xc6, 0xa9, 0xef, 0x9d, 0x40, 0x66, 0xdd, 0xd0, 0x62, 0xbd, 0xbf, 0x73, 0xdc, 0x8a,
+	0x53, 0x7f, 0x44, 0x03, 0x85, 0x7e, 0xc0, 0x19, 0x95, 0xc0, 0x62, 0x99, 0x4d, 0x73, 0xbc, 0x9a,
+	0x11, 0x36, 0x1a, 0xd8, 0x57, 0x59, 0xdb, 0x6d, 0xc4, 0xa9, 0xff, 0x08, 0x32, 0xf3, 0x13, 0xb4,
+	0x42, 0x8a, 0xf9, 0xbc, 0x28, 0x65, 0x3e, 0x24, 0xd6, 0x42, 0xd7, 0xd8, 0xac, 0x3b, 0x1b, 0xd3,
+	0x1c, 0xdf, 0x2e, 0x68, 0xb3, 0x79, 0xdb, 0xbd, 0x55, 0x02, 0x4f, 0x74, 0x6c, 0xb6, 0xd1, 0x92,
+	0x80, 0xe7, 0x29, 0x44, 0x01, 0x58, 0x75, 0xc5, 0x75, 0x2f, 0xe3, 0x41, 0xeb, 0xbb, 0x53, 0x5c,
+	0xfb, 0xf1, 0x14, 0xd7, 0x7e, 0xfb, 0xb9, 0xb7, 0x54, 0xfa, 0xf0, 0xd0, 0xfe, 0xc5, 0x40, 0xb7,
+	0x0e, 0xf8, 0x30, 0x1d, 0x5d, 0x5a, 0xf3, 0x05, 0x5a, 0xf6, 0x89, 0x00, 0xaf, 0x54, 0xd6, 0xfe,
+	0x34, 0x77, 0xac, 0x7e, 0xe5, 0x52, 0xfa, 0x15, 0x2b, 0x9d, 0xb7, 0xce, 0x72, 0x6c, 0x4c, 0x73,
+	0xbc, 0x56, 0x4c, 0x58, 0xe5, 0xda, 0x6e, 0xd3, 0xaf, 0x98, 0x6e, 0xa2, 0x7a, 0x44, 0x18, 0x68,
+	0x93, 0x6e, 0xba, 0xfa, 0xbf, 0xd9, 0x45, 0xcd, 0x18, 0x12, 0x46, 0x85, 0xa0, 0x3c, 0x12, 0xd6,
+	0x42, 0x77, 0x61, 0xf3, 0xa6, 0x5b, 0x85, 0x06, 0xed, 0xca, 0xdc, 0x2b, 0x33, 0xa3, 0x3e, 0xb4,
+	0x7f, 0x6d, 0xa0, 0xc6, 0x21, 0x49, 0x08, 0x13, 0xe6, 0x13, 0xb4, 0xc6, 0xc8, 0xd8, 0x63, 0xc0,
+	0xb8, 0x17, 0x1c, 0x93, 0x84, 0x04, 0x12, 0x92, 0xe2, 0x76, 0xeb, 0x4e, 0x67, 0x9a, 0xe3, 0x76,
+	0x31, 0xdf, 0x9c, 0x22, 0xdb, 0x5d, 0x65, 0x64, 0x7c, 0x00, 0x8c, 0xef, 0x5f, 0x62, 0xe6, 0x5d,
+	0xb4, 0x2c, 0xc7, 0x9e, 0xa0, 0xa1, 0x37, 0xa2, 0x8c, 0x4a, 0x3d, 0x74, 0xdd, 0xb9, 0x73, 0x75,
+	0xd0, 0x6a, 0xd6, 0x76, 0x91, 0x1c, 0x1f, 0xd1, 0xf0, 0xb1, 0x0a, 0x4c, 0x17, 0xdd, 0xd6, 0xc9,
+	0x17, 0xe0, 0x05, 0x5c, 0x48, 0x2f, 0x86, 0xc4, 0xf3, 0x33, 0x09, 0xe5, 0x75, 0x76, 0xa7, 0x39,
+	0x7e, 0xbb, 0xa2, 0xf1, 0x6a, 0x99, 0xed, 0xae, 0x2a, 0xb1, 0x17, 0xb0, 0xcf, 0x85, 0x3c, 0x84,
+	0xc4, 0xc9, 0x24, 0x98, 0xcf, 0xd1, 0x1d, 0xd5, 0xed, 0x6b, 0x48, 0xe8, 0xb3, 0xac, 0xa8, 0x87,
+	0xe1, 0xce, 0xee, 0xee, 0xf6, 0xdd, 0xe2, 0xa2, 0x9d, 0xc1, 0x24, 0xc7, 0xad, 0x23, 0x1a, 0x7e,
+	0xae, 0x2b, 0x14, 0xf5, 0xd3, 0x7b, 0x3a, 0x3f, 0xcd, 0x71, 0xa7, 0xe8, 0x76, 0x8d, 0x80, 0xed,
+	0xb6, 0xc4, 0x0c, 0xaf, 0x80, 0xcd, 0x0c, 0x6d, 0xbc, 0xca, 0x10, 0x10, 0xc4, 0x3b, 0xbb, 0x1f,
+	0x9d, 0x6c, 0x5b, 0x6f, 0xe8, 0xa6, 0x1f, 0x4f, 0x72, 0xbc, 0x3e, 0xd3, 0xf4, 0xe8, 0xa2, 0x62,
+	0x9a, 0xe3, 0xee, 0xfc, 0xb6, 0x97, 0x22, 0xb6, 0xbb, 0x2e, 0xe6, 0x72, 0xcd, 0x6f, 0x0d, 0xb4,
+	0xc2, 0x68, 0xe4, 0x85, 0x44, 0x3d, 0x7c, 0x1a, 0x80, 0xb0, 0x1a, 0xdd, 0x85, 0xcd, 0xe6, 0xce,
+	0x9b, 0x17, 0x6b, 0x78, 0x0f, 0x82, 0x7d, 0x4e, 0x23, 0xe7, 0xf1, 0xcb, 0x1c, 0xd7, 0xae, 0xde,
+	0xc7, 0x2c, 0xc9, 0xfe, 0xe9, 0x0f, 0xfc, 0xfe, 0x6b, 0x3c, 0xea, 0x52, 0x4c, 0xb8, 0xcb, 0x8c,
+	0x46, 0x0f, 0x88, 0x38, 0xd4, 0x6c, 0xf3, 0x2b, 0x64, 0xf9, 0x59, 0x4c, 0x84, 0xf0, 0x94, 0xec,
+	0x33, 0x00, 0x8f, 0x89, 0xd0, 0xd3, 0x14, 0x6b, 0x51, 0x2d, 0xaa, 0xf3, 0xce, 0x34, 0xc7, 0xb8,
+	0xdc, 0xfb, 0x6b, 0x2a, 0x6d, 0xb7, 0x55, 0xa4, 0x0e, 0x68, 0x74, 0x1f, 0xe0, 0x40, 0x84, 0x4f,
+	0x15, 0x6c, 0x7e, 0x63, 0xa0, 0x77, 0xd5, 0x2e, 0x4a, 0x2e, 0xc9, 0xc8, 0x9b, 0xc3, 0x56, 0xc7,
+	0x48, 0x05, 0x09, 0xc1, 0x5a, 0xd2, 0x5e, 0x2b, 0x47, 0x7b, 0x57, 0x3b, 0xfc, 0xff, 0x3c, 0xdb,
+	0xc5, 0x8c, 0x8c, 0x9f, 0xaa, 0x3a, 0x67, 0x76, 0x82, 0x07, 0x44, 0x7c, 0xa6, 0x2a, 0x06, 0x4b,
+	0xea, 0x5d, 0xfd, 0x75, 0x8a, 0x0d, 0x67, 0xff, 0xe5, 0xa4, 0x63, 0x9c, 0x4d, 0x3a, 0xc6, 0x9f,
+	0x93, 0x8e, 0xf1, 0xfd, 0x79, 0xa7, 0x76, 0x76, 0xde, 0xa9, 0xfd, 0x7e, 0xde, 0xa9, 0x7d, 0xf9,
+	0xde, 0x7f, 0x1a, 0x38, 0x2e, 0xbe, 0xde, 0xfa, 0xa8, 0x7e, 0x43, 0x7f, 0x6c, 0x3f, 0xfc, 0x77,
+	0x00, 0xa7, 0xa6, 0xa1, 0x95, 0xd9, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -269,6 +310,25 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
+	if len(this.BypassMinFeeMsgTypes) != len(that1.BypassMinFeeMsgTypes) {
+		return false
+	}
+	for i := range this.BypassMinFeeMsgTypes {
+		if this.BypassMinFeeMsgTypes[i] != that1.BypassMinFeeMsgTypes[i] {
+			return false
+		}
+	}
+	if this.MaxTotalBypassMinFeeMsgGasUsage != that1.MaxTotalBypassMinFeeMsgGasUsage {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -389,6 +449,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMinFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMinFeeMsgTypes[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.BypassMinFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -495,6 +583,21 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovAuth(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	}
 	return n
 }
 
@@ -940,6 +1043,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBypassMinFeeMsgGasUsage", wireType)
+			}
+			m.MaxTotalBypassMinFeeMsgGasUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBypassMinFeeMsgGasUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
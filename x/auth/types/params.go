@@ -5,6 +5,7 @@ import (
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -15,6 +16,8 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	DefaultMaxTotalBypassMinFeeMsgGasUsage uint64 = 1000000
 )
 
 // Parameter keys
@@ -24,13 +27,35 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyMinGasPrices           = []byte("MinGasPrices")
+	KeyBypassMinFeeMsgTypes   = []byte("BypassMinFeeMsgTypes")
+
+	KeyMaxTotalBypassMinFeeMsgGasUsage = []byte("MaxTotalBypassMinFeeMsgGasUsage")
 )
 
+// DefaultMinGasPrices returns the default global minimum gas prices, which are
+// empty and so don't require any fee.
+func DefaultMinGasPrices() sdk.DecCoins {
+	return nil
+}
+
+// DefaultBypassMinFeeMsgTypes returns the type URLs of the messages exempt from
+// the global minimum gas prices by default, which are the IBC packet relaying
+// messages.
+func DefaultBypassMinFeeMsgTypes() []string {
+	return []string{
+		"/ibc.channel.MsgPacket",
+		"/ibc.channel.MsgAcknowledgement",
+		"/ibc.channel.MsgTimeout",
+	}
+}
+
 var _ paramtypes.ParamSet = &Params{}
 
 // NewParams creates a new Params object
 func NewParams(
 	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
+	minGasPrices sdk.DecCoins, bypassMinFeeMsgTypes []string, maxTotalBypassMinFeeMsgGasUsage uint64,
 ) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -38,6 +63,10 @@ func NewParams(
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		MinGasPrices:           minGasPrices,
+		BypassMinFeeMsgTypes:   bypassMinFeeMsgTypes,
+
+		MaxTotalBypassMinFeeMsgGasUsage: maxTotalBypassMinFeeMsgGasUsage,
 	}
 }
 
@@ -56,6 +85,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyMinGasPrices, &p.MinGasPrices, validateMinGasPrices),
+		paramtypes.NewParamSetPair(KeyBypassMinFeeMsgTypes, &p.BypassMinFeeMsgTypes, validateBypassMinFeeMsgTypes),
+		paramtypes.NewParamSetPair(KeyMaxTotalBypassMinFeeMsgGasUsage, &p.MaxTotalBypassMinFeeMsgGasUsage, validateMaxTotalBypassMinFeeMsgGasUsage),
 	}
 }
 
@@ -67,6 +99,10 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		MinGasPrices:           DefaultMinGasPrices(),
+		BypassMinFeeMsgTypes:   DefaultBypassMinFeeMsgTypes(),
+
+		MaxTotalBypassMinFeeMsgGasUsage: DefaultMaxTotalBypassMinFeeMsgGasUsage,
 	}
 }
 
@@ -141,6 +177,49 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateMinGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid min gas prices: %s", v)
+	}
+
+	return nil
+}
+
+func validateBypassMinFeeMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, msgType := range v {
+		if len(msgType) < 2 || msgType[0] != '/' {
+			return fmt.Errorf("invalid bypass min fee msg type: %q", msgType)
+		}
+
+		if seen[msgType] {
+			return fmt.Errorf("duplicate bypass min fee msg type: %s", msgType)
+		}
+
+		seen[msgType] = true
+	}
+
+	return nil
+}
+
+func validateMaxTotalBypassMinFeeMsgGasUsage(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -158,6 +237,15 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateMinGasPrices(p.MinGasPrices); err != nil {
+		return err
+	}
+	if err := validateBypassMinFeeMsgTypes(p.BypassMinFeeMsgTypes); err != nil {
+		return err
+	}
+	if err := validateMaxTotalBypassMinFeeMsgGasUsage(p.MaxTotalBypassMinFeeMsgGasUsage); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -19,6 +20,11 @@ func TestParamsEqual(t *testing.T) {
 }
 
 func TestParams_Validate(t *testing.T) {
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3)))
+	invalidMinGasPrices := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.ZeroDec())}
+	bypassMsgTypes := types.DefaultBypassMinFeeMsgTypes()
+	maxBypassGas := types.DefaultMaxTotalBypassMinFeeMsgGasUsage
+
 	tests := []struct {
 		name    string
 		params  types.Params
@@ -26,15 +32,22 @@ func TestParams_Validate(t *testing.T) {
 	}{
 		{"default params", types.DefaultParams(), nil},
 		{"invalid tx signature limit", types.NewParams(types.DefaultMaxMemoCharacters, 0, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx signature limit: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, minGasPrices, bypassMsgTypes, maxBypassGas), fmt.Errorf("invalid tx signature limit: 0")},
 		{"invalid ED25519 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			0, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
+			0, types.DefaultSigVerifyCostSecp256k1, minGasPrices, bypassMsgTypes, maxBypassGas), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
 		{"invalid SECK256k1 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, 0), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
+			types.DefaultSigVerifyCostED25519, 0, minGasPrices, bypassMsgTypes, maxBypassGas), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
 		{"invalid max memo characters", types.NewParams(0, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, minGasPrices, bypassMsgTypes, maxBypassGas), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, minGasPrices, bypassMsgTypes, maxBypassGas), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"invalid min gas prices", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, invalidMinGasPrices, bypassMsgTypes, maxBypassGas), fmt.Errorf("invalid min gas prices: %s", invalidMinGasPrices)},
+		{"invalid bypass min fee msg type", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, minGasPrices, []string{"MsgSend"}, maxBypassGas), fmt.Errorf("invalid bypass min fee msg type: \"MsgSend\"")},
+		{"duplicate bypass min fee msg type", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, minGasPrices, append(bypassMsgTypes, bypassMsgTypes[0]), maxBypassGas),
+			fmt.Errorf("duplicate bypass min fee msg type: %s", bypassMsgTypes[0])},
 	}
 	for _, tt := range tests {
 		tt := tt
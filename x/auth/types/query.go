@@ -0,0 +1,17 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = QueryAccountResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryAccountResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if res.Account == nil {
+		return nil
+	}
+
+	var account AccountI
+	return unpacker.UnpackAny(res.Account, &account)
+}
@@ -12,6 +12,7 @@ import (
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -213,30 +214,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/query.proto", fileDescriptor_e1bc52f4cb65abdb) }
 
 var fileDescriptor_e1bc52f4cb65abdb = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xbb, 0x4e, 0xf3, 0x30,
-	0x18, 0x4d, 0xa4, 0xff, 0x6f, 0x91, 0xdb, 0xc9, 0x8d, 0xb8, 0x64, 0x48, 0x4b, 0x26, 0x18, 0xea,
-	0xa8, 0x65, 0x65, 0x49, 0x58, 0x40, 0x08, 0xa9, 0x44, 0x4c, 0x2c, 0x28, 0x71, 0x4d, 0x8a, 0xa0,
-	0x71, 0x1a, 0xdb, 0x12, 0x7d, 0x0b, 0x1e, 0x83, 0x07, 0xe0, 0x21, 0x2a, 0xa6, 0x8e, 0x4c, 0x15,
-	0x6a, 0xdf, 0x82, 0x09, 0xc5, 0x17, 0xa9, 0x15, 0xd0, 0x25, 0x89, 0x8f, 0xcf, 0xc5, 0xe7, 0x73,
-	0xc0, 0x1e, 0xa6, 0x6c, 0x4c, 0x59, 0x90, 0x08, 0x3e, 0x0a, 0x26, 0x82, 0x94, 0x53, 0x54, 0x94,
-	0x94, 0x53, 0xd8, 0x50, 0x1b, 0xa8, 0xda, 0x70, 0x9d, 0x8c, 0x66, 0x54, 0xe2, 0x41, 0xf5, 0xa5,
-	0x28, 0xee, 0x41, 0x46, 0x69, 0xf6, 0x44, 0x02, 0xb9, 0x4a, 0xc5, 0x7d, 0x90, 0xe4, 0x5a, 0xed,
-	0xee, 0xae, 0xdb, 0x56, 0x0f, 0x23, 0x51, 0xf8, 0x9d, 0xf2, 0xd2, 0x11, 0x72, 0xe1, 0xa7, 0xa0,
-	0x75, 0x5d, 0xe5, 0x87, 0x18, 0x53, 0x91, 0xf3, 0x98, 0x4c, 0x04, 0x61, 0x1c, 0x5e, 0x82, 0x7a,
-	0x32, 0x1c, 0x96, 0x84, 0xb1, 0x7d, 0xbb, 0x63, 0x1f, 0x35, 0xa3, 0xde, 0xd7, 0xa2, 0xdd, 0xcd,
-	0x1e, 0xf8, 0x48, 0xa4, 0x08, 0xd3, 0xb1, 0x36, 0xd1, 0xaf, 0x2e, 0x1b, 0x3e, 0x06, 0x7c, 0x5a,
-	0x10, 0x86, 0x42, 0x8c, 0x43, 0x25, 0x8c, 0x8d, 0x83, 0x7f, 0x03, 0x9c, 0xcd, 0x0c, 0x56, 0xd0,
-	0x9c, 0x11, 0x78, 0x0a, 0xea, 0x89, 0x82, 0x64, 0x48, 0xa3, 0xef, 0x20, 0xd5, 0x0d, 0x99, 0x6e,
-	0x28, 0xcc, 0xa7, 0x51, 0xf3, 0xfd, 0xad, 0xbb, 0xa3, 0xb5, 0x17, 0xb1, 0x91, 0xf8, 0x0e, 0x80,
-	0xd2, 0x75, 0x90, 0x94, 0xc9, 0x98, 0xe9, 0x83, 0xfb, 0xe7, 0xba, 0x8f, 0x41, 0x75, 0x54, 0x0f,
-	0xd4, 0x0a, 0x89, 0xe8, 0xa4, 0x16, 0x5a, 0x1b, 0x34, 0x52, 0xe4, 0xe8, 0xdf, 0x6c, 0xd1, 0xb6,
-	0x62, 0x4d, 0xec, 0xbf, 0xda, 0xe0, 0xbf, 0xb4, 0x82, 0x03, 0x50, 0xd7, 0xf1, 0xb0, 0xb3, 0xa1,
-	0xfb, 0x65, 0x72, 0xee, 0xe1, 0x16, 0x86, 0x3a, 0x8c, 0x6f, 0xc1, 0x2b, 0x50, 0x53, 0x99, 0xb0,
-	0xfd, 0x93, 0xbe, 0x51, 0xc8, 0xed, 0xfc, 0x4d, 0x30, 0x76, 0xd1, 0xd9, 0x6c, 0xe9, 0xd9, 0xf3,
-	0xa5, 0x67, 0x7f, 0x2e, 0x3d, 0xfb, 0x65, 0xe5, 0x59, 0xf3, 0x95, 0x67, 0x7d, 0xac, 0x3c, 0xeb,
-	0xf6, 0x78, 0xeb, 0x95, 0x3d, 0xab, 0x3f, 0x45, 0xde, 0x5c, 0x5a, 0x93, 0x43, 0x3f, 0xf9, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0xf2, 0xf9, 0x5a, 0x33, 0x9c, 0x02, 0x00, 0x00,
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0x3d, 0x6e, 0xdb, 0x30,
+	0x14, 0x96, 0x8a, 0xd6, 0x2e, 0x68, 0x4f, 0x94, 0xfa, 0xa7, 0x16, 0x92, 0xad, 0xa5, 0xed, 0x60,
+	0x12, 0x76, 0xd7, 0x2e, 0x52, 0x97, 0x16, 0x5d, 0x5a, 0x21, 0x53, 0x96, 0x80, 0x92, 0x15, 0xd9,
+	0x48, 0x2c, 0xca, 0x22, 0x85, 0xc4, 0x08, 0xb2, 0xe4, 0x04, 0x01, 0x72, 0x95, 0x1c, 0xc2, 0xc8,
+	0x64, 0x20, 0x4b, 0x26, 0x23, 0xb0, 0x33, 0xe5, 0x08, 0x99, 0x02, 0x91, 0x14, 0x60, 0x21, 0x89,
+	0x17, 0x89, 0x7c, 0xfc, 0x7e, 0xde, 0x7b, 0x1f, 0xf8, 0x10, 0x51, 0x36, 0xa1, 0x0c, 0x93, 0x82,
+	0x8f, 0xf0, 0xb4, 0x88, 0xf3, 0x19, 0xca, 0x72, 0xca, 0x29, 0x6c, 0xc9, 0x07, 0x54, 0x3e, 0x58,
+	0x5f, 0x12, 0x4a, 0x93, 0xc3, 0x18, 0x93, 0x6c, 0x8c, 0x49, 0x9a, 0x52, 0x4e, 0xf8, 0x98, 0xa6,
+	0x4c, 0x42, 0x2d, 0x33, 0xa1, 0x09, 0x15, 0x47, 0x5c, 0x9e, 0x54, 0xf5, 0x93, 0xe2, 0x88, 0x5b,
+	0x58, 0xec, 0x63, 0x92, 0x2a, 0x6d, 0xeb, 0xfd, 0xa6, 0x69, 0xf9, 0xa9, 0x28, 0xb2, 0xbe, 0x27,
+	0xb5, 0x54, 0x03, 0xe2, 0xe2, 0x86, 0xc0, 0xf8, 0x5f, 0x76, 0xe7, 0x45, 0x11, 0x2d, 0x52, 0x1e,
+	0xc4, 0xd3, 0x22, 0x66, 0x1c, 0xfe, 0x05, 0x4d, 0x32, 0x1c, 0xe6, 0x31, 0x63, 0x1f, 0xf5, 0x8e,
+	0xfe, 0xad, 0xed, 0xf7, 0x1f, 0x96, 0x4e, 0x2f, 0x19, 0xf3, 0x51, 0x11, 0xa2, 0x88, 0x4e, 0x94,
+	0x88, 0xfa, 0xf5, 0xd8, 0xf0, 0x00, 0xf3, 0x59, 0x16, 0x33, 0xe4, 0x45, 0x91, 0x27, 0x89, 0x41,
+	0xa5, 0xe0, 0xee, 0x00, 0xb3, 0xee, 0xc1, 0x32, 0x9a, 0xb2, 0x18, 0xfe, 0x04, 0x4d, 0x22, 0x4b,
+	0xc2, 0xa4, 0x35, 0x30, 0x91, 0x9c, 0x0d, 0x55, 0xb3, 0x21, 0x2f, 0x9d, 0xf9, 0xed, 0xab, 0xcb,
+	0xde, 0x5b, 0xc5, 0xfd, 0x13, 0x54, 0x14, 0xd7, 0x04, 0x50, 0xa8, 0xfe, 0x23, 0x39, 0x99, 0x30,
+	0xd5, 0xb8, 0xfb, 0x1b, 0x18, 0xb5, 0xaa, 0xb2, 0xea, 0x83, 0x46, 0x26, 0x2a, 0xca, 0xc9, 0x40,
+	0x1b, 0x31, 0x20, 0x09, 0xf6, 0x5f, 0xcf, 0x97, 0x8e, 0x16, 0x28, 0xe0, 0xe0, 0x5e, 0x07, 0x6f,
+	0x84, 0x14, 0x3c, 0x02, 0x4d, 0x65, 0x0f, 0x3b, 0x35, 0xde, 0x33, 0x9b, 0xb3, 0xba, 0x5b, 0x10,
+	0xb2, 0x19, 0xf7, 0xeb, 0xd9, 0xf5, 0xdd, 0xc5, 0xab, 0x2e, 0x74, 0x70, 0x2d, 0x2f, 0x89, 0x62,
+	0xf8, 0x44, 0xed, 0xed, 0x14, 0x8e, 0x40, 0x43, 0xb6, 0x06, 0x9d, 0xa7, 0xaa, 0xb5, 0xb9, 0xad,
+	0xce, 0xcb, 0x00, 0xe5, 0xfa, 0x59, 0xb8, 0xbe, 0x83, 0x46, 0xcd, 0x55, 0x0e, 0xeb, 0xff, 0x9a,
+	0xaf, 0x6c, 0x7d, 0xb1, 0xb2, 0xf5, 0xdb, 0x95, 0xad, 0x9f, 0xaf, 0x6d, 0x6d, 0xb1, 0xb6, 0xb5,
+	0x9b, 0xb5, 0xad, 0xed, 0x7e, 0xdf, 0x1a, 0xfa, 0xb1, 0x54, 0x11, 0xd9, 0x87, 0x0d, 0x11, 0xdb,
+	0x8f, 0xc7, 0x01, 0x00, 0x8a, 0x91, 0x1d, 0x8e, 0xfc, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
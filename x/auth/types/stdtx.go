@@ -22,10 +22,12 @@ const MaxGasWanted = uint64((1 << 63) - 1)
 
 // Deprecated: StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
-// which must be above some miminum to be accepted into the mempool.
+// which must be above some miminum to be accepted into the mempool. If a
+// granter is set, the fee is paid from the granter's fee allowance.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas     uint64         `json:"gas" yaml:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty" yaml:"granter,omitempty"`
 }
 
 // Deprecated: NewStdFee returns a new instance of StdFee
@@ -46,6 +48,11 @@ func (fee StdFee) GetAmount() sdk.Coins {
 	return fee.Amount
 }
 
+// GetGranter returns the account paying the fee from its fee allowance, if any.
+func (fee StdFee) GetGranter() sdk.AccAddress {
+	return fee.Granter
+}
+
 // Bytes returns the encoded bytes of a StdFee.
 func (fee StdFee) Bytes() []byte {
 	if len(fee.Amount) == 0 {
@@ -280,6 +287,11 @@ func (tx StdTx) FeePayer() sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
+// FeeGranter returns the address whose fee allowance pays the fee, if any.
+func (tx StdTx) FeeGranter() sdk.AccAddress {
+	return tx.Fee.Granter
+}
+
 // StdSignDoc is replay-prevention structure.
 // It includes the result of msg.GetSignBytes(),
 // as well as the ChainID (prevent cross chain replay)
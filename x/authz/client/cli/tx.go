@@ -0,0 +1,230 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// authz CLI flags
+const (
+	FlagMsgType    = "msg-type"
+	FlagSpendLimit = "spend-limit"
+	FlagExpiration = "expiration"
+)
+
+// authorization types supported by the grant command
+const (
+	authorizationTypeGeneric = "generic"
+	authorizationTypeSend    = "send"
+)
+
+// GetTxCmd returns the transaction commands for the authz module.
+func GetTxCmd() *cobra.Command {
+	authorizationTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		Long:                       "Authorize and revoke access to execute transactions on behalf of your address",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authorizationTxCmd.AddCommand(
+		NewCmdGrantAuthorization(),
+		NewCmdRevokeAuthorization(),
+		NewCmdExecAuthorization(),
+	)
+
+	return authorizationTxCmd
+}
+
+// NewCmdGrantAuthorization returns a CLI command handler for creating a
+// MsgGrant transaction.
+func NewCmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [authorization-type] --from [granter]",
+		Short: "Grant authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to an address to execute a transaction on your behalf.
+The authorization type is either "generic", which authorizes any message of the
+given --msg-type, or "send", which authorizes bank sends up to the --spend-limit.
+
+Examples:
+$ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
+$ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.MsgVote --from=cosmos1sk..
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization types.Authorization
+			switch args[1] {
+			case authorizationTypeGeneric:
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				authorization = types.NewGenericAuthorization(msgType)
+
+			case authorizationTypeSend:
+				limit, err := cmd.Flags().GetString(FlagSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				spendLimit, err := sdk.ParseCoins(limit)
+				if err != nil {
+					return err
+				}
+
+				authorization = types.NewSendAuthorization(spendLimit)
+
+			default:
+				return fmt.Errorf("invalid authorization type %q, expected %q or %q",
+					args[1], authorizationTypeGeneric, authorizationTypeSend)
+			}
+
+			expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, expirationStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMsgType, "", "The message type URL the generic authorization is granted for")
+	cmd.Flags().String(FlagSpendLimit, "", "The maximum amount of coins the send authorization allows to spend")
+	cmd.Flags().String(FlagExpiration, time.Now().AddDate(1, 0, 0).UTC().Format(time.RFC3339),
+		"The RFC 3339 expiration time of the authorization")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevokeAuthorization returns a CLI command handler for creating a
+// MsgRevoke transaction.
+func NewCmdRevokeAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg-type-url] --from [granter]",
+		Short: "Revoke an authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization of an address to execute messages of a type on your behalf.
+
+Example:
+$ %s tx %s revoke cosmos1skjw.. /cosmos.bank.MsgSend --from=cosmos1skj..
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevoke(clientCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdExecAuthorization returns a CLI command handler for creating a
+// MsgExec transaction.
+func NewCmdExecAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [tx-json-file] --from [grantee]",
+		Short: "Execute the messages of a transaction on behalf of their signers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of an unsigned transaction on behalf of their signers,
+using the authorizations granted to you.
+
+Example:
+$ %s tx %s exec tx.json --from=cosmos1skjw..
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExec(clientCtx.GetFromAddress(), theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
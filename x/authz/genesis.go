@@ -0,0 +1,45 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// InitGenesis initializes the authz module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	for _, entry := range gs.Authorization {
+		if err := k.Grant(ctx, entry.Granter, entry.Grantee, entry.GetAuthorization(), entry.Expiration); err != nil {
+			panic(fmt.Sprintf("failed to import %s authorization grant: %s", types.ModuleName, err))
+		}
+	}
+}
+
+// ExportGenesis returns the authz module's exported genesis. Expired grants
+// are not exported.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	var entries []types.GrantAuthorization
+
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		if grant.IsExpired(ctx.BlockTime()) {
+			return false
+		}
+
+		entry, err := types.NewGrantAuthorization(granter, grantee, grant.GetAuthorization(), grant.Expiration)
+		if err != nil {
+			panic(err)
+		}
+
+		entries = append(entries, entry)
+		return false
+	})
+
+	return types.NewGenesisState(entries)
+}
@@ -0,0 +1,80 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var (
+	granter = sdk.AccAddress([]byte("granter_____________"))
+	grantee = sdk.AccAddress([]byte("grantee_____________"))
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1, Time: time.Now().UTC()})
+}
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	app, ctx := suite.app, suite.ctx
+	now := ctx.BlockTime()
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	suite.Require().NoError(app.AuthzKeeper.Grant(
+		ctx, granter, grantee, types.NewSendAuthorization(spendLimit), now.Add(time.Hour),
+	))
+	suite.Require().NoError(app.AuthzKeeper.Grant(
+		ctx, granter, grantee, types.NewGenericAuthorization("/cosmos.gov.MsgVote"), now.Add(time.Minute),
+	))
+
+	// expired grants are not exported
+	genesis := authz.ExportGenesis(ctx.WithBlockTime(now.Add(time.Minute)), app.AuthzKeeper)
+	suite.Require().Len(genesis.Authorization, 1)
+	suite.Require().Equal(types.NewSendAuthorization(spendLimit), genesis.Authorization[0].GetAuthorization())
+
+	// round trip the genesis state through JSON
+	cdc := app.AppCodec()
+	var imported types.GenesisState
+	suite.Require().NoError(cdc.UnmarshalJSON(cdc.MustMarshalJSON(genesis), &imported))
+
+	suite.SetupTest()
+	app, ctx = suite.app, suite.ctx
+	suite.Require().NotPanics(func() { authz.InitGenesis(ctx, app.AuthzKeeper, imported) })
+
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granter, grantee, "/cosmos.bank.MsgSend")
+	suite.Require().Equal(types.NewSendAuthorization(spendLimit), authorization)
+	suite.Require().True(genesis.Authorization[0].Expiration.Equal(expiration))
+}
+
+func (suite *GenesisTestSuite) TestInitGenesis_Invalid() {
+	entry, err := types.NewGrantAuthorization(
+		granter, granter, types.NewGenericAuthorization("/cosmos.gov.MsgVote"), suite.ctx.BlockTime().Add(time.Hour),
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().Panics(func() {
+		authz.InitGenesis(suite.ctx, suite.app.AuthzKeeper, types.NewGenesisState([]types.GrantAuthorization{entry}))
+	})
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,53 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(1000))
+	granter, grantee, recipient := addrs[0], addrs[1], addrs[2]
+	handler := authz.NewHandler(app.AuthzKeeper)
+
+	msgSend := banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	msgExec, err := types.NewMsgExec(grantee, []sdk.Msg{msgSend})
+	require.NoError(t, err)
+
+	// no grant yet
+	_, err = handler(ctx, msgExec)
+	require.Error(t, err)
+
+	authorization := types.NewGenericAuthorization(types.MsgTypeURL(msgSend))
+	msgGrant, err := types.NewMsgGrant(granter, grantee, authorization, ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	res, err := handler(ctx, msgGrant)
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeGrantAuthorization, res.Events[0].Type)
+
+	res, err = handler(ctx, msgExec)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Data)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1010)), app.BankKeeper.GetAllBalances(ctx, recipient))
+
+	_, err = handler(ctx, types.NewMsgRevoke(granter, grantee, types.MsgTypeURL(msgSend)))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, msgExec)
+	require.Error(t, err)
+
+	_, err = handler(ctx, msgSend)
+	require.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Authorization implements the Query/Authorization gRPC method
+func (k Keeper) Authorization(c context.Context, req *types.QueryAuthorizationRequest) (*types.QueryAuthorizationResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() || req.Grantee.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "granter and grantee cannot be empty")
+	}
+
+	if req.MsgTypeURL == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty message type URL")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	authorization, expiration := k.GetCleanAuthorization(ctx, req.Granter, req.Grantee, req.MsgTypeURL)
+	if authorization == nil {
+		return nil, status.Errorf(
+			codes.NotFound, "no authorization found for %s from %s to %s", req.MsgTypeURL, req.Granter, req.Grantee,
+		)
+	}
+
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizationResponse{Authorization: &grant}, nil
+}
+
+// Authorizations implements the Query/Authorizations gRPC method
+func (k Keeper) Authorizations(c context.Context, req *types.QueryAuthorizationsRequest) (*types.QueryAuthorizationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() || req.Grantee.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "granter and grantee cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*types.AuthorizationGrant
+	store := ctx.KVStore(k.storeKey)
+	grantsStore := prefix.NewStore(store, types.GetGrantsStoreKey(req.Granter, req.Grantee))
+
+	pageRes, err := query.Paginate(grantsStore, req.Pagination, func(key []byte, value []byte) error {
+		var grant types.AuthorizationGrant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return err
+		}
+
+		if !grant.IsExpired(ctx.BlockTime()) {
+			grants = append(grants, &grant)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizationsResponse{Authorizations: grants, Pagination: pageRes}, nil
+}
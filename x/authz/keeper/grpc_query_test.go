@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryAuthorization() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+
+	_, err := queryClient.Authorization(gocontext.Background(), &types.QueryAuthorizationRequest{})
+	suite.Require().Error(err)
+
+	req := &types.QueryAuthorizationRequest{Granter: granter, Grantee: grantee, MsgTypeURL: sendMsgTypeURL}
+	_, err = queryClient.Authorization(gocontext.Background(), req)
+	suite.Require().Error(err)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	expiration := ctx.BlockTime().Add(time.Hour)
+	err = app.AuthzKeeper.Grant(ctx, granter, grantee, types.NewSendAuthorization(spendLimit), expiration)
+	suite.Require().NoError(err)
+
+	res, err := queryClient.Authorization(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewSendAuthorization(spendLimit), res.Authorization.GetAuthorization())
+	suite.Require().True(expiration.Equal(res.Authorization.Expiration))
+}
+
+func (suite *KeeperTestSuite) TestQueryAuthorizations() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+
+	_, err := queryClient.Authorizations(gocontext.Background(), &types.QueryAuthorizationsRequest{})
+	suite.Require().Error(err)
+
+	msgTypeURLs := []string{"/cosmos.gov.MsgVote", "/cosmos.gov.MsgDeposit", sendMsgTypeURL}
+	expiration := ctx.BlockTime().Add(time.Hour)
+	for _, url := range msgTypeURLs {
+		err = app.AuthzKeeper.Grant(ctx, granter, grantee, types.NewGenericAuthorization(url), expiration)
+		suite.Require().NoError(err)
+	}
+
+	req := &types.QueryAuthorizationsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	}
+	res, err := queryClient.Authorizations(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Authorizations, 2)
+	suite.Require().Equal(uint64(len(msgTypeURLs)), res.Pagination.Total)
+
+	// grants to other grantees are not included
+	req = &types.QueryAuthorizationsRequest{Granter: grantee, Grantee: granter}
+	res, err = queryClient.Authorizations(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Authorizations)
+}
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper defines the authz module's keeper. It manages the authorization
+// grants and executes messages on behalf of their granters.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.Marshaler
+	router   sdk.Router
+}
+
+// NewKeeper constructs a message authorization Keeper. The router is used to
+// execute the authorized messages, and is usually the BaseApp's router.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.Marshaler, router sdk.Router) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// DispatchActions attempts to execute the provided messages via the router on
+// behalf of their signers. Messages signed by the grantee itself are executed
+// directly, all others require an unexpired authorization of the grantee by
+// the message's signer which accepts the message.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) (*sdk.Result, error) {
+	txData := &sdk.TxMsgData{
+		Data: make([]*sdk.MsgData, 0, len(msgs)),
+	}
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message %d must have exactly one signer", i)
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.acceptAuthorization(ctx, granter, grantee, msg); err != nil {
+				return nil, sdkerrors.Wrapf(err, "message index: %d", i)
+			}
+		}
+
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		msgResult, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		txData.Data = append(txData.Data, &sdk.MsgData{MsgType: msg.Type(), Data: msgResult.Data})
+		txData.MsgResponses = append(txData.MsgResponses, msgResult.MsgResponses...)
+	}
+
+	data, err := proto.Marshal(txData)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal execution data")
+	}
+
+	return &sdk.Result{Data: data, Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// acceptAuthorization checks that the grantee is authorized to execute the
+// message on behalf of the granter, and updates or deletes the authorization
+// as requested by it.
+func (k Keeper) acceptAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgTypeURL := types.MsgTypeURL(msg)
+
+	authorization, _ := k.GetCleanAuthorization(ctx, granter, grantee, msgTypeURL)
+	if authorization == nil {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s to %s for %s", granter, grantee, msgTypeURL)
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.Delete:
+		k.deleteGrant(ctx, granter, grantee, msgTypeURL)
+	case resp.Updated != nil:
+		if err := k.update(ctx, granter, grantee, resp.Updated); err != nil {
+			return err
+		}
+	}
+
+	if !resp.Accept {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s to %s for %s", granter, grantee, msgTypeURL)
+	}
+
+	return nil
+}
+
+// Grant grants the grantee the provided authorization on behalf of the granter
+// until the expiration time. Any existing authorization of the grantee for the
+// same message type is overwritten.
+func (k Keeper) Grant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.Authorization, expiration time.Time,
+) error {
+	if !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidExpirationTime, "%s <= %s", expiration, ctx.BlockTime())
+	}
+
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	k.setGrant(ctx, granter, grantee, authorization.MsgTypeURL(), grant)
+
+	return nil
+}
+
+// Revoke revokes the authorization of the grantee to execute messages of the
+// given type on behalf of the granter.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) error {
+	if _, found := k.getGrant(ctx, granter, grantee, msgTypeURL); !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s to %s for %s", granter, grantee, msgTypeURL)
+	}
+
+	k.deleteGrant(ctx, granter, grantee, msgTypeURL)
+
+	return nil
+}
+
+// GetCleanAuthorization returns the authorization of the grantee by the granter
+// for the given message type and its expiration time. If the authorization is
+// expired, it is deleted and nil is returned.
+func (k Keeper) GetCleanAuthorization(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string,
+) (types.Authorization, time.Time) {
+	grant, found := k.getGrant(ctx, granter, grantee, msgTypeURL)
+	if !found {
+		return nil, time.Time{}
+	}
+
+	if grant.IsExpired(ctx.BlockTime()) {
+		k.deleteGrant(ctx, granter, grantee, msgTypeURL)
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorization(), grant.Expiration
+}
+
+// IterateGrants iterates over all the authorization grants, including the
+// expired ones not yet deleted. Iteration stops when the callback returns true.
+func (k Keeper) IterateGrants(
+	ctx sdk.Context, cb func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GrantKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		granter, grantee, _ := types.ParseGrantStoreKey(iterator.Key())
+		if cb(granter, grantee, grant) {
+			break
+		}
+	}
+}
+
+// update replaces the authorization of an existing grant, keeping its
+// expiration time.
+func (k Keeper) update(ctx sdk.Context, granter, grantee sdk.AccAddress, updated types.Authorization) error {
+	msgTypeURL := updated.MsgTypeURL()
+
+	grant, found := k.getGrant(ctx, granter, grantee, msgTypeURL)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s to %s for %s", granter, grantee, msgTypeURL)
+	}
+
+	grant, err := types.NewAuthorizationGrant(updated, grant.Expiration)
+	if err != nil {
+		return err
+	}
+
+	k.setGrant(ctx, granter, grantee, msgTypeURL, grant)
+
+	return nil
+}
+
+func (k Keeper) getGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string,
+) (grant types.AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetGrantStoreKey(granter, grantee, msgTypeURL))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+func (k Keeper) setGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string, grant types.AuthorizationGrant,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGrantStoreKey(granter, grantee, msgTypeURL), k.cdc.MustMarshalBinaryBare(&grant))
+}
+
+func (k Keeper) deleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetGrantStoreKey(granter, grantee, msgTypeURL))
+}
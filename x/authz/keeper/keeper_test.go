@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var sendMsgTypeURL = types.MsgTypeURL(&banktypes.MsgSend{})
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestGrantRevoke() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	now := ctx.BlockTime()
+
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granter, grantee, sendMsgTypeURL)
+	suite.Require().Nil(authorization)
+
+	// expiration must be in the future
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	err := app.AuthzKeeper.Grant(ctx, granter, grantee, types.NewSendAuthorization(spendLimit), now)
+	suite.Require().Error(err)
+
+	expiration := now.Add(time.Hour)
+	err = app.AuthzKeeper.Grant(ctx, granter, grantee, types.NewSendAuthorization(spendLimit), expiration)
+	suite.Require().NoError(err)
+
+	authorization, exp := app.AuthzKeeper.GetCleanAuthorization(ctx, granter, grantee, sendMsgTypeURL)
+	suite.Require().Equal(types.NewSendAuthorization(spendLimit), authorization)
+	suite.Require().True(expiration.Equal(exp))
+
+	// grants are directional
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, sendMsgTypeURL)
+	suite.Require().Nil(authorization)
+
+	suite.Require().NoError(app.AuthzKeeper.Revoke(ctx, granter, grantee, sendMsgTypeURL))
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granter, grantee, sendMsgTypeURL)
+	suite.Require().Nil(authorization)
+
+	suite.Require().Error(app.AuthzKeeper.Revoke(ctx, granter, grantee, sendMsgTypeURL))
+}
+
+func (suite *KeeperTestSuite) TestExpiredGrant() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	err := app.AuthzKeeper.Grant(ctx, granter, grantee, types.NewGenericAuthorization(sendMsgTypeURL), expiration)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(expiration)
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granter, grantee, sendMsgTypeURL)
+	suite.Require().Nil(authorization)
+
+	// the expired grant is deleted
+	suite.Require().Error(app.AuthzKeeper.Revoke(ctx, granter, grantee, sendMsgTypeURL))
+}
+
+func (suite *KeeperTestSuite) TestDispatchActions() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))
+	msgs := []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sendCoins)}
+
+	// no authorization
+	_, err := app.AuthzKeeper.DispatchActions(ctx, grantee, msgs)
+	suite.Require().Error(err)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	expiration := ctx.BlockTime().Add(time.Hour)
+	err = app.AuthzKeeper.Grant(ctx, granter, grantee, types.NewSendAuthorization(spendLimit), expiration)
+	suite.Require().NoError(err)
+
+	// the authorization is updated with the remaining spend limit
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, msgs)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sendCoins, app.BankKeeper.GetAllBalances(ctx, recipient).Sub(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))))
+
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granter, grantee, sendMsgTypeURL)
+	suite.Require().Equal(types.NewSendAuthorization(spendLimit.Sub(sendCoins)), authorization)
+
+	// the spend limit is exceeded
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, msgs)
+	suite.Require().Error(err)
+
+	// spending the full remaining limit deletes the authorization
+	msgs = []sdk.Msg{banktypes.NewMsgSend(granter, recipient, spendLimit.Sub(sendCoins))}
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, msgs)
+	suite.Require().NoError(err)
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granter, grantee, sendMsgTypeURL)
+	suite.Require().Nil(authorization)
+
+	// messages signed by the grantee need no authorization
+	msgs = []sdk.Msg{banktypes.NewMsgSend(grantee, recipient, sendCoins)}
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, msgs)
+	suite.Require().NoError(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
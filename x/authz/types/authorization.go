@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the interface of the various authorization types.
+// An Authorization grants a grantee the right to execute messages of a single
+// type on behalf of a granter.
+type Authorization interface {
+	proto.Message
+
+	// MsgTypeURL returns the type URL of the messages the authorization
+	// permits, e.g. "/cosmos.bank.MsgSend".
+	MsgTypeURL() string
+
+	// Accept determines whether the authorization permits the given message
+	// to be executed, and whether the authorization must be updated or
+	// deleted as a result.
+	Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error)
+
+	// ValidateBasic does a simple validation check that doesn't require
+	// access to any other information.
+	ValidateBasic() error
+}
+
+// AcceptResponse is the result of Authorization.Accept.
+type AcceptResponse struct {
+	// Accept is true if the message is permitted by the authorization.
+	Accept bool
+	// Delete is true if the authorization must be deleted, e.g. once a
+	// spend limit is exhausted.
+	Delete bool
+	// Updated is the new state of the authorization if it must be updated.
+	Updated Authorization
+}
+
+// MsgTypeURL returns the type URL of a message, as used to identify the
+// messages an Authorization permits.
+func MsgTypeURL(msg proto.Message) string {
+	return "/" + proto.MessageName(msg)
+}
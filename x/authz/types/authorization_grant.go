@@ -0,0 +1,83 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = AuthorizationGrant{}
+	_ types.UnpackInterfacesMessage = GrantAuthorization{}
+)
+
+// NewAuthorizationGrant creates a new AuthorizationGrant object.
+func NewAuthorizationGrant(authorization Authorization, expiration time.Time) (AuthorizationGrant, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return AuthorizationGrant{}, err
+	}
+
+	return AuthorizationGrant{Authorization: any, Expiration: expiration}, nil
+}
+
+// GetAuthorization returns the grant's Authorization.
+func (g AuthorizationGrant) GetAuthorization() Authorization {
+	return unpackAuthorization(g.Authorization)
+}
+
+// IsExpired returns true if the grant is expired at the given time.
+func (g AuthorizationGrant) IsExpired(now time.Time) bool {
+	return !g.Expiration.After(now)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g AuthorizationGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// NewGrantAuthorization creates a new GrantAuthorization object.
+func NewGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time,
+) (GrantAuthorization, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return GrantAuthorization{}, err
+	}
+
+	return GrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorization returns the grant's Authorization.
+func (g GrantAuthorization) GetAuthorization() Authorization {
+	return unpackAuthorization(g.Authorization)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+func packAuthorization(authorization Authorization) (*types.Any, error) {
+	if authorization == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidAuthorization, "authorization cannot be nil")
+	}
+	return types.NewAnyWithValue(authorization)
+}
+
+func unpackAuthorization(any *types.Any) Authorization {
+	authorization, ok := any.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestGenericAuthorization(t *testing.T) {
+	authorization := types.NewGenericAuthorization(types.MsgTypeURL(&govtypes.MsgVote{}))
+	require.NoError(t, authorization.ValidateBasic())
+
+	resp, err := authorization.Accept(sdk.Context{}, govtypes.NewMsgVote(granter, 1, govtypes.OptionYes))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	resp, err = authorization.Accept(sdk.Context{}, banktypes.NewMsgSend(granter, grantee, coins))
+	require.NoError(t, err)
+	require.False(t, resp.Accept)
+}
+
+func TestSendAuthorization(t *testing.T) {
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	authorization := types.NewSendAuthorization(spendLimit)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "/cosmos.bank.MsgSend", authorization.MsgTypeURL())
+
+	// within the limit
+	send := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+	resp, err := authorization.Accept(sdk.Context{}, banktypes.NewMsgSend(granter, grantee, send))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewSendAuthorization(spendLimit.Sub(send)), resp.Updated)
+
+	// exhausting the limit
+	resp, err = authorization.Accept(sdk.Context{}, banktypes.NewMsgSend(granter, grantee, spendLimit))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	// exceeding the limit
+	send = sdk.NewCoins(sdk.NewInt64Coin("atom", 101))
+	_, err = authorization.Accept(sdk.Context{}, banktypes.NewMsgSend(granter, grantee, send))
+	require.Error(t, err)
+
+	// other denoms are not allowed
+	send = sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+	_, err = authorization.Accept(sdk.Context{}, banktypes.NewMsgSend(granter, grantee, send))
+	require.Error(t, err)
+
+	// only MsgSend is allowed
+	_, err = authorization.Accept(sdk.Context{}, govtypes.NewMsgVote(granter, 1, govtypes.OptionYes))
+	require.Error(t, err)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	ErrInvalidAuthorization  = sdkerrors.Register(ModuleName, 2, "invalid authorization")
+	ErrNoAuthorizationFound  = sdkerrors.Register(ModuleName, 3, "authorization not found")
+	ErrInvalidExpirationTime = sdkerrors.Register(ModuleName, 4, "expiration time of authorization should be more than current time")
+	ErrSpendLimitExceeded    = sdkerrors.Register(ModuleName, 5, "requested amount is more than spend limit")
+)
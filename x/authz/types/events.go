@@ -0,0 +1,13 @@
+package types
+
+// authz module events
+const (
+	EventTypeGrantAuthorization  = "grant_authorization"
+	EventTypeRevokeAuthorization = "revoke_authorization"
+	EventTypeExecAuthorization   = "exec_authorization"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+)
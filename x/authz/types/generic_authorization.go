@@ -0,0 +1,34 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization object.
+func NewGenericAuthorization(msgTypeURL string) *GenericAuthorization {
+	return &GenericAuthorization{Msg: msgTypeURL}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a GenericAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. A GenericAuthorization accepts any
+// message of its type and is never updated.
+func (a GenericAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	return AcceptResponse{Accept: MsgTypeURL(msg) == a.Msg}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a GenericAuthorization) ValidateBasic() error {
+	if !strings.HasPrefix(a.Msg, "/") || len(a.Msg) == 1 {
+		return sdkerrors.Wrapf(ErrInvalidAuthorization, "invalid message type URL %q", a.Msg)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// GenesisState defines the authz module's genesis state.
+type GenesisState struct {
+	Authorization []GrantAuthorization `json:"authorization" yaml:"authorization"`
+}
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(entries []GrantAuthorization) GenesisState {
+	return GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Authorization: []GrantAuthorization{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for i, grant := range gs.Authorization {
+		if grant.Granter.Empty() || grant.Grantee.Empty() {
+			return fmt.Errorf("authorization %d: granter and grantee cannot be empty", i)
+		}
+		if grant.Granter.Equals(grant.Grantee) {
+			return fmt.Errorf("authorization %d: granter and grantee cannot be the same", i)
+		}
+
+		authorization := grant.GetAuthorization()
+		if authorization == nil {
+			return fmt.Errorf("authorization %d: missing authorization", i)
+		}
+		if err := authorization.ValidateBasic(); err != nil {
+			return fmt.Errorf("authorization %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range gs.Authorization {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// GrantKeyPrefix is the prefix of the authorization grants, stored by
+	// granter, grantee and message type URL
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GetGrantStoreKey returns the store key of the grant of the grantee by the
+// granter for the given message type:
+// 0x01<granter><grantee><msgTypeURL>
+func GetGrantStoreKey(granter, grantee sdk.AccAddress, msgTypeURL string) []byte {
+	return append(GetGrantsStoreKey(granter, grantee), []byte(msgTypeURL)...)
+}
+
+// GetGrantsStoreKey returns the prefix of all the grants of the grantee by
+// the granter: 0x01<granter><grantee>
+func GetGrantsStoreKey(granter, grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(GrantKeyPrefix)+len(granter)+len(grantee))
+	key = append(key, GrantKeyPrefix...)
+	key = append(key, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+// ParseGrantStoreKey returns the granter, grantee and message type URL of a
+// grant store key.
+func ParseGrantStoreKey(key []byte) (granter, grantee sdk.AccAddress, msgTypeURL string) {
+	key = key[len(GrantKeyPrefix):]
+	granter = sdk.AccAddress(key[:sdk.AddrLen])
+	grantee = sdk.AccAddress(key[sdk.AddrLen : 2*sdk.AddrLen])
+	msgTypeURL = string(key[2*sdk.AddrLen:])
+
+	return granter, grantee, msgTypeURL
+}
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	granter = sdk.AccAddress([]byte("granter_____________"))
+	grantee = sdk.AccAddress([]byte("grantee_____________"))
+	coins   = sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+)
+
+func TestMsgGrantValidateBasic(t *testing.T) {
+	expiration := time.Now().Add(time.Hour)
+
+	cases := []struct {
+		name          string
+		granter       sdk.AccAddress
+		grantee       sdk.AccAddress
+		authorization types.Authorization
+		expPass       bool
+	}{
+		{"valid send authorization", granter, grantee, types.NewSendAuthorization(coins), true},
+		{"valid generic authorization", granter, grantee, types.NewGenericAuthorization("/cosmos.gov.MsgVote"), true},
+		{"empty granter", nil, grantee, types.NewSendAuthorization(coins), false},
+		{"empty grantee", granter, nil, types.NewSendAuthorization(coins), false},
+		{"granter is grantee", granter, granter, types.NewSendAuthorization(coins), false},
+		{"zero spend limit", granter, grantee, types.NewSendAuthorization(sdk.NewCoins()), false},
+		{"invalid message type URL", granter, grantee, types.NewGenericAuthorization("MsgVote"), false},
+	}
+
+	for _, tc := range cases {
+		msg, err := types.NewMsgGrant(tc.granter, tc.grantee, tc.authorization, expiration)
+		require.NoError(t, err, tc.name)
+
+		if tc.expPass {
+			require.NoError(t, msg.ValidateBasic(), tc.name)
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners(), tc.name)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.name)
+		}
+	}
+
+	_, err := types.NewMsgGrant(granter, grantee, nil, expiration)
+	require.Error(t, err)
+}
+
+func TestMsgRevokeValidateBasic(t *testing.T) {
+	sendMsgTypeURL := types.MsgTypeURL(&banktypes.MsgSend{})
+
+	require.NoError(t, types.NewMsgRevoke(granter, grantee, sendMsgTypeURL).ValidateBasic())
+	require.Error(t, types.NewMsgRevoke(nil, grantee, sendMsgTypeURL).ValidateBasic())
+	require.Error(t, types.NewMsgRevoke(granter, nil, sendMsgTypeURL).ValidateBasic())
+	require.Error(t, types.NewMsgRevoke(granter, granter, sendMsgTypeURL).ValidateBasic())
+	require.Error(t, types.NewMsgRevoke(granter, grantee, "").ValidateBasic())
+}
+
+func TestMsgExec(t *testing.T) {
+	msgSend := banktypes.NewMsgSend(granter, grantee, coins)
+
+	msg, err := types.NewMsgExec(grantee, []sdk.Msg{msgSend})
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+
+	msgs, err := msg.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{msgSend}, msgs)
+
+	// the sign bytes include the executed message's sign bytes
+	require.Contains(t, string(msg.GetSignBytes()), string(msgSend.GetSignBytes()))
+
+	msg, err = types.NewMsgExec(grantee, nil)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = types.NewMsgExec(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, nil)})
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
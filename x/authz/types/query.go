@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = QueryAuthorizationResponse{}
+	_ types.UnpackInterfacesMessage = QueryAuthorizationsResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryAuthorizationResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if res.Authorization == nil {
+		return nil
+	}
+	return res.Authorization.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryAuthorizationsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range res.Authorizations {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SendAuthorization) MsgTypeURL() string {
+	return MsgTypeURL(&banktypes.MsgSend{})
+}
+
+// Accept implements Authorization.Accept. It accepts a MsgSend as long as its
+// amount is within the spend limit, which is reduced by the amount sent. The
+// authorization is deleted once the spend limit is exhausted.
+func (a SendAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	msgSend, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &banktypes.MsgSend{}, msg)
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(msgSend.Amount)
+	if isNegative {
+		return AcceptResponse{}, sdkerrors.Wrapf(ErrSpendLimitExceeded, "%s > %s", msgSend.Amount, a.SpendLimit)
+	}
+	if limitLeft.IsZero() {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return AcceptResponse{Accept: true, Updated: NewSendAuthorization(limitLeft)}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() || a.SpendLimit.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit must be positive, got %s", a.SpendLimit)
+	}
+	return nil
+}
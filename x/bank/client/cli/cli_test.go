@@ -13,10 +13,38 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+var denomMetadata = []types.Metadata{
+	{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	},
+	{
+		Description: "Ethereum mainnet token",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "wei", Exponent: 0},
+			{Denom: "eth", Exponent: 18, Aliases: []string{"ether"}},
+		},
+		Base:    "wei",
+		Display: "eth",
+	},
+}
+
+var sendEnabled = []types.SendEnabled{
+	{Denom: "uatom", Enabled: true},
+	{Denom: "wei", Enabled: false},
+}
+
 type IntegrationTestSuite struct {
 	suite.Suite
 
@@ -30,10 +58,20 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = 1
 
+	var bankGenesis types.GenesisState
+	s.Require().NoError(cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &bankGenesis))
+
+	bankGenesis.DenomMetadata = denomMetadata
+	bankGenesis.SendEnabled = sendEnabled
+
+	bankGenesisBz, err := cfg.Codec.MarshalJSON(bankGenesis)
+	s.Require().NoError(err)
+	cfg.GenesisState[types.ModuleName] = bankGenesisBz
+
 	s.cfg = cfg
 	s.network = network.New(s.T(), cfg)
 
-	_, err := s.network.WaitForHeight(1)
+	_, err = s.network.WaitForHeight(1)
 	s.Require().NoError(err)
 }
 
@@ -182,6 +220,118 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdDenomsMetadata() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  fmt.Stringer
+		expected  fmt.Stringer
+	}{
+		{
+			"all denoms client metadata",
+			[]string{},
+			false,
+			&types.QueryDenomsMetadataResponse{},
+			&types.QueryDenomsMetadataResponse{
+				Metadatas:  denomMetadata,
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			"client metadata of a specific denomination",
+			[]string{fmt.Sprintf("--%s=%s", cli.FlagDenom, "uatom")},
+			false,
+			&types.Metadata{},
+			&denomMetadata[0],
+		},
+		{
+			"client metadata of a bogus denom",
+			[]string{fmt.Sprintf("--%s=foobar", cli.FlagDenom)},
+			true,
+			&types.Metadata{},
+			&types.Metadata{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdDenomsMetadata()
+			_, out := testutil.ApplyMockIO(cmd)
+
+			clientCtx := val.ClientCtx.WithOutput(out)
+
+			ctx := context.Background()
+			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+
+			out.Reset()
+			cmd.SetArgs(tc.args)
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQuerySendEnabled() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *types.QuerySendEnabledResponse
+	}{
+		{
+			"all send enabled flags",
+			[]string{},
+			&types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{&sendEnabled[0], &sendEnabled[1]},
+				Pagination:  &query.PageResponse{},
+			},
+		},
+		{
+			"send enabled flags of specific denominations",
+			[]string{"wei", "foobar"},
+			&types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{&sendEnabled[1]},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySendEnabled()
+			_, out := testutil.ApplyMockIO(cmd)
+
+			clientCtx := val.ClientCtx.WithOutput(out)
+
+			ctx := context.Background()
+			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+
+			out.Reset()
+			cmd.SetArgs(tc.args)
+
+			s.Require().NoError(cmd.ExecuteContext(ctx))
+
+			var resp types.QuerySendEnabledResponse
+			s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &resp), out.String())
+			s.Require().Equal(tc.expected.String(), resp.String())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	val := s.network.Validators[0]
 
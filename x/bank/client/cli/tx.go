@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
@@ -63,3 +68,76 @@ func NewSendTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdSubmitProposal implements the command to submit a set-send-enabled proposal
+func GetCmdSubmitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-send-enabled [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a set send enabled proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to set the send enabled flags of coin denominations
+along with an initial deposit. The denominations listed in use_default_for have
+their flag removed, and fall back to the default_send_enabled param.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-send-enabled <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Freeze Voucher",
+  "description": "Disable the transfers of an IBC voucher",
+  "send_enabled": [
+    {
+      "denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+      "enabled": false
+    }
+  ],
+  "use_default_for": ["footoken"],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseSetSendEnabledProposalJSON(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSetSendEnabledProposal(
+				proposal.Title, proposal.Description, proposal.SendEnabled, proposal.UseDefaultFor,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
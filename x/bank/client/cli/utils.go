@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type (
+	// SetSendEnabledProposalJSON defines a SetSendEnabledProposal with a deposit
+	SetSendEnabledProposalJSON struct {
+		Title         string              `json:"title" yaml:"title"`
+		Description   string              `json:"description" yaml:"description"`
+		SendEnabled   []types.SendEnabled `json:"send_enabled" yaml:"send_enabled"`
+		UseDefaultFor []string            `json:"use_default_for" yaml:"use_default_for"`
+		Deposit       string              `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseSetSendEnabledProposalJSON reads and parses a SetSendEnabledProposalJSON from a file.
+func ParseSetSendEnabledProposalJSON(cdc codec.JSONMarshaler, proposalFile string) (SetSendEnabledProposalJSON, error) {
+	proposal := SetSendEnabledProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
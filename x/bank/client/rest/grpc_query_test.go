@@ -0,0 +1,70 @@
+package rest_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *IntegrationTestSuite) TestGRPCGatewayQueries() {
+	val := s.network.Validators[0]
+	baseURL := val.APIAddress
+
+	// bytes path parameters are base64 encoded
+	address := base64.URLEncoding.EncodeToString(val.Address)
+
+	testCases := []struct {
+		name     string
+		url      string
+		respType proto.Message
+		expected proto.Message
+	}{
+		{
+			"all balances",
+			fmt.Sprintf("%s/cosmos/bank/balances/%s", baseURL, address),
+			&types.QueryAllBalancesResponse{},
+			&types.QueryAllBalancesResponse{
+				Balances: sdk.NewCoins(
+					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				),
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			"balance of a specific denom",
+			fmt.Sprintf("%s/cosmos/bank/balances/%s/%s", baseURL, address, s.cfg.BondDenom),
+			&types.QueryBalanceResponse{},
+			&types.QueryBalanceResponse{
+				Balance: &sdk.Coin{Denom: s.cfg.BondDenom, Amount: s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)},
+			},
+		},
+		{
+			"supply of a specific denom",
+			fmt.Sprintf("%s/cosmos/bank/supply/%stoken", baseURL, val.Moniker),
+			&types.QuerySupplyOfResponse{},
+			&types.QuerySupplyOfResponse{
+				Amount: sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			resp, err := rest.GetRequest(tc.url)
+			s.Require().NoError(err)
+
+			s.Require().NoError(jsonpb.Unmarshal(strings.NewReader(string(resp)), tc.respType))
+			s.Require().Equal(tc.expected.String(), tc.respType.String())
+		})
+	}
+}
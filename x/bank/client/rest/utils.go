@@ -0,0 +1,21 @@
+package rest
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type (
+	// SetSendEnabledProposalReq defines a set send enabled proposal request body.
+	SetSendEnabledProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title         string              `json:"title" yaml:"title"`
+		Description   string              `json:"description" yaml:"description"`
+		SendEnabled   []types.SendEnabled `json:"send_enabled" yaml:"send_enabled"`
+		UseDefaultFor []string            `json:"use_default_for" yaml:"use_default_for"`
+		Proposer      sdk.AccAddress      `json:"proposer" yaml:"proposer"`
+		Deposit       sdk.Coins           `json:"deposit" yaml:"deposit"`
+	}
+)
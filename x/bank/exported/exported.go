@@ -13,6 +13,10 @@ type GenesisBalance interface {
 
 // SupplyI defines an inflationary supply interface for modules that handle
 // token supply.
+//
+// Deprecated: the bank keeper stores the supply of each denomination under its
+// own key. SupplyI is only kept to decode the supply stored by previous versions
+// of the bank module.
 type SupplyI interface {
 	GetTotal() sdk.Coins
 	SetTotal(total sdk.Coins)
@@ -30,7 +30,17 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		genState.Supply = totalSupply
 	}
 
-	k.SetSupply(ctx, types.NewSupply(genState.Supply))
+	for _, supply := range genState.Supply {
+		k.SetSupply(ctx, supply)
+	}
+
+	for _, meta := range genState.DenomMetadata {
+		k.SetDenomMetaData(ctx, meta)
+	}
+
+	for _, se := range genState.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -56,5 +66,13 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		})
 	}
 
-	return types.NewGenesisState(k.GetParams(ctx), balances, k.GetSupply(ctx).GetTotal())
+	totalSupply := sdk.Coins{}
+	k.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		totalSupply = append(totalSupply, supply)
+		return false
+	})
+
+	return types.NewGenesisState(
+		k.GetParams(ctx), balances, totalSupply, k.GetAllDenomMetaData(ctx), k.GetAllSendEnabledEntries(ctx),
+	)
 }
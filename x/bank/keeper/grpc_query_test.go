@@ -94,8 +94,10 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx := suite.app, suite.ctx
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	expectedTotalSupply := sdk.NewCoins(sdk.NewInt64Coin("test1", 400000000), sdk.NewInt64Coin("test2", 700000000))
+	for _, supply := range expectedTotalSupply {
+		app.BankKeeper.SetSupply(ctx, supply)
+	}
 
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, app.BankKeeper)
@@ -105,7 +107,21 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
 
-	suite.Require().Equal(expectedTotalSupply.Total, res.Supply)
+	suite.Require().Equal(expectedTotalSupply, res.Supply)
+
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply[:1], res.Supply)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply[1:], res.Supply)
+	suite.Require().Nil(res.Pagination.NextKey)
 }
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
@@ -113,8 +129,8 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	test1Supply := sdk.NewInt64Coin("test1", 4000000)
 	test2Supply := sdk.NewInt64Coin("test2", 700000000)
-	expectedTotalSupply := types.NewSupply(sdk.NewCoins(test1Supply, test2Supply))
-	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+	app.BankKeeper.SetSupply(ctx, test1Supply)
+	app.BankKeeper.SetSupply(ctx, test2Supply)
 
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, app.BankKeeper)
@@ -129,3 +145,89 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	suite.Require().Equal(test1Supply, res.Amount)
 }
+
+func (suite *IntegrationTestSuite) TestQueryDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := suite.getTestMetadata()
+	for _, m := range metadata {
+		app.BankKeeper.SetDenomMetaData(ctx, m)
+	}
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "unknown"})
+	suite.Require().Error(err)
+
+	res, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: metadata[0].Base})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Require().Equal(metadata[0], res.Metadata)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomsMetadata() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := suite.getTestMetadata()
+	for _, m := range metadata {
+		app.BankKeeper.SetDenomMetaData(ctx, m)
+	}
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata[:1], res.Metadatas)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata[1:], res.Metadatas)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx := suite.app, suite.ctx
+
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, false)
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, true)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// denoms without a stored flag are omitted
+	res, err := queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Denoms: []string{fooDenom, "unknown"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled(fooDenom, true)}, res.SendEnabled)
+	suite.Require().Nil(res.Pagination)
+
+	res, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled(barDenom, false)}, res.SendEnabled)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled(fooDenom, true)}, res.SendEnabled)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
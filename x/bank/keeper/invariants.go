@@ -46,23 +46,36 @@ func NonnegativeBalanceInvariant(k ViewKeeper) sdk.Invariant {
 	}
 }
 
-// TotalSupply checks that the total supply reflects all the coins held in accounts
+// TotalSupply checks that the total supply of each denomination reflects all
+// the coins of that denomination held in accounts
 func TotalSupply(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		expectedTotal := sdk.Coins{}
-		supply := k.GetSupply(ctx)
+		supply := sdk.Coins{}
 
 		k.IterateAllBalances(ctx, func(_ sdk.AccAddress, balance sdk.Coin) bool {
 			expectedTotal = expectedTotal.Add(balance)
 			return false
 		})
 
-		broken := !expectedTotal.IsEqual(supply.GetTotal())
+		k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			supply = append(supply, coin)
+			return false
+		})
+
+		// the supply store holds no zero supplies, so the supply matches the
+		// sum of the balances if each denomination of the supply does
+		broken := len(expectedTotal) != len(supply)
+		for _, coin := range supply {
+			if !coin.Amount.Equal(expectedTotal.AmountOf(coin.Denom)) {
+				broken = true
+			}
+		}
 
 		return sdk.FormatInvariant(types.ModuleName, "total supply",
 			fmt.Sprintf(
 				"\tsum of accounts coins: %v\n"+
 					"\tsupply.Total:          %v\n",
-				expectedTotal, supply.GetTotal())), broken
+				expectedTotal, supply)), broken
 	}
 }
@@ -4,15 +4,13 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
-
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
-	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -27,8 +25,16 @@ type Keeper interface {
 	InitGenesis(sdk.Context, types.GenesisState)
 	ExportGenesis(sdk.Context) types.GenesisState
 
-	GetSupply(ctx sdk.Context) exported.SupplyI
-	SetSupply(ctx sdk.Context, supply exported.SupplyI)
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	SetSupply(ctx sdk.Context, supply sdk.Coin)
+	HasSupply(ctx sdk.Context, denom string) bool
+	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+
+	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
+	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool)
+	GetAllDenomMetaData(ctx sdk.Context) []types.Metadata
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
@@ -40,10 +46,6 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
-	MarshalSupply(supplyI exported.SupplyI) ([]byte, error)
-	UnmarshalSupply(bz []byte) (exported.SupplyI, error)
-	MarshalSupplyJSON(supply exported.SupplyI) ([]byte, error)
-	UnmarshalSupplyJSON(bz []byte) (exported.SupplyI, error)
 
 	types.QueryServer
 }
@@ -153,31 +155,140 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 	return nil
 }
 
-// GetSupply retrieves the Supply from store
-func (k BaseKeeper) GetSupply(ctx sdk.Context) exported.SupplyI {
+// GetSupply retrieves the total supply of a given denomination from store. A
+// zero coin is returned if the denomination has no supply.
+func (k BaseKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.SupplyKey)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	bz := supplyStore.Get([]byte(denom))
 	if bz == nil {
-		panic("stored supply should not have been nil")
+		return sdk.NewCoin(denom, sdk.ZeroInt())
 	}
 
-	supply, err := k.UnmarshalSupply(bz)
-	if err != nil {
-		panic(err)
-	}
+	var supply sdk.Coin
+	k.cdc.MustUnmarshalBinaryBare(bz, &supply)
 
 	return supply
 }
 
-// SetSupply sets the Supply to store
-func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+// SetSupply sets the total supply of a denomination to store, keyed by its
+// denomination. The supply is removed from store if it is zero.
+func (k BaseKeeper) SetSupply(ctx sdk.Context, supply sdk.Coin) {
 	store := ctx.KVStore(k.storeKey)
-	bz, err := k.MarshalSupply(supply)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	if supply.IsZero() {
+		supplyStore.Delete([]byte(supply.Denom))
+		return
+	}
+
+	supplyStore.Set([]byte(supply.Denom), k.cdc.MustMarshalBinaryBare(&supply))
+}
+
+// HasSupply checks if a denomination has a non-zero supply in store.
+func (k BaseKeeper) HasSupply(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	return supplyStore.Has([]byte(denom))
+}
+
+// GetPaginatedTotalSupply returns a page of the total supply of all the
+// denominations, in the order of their denomination.
+func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	supply := sdk.NewCoins()
+
+	pageRes, err := query.Paginate(supplyStore, pagination, func(_, value []byte) error {
+		var coin sdk.Coin
+		if err := k.cdc.UnmarshalBinaryBare(value, &coin); err != nil {
+			return err
+		}
+
+		supply = append(supply, coin)
+		return nil
+	})
 	if err != nil {
-		panic(err)
+		return nil, nil, err
+	}
+
+	return supply, pageRes, nil
+}
+
+// IterateTotalSupply iterates over the total supply of all the denominations,
+// in the order of their denomination, and calls the provided callback. If true
+// is returned from the callback, iteration is halted.
+func (k BaseKeeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+
+	iterator := supplyStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var supply sdk.Coin
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &supply)
+
+		if cb(supply) {
+			break
+		}
 	}
+}
 
-	store.Set(types.SupplyKey, bz)
+// GetDenomMetaData retrieves the denomination metadata of a given base
+// denomination, and whether it was found.
+func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomMetadataKey(denom))
+	if bz == nil {
+		return types.Metadata{}, false
+	}
+
+	var metadata types.Metadata
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+
+	return metadata, true
+}
+
+// SetDenomMetaData sets the denomination metadata, keyed by its base
+// denomination.
+func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomMetadataKey(denomMetaData.Base), k.cdc.MustMarshalBinaryBare(&denomMetaData))
+}
+
+// IterateAllDenomMetaData iterates over all the denomination metadata, in the
+// order of their base denomination, and calls the provided callback. If true is
+// returned from the callback, iteration is halted.
+func (k BaseKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool) {
+	store := ctx.KVStore(k.storeKey)
+	denomMetaDataStore := prefix.NewStore(store, types.DenomMetadataPrefix)
+
+	iterator := denomMetaDataStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Metadata
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &metadata)
+
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
+// GetAllDenomMetaData returns all the denomination metadata.
+func (k BaseKeeper) GetAllDenomMetaData(ctx sdk.Context) []types.Metadata {
+	denomMetaData := make([]types.Metadata, 0)
+	k.IterateAllDenomMetaData(ctx, func(metadata types.Metadata) bool {
+		denomMetaData = append(denomMetaData, metadata)
+		return false
+	})
+
+	return denomMetaData
 }
 
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
@@ -283,10 +394,10 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Inflate(amt)
-
-	k.SetSupply(ctx, supply)
+	for _, coin := range amt {
+		supply := k.GetSupply(ctx, coin.Denom)
+		k.SetSupply(ctx, supply.Add(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("minted %s from %s module account", amt.String(), moduleName))
@@ -312,9 +423,10 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Deflate(amt)
-	k.SetSupply(ctx, supply)
+	for _, coin := range amt {
+		supply := k.GetSupply(ctx, coin.Denom)
+		k.SetSupply(ctx, supply.Sub(coin))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("burned %s from %s module account", amt.String(), moduleName))
@@ -351,53 +463,3 @@ func (k BaseKeeper) trackUndelegation(ctx sdk.Context, addr sdk.AccAddress, amt
 
 	return nil
 }
-
-// MarshalSupply marshals a Supply interface. If the given type implements
-// the Marshaler interface, it is treated as a Proto-defined message and
-// serialized that way. Otherwise, it falls back on the internal Amino codec.
-func (k BaseKeeper) MarshalSupply(supplyI exported.SupplyI) ([]byte, error) {
-	return codec.MarshalAny(k.cdc, supplyI)
-}
-
-// UnmarshalSupply returns a Supply interface from raw encoded supply
-// bytes of a Proto-based Supply type. An error is returned upon decoding
-// failure.
-func (k BaseKeeper) UnmarshalSupply(bz []byte) (exported.SupplyI, error) {
-	var evi exported.SupplyI
-	if err := codec.UnmarshalAny(k.cdc, &evi, bz); err != nil {
-		return nil, err
-	}
-
-	return evi, nil
-}
-
-// MarshalSupplyJSON JSON encodes a supply object implementing the Supply
-// interface.
-func (k BaseKeeper) MarshalSupplyJSON(supply exported.SupplyI) ([]byte, error) {
-	msg, ok := supply.(proto.Message)
-	if !ok {
-		return nil, fmt.Errorf("cannot proto marshal %T", supply)
-	}
-
-	any, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return nil, err
-	}
-
-	return k.cdc.MarshalJSON(any)
-}
-
-// UnmarshalSupplyJSON returns a Supply from JSON encoded bytes
-func (k BaseKeeper) UnmarshalSupplyJSON(bz []byte) (exported.SupplyI, error) {
-	var any codectypes.Any
-	if err := k.cdc.UnmarshalJSON(bz, &any); err != nil {
-		return nil, err
-	}
-
-	var supply exported.SupplyI
-	if err := k.cdc.UnpackAny(&any, &supply); err != nil {
-		return nil, err
-	}
-
-	return supply, nil
-}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -81,11 +83,46 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	initialPower := int64(100)
 	initTokens := sdk.TokensFromConsensusPower(initialPower)
 
-	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
-	app.BankKeeper.SetSupply(ctx, types.NewSupply(totalSupply))
+	totalSupply := sdk.NewCoin(sdk.DefaultBondDenom, initTokens)
+	app.BankKeeper.SetSupply(ctx, totalSupply)
 
-	total := app.BankKeeper.GetSupply(ctx).GetTotal()
-	suite.Require().Equal(totalSupply, total)
+	suite.Require().True(app.BankKeeper.HasSupply(ctx, sdk.DefaultBondDenom))
+	suite.Require().Equal(totalSupply, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+
+	// a denomination without supply has a zero supply
+	suite.Require().False(app.BankKeeper.HasSupply(ctx, fooDenom))
+	suite.Require().Equal(newFooCoin(0), app.BankKeeper.GetSupply(ctx, fooDenom))
+
+	// a zero supply is removed from store
+	app.BankKeeper.SetSupply(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()))
+	suite.Require().False(app.BankKeeper.HasSupply(ctx, sdk.DefaultBondDenom))
+}
+
+func (suite *IntegrationTestSuite) TestTotalSupply() {
+	app, ctx := suite.app, suite.ctx
+
+	totalSupply := sdk.NewCoins(newBarCoin(100), newFooCoin(50), sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
+	for _, supply := range totalSupply {
+		app.BankKeeper.SetSupply(ctx, supply)
+	}
+
+	// the supply is iterated in the order of its denomination
+	var iterated sdk.Coins
+	app.BankKeeper.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		iterated = append(iterated, supply)
+		return false
+	})
+	suite.Require().Equal(totalSupply, iterated)
+
+	supply, pageRes, err := app.BankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: 2, CountTotal: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal(totalSupply[:2], supply)
+	suite.Require().Equal(uint64(3), pageRes.Total)
+
+	supply, pageRes, err = app.BankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{Key: pageRes.NextKey})
+	suite.Require().NoError(err)
+	suite.Require().Equal(totalSupply[2:], supply)
+	suite.Require().Nil(pageRes.NextKey)
 }
 
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
@@ -113,7 +150,7 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
 	suite.Require().NoError(keeper.SetBalances(ctx, holderAcc.GetAddress(), initCoins))
 
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+	keeper.SetSupply(ctx, initCoins[0])
 	authKeeper.SetModuleAccount(ctx, holderAcc)
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
 	authKeeper.SetAccount(ctx, baseAcc)
@@ -178,7 +215,7 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	authKeeper.SetModuleAccount(ctx, multiPermAcc)
 	authKeeper.SetModuleAccount(ctx, randomPermAcc)
 
-	initialSupply := keeper.GetSupply(ctx)
+	initialSupply := keeper.GetSupply(ctx, sdk.DefaultBondDenom)
 
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, "", initCoins) }, "no module account")                // nolint:errcheck
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }, "invalid permission") // nolint:errcheck
@@ -192,16 +229,16 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, authtypes.Minter))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Add(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
+	initialSupply = keeper.GetSupply(ctx, sdk.DefaultBondDenom)
 
 	err = keeper.MintCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Add(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }) // nolint:errcheck
 }
 
@@ -228,28 +265,25 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	)
 
 	suite.Require().NoError(keeper.SetBalances(ctx, burnerAcc.GetAddress(), initCoins))
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
 
-	initialSupply := keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
-	keeper.SetSupply(ctx, initialSupply)
+	initialSupply := sdk.NewCoins(initCoins[0].Add(initCoins[0]))
+	keeper.SetSupply(ctx, initialSupply[0])
 
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                        // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission")         // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, initialSupply.GetTotal()) }, "random permission") // nolint:errcheck
-	err := keeper.BurnCoins(ctx, authtypes.Burner, initialSupply.GetTotal())
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission") // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, initialSupply) }, "random permission")    // nolint:errcheck
+	err := keeper.BurnCoins(ctx, authtypes.Burner, initialSupply)
 	suite.Require().Error(err, "insufficient coins")
 
 	err = keeper.BurnCoins(ctx, authtypes.Burner, initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, authtypes.Burner))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply[0].Sub(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
-	keeper.SetSupply(ctx, initialSupply)
+	initialSupply = sdk.NewCoins(keeper.GetSupply(ctx, sdk.DefaultBondDenom).Add(initCoins[0]))
+	keeper.SetSupply(ctx, initialSupply[0])
 
 	suite.Require().NoError(keeper.SetBalances(ctx, multiPermAcc.GetAddress(), initCoins))
 	authKeeper.SetModuleAccount(ctx, multiPermAcc)
@@ -257,7 +291,7 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	err = keeper.BurnCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply[0].Sub(initCoins[0]), keeper.GetSupply(ctx, sdk.DefaultBondDenom))
 }
 
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
@@ -398,6 +432,153 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(expected, acc2Balances)
 }
 
+func (suite *IntegrationTestSuite) TestSendRestriction() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, feeCollector.GetAddress(), balances))
+
+	// restrict the transfers of bar coins, and the transfers to addr3
+	var calls []string
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, amt sdk.Coins) error {
+		calls = append(calls, "frozen")
+		if !amt.AmountOf(barDenom).IsZero() {
+			return errors.New("bar is frozen")
+		}
+		return nil
+	})
+	app.BankKeeper.PrependSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) error {
+		calls = append(calls, "recipient")
+		if toAddr.Equals(addr3) {
+			return errors.New("unverified recipient")
+		}
+		return nil
+	})
+
+	// the prepended restriction is applied first
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal([]string{"recipient", "frozen"}, calls)
+
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10)))
+	suite.Require().True(types.ErrSendRestricted.Is(err))
+	suite.Require().Contains(err.Error(), "bar is frozen")
+
+	err = app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().True(types.ErrSendRestricted.Is(err))
+	suite.Require().Contains(err.Error(), "unverified recipient")
+
+	// module to account sends are restricted
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, addr3, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().True(types.ErrSendRestricted.Is(err))
+
+	// multi-sends are restricted for each output
+	inputs := []types.Input{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr2, Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3, Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().True(types.ErrSendRestricted.Is(err))
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// all the transfers are allowed once the restrictions are cleared
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, addr3, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+}
+
+func (suite *IntegrationTestSuite) TestInputOutputCoinsSendRestriction() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	addr4 := sdk.AccAddress([]byte("addr4"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, balances))
+
+	type transfer struct {
+		from, to sdk.AccAddress
+		amt      sdk.Coins
+	}
+
+	// restrict the transfers of more than 30 bar coins
+	var transfers []transfer
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		transfers = append(transfers, transfer{fromAddr, toAddr, amt})
+		if amt.AmountOf(barDenom).GT(sdk.NewInt(30)) {
+			return errors.New("too many bar")
+		}
+		return nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	// the restriction is applied once to each input and to each output, with
+	// the address of the other side when it is the only one
+	inputs := []types.Input{
+		{Address: addr1, Coins: sdk.NewCoins(newFooCoin(20))},
+		{Address: addr2, Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs := []types.Output{
+		{Address: addr3, Coins: sdk.NewCoins(newFooCoin(15))},
+		{Address: addr4, Coins: sdk.NewCoins(newFooCoin(15))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal([]transfer{
+		{addr1, nil, inputs[0].Coins},
+		{addr2, nil, inputs[1].Coins},
+		{nil, addr3, outputs[0].Coins},
+		{nil, addr4, outputs[1].Coins},
+	}, transfers)
+
+	transfers = nil
+	inputs = []types.Input{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs = []types.Output{
+		{Address: addr3, Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr4, Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal([]transfer{
+		{addr1, nil, inputs[0].Coins},
+		{addr1, addr3, outputs[0].Coins},
+		{addr1, addr4, outputs[1].Coins},
+	}, transfers)
+
+	// an input rejected by the restriction fails the multi-send, although each
+	// output alone would be allowed
+	inputs = []types.Input{{Address: addr1, Coins: sdk.NewCoins(newBarCoin(40))}}
+	outputs = []types.Output{
+		{Address: addr3, Coins: sdk.NewCoins(newBarCoin(20))},
+		{Address: addr4, Coins: sdk.NewCoins(newBarCoin(20))},
+	}
+	err := app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().True(types.ErrSendRestricted.Is(err))
+
+	// as does an output rejected by the restriction, although each input alone
+	// would be allowed
+	inputs = []types.Input{
+		{Address: addr1, Coins: sdk.NewCoins(newBarCoin(20))},
+		{Address: addr2, Coins: sdk.NewCoins(newBarCoin(20))},
+	}
+	outputs = []types.Output{{Address: addr3, Coins: sdk.NewCoins(newBarCoin(40))}}
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().True(types.ErrSendRestricted.Is(err))
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(25)), app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(25)), app.BankKeeper.GetAllBalances(ctx, addr4))
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -495,6 +676,44 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Error(err)
 }
 
+func (suite *IntegrationTestSuite) TestSendEnabledEntries() {
+	app, ctx := suite.app, suite.ctx
+
+	_, found := app.BankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().False(found)
+
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, false)
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, true)
+
+	se, found := app.BankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(*types.NewSendEnabled(fooDenom, false), se)
+	suite.Require().Equal(
+		[]types.SendEnabled{*types.NewSendEnabled(barDenom, true), *types.NewSendEnabled(fooDenom, false)},
+		app.BankKeeper.GetAllSendEnabledEntries(ctx),
+	)
+
+	// the stored flag takes precedence over the default
+	suite.Require().False(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().Error(app.BankKeeper.SendEnabledCoins(ctx, newBarCoin(1), newFooCoin(1)))
+
+	// a removed flag falls back to the default
+	app.BankKeeper.DeleteSendEnabled(ctx, fooDenom)
+	_, found = app.BankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().False(found)
+	suite.Require().True(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+
+	// setting the params moves the SendEnabled entries to the store
+	params := types.DefaultParams().SetSendEnabledParam(fooDenom, false)
+	app.BankKeeper.SetParams(ctx, params)
+	suite.Require().Empty(app.BankKeeper.GetParams(ctx).SendEnabled)
+
+	se, found = app.BankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().False(se.Enabled)
+	suite.Require().False(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -965,6 +1184,105 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
 	suite.Require().Error(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, delCoins))
 }
 
+func (suite *IntegrationTestSuite) TestSetDenomMetaData() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := suite.getTestMetadata()
+
+	for _, m := range metadata {
+		app.BankKeeper.SetDenomMetaData(ctx, m)
+	}
+
+	actualMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, metadata[1].Base)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata[1].GetBase(), actualMetadata.GetBase())
+	suite.Require().Equal(metadata[1].GetDisplay(), actualMetadata.GetDisplay())
+	suite.Require().Equal(metadata[1].GetDescription(), actualMetadata.GetDescription())
+	suite.Require().Equal(metadata[1].GetDenomUnits(), actualMetadata.GetDenomUnits())
+
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, "unknown")
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestInitGenesisDoesNotRegisterDenomUnits() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := types.Metadata{
+		Description: "The native token of the Osmosis network.",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "uosmo", Exponent: 0, Aliases: nil},
+			{Denom: "osmo", Exponent: 6, Aliases: []string{"osmosis"}},
+		},
+		Base:    "uosmo",
+		Display: "osmo",
+	}
+
+	genState := types.DefaultGenesisState()
+	genState.DenomMetadata = []types.Metadata{metadata}
+	app.BankKeeper.InitGenesis(ctx, genState)
+
+	// the process-global registry is left to the clients of the metadata
+	for _, denom := range []string{"uosmo", "osmo", "osmosis"} {
+		_, found := sdk.GetDenomUnit(denom)
+		suite.Require().False(found)
+	}
+
+	// the stored metadata registers the same units once queried
+	stored, found := app.BankKeeper.GetDenomMetaData(ctx, "uosmo")
+	suite.Require().True(found)
+	suite.Require().NoError(stored.RegisterDenomUnits())
+
+	coin, err := sdk.ConvertCoin(sdk.NewInt64Coin("uosmo", 2500000), "osmosis")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("osmosis", 2), coin)
+}
+
+func (suite *IntegrationTestSuite) TestIterateAllDenomMetaData() {
+	app, ctx := suite.app, suite.ctx
+
+	expectedMetadata := suite.getTestMetadata()
+	// set metadata
+	for _, m := range expectedMetadata {
+		app.BankKeeper.SetDenomMetaData(ctx, m)
+	}
+
+	// retrieve metadata
+	actualMetadata := make([]types.Metadata, 0)
+	app.BankKeeper.IterateAllDenomMetaData(ctx, func(metadata types.Metadata) bool {
+		actualMetadata = append(actualMetadata, metadata)
+		return false
+	})
+
+	// execute checks, metadata is iterated in the order of its base denom
+	suite.Require().Equal(expectedMetadata, actualMetadata)
+	suite.Require().Equal(expectedMetadata, app.BankKeeper.GetAllDenomMetaData(ctx))
+}
+
+func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
+	return []types.Metadata{
+		{
+			Description: "The native staking token of the Cosmos Hub.",
+			DenomUnits: []*types.DenomUnit{
+				{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+				{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+				{Denom: "atom", Exponent: 6, Aliases: nil},
+			},
+			Base:    "uatom",
+			Display: "atom",
+		},
+		{
+			Description: "The native token of the Ethereum network.",
+			DenomUnits: []*types.DenomUnit{
+				{Denom: "wei", Exponent: 0, Aliases: nil},
+				{Denom: "gwei", Exponent: 9, Aliases: []string{"shannon"}},
+				{Denom: "eth", Exponent: 18, Aliases: []string{"ether"}},
+			},
+			Base:    "wei",
+			Display: "eth",
+		},
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
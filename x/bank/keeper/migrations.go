@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Migrator is a struct for handling in-place store migrations of the bank
+// module.
+type Migrator struct {
+	keeper BaseKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper BaseKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the bank store from consensus version 1 to 2. Version 1
+// stores the total supply of all the denominations as a single Supply object
+// under the SupplyKey, which version 2 replaces with the supply of each
+// denomination stored under its own key, prefixed by the SupplyKey.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return migrateSupply(ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate2to3 migrates the bank store from consensus version 2 to 3. Version 3
+// stores the send enabled flag of each denomination under its own key, so the
+// entries of the SendEnabled param are moved to the store.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, m.keeper.GetParams(ctx))
+	return nil
+}
+
+func migrateSupply(store sdk.KVStore, cdc codec.Marshaler) error {
+	// the legacy supply is stored under the bare prefix of the new supply keys
+	bz := store.Get(types.SupplyKey)
+	if bz == nil {
+		return nil
+	}
+
+	var legacySupply exported.SupplyI
+	if err := codec.UnmarshalAny(cdc, &legacySupply, bz); err != nil {
+		return err
+	}
+
+	store.Delete(types.SupplyKey)
+
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+	for _, supply := range legacySupply.GetTotal() {
+		if supply.IsZero() {
+			continue
+		}
+
+		supply := supply
+		supplyStore.Set([]byte(supply.Denom), cdc.MustMarshalBinaryBare(&supply))
+	}
+
+	return nil
+}
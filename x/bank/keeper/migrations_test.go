@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *IntegrationTestSuite) TestMigrate1to2() {
+	app, ctx := suite.app, suite.ctx
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	// store the supply in the single key layout of version 1
+	legacySupply := sdk.NewCoins(newBarCoin(100), newFooCoin(50))
+	bz, err := codec.MarshalAny(app.AppCodec(), types.NewSupply(legacySupply))
+	suite.Require().NoError(err)
+	store.Set(types.SupplyKey, bz)
+
+	migrator := keeper.NewMigrator(app.BankKeeper.(keeper.BaseKeeper))
+	suite.Require().NoError(migrator.Migrate1to2(ctx))
+
+	suite.Require().Nil(store.Get(types.SupplyKey))
+	suite.Require().Equal(newBarCoin(100), app.BankKeeper.GetSupply(ctx, barDenom))
+	suite.Require().Equal(newFooCoin(50), app.BankKeeper.GetSupply(ctx, fooDenom))
+
+	var totalSupply sdk.Coins
+	app.BankKeeper.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		totalSupply = append(totalSupply, supply)
+		return false
+	})
+	suite.Require().Equal(legacySupply, totalSupply)
+
+	// migrating a store without a legacy supply is a no-op
+	suite.Require().NoError(migrator.Migrate1to2(ctx))
+	suite.Require().Equal(newBarCoin(100), app.BankKeeper.GetSupply(ctx, barDenom))
+	suite.Require().Equal(newFooCoin(50), app.BankKeeper.GetSupply(ctx, fooDenom))
+}
+
+func (suite *IntegrationTestSuite) TestMigrate2to3() {
+	app, ctx := suite.app, suite.ctx
+
+	// store the send enabled flags in the params of version 2
+	params := types.NewParams(true, types.SendEnabledParams{types.NewSendEnabled(fooDenom, false)})
+	app.GetSubspace(types.ModuleName).SetParamSet(ctx, &params)
+
+	migrator := keeper.NewMigrator(app.BankKeeper.(keeper.BaseKeeper))
+	suite.Require().NoError(migrator.Migrate2to3(ctx))
+
+	suite.Require().Empty(app.BankKeeper.GetParams(ctx).SendEnabled)
+	suite.Require().True(app.BankKeeper.GetParams(ctx).DefaultSendEnabled)
+	suite.Require().Equal([]types.SendEnabled{*types.NewSendEnabled(fooDenom, false)}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+	suite.Require().False(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+}
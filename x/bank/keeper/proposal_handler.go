@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleSetSendEnabledProposal is a handler for executing a passed set send
+// enabled proposal. The flags of the UseDefaultFor denominations are removed,
+// so that they fall back to the DefaultSendEnabled param.
+func HandleSetSendEnabledProposal(ctx sdk.Context, k Keeper, p *types.SetSendEnabledProposal) error {
+	for _, se := range p.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
+	for _, denom := range p.UseDefaultFor {
+		k.DeleteSendEnabled(ctx, denom)
+	}
+
+	return nil
+}
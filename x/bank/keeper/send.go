@@ -30,7 +30,17 @@ type SendKeeper interface {
 	SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabled(ctx sdk.Context, denom string, value bool)
+	DeleteSendEnabled(ctx sdk.Context, denom string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
+
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -47,6 +57,14 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the send restriction registered by other modules, which is shared by all
+	// the copies of the keeper
+	sendRestriction *sendRestriction
+}
+
+type sendRestriction struct {
+	fn types.SendRestrictionFn
 }
 
 func NewBaseSendKeeper(
@@ -54,29 +72,75 @@ func NewBaseSendKeeper(
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		sendRestriction: &sendRestriction{},
 	}
 }
 
+// AppendSendRestriction adds a restriction on the transfers of coins, applied
+// after the restrictions already registered.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = k.sendRestriction.fn.Then(restriction)
+}
+
+// PrependSendRestriction adds a restriction on the transfers of coins, applied
+// before the restrictions already registered.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = restriction.Then(k.sendRestriction.fn)
+}
+
+// ClearSendRestriction removes all the restrictions on the transfers of coins.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.fn = nil
+}
+
+// applySendRestriction applies the registered send restriction to a transfer
+// of coins. A rejected transfer returns an ErrSendRestricted error with the
+// reason of the rejection.
+func (k BaseSendKeeper) applySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.sendRestriction.fn == nil {
+		return nil
+	}
+
+	if err := k.sendRestriction.fn(ctx, fromAddr, toAddr, amt); err != nil {
+		return sdkerrors.Wrapf(types.ErrSendRestricted, "transfer of %s from %s to %s: %s", amt, fromAddr, toAddr, err)
+	}
+
+	return nil
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return params
 }
 
-// SetParams sets the total set of bank parameters.
+// SetParams sets the total set of bank parameters. The entries of the
+// deprecated SendEnabled param are moved to the per denomination send enabled
+// flags, and the param itself is stored empty.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	for _, se := range params.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
+	params.SendEnabled = types.SendEnabledParams{}
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
+//
+// The send restriction is applied to the coins of each input and to the coins
+// of each output. As the coins are pooled, the recipient of an input is only
+// known if there is a single output, and the sender of an output only if there
+// is a single input; otherwise they are passed to the restriction as empty
+// addresses.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -84,6 +148,26 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	var fromAddr, toAddr sdk.AccAddress
+	if len(inputs) == 1 {
+		fromAddr = inputs[0].Address
+	}
+	if len(outputs) == 1 {
+		toAddr = outputs[0].Address
+	}
+
+	for _, in := range inputs {
+		if err := k.applySendRestriction(ctx, in.Address, toAddr, in.Coins); err != nil {
+			return err
+		}
+	}
+
+	for _, out := range outputs {
+		if err := k.applySendRestriction(ctx, fromAddr, out.Address, out.Coins); err != nil {
+			return err
+		}
+	}
+
 	for _, in := range inputs {
 		_, err := k.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -127,8 +211,13 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// An error is returned upon failure, or if the transfer is rejected by the send
+// restriction.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.applySendRestriction(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -282,13 +371,85 @@ func (k BaseSendKeeper) SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) err
 	return nil
 }
 
-// SendEnabledCoin returns the current SendEnabled status of the provided coin's denom
+// SendEnabledCoin returns the current SendEnabled status of the provided coin's
+// denom. The flag stored for the denom takes precedence over the params.
 func (k BaseSendKeeper) SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
+	if se, found := k.GetSendEnabledEntry(ctx, coin.Denom); found {
+		return se.Enabled
+	}
+
 	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
 }
 
+// GetSendEnabledEntry returns the send enabled flag stored for a given
+// denomination, and whether it was found.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SendEnabledKey(denom))
+	if bz == nil {
+		return types.SendEnabled{}, false
+	}
+
+	return *types.NewSendEnabled(denom, isTrueByte(bz)), true
+}
+
+// SetSendEnabled sets the send enabled flag of a given denomination.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, value bool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SendEnabledKey(denom), boolToByte(value))
+}
+
+// DeleteSendEnabled removes the send enabled flag of a given denomination, so
+// that it falls back to the DefaultSendEnabled param.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SendEnabledKey(denom))
+}
+
+// IterateSendEnabledEntries iterates over all the stored send enabled flags, in
+// the order of their denomination, and calls the provided callback. If true is
+// returned from the callback, iteration is halted.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	sendEnabledStore := prefix.NewStore(store, types.SendEnabledPrefix)
+
+	iterator := sendEnabledStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), isTrueByte(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries returns all the stored send enabled flags.
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	sendEnabled := make([]types.SendEnabled, 0)
+	k.IterateSendEnabledEntries(ctx, func(denom string, enabled bool) bool {
+		sendEnabled = append(sendEnabled, *types.NewSendEnabled(denom, enabled))
+		return false
+	})
+
+	return sendEnabled
+}
+
 // BlockedAddr checks if a given address is restricted from
 // receiving funds.
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// boolToByte returns the byte used to store a send enabled flag.
+func boolToByte(value bool) []byte {
+	if value {
+		return []byte{1}
+	}
+
+	return []byte{0}
+}
+
+// isTrueByte returns whether a stored send enabled flag is set.
+func isTrueByte(bz []byte) bool {
+	return len(bz) == 1 && bz[0] == 1
+}
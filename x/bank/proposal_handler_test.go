@@ -0,0 +1,38 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestSetSendEnabledProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	fooCoin := sdk.NewInt64Coin("foocoin", 1)
+	barCoin := sdk.NewInt64Coin("barcoin", 1)
+	app.BankKeeper.SetSendEnabled(ctx, barCoin.Denom, false)
+
+	hdlr := bank.NewSetSendEnabledProposalHandler(app.BankKeeper)
+
+	tp := types.NewSetSendEnabledProposal(
+		"Test", "description", []types.SendEnabled{*types.NewSendEnabled(fooCoin.Denom, false)}, []string{barCoin.Denom},
+	)
+	require.NoError(t, hdlr(ctx, tp))
+
+	require.False(t, app.BankKeeper.SendEnabledCoin(ctx, fooCoin))
+	require.True(t, app.BankKeeper.SendEnabledCoin(ctx, barCoin))
+
+	_, found := app.BankKeeper.GetSendEnabledEntry(ctx, barCoin.Denom)
+	require.False(t, found)
+
+	require.Error(t, hdlr(ctx, govtypes.NewTextProposal("Test", "description")))
+}
@@ -15,15 +15,16 @@ import (
 
 func TestDecodeStore(t *testing.T) {
 	app := simapp.Setup(false)
-	dec := simulation.NewDecodeStore(app.BankKeeper)
+	dec := simulation.NewDecodeStore(app.AppCodec())
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	totalSupply := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
 
-	supplyBz, err := app.BankKeeper.MarshalSupply(totalSupply)
+	supplyBz, err := app.AppCodec().MarshalBinaryBare(&totalSupply)
 	require.NoError(t, err)
 
 	kvPairs := tmkv.Pairs{
-		tmkv.Pair{Key: types.SupplyKey, Value: supplyBz},
+		tmkv.Pair{Key: append(types.SupplyKey, []byte(totalSupply.Denom)...), Value: supplyBz},
+		tmkv.Pair{Key: types.SendEnabledKey(totalSupply.Denom), Value: []byte{1}},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -32,6 +33,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"SendEnabled", "true\ntrue"},
 		{"other", ""},
 	}
 
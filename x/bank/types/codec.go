@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterCodec registers the necessary x/bank interfaces and concrete types
@@ -15,6 +16,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&Supply{}, "cosmos-sdk/Supply", nil)
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&SetSendEnabledProposal{}, "cosmos-sdk/SetSendEnabledProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -22,7 +24,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgMultiSend{},
 	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetSendEnabledProposal{},
+	)
 
+	// the Supply interface is only registered to decode the supply stored by
+	// previous versions of the bank module
 	registry.RegisterInterface(
 		"cosmos_sdk.bank.v1.bank",
 		(*exported.SupplyI)(nil),
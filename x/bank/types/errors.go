@@ -10,4 +10,6 @@ var (
 	ErrNoOutputs           = sdkerrors.Register(ModuleName, 3, "no outputs to send transaction")
 	ErrInputOutputMismatch = sdkerrors.Register(ModuleName, 4, "sum inputs != sum outputs")
 	ErrSendDisabled        = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
+	ErrSendRestricted      = sdkerrors.Register(ModuleName, 6, "send restricted")
+	ErrInvalidProposal     = sdkerrors.Register(ModuleName, 7, "invalid set send enabled proposal")
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,9 +15,11 @@ var _ exported.GenesisBalance = (*Balance)(nil)
 
 // GenesisState defines the bank module's genesis state.
 type GenesisState struct {
-	Params   Params    `json:"params" yaml:"params"`
-	Balances []Balance `json:"balances" yaml:"balances"`
-	Supply   sdk.Coins `json:"supply" yaml:"supply"`
+	Params        Params        `json:"params" yaml:"params"`
+	Balances      []Balance     `json:"balances" yaml:"balances"`
+	Supply        sdk.Coins     `json:"supply" yaml:"supply"`
+	DenomMetadata []Metadata    `json:"denom_metadata" yaml:"denom_metadata"`
+	SendEnabled   []SendEnabled `json:"send_enabled" yaml:"send_enabled"`
 }
 
 // Balance defines an account address and balance pair used in the bank module's
@@ -56,21 +59,55 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return NewSupply(data.Supply).ValidateBasic()
+	seenMetadatas := make(map[string]bool)
+	for _, metadata := range data.DenomMetadata {
+		if seenMetadatas[metadata.Base] {
+			return fmt.Errorf("duplicate denom metadata for denom %s", metadata.Base)
+		}
+
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		seenMetadatas[metadata.Base] = true
+	}
+
+	seenSendEnabled := make(map[string]bool)
+	for _, se := range data.SendEnabled {
+		if seenSendEnabled[se.Denom] {
+			return fmt.Errorf("duplicate send enabled flag for denom %s", se.Denom)
+		}
+
+		if err := validateSendEnabled(se); err != nil {
+			return err
+		}
+
+		seenSendEnabled[se.Denom] = true
+	}
+
+	if !data.Supply.IsValid() {
+		return fmt.Errorf("invalid total supply: %s", data.Supply)
+	}
+
+	return nil
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, balances []Balance, supply sdk.Coins) GenesisState {
+func NewGenesisState(
+	params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled,
+) GenesisState {
 	return GenesisState{
-		Params:   params,
-		Balances: balances,
-		Supply:   supply,
+		Params:        params,
+		Balances:      balances,
+		Supply:        supply,
+		DenomMetadata: denomMetaData,
+		SendEnabled:   sendEnabled,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, DefaultSupply().GetTotal())
+	return NewGenesisState(DefaultParams(), []Balance{}, sdk.NewCoins(), []Metadata{}, []SendEnabled{})
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
@@ -22,10 +22,24 @@ const (
 
 // KVStore keys
 var (
-	BalancesPrefix = []byte("balances")
-	SupplyKey      = []byte{0x00}
+	BalancesPrefix      = []byte("balances")
+	SupplyKey           = []byte{0x00}
+	DenomMetadataPrefix = []byte{0x1}
+	SendEnabledPrefix   = []byte{0x2}
 )
 
+// DenomMetadataKey returns the store key of the metadata of a given base
+// denomination.
+func DenomMetadataKey(denom string) []byte {
+	return append(DenomMetadataPrefix, []byte(denom)...)
+}
+
+// SendEnabledKey returns the store key of the send enabled flag of a given
+// denomination.
+func SendEnabledKey(denom string) []byte {
+	return append(SendEnabledPrefix, []byte(denom)...)
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
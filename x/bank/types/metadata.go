@@ -0,0 +1,143 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the coin metadata fields. It checks:
+//   - Base and Display denominations are valid coin denominations
+//   - Base and Display denominations are present in the DenomUnit slice
+//   - Base denomination has exponent 0
+//   - Denomination units are sorted in ascending order of exponent
+//   - Denomination unit exponents do not exceed the sdk.Dec precision
+//   - Denomination units and their aliases are not duplicated
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return fmt.Errorf("invalid metadata base denom: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return fmt.Errorf("invalid metadata display denom: %w", err)
+	}
+
+	if len(m.DenomUnits) == 0 {
+		return errors.New("metadata must contain at least one denomination unit")
+	}
+
+	// the base denomination must be the first unit, with exponent 0
+	if m.DenomUnits[0].Denom != m.Base {
+		return fmt.Errorf("metadata's first denomination unit must be the one with base denom '%s'", m.Base)
+	}
+
+	if m.DenomUnits[0].Exponent != 0 {
+		return fmt.Errorf("the exponent for base denomination unit %s must be 0", m.Base)
+	}
+
+	var (
+		hasDisplay      bool
+		currentExponent uint32
+	)
+
+	seenUnits := make(map[string]bool)
+
+	for i, denomUnit := range m.DenomUnits {
+		if i > 0 && currentExponent >= denomUnit.Exponent {
+			return errors.New("the denomination units must be sorted in ascending order of exponent")
+		}
+
+		// the units are converted with sdk.Dec, which cannot represent larger
+		// powers of ten exactly
+		if denomUnit.Exponent > sdk.Precision {
+			return fmt.Errorf("the exponent for denomination unit %s must not exceed %d", denomUnit.Denom, sdk.Precision)
+		}
+
+		currentExponent = denomUnit.Exponent
+
+		if seenUnits[denomUnit.Denom] {
+			return fmt.Errorf("duplicate denomination unit %s", denomUnit.Denom)
+		}
+
+		if denomUnit.Denom == m.Display {
+			hasDisplay = true
+		}
+
+		if err := denomUnit.Validate(); err != nil {
+			return err
+		}
+
+		seenUnits[denomUnit.Denom] = true
+
+		for _, alias := range denomUnit.Aliases {
+			if seenUnits[alias] {
+				return fmt.Errorf("duplicate denomination unit alias %s", alias)
+			}
+
+			seenUnits[alias] = true
+		}
+	}
+
+	if !hasDisplay {
+		return fmt.Errorf("metadata must contain a denomination unit with display denom '%s'", m.Display)
+	}
+
+	return nil
+}
+
+// RegisterDenomUnits registers the denomination units of the metadata, and
+// their aliases, with the SDK denomination registry, which the sdk.Coin
+// conversion helpers such as sdk.ConvertCoin and sdk.ConvertDecCoin use to
+// convert the coins of the base denomination to their display denomination.
+// The registry is process-global and not part of the state, so it is meant to
+// be filled by clients with the metadata they query, or by the application at
+// initialization, and never while executing state transitions. The units
+// already registered with the same value are skipped. If the metadata is
+// invalid or one of its units is registered with another value, an error is
+// returned and none of the units are registered.
+func (m Metadata) RegisterDenomUnits() error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	units := make(map[string]sdk.Dec)
+	for _, denomUnit := range m.DenomUnits {
+		unit := sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(denomUnit.Exponent)))
+
+		for _, denom := range append([]string{denomUnit.Denom}, denomUnit.Aliases...) {
+			if registered, ok := sdk.GetDenomUnit(denom); ok {
+				if !registered.Equal(unit) {
+					return fmt.Errorf("denom %s already registered with unit %s", denom, registered)
+				}
+
+				continue
+			}
+
+			units[denom] = unit
+		}
+	}
+
+	for denom, unit := range units {
+		if err := sdk.RegisterDenom(denom, unit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the denomination unit fields.
+func (du DenomUnit) Validate() error {
+	if err := sdk.ValidateDenom(du.Denom); err != nil {
+		return fmt.Errorf("invalid denom unit: %w", err)
+	}
+
+	for _, alias := range du.Aliases {
+		if err := sdk.ValidateDenom(alias); err != nil {
+			return fmt.Errorf("invalid alias of denom unit %s: %w", du.Denom, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata types.Metadata
+		expErr   bool
+	}{
+		{
+			"valid metadata",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"matom", uint32(3), []string{"milliatom"}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			false,
+		},
+		{"empty metadata", types.Metadata{}, true},
+		{
+			"invalid base denom",
+			types.Metadata{
+				Base: "",
+			},
+			true,
+		},
+		{
+			"invalid display denom",
+			types.Metadata{
+				Base:    "uatom",
+				Display: "",
+			},
+			true,
+		},
+		{
+			"no denom units",
+			types.Metadata{
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"base denom is not the first unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"matom", uint32(3), []string{"milliatom"}},
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"base denom exponent is not zero",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(1), []string{"microatom"}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"denom units not sorted by exponent",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"atom", uint32(6), nil},
+					{"matom", uint32(3), []string{"milliatom"}},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"duplicate denom unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"uatom", uint32(1), nil},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"duplicate denom unit alias",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"matom", uint32(3), []string{"microatom"}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"invalid denom unit alias",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{""}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"max exponent",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"ufoo", uint32(0), nil},
+					{"foo", uint32(18), nil},
+				},
+				Base:    "ufoo",
+				Display: "foo",
+			},
+			false,
+		},
+		{
+			"exponent exceeds the precision",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"ufoo", uint32(0), nil},
+					{"foo", uint32(100), nil},
+				},
+				Base:    "ufoo",
+				Display: "foo",
+			},
+			true,
+		},
+		{
+			"missing display denom unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"matom", uint32(3), []string{"milliatom"}},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMetadataRegisterDenomUnits(t *testing.T) {
+	metadata := types.Metadata{
+		Description: "The native token of the Ethereum network.",
+		DenomUnits: []*types.DenomUnit{
+			{"wei", uint32(0), nil},
+			{"gwei", uint32(9), []string{"shannon"}},
+			{"eth", uint32(18), []string{"ether"}},
+		},
+		Base:    "wei",
+		Display: "eth",
+	}
+	require.NoError(t, metadata.Validate())
+	require.NoError(t, metadata.RegisterDenomUnits())
+
+	// registering the same units twice is a no-op
+	require.NoError(t, metadata.RegisterDenomUnits())
+
+	// registering a unit with another value fails without registering any unit
+	conflicting := types.Metadata{
+		DenomUnits: []*types.DenomUnit{{"szabo", uint32(0), nil}, {"ether", uint32(6), nil}},
+		Base:       "szabo",
+		Display:    "ether",
+	}
+	require.Error(t, conflicting.RegisterDenomUnits())
+
+	_, found := sdk.GetDenomUnit("szabo")
+	require.False(t, found)
+
+	// invalid metadata is not registered
+	invalid := types.Metadata{
+		DenomUnits: []*types.DenomUnit{{"ufoo", uint32(0), nil}, {"foo", uint32(100), nil}},
+		Base:       "ufoo",
+		Display:    "foo",
+	}
+	require.Error(t, invalid.RegisterDenomUnits())
+
+	_, found = sdk.GetDenomUnit("ufoo")
+	require.False(t, found)
+
+	coin, err := sdk.ConvertCoin(sdk.NewInt64Coin("wei", 3000000000), "shannon")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("shannon", 3), coin)
+
+	decCoin, err := sdk.ConvertDecCoin(sdk.NewInt64DecCoin("gwei", 1), "ether")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("ether", sdk.NewDecWithPrec(1, 9)), decCoin)
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSetSendEnabledProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *types.SetSendEnabledProposal
+		expErr   bool
+	}{
+		{
+			"valid proposal",
+			types.NewSetSendEnabledProposal(
+				"Test", "description", []types.SendEnabled{*types.NewSendEnabled("foo", false)}, []string{"bar"},
+			),
+			false,
+		},
+		{
+			"empty title",
+			types.NewSetSendEnabledProposal("", "description", []types.SendEnabled{*types.NewSendEnabled("foo", false)}, nil),
+			true,
+		},
+		{"no flags", types.NewSetSendEnabledProposal("Test", "description", nil, nil), true},
+		{
+			"invalid denom",
+			types.NewSetSendEnabledProposal("Test", "description", []types.SendEnabled{*types.NewSendEnabled("", false)}, nil),
+			true,
+		},
+		{
+			"invalid use default denom",
+			types.NewSetSendEnabledProposal("Test", "description", nil, []string{"1"}),
+			true,
+		},
+		{
+			"duplicate denom",
+			types.NewSetSendEnabledProposal(
+				"Test", "description",
+				[]types.SendEnabled{*types.NewSendEnabled("foo", false), *types.NewSendEnabled("foo", true)}, nil,
+			),
+			true,
+		},
+		{
+			"denom both set and removed",
+			types.NewSetSendEnabledProposal(
+				"Test", "description", []types.SendEnabled{*types.NewSendEnabled("foo", false)}, []string{"foo"},
+			),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
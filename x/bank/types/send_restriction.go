@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn defines a restriction on the transfer of coins from an
+// address to another address, which modules register with the bank keeper to,
+// for example, block the transfers of a frozen denomination or the transfers
+// to unverified recipients. The transfer is rejected if a non-nil error is
+// returned.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+// Then returns a SendRestrictionFn which applies the restriction r and then
+// the second restriction, rejecting the transfers rejected by either of them.
+// A nil restriction is ignored.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	switch {
+	case r == nil:
+		return second
+
+	case second == nil:
+		return r
+
+	default:
+		return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+			if err := r(ctx, fromAddr, toAddr, amt); err != nil {
+				return err
+			}
+
+			return second(ctx, fromAddr, toAddr, amt)
+		}
+	}
+}
@@ -13,6 +13,9 @@ import (
 var _ exported.SupplyI = (*Supply)(nil)
 
 // NewSupply creates a new Supply instance
+//
+// Deprecated: the bank keeper stores the supply of each denomination under its
+// own key, see BaseKeeper.SetSupply.
 func NewSupply(total sdk.Coins) *Supply {
 	return &Supply{total}
 }
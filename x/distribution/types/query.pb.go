@@ -12,6 +12,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -901,66 +902,74 @@ func init() {
 func init() { proto.RegisterFile("cosmos/distribution/query.proto", fileDescriptor_2111c1b119d22af6) }
 
 var fileDescriptor_2111c1b119d22af6 = []byte{
-	// 936 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
-	0x14, 0xdf, 0xd9, 0xa6, 0xa9, 0x78, 0x6d, 0x49, 0x3a, 0xa9, 0xd0, 0xd6, 0xa1, 0xbb, 0x91, 0x0b,
-	0x64, 0x25, 0x54, 0x9b, 0x24, 0x20, 0x48, 0x05, 0x87, 0xa4, 0x41, 0xaa, 0x84, 0x04, 0xdb, 0x05,
-	0x15, 0xa8, 0xa0, 0xd5, 0xc4, 0x1e, 0x79, 0x2d, 0xbc, 0x9e, 0xad, 0x67, 0x9c, 0x10, 0xf1, 0x21,
-	0x90, 0x0a, 0x88, 0x03, 0x12, 0x12, 0x57, 0xae, 0x5c, 0xfa, 0x97, 0xf4, 0x58, 0x6e, 0x9c, 0x5a,
-	0x94, 0xfc, 0x17, 0x9c, 0xd0, 0xce, 0x8c, 0xbd, 0xeb, 0xc4, 0xf6, 0x7e, 0x34, 0xda, 0xdb, 0xee,
-	0x9b, 0xf7, 0x7e, 0xef, 0xf7, 0x3e, 0xe6, 0xcd, 0x33, 0x34, 0x1c, 0xc6, 0xbb, 0x8c, 0xdb, 0xae,
-	0xcf, 0x45, 0xe4, 0xef, 0xc6, 0xc2, 0x67, 0xa1, 0xfd, 0x20, 0xa6, 0xd1, 0x81, 0xd5, 0x8b, 0x98,
-	0x60, 0x78, 0x49, 0x29, 0x58, 0xc3, 0x0a, 0xc6, 0x55, 0x6d, 0x25, 0x15, 0xed, 0x1e, 0xf1, 0xfc,
-	0x90, 0xf4, 0x0f, 0x94, 0x8d, 0x71, 0xd9, 0x63, 0x1e, 0x93, 0x3f, 0xed, 0xfe, 0x2f, 0x2d, 0xd5,
-	0x48, 0xb6, 0x06, 0x54, 0xc2, 0xd7, 0xf2, 0xfc, 0x0f, 0xff, 0x51, 0x7a, 0xe6, 0x65, 0xc0, 0xb7,
-	0xfb, 0xce, 0x5a, 0x24, 0x22, 0x5d, 0xde, 0xa6, 0x0f, 0x62, 0xca, 0x85, 0xd9, 0x82, 0xa5, 0x8c,
-	0x94, 0xf7, 0x58, 0xc8, 0x29, 0xde, 0x84, 0xf9, 0x9e, 0x94, 0xd4, 0xd0, 0x0a, 0x6a, 0x9e, 0x5f,
-	0x5f, 0xb6, 0x72, 0x82, 0xb0, 0x94, 0xd1, 0xf6, 0xdc, 0xe3, 0xa7, 0x8d, 0x4a, 0x5b, 0x1b, 0x98,
-	0xbf, 0x22, 0x58, 0x95, 0x90, 0x77, 0x48, 0xe0, 0xbb, 0x44, 0xb0, 0xe8, 0xa3, 0x58, 0x70, 0x41,
-	0x42, 0xd7, 0x0f, 0xbd, 0x36, 0xdd, 0x27, 0x91, 0x9b, 0x78, 0xc7, 0xf7, 0xe0, 0xd2, 0x5e, 0xa2,
-	0x75, 0x9f, 0xb8, 0x6e, 0x44, 0xb9, 0xf2, 0x78, 0x61, 0x7b, 0xed, 0xbf, 0xa7, 0x8d, 0xeb, 0x9e,
-	0x2f, 0x3a, 0xf1, 0xae, 0xe5, 0xb0, 0xae, 0x9d, 0x09, 0xfd, 0x3a, 0x77, 0xbf, 0xb2, 0xc5, 0x41,
-	0x8f, 0x72, 0xeb, 0x0e, 0x09, 0xb6, 0x94, 0x61, 0x7b, 0x31, 0xc5, 0xd2, 0x12, 0xf3, 0x5b, 0x68,
-	0x8e, 0xa6, 0xa2, 0x43, 0x6e, 0xc1, 0xb9, 0x48, 0x89, 0x74, 0xcc, 0x6f, 0xe4, 0xc6, 0x5c, 0x02,
-	0xa5, 0x13, 0x91, 0xc0, 0x98, 0x3f, 0x22, 0x68, 0x64, 0xdd, 0xdf, 0x64, 0xdd, 0xae, 0xcf, 0xb9,
-	0xcf, 0xc2, 0x59, 0x65, 0xe0, 0x3b, 0x58, 0x29, 0xa6, 0xa0, 0x23, 0xff, 0x1c, 0xc0, 0x49, 0xa5,
-	0x3a, 0xf8, 0x8d, 0xf2, 0xe0, 0xb7, 0x1c, 0x27, 0xee, 0xc6, 0x01, 0x11, 0xd4, 0x1d, 0x00, 0xea,
-	0xf8, 0x87, 0xc0, 0xcc, 0x5f, 0xaa, 0xf0, 0x72, 0xd6, 0xff, 0xc7, 0x01, 0xe1, 0x1d, 0x3a, 0xab,
-	0x0e, 0xc0, 0xab, 0xb0, 0xc0, 0x05, 0x89, 0x84, 0x1f, 0x7a, 0xf7, 0x3b, 0xd4, 0xf7, 0x3a, 0xa2,
-	0x56, 0x5d, 0x41, 0xcd, 0xb9, 0xf6, 0x8b, 0x89, 0xf8, 0x96, 0x94, 0xe2, 0x6b, 0x70, 0x91, 0xca,
-	0x62, 0x26, 0x6a, 0x67, 0xa4, 0xda, 0x05, 0x25, 0xd4, 0x4a, 0x9b, 0x00, 0x83, 0xab, 0x5a, 0x9b,
-	0x93, 0x99, 0xba, 0x92, 0x64, 0x4a, 0xdd, 0xf9, 0x16, 0xf1, 0xa8, 0x0e, 0xae, 0x3d, 0xa4, 0x6c,
-	0xfe, 0x85, 0xe0, 0x6a, 0x41, 0x26, 0x74, 0x19, 0x6e, 0xc1, 0x39, 0xae, 0x44, 0x35, 0xb4, 0x72,
-	0xa6, 0x79, 0x7e, 0xbd, 0x59, 0x5e, 0x03, 0x69, 0xff, 0xfe, 0x1e, 0x0d, 0x45, 0xd2, 0x78, 0xda,
-	0x1c, 0xdf, 0xc8, 0xd0, 0xac, 0x4a, 0x9a, 0x46, 0x1e, 0x4d, 0xe5, 0x39, 0xc3, 0xf3, 0x59, 0xc2,
-	0x73, 0x87, 0x06, 0xd4, 0x93, 0xb2, 0x93, 0x97, 0xd6, 0x55, 0x67, 0x53, 0x97, 0x6c, 0xcb, 0x71,
-	0xd2, 0x92, 0xa5, 0x58, 0x49, 0xc9, 0x72, 0x5b, 0xa2, 0x7a, 0x7a, 0x57, 0xe2, 0x07, 0x04, 0xf5,
-	0xa2, 0x08, 0x75, 0x29, 0xee, 0x0d, 0xcf, 0x82, 0x7e, 0x29, 0x16, 0x92, 0xec, 0xed, 0x50, 0xe7,
-	0x26, 0xf3, 0xc3, 0xed, 0x8d, 0x7e, 0xc6, 0x1f, 0x3d, 0x6b, 0xbc, 0x3e, 0x06, 0x1b, 0x6d, 0xc3,
-	0x07, 0x93, 0xe1, 0x21, 0x02, 0xf3, 0x18, 0x85, 0x4f, 0x98, 0x20, 0xc1, 0x6c, 0x33, 0x6d, 0xfe,
-	0x8d, 0xe0, 0x5a, 0x29, 0x0d, 0x9d, 0x8e, 0x0f, 0x8f, 0xa7, 0xc3, 0xca, 0xed, 0xcc, 0x01, 0xca,
-	0x4e, 0xe2, 0x49, 0x21, 0x1d, 0x1b, 0x8c, 0xf8, 0x2e, 0x9c, 0x15, 0x7d, 0x3f, 0xb5, 0xea, 0x29,
-	0x26, 0x57, 0x41, 0x0e, 0x86, 0x6e, 0xca, 0x21, 0xbd, 0x30, 0x33, 0xcb, 0x6b, 0xac, 0x87, 0x6e,
-	0x2e, 0x05, 0x9d, 0xd3, 0xdb, 0x00, 0x69, 0x67, 0xaa, 0xb4, 0x4e, 0xd5, 0xde, 0x43, 0x20, 0xe6,
-	0xcf, 0x08, 0x5e, 0xc9, 0xfa, 0xfd, 0xd4, 0x17, 0x1d, 0x37, 0x22, 0xfb, 0x89, 0xf6, 0x8c, 0xe2,
-	0xff, 0x09, 0xc1, 0xab, 0x23, 0x88, 0xe8, 0x2c, 0x7c, 0x01, 0x8b, 0xfb, 0xfa, 0xe8, 0xf9, 0x89,
-	0x2c, 0xec, 0x67, 0xbd, 0x98, 0xcb, 0x70, 0x45, 0xd2, 0xe8, 0x3f, 0x51, 0x71, 0xe8, 0x8b, 0x83,
-	0x16, 0x63, 0x41, 0xb2, 0xf9, 0xec, 0x81, 0x91, 0x77, 0xa8, 0x89, 0x7d, 0x06, 0x73, 0x3d, 0xc6,
-	0x82, 0x53, 0xbd, 0xfe, 0x12, 0x71, 0xfd, 0xd1, 0x0b, 0x70, 0x56, 0x3a, 0xc6, 0x5f, 0xc2, 0xbc,
-	0xda, 0xa0, 0xf0, 0x6a, 0xee, 0x7d, 0x3a, 0xb9, 0xae, 0x19, 0xcd, 0xd1, 0x8a, 0x2a, 0x00, 0xb3,
-	0x82, 0xff, 0x44, 0xb0, 0x5c, 0xb2, 0xad, 0xe0, 0x77, 0x8b, 0xb1, 0x46, 0xaf, 0x6e, 0xc6, 0x7b,
-	0x53, 0x5a, 0xa7, 0xf4, 0x1e, 0x22, 0x58, 0xca, 0xd9, 0x4a, 0xf0, 0x9b, 0x63, 0x00, 0x9f, 0xd8,
-	0xa3, 0x8c, 0xb7, 0x26, 0xb4, 0x4a, 0x69, 0x7c, 0x03, 0x8b, 0xc7, 0x5f, 0x64, 0xbc, 0x36, 0x06,
-	0x58, 0x76, 0x8f, 0x31, 0xd6, 0x27, 0x31, 0x49, 0x9d, 0x7f, 0x0f, 0x97, 0x4e, 0x3c, 0x42, 0xb8,
-	0x04, 0xaa, 0xe8, 0x4d, 0x36, 0x36, 0x26, 0xb2, 0x49, 0xfd, 0xff, 0x86, 0xe0, 0xa5, 0xfc, 0xd9,
-	0x8f, 0xdf, 0x1e, 0x07, 0x31, 0xe7, 0xd1, 0x32, 0xde, 0x99, 0xdc, 0x30, 0xd3, 0x13, 0x39, 0x43,
-	0xb3, 0xac, 0x27, 0x8a, 0xc7, 0x7c, 0x59, 0x4f, 0x94, 0x4c, 0x66, 0xb3, 0x82, 0xff, 0x40, 0x50,
-	0x2b, 0x1a, 0x5d, 0x78, 0x73, 0x0c, 0xd4, 0xfc, 0xb9, 0x6b, 0xdc, 0x98, 0xc6, 0x34, 0x65, 0x15,
-	0xc1, 0xc5, 0xcc, 0xac, 0xc2, 0x56, 0x31, 0x5c, 0xde, 0xc4, 0x33, 0xec, 0xb1, 0xf5, 0x13, 0x9f,
-	0xdb, 0x1f, 0x3c, 0x3e, 0xac, 0xa3, 0x27, 0x87, 0x75, 0xf4, 0xef, 0x61, 0x1d, 0xfd, 0x7e, 0x54,
-	0xaf, 0x3c, 0x39, 0xaa, 0x57, 0xfe, 0x39, 0xaa, 0x57, 0xee, 0xae, 0x95, 0x4e, 0xbe, 0xaf, 0xb3,
-	0x9f, 0xa3, 0x72, 0x10, 0xee, 0xce, 0xcb, 0x0f, 0xd1, 0x8d, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x6d, 0x56, 0x99, 0x70, 0x32, 0x0f, 0x00, 0x00,
+	// 1072 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xb8, 0x69, 0x2a, 0xbd, 0x7e, 0x24, 0x9d, 0x54, 0xc8, 0xdd, 0x34, 0xb6, 0xb5, 0xa1,
+	0xc4, 0x52, 0x55, 0x2f, 0x49, 0xa8, 0x20, 0x85, 0x22, 0xf2, 0x41, 0x29, 0x02, 0x11, 0xc7, 0xa0,
+	0x16, 0x2a, 0xd4, 0x68, 0xe2, 0x5d, 0xad, 0x57, 0xd8, 0x3b, 0xee, 0xce, 0x38, 0x21, 0x2a, 0x91,
+	0x02, 0xe2, 0x43, 0xdc, 0x90, 0x38, 0x73, 0xe4, 0xc2, 0x1f, 0xc1, 0xb9, 0xe2, 0x14, 0x84, 0x90,
+	0x38, 0x35, 0x28, 0xe1, 0xc6, 0x7f, 0xc0, 0x09, 0x79, 0x66, 0xd6, 0xf6, 0xda, 0x63, 0x7b, 0xed,
+	0x46, 0xbe, 0xad, 0xdf, 0xbc, 0x8f, 0xdf, 0xef, 0xcd, 0x7b, 0xf3, 0x9e, 0x0c, 0xe9, 0x22, 0x65,
+	0x15, 0xca, 0x2c, 0xdb, 0x63, 0x3c, 0xf0, 0xb6, 0x6b, 0xdc, 0xa3, 0xbe, 0xf5, 0xb8, 0xe6, 0x04,
+	0x7b, 0xb9, 0x6a, 0x40, 0x39, 0xc5, 0xd3, 0x52, 0x21, 0xd7, 0xaa, 0x60, 0x5c, 0x73, 0x29, 0x75,
+	0xcb, 0x8e, 0x45, 0xaa, 0x9e, 0x45, 0x7c, 0x9f, 0x72, 0x52, 0x17, 0x33, 0x69, 0x62, 0xcc, 0x2a,
+	0x9f, 0xc2, 0x8d, 0x55, 0x25, 0xae, 0xe7, 0x8b, 0x73, 0x75, 0x7c, 0xc5, 0xa5, 0x2e, 0x15, 0x9f,
+	0x56, 0xfd, 0x4b, 0x49, 0x55, 0x1c, 0x4b, 0x85, 0x93, 0xc2, 0x97, 0x74, 0xe8, 0x5a, 0x7f, 0x48,
+	0x3d, 0xf3, 0x0a, 0xe0, 0xcd, 0x7a, 0xb0, 0x3c, 0x09, 0x48, 0x85, 0x15, 0x9c, 0xc7, 0x35, 0x87,
+	0x71, 0x33, 0x0f, 0xd3, 0x11, 0x29, 0xab, 0x52, 0x9f, 0x39, 0x78, 0x19, 0x26, 0xaa, 0x42, 0x92,
+	0x44, 0x19, 0x94, 0x3d, 0xbf, 0x38, 0x93, 0xd3, 0x50, 0xcc, 0x49, 0xa3, 0xd5, 0xf1, 0xa7, 0xcf,
+	0xd2, 0x63, 0x05, 0x65, 0x60, 0x7e, 0x8f, 0x60, 0x5e, 0xb8, 0xbc, 0x4f, 0xca, 0x9e, 0x4d, 0x38,
+	0x0d, 0x36, 0x6a, 0x9c, 0x71, 0xe2, 0xdb, 0x9e, 0xef, 0x16, 0x9c, 0x5d, 0x12, 0xd8, 0x61, 0x74,
+	0xfc, 0x08, 0x2e, 0xef, 0x84, 0x5a, 0x5b, 0xc4, 0xb6, 0x03, 0x87, 0xc9, 0x88, 0x17, 0x56, 0x17,
+	0xfe, 0x7b, 0x96, 0xbe, 0xe9, 0x7a, 0xbc, 0x54, 0xdb, 0xce, 0x15, 0x69, 0xc5, 0x8a, 0x50, 0xbf,
+	0xc9, 0xec, 0xcf, 0x2c, 0xbe, 0x57, 0x75, 0x58, 0xee, 0x3e, 0x29, 0xaf, 0x48, 0xc3, 0xc2, 0x54,
+	0xc3, 0x97, 0x92, 0x98, 0x5f, 0x40, 0xb6, 0x3f, 0x14, 0x45, 0x39, 0x0f, 0xe7, 0x02, 0x29, 0x52,
+	0x9c, 0x5f, 0xd6, 0x72, 0xee, 0xe1, 0x4a, 0x25, 0x22, 0x74, 0x63, 0x7e, 0x89, 0x20, 0x1d, 0x0d,
+	0xbf, 0x46, 0x2b, 0x15, 0x8f, 0x31, 0x8f, 0xfa, 0xa3, 0xca, 0xc0, 0x3e, 0x64, 0xba, 0x43, 0x50,
+	0xcc, 0x3f, 0x01, 0x28, 0x36, 0xa4, 0x8a, 0xfc, 0x52, 0x6f, 0xf2, 0x2b, 0xc5, 0x62, 0xad, 0x52,
+	0x2b, 0x13, 0xee, 0xd8, 0x4d, 0x87, 0x8a, 0x7f, 0x8b, 0x33, 0xf3, 0xbb, 0x04, 0x5c, 0x8b, 0xc6,
+	0xff, 0xb0, 0x4c, 0x58, 0xc9, 0x19, 0x55, 0x05, 0xe0, 0x79, 0x98, 0x64, 0x9c, 0x04, 0xdc, 0xf3,
+	0xdd, 0xad, 0x92, 0xe3, 0xb9, 0x25, 0x9e, 0x4c, 0x64, 0x50, 0x76, 0xbc, 0x70, 0x29, 0x14, 0xdf,
+	0x13, 0x52, 0x3c, 0x07, 0x17, 0x1d, 0xdf, 0x6e, 0x51, 0x3b, 0x23, 0xd4, 0x2e, 0x48, 0xa1, 0x52,
+	0x5a, 0x06, 0x68, 0xb6, 0x6a, 0x72, 0x5c, 0x64, 0xea, 0x6a, 0x98, 0x29, 0xf9, 0x22, 0xe4, 0x89,
+	0xeb, 0x28, 0x72, 0x85, 0x16, 0x65, 0xf3, 0x67, 0x04, 0xb3, 0x5d, 0x32, 0xa1, 0xae, 0xe1, 0x1e,
+	0x9c, 0x63, 0x52, 0x94, 0x44, 0x99, 0x33, 0xd9, 0xf3, 0x8b, 0xd9, 0xde, 0x77, 0x20, 0xec, 0xdf,
+	0xde, 0x71, 0x7c, 0x1e, 0x16, 0x9e, 0x32, 0xc7, 0xb7, 0x23, 0x30, 0x13, 0x02, 0xa6, 0xa1, 0x83,
+	0x29, 0x23, 0x47, 0x70, 0x1e, 0x85, 0x38, 0xd7, 0x9d, 0xb2, 0xe3, 0x0a, 0x59, 0x67, 0xd3, 0xda,
+	0xf2, 0x6c, 0xe8, 0x2b, 0x5b, 0x29, 0x16, 0x1b, 0x57, 0xd6, 0xf0, 0x15, 0x5e, 0x99, 0xb6, 0x24,
+	0x12, 0xa7, 0xd7, 0x12, 0x07, 0x08, 0x52, 0xdd, 0x18, 0xaa, 0xab, 0x78, 0xd4, 0xfa, 0x16, 0xd4,
+	0xaf, 0x62, 0x32, 0xcc, 0xde, 0xba, 0x53, 0x5c, 0xa3, 0x9e, 0xbf, 0xba, 0x54, 0xcf, 0xf8, 0x2f,
+	0x47, 0xe9, 0x1b, 0x31, 0xd0, 0x28, 0x1b, 0xd6, 0x7c, 0x19, 0xbe, 0x46, 0x60, 0xb6, 0x41, 0xf8,
+	0x88, 0x72, 0x52, 0x1e, 0x6d, 0xa6, 0xcd, 0xdf, 0x11, 0xcc, 0xf5, 0x84, 0xa1, 0xd2, 0xf1, 0x41,
+	0x7b, 0x3a, 0x72, 0xda, 0xca, 0x6c, 0x7a, 0x59, 0x0f, 0x23, 0x49, 0x4f, 0x6d, 0x0f, 0x23, 0x7e,
+	0x08, 0x67, 0x79, 0x3d, 0x4e, 0x32, 0x71, 0x8a, 0xc9, 0x95, 0x2e, 0x9b, 0x8f, 0x6e, 0x03, 0x43,
+	0xa3, 0x61, 0x46, 0x96, 0xd7, 0x1a, 0x64, 0xba, 0x43, 0x50, 0x39, 0xdd, 0x04, 0x68, 0x54, 0xa6,
+	0x4c, 0xeb, 0x50, 0xe5, 0xdd, 0xe2, 0xc4, 0xfc, 0x16, 0xc1, 0x8b, 0xd1, 0xb8, 0x0f, 0x3c, 0x5e,
+	0xb2, 0x03, 0xb2, 0x1b, 0x6a, 0x8f, 0x88, 0xff, 0x37, 0x08, 0xae, 0xf7, 0x01, 0xa2, 0xb2, 0xf0,
+	0x29, 0x4c, 0xed, 0xaa, 0xa3, 0xe7, 0x07, 0x32, 0xb9, 0x1b, 0x8d, 0x62, 0xce, 0xc0, 0x55, 0x01,
+	0xa3, 0x3e, 0xa2, 0x6a, 0xbe, 0xc7, 0xf7, 0xf2, 0x94, 0x96, 0xc3, 0xcd, 0x67, 0x07, 0x0c, 0xdd,
+	0xa1, 0x02, 0xf6, 0x31, 0x8c, 0x57, 0x29, 0x2d, 0x9f, 0x6a, 0xfb, 0x0b, 0x8f, 0x8b, 0x07, 0x97,
+	0xe0, 0xac, 0x08, 0x8c, 0x0f, 0x10, 0x4c, 0xc8, 0x15, 0x0a, 0xcf, 0x6b, 0x1b, 0xaa, 0x73, 0x5f,
+	0x33, 0xb2, 0xfd, 0x15, 0x25, 0x03, 0x73, 0xee, 0xab, 0x3f, 0xfe, 0xf9, 0x31, 0x31, 0x8b, 0x67,
+	0x2c, 0xdd, 0x82, 0x28, 0x97, 0x35, 0xfc, 0x2f, 0x82, 0x99, 0x1e, 0x1b, 0x0d, 0x7e, 0xa3, 0x7b,
+	0xb8, 0xfe, 0xeb, 0x9d, 0x71, 0x67, 0x48, 0x6b, 0xc5, 0x60, 0x43, 0x30, 0x78, 0x17, 0xbf, 0xa3,
+	0x65, 0xd0, 0x2c, 0x7c, 0xeb, 0x49, 0xc7, 0xbc, 0xd8, 0xb7, 0x68, 0xd3, 0xef, 0x56, 0xf8, 0xee,
+	0xfc, 0x86, 0x60, 0x5a, 0xb3, 0x08, 0xe1, 0x57, 0x62, 0xe0, 0xec, 0x58, 0xdd, 0x8c, 0x5b, 0x03,
+	0x5a, 0x29, 0x56, 0x77, 0x05, 0xab, 0xb7, 0xf0, 0x9b, 0xc3, 0xb0, 0x6a, 0xae, 0x56, 0xf8, 0x57,
+	0x04, 0x53, 0xed, 0xbb, 0x04, 0x5e, 0x88, 0x81, 0x29, 0xba, 0x81, 0x19, 0x8b, 0x83, 0x98, 0x28,
+	0x0e, 0x6b, 0x82, 0xc3, 0x1d, 0xfc, 0xfa, 0x30, 0x1c, 0xc2, 0x2d, 0xe5, 0x4f, 0x04, 0x97, 0x3b,
+	0x46, 0x30, 0xee, 0x01, 0xa7, 0xdb, 0x46, 0x62, 0x2c, 0x0d, 0x64, 0xa3, 0x38, 0x3c, 0x10, 0x1c,
+	0x36, 0xf1, 0x86, 0x96, 0x43, 0xe3, 0x4d, 0x63, 0xd6, 0x93, 0x8e, 0xb7, 0x72, 0xdf, 0x52, 0x15,
+	0xa5, 0xe3, 0x87, 0x0f, 0x11, 0xbc, 0xa0, 0x1f, 0xa8, 0xf8, 0xd5, 0x38, 0x40, 0x35, 0x9b, 0x80,
+	0xf1, 0xda, 0xe0, 0x86, 0xb1, 0xae, 0x2a, 0x1e, 0x4d, 0xd1, 0x38, 0x9a, 0x61, 0xd6, 0xab, 0x71,
+	0xba, 0x8f, 0x5f, 0xe3, 0xd6, 0x80, 0x56, 0xb1, 0x1a, 0xa7, 0x0f, 0x93, 0x66, 0x4d, 0xe2, 0x23,
+	0x04, 0xc9, 0x6e, 0x83, 0x09, 0x2f, 0xc7, 0xc0, 0xa6, 0x9f, 0xaa, 0xc6, 0xed, 0x61, 0x4c, 0x15,
+	0xb7, 0xf7, 0x05, 0xb7, 0xbb, 0x78, 0x7d, 0x18, 0x6e, 0xed, 0x13, 0x14, 0xff, 0x84, 0xe0, 0x62,
+	0x64, 0xac, 0xe1, 0x5c, 0x77, 0x6c, 0xba, 0xe1, 0x68, 0x58, 0xb1, 0xf5, 0x15, 0x81, 0x1b, 0x82,
+	0xc0, 0x75, 0x3c, 0xa7, 0x25, 0x50, 0x0c, 0x6d, 0xb6, 0xea, 0x23, 0x70, 0xf5, 0xbd, 0xa7, 0xc7,
+	0x29, 0x74, 0x78, 0x9c, 0x42, 0x7f, 0x1f, 0xa7, 0xd0, 0x0f, 0x27, 0xa9, 0xb1, 0xc3, 0x93, 0xd4,
+	0xd8, 0x5f, 0x27, 0xa9, 0xb1, 0x87, 0x0b, 0x3d, 0xe7, 0xe9, 0xe7, 0x51, 0xaf, 0x62, 0xbc, 0x6e,
+	0x4f, 0x88, 0xbf, 0x37, 0x96, 0xfe, 0x1f, 0x00, 0xb2, 0x32, 0x1d, 0xa7, 0xa6, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
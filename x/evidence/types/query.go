@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = QueryEvidenceResponse{}
+	_ types.UnpackInterfacesMessage = QueryAllEvidenceResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryEvidenceResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if res.Evidence == nil {
+		return nil
+	}
+
+	var evidence exported.Evidence
+	return unpacker.UnpackAny(res.Evidence, &evidence)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryAllEvidenceResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range res.Evidence {
+		var evidence exported.Evidence
+		if err := unpacker.UnpackAny(any, &evidence); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -229,33 +230,35 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evidence/query.proto", fileDescriptor_6afffc78347cbc5e) }
 
 var fileDescriptor_6afffc78347cbc5e = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x4f, 0xdb, 0x30,
-	0x1c, 0xc5, 0x93, 0x4d, 0x9b, 0x2a, 0xb7, 0xd3, 0x24, 0xab, 0xd3, 0xda, 0x4c, 0xcb, 0xa6, 0x48,
-	0x9b, 0xba, 0x43, 0xed, 0xa9, 0x70, 0x00, 0x6e, 0xad, 0x84, 0x28, 0x37, 0x88, 0x38, 0x81, 0x10,
-	0x4a, 0x1a, 0x93, 0x44, 0xa4, 0x76, 0x5a, 0x3b, 0xa8, 0xf9, 0x06, 0x1c, 0xf9, 0x58, 0x88, 0x53,
-	0x8f, 0x9c, 0x10, 0x6a, 0xbf, 0x05, 0x27, 0x54, 0x27, 0xa6, 0xa1, 0x8d, 0x54, 0x4e, 0xf9, 0x27,
-	0x7e, 0x79, 0xef, 0xe7, 0x17, 0x07, 0xfc, 0x18, 0x30, 0x3e, 0x64, 0x1c, 0x93, 0xeb, 0xd0, 0x23,
-	0x74, 0x40, 0xf0, 0x28, 0x21, 0xe3, 0x14, 0xc5, 0x63, 0x26, 0x18, 0xfc, 0x9a, 0x2d, 0x22, 0xb5,
-	0x68, 0xfc, 0xcc, 0xd5, 0x52, 0x84, 0x63, 0xc7, 0x0f, 0xa9, 0x23, 0x42, 0x46, 0x33, 0xbd, 0x51,
-	0xf7, 0x99, 0xcf, 0xe4, 0x88, 0x17, 0x53, 0xfe, 0xb4, 0xe9, 0x33, 0xe6, 0x47, 0x04, 0xcb, 0x3b,
-	0x37, 0xb9, 0xc4, 0x0e, 0xcd, 0x03, 0xac, 0x04, 0xd4, 0x8f, 0x17, 0x56, 0xfb, 0x79, 0x80, 0x4d,
-	0x46, 0x09, 0xe1, 0x02, 0x9e, 0x83, 0x2f, 0x2a, 0xf3, 0x22, 0x70, 0x78, 0xd0, 0xd0, 0x7f, 0xeb,
-	0xad, 0x5a, 0x6f, 0xe7, 0xf9, 0xf1, 0xd7, 0xb6, 0x1f, 0x8a, 0x20, 0x71, 0xd1, 0x80, 0x0d, 0xb1,
-	0x20, 0xd4, 0x23, 0xe3, 0x61, 0x48, 0x45, 0x71, 0x8c, 0x42, 0x97, 0x63, 0x37, 0x15, 0x84, 0xa3,
-	0x3e, 0x99, 0xf4, 0x16, 0x83, 0x5d, 0x53, 0x76, 0x7d, 0x87, 0x07, 0xd6, 0x21, 0xf8, 0xb6, 0x12,
-	0xcb, 0x63, 0x46, 0x39, 0x81, 0xff, 0x41, 0x45, 0x09, 0x65, 0x64, 0xb5, 0x53, 0x47, 0x19, 0x3d,
-	0x52, 0xf4, 0xa8, 0x4b, 0x53, 0xfb, 0x55, 0x65, 0x9d, 0x80, 0xef, 0xd2, 0xaa, 0x1b, 0x45, 0xab,
-	0x9b, 0xd8, 0x05, 0x60, 0xd9, 0x50, 0x6e, 0xd7, 0x44, 0x79, 0xa5, 0x59, 0xcd, 0x47, 0x8e, 0xaf,
-	0xe4, 0x76, 0x41, 0x6c, 0xdd, 0xe8, 0xa0, 0xb1, 0x6e, 0x5b, 0x0a, 0xf9, 0x71, 0x33, 0x24, 0xdc,
-	0x7b, 0x43, 0xf2, 0x41, 0x92, 0x18, 0x65, 0x24, 0x59, 0x42, 0x11, 0xa5, 0x73, 0xaf, 0x83, 0x4f,
-	0x12, 0x05, 0x9e, 0x81, 0x8a, 0x62, 0x81, 0x7f, 0xd0, 0xca, 0xd1, 0x40, 0x65, 0xdf, 0xd1, 0xf8,
-	0xbb, 0x49, 0x96, 0x05, 0x5a, 0x1a, 0xf4, 0x40, 0xb5, 0xb0, 0x57, 0xd8, 0x2a, 0x7f, 0x71, 0xbd,
-	0x65, 0xe3, 0xdf, 0x3b, 0x94, 0x2a, 0xa5, 0x77, 0x70, 0x37, 0x33, 0xf5, 0xe9, 0xcc, 0xd4, 0x9f,
-	0x66, 0xa6, 0x7e, 0x3b, 0x37, 0xb5, 0xe9, 0xdc, 0xd4, 0x1e, 0xe6, 0xa6, 0x76, 0xda, 0x2e, 0x1c,
-	0xab, 0xfc, 0x90, 0x67, 0x97, 0x36, 0xf7, 0xae, 0xf0, 0x64, 0xf9, 0x7f, 0x88, 0x34, 0x26, 0xdc,
-	0xfd, 0x2c, 0x9b, 0xde, 0x7a, 0x09, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x1c, 0xab, 0xe8, 0x3f, 0x03,
-	0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8b, 0xd3, 0x40,
+	0x14, 0xc6, 0x3b, 0x15, 0x65, 0x99, 0x5d, 0x11, 0x86, 0x8a, 0xdb, 0xac, 0x46, 0x0d, 0x28, 0x2d,
+	0xd2, 0x19, 0xad, 0x1e, 0xd4, 0x5b, 0x0b, 0x62, 0xbd, 0x69, 0xf0, 0x24, 0x88, 0x4c, 0xda, 0x31,
+	0x09, 0xa6, 0x33, 0x69, 0x67, 0x22, 0x0d, 0xe2, 0x45, 0x10, 0x3c, 0x78, 0x10, 0xbc, 0xfb, 0xf7,
+	0x78, 0x2c, 0x78, 0xf1, 0x24, 0xd2, 0xfa, 0x57, 0x78, 0x92, 0x4e, 0x26, 0x6d, 0x9a, 0x46, 0xba,
+	0xa7, 0xbe, 0xe6, 0x7d, 0xf9, 0xbe, 0x5f, 0xde, 0x7b, 0xf0, 0x64, 0x28, 0xe4, 0x58, 0x48, 0xc2,
+	0xde, 0x86, 0x23, 0xc6, 0x87, 0x8c, 0x4c, 0x12, 0x36, 0x4d, 0x71, 0x3c, 0x15, 0x4a, 0xa0, 0x0b,
+	0x59, 0x13, 0xe7, 0x4d, 0xeb, 0xb2, 0x2f, 0x84, 0x1f, 0x31, 0x42, 0xe3, 0x90, 0x50, 0xce, 0x85,
+	0xa2, 0x2a, 0x14, 0x5c, 0x66, 0x72, 0xeb, 0x8a, 0xf1, 0xd2, 0x16, 0x24, 0xa6, 0x7e, 0xc8, 0x75,
+	0xdf, 0xb4, 0x1b, 0xbe, 0xf0, 0x85, 0x2e, 0xc9, 0xaa, 0x32, 0x4f, 0x9b, 0xc6, 0x52, 0xff, 0xf3,
+	0x92, 0xd7, 0x84, 0x72, 0x13, 0xef, 0x24, 0xb0, 0xf1, 0x6c, 0x65, 0xf5, 0xc8, 0xc4, 0xbb, 0x6c,
+	0x92, 0x30, 0xa9, 0xd0, 0x4b, 0x78, 0x3e, 0x27, 0x7a, 0x15, 0x50, 0x19, 0x1c, 0x83, 0x6b, 0xa0,
+	0x75, 0xd4, 0xbf, 0xff, 0xf7, 0xd7, 0xd5, 0x7b, 0x7e, 0xa8, 0x82, 0xc4, 0xc3, 0x43, 0x31, 0x26,
+	0x8a, 0xf1, 0x11, 0x9b, 0x8e, 0x43, 0xae, 0x8a, 0x65, 0x14, 0x7a, 0x92, 0x78, 0xa9, 0x62, 0x12,
+	0x0f, 0xd8, 0xac, 0xbf, 0x2a, 0xdc, 0xa3, 0xdc, 0x6e, 0x40, 0x65, 0xe0, 0x3c, 0x81, 0x17, 0x4b,
+	0xb1, 0x32, 0x16, 0x5c, 0x32, 0x74, 0x1b, 0x1e, 0xe4, 0x42, 0x1d, 0x79, 0xd8, 0x6d, 0xe0, 0x8c,
+	0x1e, 0xe7, 0xf4, 0xb8, 0xc7, 0x53, 0x77, 0xad, 0x72, 0x9e, 0xc3, 0x4b, 0xda, 0xaa, 0x17, 0x45,
+	0xe5, 0x8f, 0x78, 0x00, 0xe1, 0x66, 0x42, 0xc6, 0xae, 0x89, 0xcd, 0xc0, 0xb3, 0x25, 0x3c, 0xa5,
+	0x7e, 0x2e, 0x77, 0x0b, 0x62, 0xe7, 0x13, 0x80, 0xc7, 0xbb, 0xb6, 0x95, 0x90, 0x67, 0xf6, 0x43,
+	0xa2, 0x87, 0x5b, 0x24, 0x75, 0x4d, 0x62, 0x55, 0x91, 0x64, 0x09, 0x45, 0x94, 0xee, 0xb7, 0x3a,
+	0x3c, 0xab, 0x51, 0xd0, 0x67, 0x00, 0x0f, 0x72, 0x18, 0x74, 0x03, 0x97, 0x2e, 0x07, 0x57, 0x2d,
+	0xd2, 0xba, 0xb9, 0x4f, 0x96, 0x25, 0x3a, 0x77, 0x3e, 0xfc, 0xf8, 0xf3, 0xb5, 0x7e, 0x0b, 0xb5,
+	0x49, 0xf9, 0x5a, 0xd7, 0xc5, 0xbb, 0xad, 0x8b, 0x78, 0x8f, 0x3e, 0x02, 0x78, 0x58, 0x18, 0x0f,
+	0x6a, 0x55, 0x47, 0xed, 0x2e, 0xc6, 0x6a, 0x9f, 0x42, 0x69, 0xb8, 0xae, 0x6b, 0xae, 0x13, 0xd4,
+	0xfc, 0x2f, 0x57, 0xff, 0xf1, 0xf7, 0x85, 0x0d, 0xe6, 0x0b, 0x1b, 0xfc, 0x5e, 0xd8, 0xe0, 0xcb,
+	0xd2, 0xae, 0xcd, 0x97, 0x76, 0xed, 0xe7, 0xd2, 0xae, 0xbd, 0xe8, 0x14, 0x4e, 0xd5, 0xbc, 0x9e,
+	0xfd, 0x74, 0xe4, 0xe8, 0x0d, 0x99, 0x6d, 0xbc, 0x54, 0x1a, 0x33, 0xe9, 0x9d, 0xd3, 0xdb, 0xbb,
+	0xfb, 0x6f, 0x00, 0xe2, 0xde, 0x4b, 0x80, 0xb1, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,106 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type AnteTestSuite struct {
+	suite.Suite
+
+	app      *simapp.SimApp
+	ctx      sdk.Context
+	txConfig client.TxConfig
+}
+
+func (suite *AnteTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Now().UTC()})
+	suite.txConfig = simappparams.MakeEncodingConfig().TxConfig
+}
+
+func (suite *AnteTestSuite) newTx(payer, granter sdk.AccAddress, fee sdk.Coins) sdk.Tx {
+	txBuilder := suite.txConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetFeeGranter(granter)
+
+	return txBuilder.GetTx()
+}
+
+func (suite *AnteTestSuite) TestDeductFeesNoDelegation() {
+	app, ctx := suite.app, suite.ctx
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+
+	// addr1 has funds, addr2 has none
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 99999))))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr2))
+
+	// addr2 may spend up to 500 atom of addr1, addr3 has no account yet
+	basic := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), nil)
+	suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, addr1, addr2, basic))
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewDeductGrantedFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper))
+
+	cases := map[string]struct {
+		payer   sdk.AccAddress
+		granter sdk.AccAddress
+		fee     int64
+		valid   bool
+	}{
+		"paying with own funds":       {payer: addr1, fee: 50, valid: true},
+		"paying with no funds":        {payer: addr2, fee: 50, valid: false},
+		"paying with unknown account": {payer: addr3, fee: 50, valid: false},
+		"zero fee with no funds":      {payer: addr2, fee: 0, valid: true},
+		"granter is the payer":        {payer: addr1, granter: addr1, fee: 50, valid: true},
+		"valid fee grant":             {payer: addr2, granter: addr1, fee: 50, valid: true},
+		"fee grant exceeding limit":   {payer: addr2, granter: addr1, fee: 501, valid: false},
+		"no fee grant":                {payer: addr1, granter: addr2, fee: 50, valid: false},
+		"no fee grant for account":    {payer: addr3, granter: addr1, fee: 50, valid: false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			fee := sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee))
+			_, err := antehandler(ctx, suite.newTx(tc.payer, tc.granter, fee), false)
+			if !tc.valid {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			if !tc.granter.Empty() && !tc.granter.Equals(tc.payer) {
+				remaining := app.FeeGrantKeeper.GetFeeAllowance(ctx, tc.granter, tc.payer)
+				suite.Require().Equal(
+					types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 500-tc.fee)), nil), remaining,
+				)
+				suite.Require().Equal(
+					sdk.NewInt64Coin("atom", 99999-tc.fee), app.BankKeeper.GetBalance(ctx, tc.granter, "atom"),
+				)
+			}
+		})
+	}
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
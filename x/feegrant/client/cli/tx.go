@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// feegrant CLI flags
+const (
+	FlagSpendLimit  = "spend-limit"
+	FlagExpiration  = "expiration"
+	FlagPeriod      = "period"
+	FlagPeriodLimit = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for the feegrant module.
+func GetTxCmd() *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee grant transactions subcommands",
+		Long:                       "Grant and revoke fee allowances paying the fees of other addresses",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(
+		NewCmdFeeGrant(),
+		NewCmdRevokeFeeGrant(),
+	)
+
+	return feegrantTxCmd
+}
+
+// NewCmdFeeGrant returns a CLI command handler for creating a
+// MsgGrantFeeAllowance transaction.
+func NewCmdFeeGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] --from [granter]",
+		Short: "Grant a fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant a fee allowance to an address, allowing it to pay the fees of its
+transactions from your account. The allowance is limited by the optional
+--spend-limit and --expiration. Setting --period and --period-limit grants a
+periodic allowance, allowing to spend at most the period limit per period.
+
+Examples:
+$ %s tx %s grant cosmos1skjw.. --spend-limit=1000stake --expiration=2021-01-01T00:00:00Z --from=cosmos1skl..
+$ %s tx %s grant cosmos1skjw.. --spend-limit=1000stake --period=24h --period-limit=10stake --from=cosmos1skl..
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(limit)
+			if err != nil {
+				return err
+			}
+
+			expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if expirationStr != "" {
+				exp, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+
+				expiration = &exp
+			}
+
+			basic := types.NewBasicFeeAllowance(spendLimit, expiration)
+
+			var allowance types.FeeAllowanceI = basic
+
+			period, err := cmd.Flags().GetDuration(FlagPeriod)
+			if err != nil {
+				return err
+			}
+
+			periodLimitStr, err := cmd.Flags().GetString(FlagPeriodLimit)
+			if err != nil {
+				return err
+			}
+
+			if period > 0 || periodLimitStr != "" {
+				periodLimit, err := sdk.ParseCoins(periodLimitStr)
+				if err != nil {
+					return err
+				}
+
+				allowance = types.NewPeriodicFeeAllowance(*basic, period, periodLimit)
+			}
+
+			msg, err := types.NewMsgGrantFeeAllowance(clientCtx.GetFromAddress(), grantee, allowance)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "The maximum amount of fees the grantee can spend, unlimited if empty")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 expiration time of the allowance, never expiring if empty")
+	cmd.Flags().Duration(FlagPeriod, 0, "The duration of a period of a periodic allowance")
+	cmd.Flags().String(FlagPeriodLimit, "", "The maximum amount of fees the grantee can spend per period")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevokeFeeGrant returns a CLI command handler for creating a
+// MsgRevokeFeeAllowance transaction.
+func NewCmdRevokeFeeGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] --from [granter]",
+		Short: "Revoke the fee allowance granted to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance granted to an address.
+
+Example:
+$ %s tx %s revoke cosmos1skjw.. --from=cosmos1skl..
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(clientCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
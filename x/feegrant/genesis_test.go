@@ -0,0 +1,68 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+var (
+	granter = sdk.AccAddress([]byte("granter_____________"))
+	grantee = sdk.AccAddress([]byte("grantee_____________"))
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1, Time: time.Now().UTC()})
+}
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	basic := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), &expiration)
+	suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, granter, grantee, basic))
+
+	genesis := feegrant.ExportGenesis(ctx, app.FeeGrantKeeper)
+	suite.Require().Len(genesis.FeeAllowances, 1)
+	suite.Require().NoError(genesis.Validate())
+
+	// clear the store and re-import the genesis
+	suite.Require().NoError(app.FeeGrantKeeper.RevokeFeeAllowance(ctx, granter, grantee))
+	suite.Require().Nil(app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+
+	feegrant.InitGenesis(ctx, app.FeeGrantKeeper, genesis)
+
+	suite.Require().Equal(basic, app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+	suite.Require().Equal(genesis, feegrant.ExportGenesis(ctx, app.FeeGrantKeeper))
+}
+
+func (suite *GenesisTestSuite) TestInitGenesisInvalid() {
+	grant, err := types.NewFeeAllowanceGrant(granter, granter, types.NewBasicFeeAllowance(nil, nil))
+	suite.Require().NoError(err)
+
+	suite.Require().Panics(func() {
+		feegrant.InitGenesis(suite.ctx, suite.app.FeeGrantKeeper, types.NewGenesisState([]types.FeeAllowanceGrant{grant}))
+	})
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
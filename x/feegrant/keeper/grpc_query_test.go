@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryFeeAllowance() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+
+	_, err := queryClient.FeeAllowance(gocontext.Background(), &types.QueryFeeAllowanceRequest{})
+	suite.Require().Error(err)
+
+	req := &types.QueryFeeAllowanceRequest{Granter: granter, Grantee: grantee}
+	_, err = queryClient.FeeAllowance(gocontext.Background(), req)
+	suite.Require().Error(err)
+
+	basic := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 555)), nil)
+	suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, granter, grantee, basic))
+
+	res, err := queryClient.FeeAllowance(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(granter, res.FeeAllowance.Granter)
+	suite.Require().Equal(grantee, res.FeeAllowance.Grantee)
+	suite.Require().Equal(basic, res.FeeAllowance.GetFeeAllowanceI())
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeAllowances() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	grantee := suite.addrs[0]
+
+	_, err := queryClient.FeeAllowances(gocontext.Background(), &types.QueryFeeAllowancesRequest{})
+	suite.Require().Error(err)
+
+	basic := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 555)), nil)
+	for _, granter := range suite.addrs[1:] {
+		suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, granter, grantee, basic))
+	}
+
+	req := &types.QueryFeeAllowancesRequest{Grantee: grantee, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}}
+	res, err := queryClient.FeeAllowances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.FeeAllowances, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	for _, grant := range res.FeeAllowances {
+		suite.Require().Equal(grantee, grant.Grantee)
+		suite.Require().Equal(basic, grant.GetFeeAllowanceI())
+	}
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Keeper manages the fee allowances granters grant to grantees, and the
+// payment of the grantees' fees from them.
+type Keeper struct {
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	authKeeper types.AccountKeeper
+}
+
+// NewKeeper creates a fee grant Keeper
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		authKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantFeeAllowance creates a new grant, replacing any existing allowance of
+// the grantee by the granter. The grantee's account is created if it does
+// not exist yet, so that it can sign transactions paid for by the granter.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, allowance types.FeeAllowanceI) error {
+	if acc := k.authKeeper.GetAccount(ctx, grantee); acc == nil {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, grantee))
+	}
+
+	grant, err := types.NewFeeAllowanceGrant(granter, grantee, allowance)
+	if err != nil {
+		return err
+	}
+
+	k.setGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// RevokeFeeAllowance removes the fee allowance of the grantee by the granter.
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	if _, found := k.GetFeeGrant(ctx, granter, grantee); !found {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "granter %s, grantee %s", granter, grantee)
+	}
+
+	k.deleteGrant(ctx, granter, grantee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetFeeAllowance returns the fee allowance of the grantee by the granter, or
+// nil if there is none.
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) types.FeeAllowanceI {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return nil
+	}
+
+	return grant.GetFeeAllowanceI()
+}
+
+// GetFeeGrant returns the grant of a fee allowance to the grantee by the
+// granter.
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.FeeAllowanceGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.FeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// IterateAllGranteeFeeAllowances iterates over all the grants of fee
+// allowances to the grantee. Iteration stops when the callback returns true.
+func (k Keeper) IterateAllGranteeFeeAllowances(
+	ctx sdk.Context, grantee sdk.AccAddress, cb func(grant types.FeeAllowanceGrant) (stop bool),
+) {
+	k.iterateGrants(ctx, types.FeeAllowancePrefixByGrantee(grantee), cb)
+}
+
+// IterateAllFeeAllowances iterates over all the grants of fee allowances.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant types.FeeAllowanceGrant) (stop bool)) {
+	k.iterateGrants(ctx, types.FeeAllowanceKeyPrefix, cb)
+}
+
+func (k Keeper) iterateGrants(ctx sdk.Context, prefix []byte, cb func(grant types.FeeAllowanceGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees pays the fee of a transaction with the given messages from
+// the fee allowance of the grantee by the granter. The allowance is updated,
+// or removed if it is exhausted or expired. It returns an error if there is
+// no allowance or the allowance doesn't accept the fee. The fee itself is not
+// deducted from the granter's account.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "granter %s, grantee %s", granter, grantee)
+	}
+
+	allowance := grant.GetFeeAllowanceI()
+	if allowance == nil {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "granter %s, grantee %s", granter, grantee)
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if remove {
+		k.deleteGrant(ctx, granter, grantee)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !remove {
+		grant, err = types.NewFeeAllowanceGrant(granter, grantee, allowance)
+		if err != nil {
+			return err
+		}
+
+		k.setGrant(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) setGrant(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeAllowanceKey(grant.Granter, grant.Grantee), k.cdc.MustMarshalBinaryBare(&grant))
+}
+
+func (k Keeper) deleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeAllowanceKey(granter, grantee))
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeGrantKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee, other := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	basic := types.NewBasicFeeAllowance(atom, nil)
+	basic2 := types.NewBasicFeeAllowance(eth, nil)
+
+	suite.Require().Nil(app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+
+	suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, granter, grantee, basic))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, granter, other, basic2))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, other, grantee, basic))
+
+	// a new grant overwrites the existing one
+	suite.Require().Equal(basic, app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, granter, grantee, basic2))
+	suite.Require().Equal(basic2, app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+
+	// allowances are directed
+	suite.Require().Nil(app.FeeGrantKeeper.GetFeeAllowance(ctx, grantee, granter))
+
+	var grants []types.FeeAllowanceGrant
+	app.FeeGrantKeeper.IterateAllGranteeFeeAllowances(ctx, grantee, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	suite.Require().Len(grants, 2)
+	for _, grant := range grants {
+		suite.Require().Equal(grantee, grant.Grantee)
+	}
+
+	var count int
+	app.FeeGrantKeeper.IterateAllFeeAllowances(ctx, func(types.FeeAllowanceGrant) bool {
+		count++
+		return false
+	})
+	suite.Require().Equal(3, count)
+
+	suite.Require().NoError(app.FeeGrantKeeper.RevokeFeeAllowance(ctx, granter, grantee))
+	suite.Require().Nil(app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+	suite.Require().Error(app.FeeGrantKeeper.RevokeFeeAllowance(ctx, granter, grantee))
+}
+
+func (suite *KeeperTestSuite) TestGrantCreatesGranteeAccount() {
+	app, ctx := suite.app, suite.ctx
+	granter := suite.addrs[0]
+	grantee := sdk.AccAddress([]byte("new_grantee_________"))
+
+	suite.Require().Nil(app.AccountKeeper.GetAccount(ctx, grantee))
+
+	basic := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), nil)
+	suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, granter, grantee, basic))
+	suite.Require().NotNil(app.AccountKeeper.GetAccount(ctx, grantee))
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFee() {
+	app, ctx := suite.app, suite.ctx
+	granter, grantee, other := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	future := ctx.BlockTime().Add(time.Hour)
+	past := ctx.BlockTime().Add(-time.Hour)
+
+	cases := map[string]struct {
+		allowance types.FeeAllowanceI
+		fee       sdk.Coins
+		allowed   bool
+		final     types.FeeAllowanceI
+	}{
+		"use entire pot": {
+			allowance: types.NewBasicFeeAllowance(atom, &future),
+			fee:       atom,
+			allowed:   true,
+			final:     nil,
+		},
+		"expired": {
+			allowance: types.NewBasicFeeAllowance(atom, &past),
+			fee:       eth,
+			allowed:   false,
+			final:     nil,
+		},
+		"too high": {
+			allowance: types.NewBasicFeeAllowance(eth, &future),
+			fee:       atom,
+			allowed:   false,
+			final:     types.NewBasicFeeAllowance(eth, &future),
+		},
+		"use a little": {
+			allowance: types.NewBasicFeeAllowance(atom, &future),
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 55)),
+			allowed:   true,
+			final:     types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), &future),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := ctx.CacheContext()
+
+			suite.Require().NoError(app.FeeGrantKeeper.GrantFeeAllowance(ctx, granter, grantee, tc.allowance))
+
+			err := app.FeeGrantKeeper.UseGrantedFees(ctx, granter, grantee, tc.fee, nil)
+			if tc.allowed {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			suite.Require().Equal(tc.final, app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+		})
+	}
+
+	// no allowance
+	err := app.FeeGrantKeeper.UseGrantedFees(ctx, granter, other, atom, nil)
+	suite.Require().Error(err)
+	suite.Require().True(types.ErrNoAllowance.Is(err))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
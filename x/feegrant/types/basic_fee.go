@@ -0,0 +1,55 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = &BasicFeeAllowance{}
+
+// NewBasicFeeAllowance creates a new BasicFeeAllowance object. A nil spend
+// limit or expiration means the allowance is not limited by it.
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration *time.Time) *BasicFeeAllowance {
+	return &BasicFeeAllowance{SpendLimit: spendLimit, Expiration: expiration}
+}
+
+// Accept implements FeeAllowanceI.Accept. The fee is deducted from the spend
+// limit, if any, and the allowance is removed once it is expired or its spend
+// limit is exhausted.
+func (a *BasicFeeAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg) (bool, error) {
+	if a.isExpired(ctx.BlockTime()) {
+		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "basic allowance")
+	}
+
+	if a.SpendLimit != nil {
+		left, isNeg := a.SpendLimit.SafeSub(fee)
+		if isNeg {
+			return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "basic allowance")
+		}
+
+		a.SpendLimit = left
+		return left.IsZero(), nil
+	}
+
+	return false, nil
+}
+
+func (a BasicFeeAllowance) isExpired(blockTime time.Time) bool {
+	return a.Expiration != nil && !blockTime.Before(*a.Expiration)
+}
+
+// ValidateBasic implements FeeAllowanceI.ValidateBasic.
+func (a BasicFeeAllowance) ValidateBasic() error {
+	if a.SpendLimit != nil {
+		if !a.SpendLimit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "send amount is invalid: %s", a.SpendLimit)
+		}
+		if !a.SpendLimit.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+		}
+	}
+
+	return nil
+}
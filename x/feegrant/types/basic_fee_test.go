@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestBasicFeeValidAllow(t *testing.T) {
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+
+	now := time.Now().UTC()
+	oneHour := now.Add(time.Hour)
+
+	cases := map[string]struct {
+		allowance *types.BasicFeeAllowance
+		// all other checks are ignored if valid=false
+		valid     bool
+		fee       sdk.Coins
+		blockTime time.Time
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"empty": {
+			allowance: &types.BasicFeeAllowance{},
+			valid:     true,
+			fee:       atom,
+			blockTime: now,
+			accept:    true,
+		},
+		"invalid spend limit": {
+			allowance: types.NewBasicFeeAllowance(sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}, nil),
+			valid:     false,
+		},
+		"small fee without expire": {
+			allowance: types.NewBasicFeeAllowance(atom, nil),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"all fee without expire": {
+			allowance: types.NewBasicFeeAllowance(smallAtom, nil),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remove:    true,
+		},
+		"wrong fee": {
+			allowance: types.NewBasicFeeAllowance(smallAtom, nil),
+			valid:     true,
+			fee:       eth,
+			blockTime: now,
+			accept:    false,
+		},
+		"non-expired": {
+			allowance: types.NewBasicFeeAllowance(atom, &oneHour),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"expired": {
+			allowance: types.NewBasicFeeAllowance(atom, &now),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: oneHour,
+			accept:    false,
+			remove:    true,
+		},
+		"fee more than allowed": {
+			allowance: types.NewBasicFeeAllowance(smallAtom, &oneHour),
+			valid:     true,
+			fee:       atom,
+			blockTime: now,
+			accept:    false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ctx := sdk.Context{}.WithBlockHeader(abci.Header{Time: tc.blockTime})
+
+			remove, err := tc.allowance.Accept(ctx, tc.fee, nil)
+			if !tc.accept {
+				require.Error(t, err)
+				require.Equal(t, tc.remove, remove)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, remove)
+			if !remove {
+				require.Equal(t, tc.remains, tc.allowance.SpendLimit)
+			}
+		})
+	}
+}
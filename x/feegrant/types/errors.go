@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feegrant module sentinel errors
+var (
+	ErrFeeLimitExceeded = sdkerrors.Register(ModuleName, 2, "fee limit exceeded")
+	ErrFeeLimitExpired  = sdkerrors.Register(ModuleName, 3, "fee allowance expired")
+	ErrInvalidDuration  = sdkerrors.Register(ModuleName, 4, "invalid duration")
+	ErrNoAllowance      = sdkerrors.Register(ModuleName, 5, "no fee allowance")
+)
@@ -0,0 +1,12 @@
+package types
+
+// feegrant module events
+const (
+	EventTypeUseFeeGrant    = "use_feegrant"
+	EventTypeRevokeFeeGrant = "revoke_feegrant"
+	EventTypeSetFeeGrant    = "set_feegrant"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+)
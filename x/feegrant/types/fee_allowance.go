@@ -0,0 +1,26 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowanceI is the interface of the fee allowances a granter can grant to
+// a grantee to pay the grantee's transaction fees.
+type FeeAllowanceI interface {
+	proto.Message
+
+	// Accept determines whether the fee can be paid from the allowance for a
+	// transaction with the given messages. Accept may update the allowance,
+	// e.g. to reduce its spend limit, and the updated allowance is stored
+	// unless remove is true, in which case the allowance is deleted.
+	//
+	// Note that remove may be true together with an error, e.g. when the
+	// allowance is expired.
+	Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (remove bool, err error)
+
+	// ValidateBasic does a simple validation check that doesn't require
+	// access to any other information.
+	ValidateBasic() error
+}
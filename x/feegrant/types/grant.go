@@ -0,0 +1,68 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = FeeAllowanceGrant{}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant object.
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance FeeAllowanceI) (FeeAllowanceGrant, error) {
+	any, err := packFeeAllowance(allowance)
+	if err != nil {
+		return FeeAllowanceGrant{}, err
+	}
+
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: any,
+	}, nil
+}
+
+// ValidateBasic performs basic validation of the grant.
+func (g FeeAllowanceGrant) ValidateBasic() error {
+	if g.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if g.Grantee.Equals(g.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	allowance := g.GetFeeAllowanceI()
+	if allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "missing allowance")
+	}
+	return allowance.ValidateBasic()
+}
+
+// GetFeeAllowanceI returns the grant's fee allowance.
+func (g FeeAllowanceGrant) GetFeeAllowanceI() FeeAllowanceI {
+	return unpackFeeAllowance(g.Allowance)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g FeeAllowanceGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(g.Allowance, &allowance)
+}
+
+func packFeeAllowance(allowance FeeAllowanceI) (*types.Any, error) {
+	if allowance == nil {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "allowance cannot be nil")
+	}
+	return types.NewAnyWithValue(allowance)
+}
+
+func unpackFeeAllowance(any *types.Any) FeeAllowanceI {
+	allowance, ok := any.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil
+	}
+	return allowance
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feegrant"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// FeeAllowanceKeyPrefix is the prefix of the fee allowances, stored by
+	// grantee and granter
+	FeeAllowanceKeyPrefix = []byte{0x00}
+)
+
+// FeeAllowanceKey returns the store key of the fee allowance of the grantee
+// by the granter: 0x00<grantee><granter>
+func FeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGrantee(grantee), granter.Bytes()...)
+}
+
+// FeeAllowancePrefixByGrantee returns the prefix of all the fee allowances of
+// the grantee: 0x00<grantee>
+func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(FeeAllowanceKeyPrefix)+len(grantee))
+	key = append(key, FeeAllowanceKeyPrefix...)
+	return append(key, grantee.Bytes()...)
+}
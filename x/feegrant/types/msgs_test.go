@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+var (
+	granter = sdk.AccAddress([]byte("granter_____________"))
+	grantee = sdk.AccAddress([]byte("grantee_____________"))
+)
+
+func TestMsgGrantFeeAllowance(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+
+	cases := map[string]struct {
+		granter   sdk.AccAddress
+		grantee   sdk.AccAddress
+		allowance types.FeeAllowanceI
+		valid     bool
+	}{
+		"valid":           {granter, grantee, types.NewBasicFeeAllowance(atom, nil), true},
+		"missing granter": {nil, grantee, types.NewBasicFeeAllowance(atom, nil), false},
+		"missing grantee": {granter, nil, types.NewBasicFeeAllowance(atom, nil), false},
+		"self grant":      {granter, granter, types.NewBasicFeeAllowance(atom, nil), false},
+		"invalid allowance": {
+			granter, grantee, types.NewBasicFeeAllowance(sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.ZeroInt()}}, nil), false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgGrantFeeAllowance(tc.granter, tc.grantee, tc.allowance)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.Equal(t, tc.allowance, msg.GetFeeAllowanceI())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+
+	_, err := types.NewMsgGrantFeeAllowance(granter, grantee, nil)
+	require.Error(t, err)
+}
+
+func TestMsgRevokeFeeAllowance(t *testing.T) {
+	require.NoError(t, types.NewMsgRevokeFeeAllowance(granter, grantee).ValidateBasic())
+	require.Error(t, types.NewMsgRevokeFeeAllowance(nil, grantee).ValidateBasic())
+	require.Error(t, types.NewMsgRevokeFeeAllowance(granter, nil).ValidateBasic())
+	require.Error(t, types.NewMsgRevokeFeeAllowance(granter, granter).ValidateBasic())
+
+	msg := types.NewMsgRevokeFeeAllowance(granter, grantee)
+	require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestPeriodicFeeValidAllow(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	oneAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+
+	now := time.Now().UTC()
+	oneHour := now.Add(time.Hour)
+	twoHours := now.Add(2 * time.Hour)
+
+	cases := map[string]struct {
+		allowance *types.PeriodicFeeAllowance
+		// all other checks are ignored if valid=false
+		valid     bool
+		fee       sdk.Coins
+		blockTime time.Time
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+		canSpend  sdk.Coins
+		periodEnd time.Time
+	}{
+		"empty": {
+			allowance: &types.PeriodicFeeAllowance{},
+			valid:     false,
+		},
+		"zero period": {
+			allowance: types.NewPeriodicFeeAllowance(types.BasicFeeAllowance{}, 0, smallAtom),
+			valid:     false,
+		},
+		"period limit of other currency": {
+			allowance: types.NewPeriodicFeeAllowance(*types.NewBasicFeeAllowance(atom, nil), time.Hour, eth),
+			valid:     false,
+		},
+		"first time": {
+			allowance: types.NewPeriodicFeeAllowance(*types.NewBasicFeeAllowance(atom, nil), time.Hour, smallAtom),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remains:   leftAtom,
+			periodEnd: oneHour,
+		},
+		"same period": {
+			allowance: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, nil),
+				Period:           time.Hour,
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   smallAtom,
+				PeriodReset:      oneHour,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remains:   leftAtom,
+			periodEnd: oneHour,
+		},
+		"period exceeded": {
+			allowance: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, nil),
+				Period:           time.Hour,
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   oneAtom,
+				PeriodReset:      oneHour,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    false,
+		},
+		"period reset": {
+			allowance: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, nil),
+				Period:           time.Hour,
+				PeriodSpendLimit: smallAtom,
+				PeriodCanSpend:   oneAtom,
+				PeriodReset:      now,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remains:   leftAtom,
+			periodEnd: oneHour,
+		},
+		"period reset capped by spend limit": {
+			allowance: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(smallAtom, nil),
+				Period:           time.Hour,
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   oneAtom,
+				PeriodReset:      now,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remove:    true,
+		},
+		"skipped periods": {
+			allowance: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, nil),
+				Period:           time.Hour,
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   sdk.NewCoins(),
+				PeriodReset:      now,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: twoHours,
+			accept:    true,
+			remains:   leftAtom,
+			canSpend:  leftAtom.Sub(smallAtom),
+			periodEnd: twoHours.Add(time.Hour),
+		},
+		"expired": {
+			allowance: types.NewPeriodicFeeAllowance(*types.NewBasicFeeAllowance(atom, &oneHour), time.Hour, smallAtom),
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: twoHours,
+			accept:    false,
+			remove:    true,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ctx := sdk.Context{}.WithBlockHeader(abci.Header{Time: tc.blockTime})
+
+			remove, err := tc.allowance.Accept(ctx, tc.fee, nil)
+			if !tc.accept {
+				require.Error(t, err)
+				require.Equal(t, tc.remove, remove)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, remove)
+			if !remove {
+				require.Equal(t, tc.remains, tc.allowance.Basic.SpendLimit)
+				require.Equal(t, tc.canSpend, tc.allowance.PeriodCanSpend)
+				require.True(t, tc.periodEnd.Equal(tc.allowance.PeriodReset), "%s != %s", tc.periodEnd, tc.allowance.PeriodReset)
+			}
+		})
+	}
+}
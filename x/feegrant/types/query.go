@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = QueryFeeAllowanceResponse{}
+	_ types.UnpackInterfacesMessage = QueryFeeAllowancesResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryFeeAllowanceResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if res.FeeAllowance == nil {
+		return nil
+	}
+	return res.FeeAllowance.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryFeeAllowancesResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range res.FeeAllowances {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -33,7 +33,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetDepositParams(ctx).GetMinDeposit(proposal.IsExpedited),
 				proposal.TotalDeposit,
 			),
 		)
@@ -46,6 +46,36 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// An expedited proposal that doesn't pass falls back to a regular
+		// proposal: its deposits and votes are kept and it is voted on until the
+		// end of the regular voting period of its type.
+		if !passes && proposal.IsExpedited {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			proposal.IsExpedited = false
+			votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.ProposalType(), false)
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: rejected, voting continues until %s",
+					proposal.ProposalID, proposal.GetTitle(), proposal.VotingEndTime,
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
 		} else {
@@ -57,9 +87,14 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
+			// on the proposal content, followed by the messages of the proposal.
+			// If the handler or any message fails, no state mutation is written
+			// and the error message is logged and stored on the proposal.
 			err := handler(cacheCtx, proposal.GetContent())
+			if err == nil {
+				proposal.MsgResults, err = keeper.ExecuteProposalMessages(cacheCtx, proposal)
+			}
+
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -75,6 +110,8 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				writeCache()
 			} else {
 				proposal.Status = types.StatusFailed
+				proposal.MsgResults = nil
+				proposal.FailedReason = err.Error()
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err)
 			}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -171,7 +172,10 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	proposalID := types.GetProposalIDFromBytes(res.Data)
+	var proposalData types.MsgSubmitProposalResponse
+	require.NoError(t, proposalData.Unmarshal(res.Data))
+
+	proposalID := proposalData.ProposalID
 
 	inactiveQueue = app.GovKeeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
 	require.False(t, inactiveQueue.Valid())
@@ -223,7 +227,10 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	proposalID := types.GetProposalIDFromBytes(res.Data)
+	var proposalData types.MsgSubmitProposalResponse
+	require.NoError(t, proposalData.Unmarshal(res.Data))
+
+	proposalID := proposalData.ProposalID
 
 	newHeader := ctx.BlockHeader()
 	newHeader.Time = ctx.BlockHeader().Time.Add(time.Duration(1) * time.Second)
@@ -282,7 +289,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
@@ -299,7 +306,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -334,7 +341,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
@@ -344,7 +351,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -358,3 +365,172 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestEndBlockerProposalMessages(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sendAmount int64
+		expStatus  types.ProposalStatus
+	}{
+		{"messages executed", 1000, types.StatusPassed},
+		{"message execution failed", 5000, types.StatusFailed},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, abci.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+			SortAddresses(addrs)
+
+			handler := gov.NewHandler(app.GovKeeper)
+			stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+			header := abci.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+
+			createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			// fund the governance account so that it can pay out the proposal message
+			govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000))
+			require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], govAddr, funds))
+
+			recipient := sdk.AccAddress([]byte("proposal_recipient__"))
+			sendCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.sendAmount))
+			msgs := []sdk.Msg{banktypes.NewMsgSend(govAddr, recipient, sendCoins)}
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, msgs, false)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+			res, err := handler(ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalID, proposalCoins))
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+
+			if tc.expStatus == types.StatusPassed {
+				require.Len(t, proposal.MsgResults, 1)
+				require.Equal(t, banktypes.TypeMsgSend, proposal.MsgResults[0].MsgType)
+				require.Empty(t, proposal.FailedReason)
+				require.Equal(t, sendCoins, app.BankKeeper.GetAllBalances(ctx, recipient))
+				require.Equal(t, funds.Sub(sendCoins), app.BankKeeper.GetAllBalances(ctx, govAddr))
+			} else {
+				require.Empty(t, proposal.MsgResults)
+				require.NotEmpty(t, proposal.FailedReason)
+				require.True(t, app.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+				require.Equal(t, funds, app.BankKeeper.GetAllBalances(ctx, govAddr))
+			}
+		})
+	}
+}
+
+func TestEndBlockerExpeditedProposal(t *testing.T) {
+	testCases := []struct {
+		name      string
+		valVote   types.VoteOption
+		expStatus types.ProposalStatus
+	}{
+		{"passes as expedited", types.OptionYes, types.StatusPassed},
+		{"falls back to regular", types.OptionNo, types.StatusVotingPeriod},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, abci.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 4, valTokens)
+
+			SortAddresses(addrs)
+
+			handler := gov.NewHandler(app.GovKeeper)
+			stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+			header := abci.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+			createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, govAddr)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, true)
+			require.NoError(t, err)
+
+			// the expedited minimum deposit is deposited in halves
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			halfDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, depositParams.ExpeditedMinDeposit.AmountOf(sdk.DefaultBondDenom).QuoRaw(2)))
+			for _, depositor := range addrs[2:] {
+				res, err := handler(ctx, types.NewMsgDeposit(depositor, proposal.ProposalID, halfDeposit))
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[1], types.NewNonSplitVoteOption(tc.valVote)))
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+
+			if tc.expStatus == types.StatusPassed {
+				require.True(t, proposal.IsExpedited)
+				require.True(t, app.BankKeeper.GetAllBalances(ctx, govAddr).IsEqual(initialModuleAccCoins))
+				return
+			}
+
+			// 6/10 of the votes are Yes, which passes the regular threshold but
+			// not the expedited one: the proposal keeps its deposits and votes
+			// and is voted on until the end of the regular voting period
+			require.False(t, proposal.IsExpedited)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+			require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalID), 2)
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, govAddr).IsEqual(initialModuleAccCoins.Add(depositParams.ExpeditedMinDeposit...)))
+
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, types.StatusPassed, proposal.Status)
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, govAddr).IsEqual(initialModuleAccCoins))
+		})
+	}
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
@@ -21,6 +24,7 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 		proposal.Description, _ = fs.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(proposalType)
 		proposal.Deposit, _ = fs.GetString(FlagDeposit)
+		proposal.Expedited, _ = fs.GetBool(FlagExpedited)
 		return proposal, nil
 	}
 
@@ -29,6 +33,9 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 			return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", flag)
 		}
 	}
+	if fs.Changed(FlagExpedited) {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", FlagExpedited)
+	}
 
 	contents, err := ioutil.ReadFile(proposalFile)
 	if err != nil {
@@ -42,3 +49,21 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseProposalMessages decodes the JSON encoded messages of a proposal file,
+// each given as an Any with its "@type" URL, into sdk.Msgs.
+func parseProposalMessages(clientCtx client.Context, rawMsgs []json.RawMessage) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		var any codectypes.Any
+		if err := clientCtx.JSONMarshaler.UnmarshalJSON(rawMsg, &any); err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+
+		if err := clientCtx.InterfaceRegistry.UnpackAny(&any, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
@@ -1,11 +1,17 @@
 package cli
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -14,7 +20,8 @@ func TestParseSubmitProposalFlags(t *testing.T) {
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "1000test"
+  "deposit": "1000test",
+  "expedited": true
 }
 `)
 	t.Cleanup(cleanup1)
@@ -42,6 +49,7 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "My awesome proposal", proposal1.Description)
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
+	require.True(t, proposal1.Expedited)
 
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
@@ -50,6 +58,9 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 		require.Error(t, err)
 		fs.Set(incompatibleFlag, "")
 	}
+	fs.Set(FlagExpedited, "true")
+	_, err = parseSubmitProposalFlags(fs)
+	require.Error(t, err)
 
 	// no --proposal, only flags
 	fs.Set(FlagProposal, "")
@@ -64,9 +75,51 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, proposal1.Description, proposal2.Description)
 	require.Equal(t, proposal1.Type, proposal2.Type)
 	require.Equal(t, proposal1.Deposit, proposal2.Deposit)
+	require.Equal(t, proposal1.Expedited, proposal2.Expedited)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseProposalMessages(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	clientCtx := client.Context{}.
+		WithJSONMarshaler(codec.NewProtoCodec(registry)).
+		WithInterfaceRegistry(registry)
+
+	from, to := sdk.AccAddress("from________________"), sdk.AccAddress("to__________________")
+	okJSON, cleanup := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "type": "Text",
+  "deposit": "1000test",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.MsgSend",
+      "from_address": "`+from.String()+`",
+      "to_address": "`+to.String()+`",
+      "amount": [{"denom": "test", "amount": "10"}]
+    }
+  ]
+}
+`)
+	t.Cleanup(cleanup)
+
+	fs := NewCmdSubmitProposal().Flags()
+	fs.Set(FlagProposal, okJSON.Name())
+	proposal, err := parseSubmitProposalFlags(fs)
+	require.NoError(t, err)
+	require.Len(t, proposal.Messages, 1)
+
+	msgs, err := parseProposalMessages(clientCtx, proposal.Messages)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("test", 10)))}, msgs)
+
+	// unknown type URL
+	_, err = parseProposalMessages(clientCtx, []json.RawMessage{[]byte(`{"@type": "/cosmos.bank.Unknown"}`)})
+	require.Error(t, err)
+}
@@ -45,6 +45,7 @@ func newPostProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
+		msg.IsExpedited = req.IsExpedited
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
@@ -163,6 +164,7 @@ func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
+		msg.IsExpedited = req.IsExpedited
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
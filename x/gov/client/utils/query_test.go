@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -15,18 +16,37 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+var msgActionRegexp = regexp.MustCompile(`message\.action='(\w+)'`)
+
 type TxSearchMock struct {
 	mock.Client
-	txs []tmtypes.Tx
+	txDecoder sdk.TxDecoder
+	txs       []tmtypes.Tx
 }
 
 func (mock TxSearchMock) TxSearch(query string, prove bool, page, perPage int, orderBy string) (*ctypes.ResultTxSearch, error) {
-	start, end := client.Paginate(len(mock.txs), page, perPage, 100)
+	// only search the txs holding msgs of the queried type
+	var matchingTxs []tmtypes.Tx
+	msgType := msgActionRegexp.FindStringSubmatch(query)[1]
+	for _, tx := range mock.txs {
+		sdkTx, err := mock.txDecoder(tx)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range sdkTx.GetMsgs() {
+			if msg.Type() == msgType {
+				matchingTxs = append(matchingTxs, tx)
+				break
+			}
+		}
+	}
+
+	start, end := client.Paginate(len(matchingTxs), page, perPage, 100)
 	if start < 0 || end < 0 {
 		// nil result with nil error crashes utils.QueryTxsByEvents
 		return &ctypes.ResultTxSearch{}, nil
 	}
-	txs := mock.txs[start:end]
+	txs := matchingTxs[start:end]
 	rst := &ctypes.ResultTxSearch{Txs: make([]*ctypes.ResultTx, len(txs)), TotalCount: len(txs)}
 	for i := range txs {
 		rst.Txs[i] = &ctypes.ResultTx{Tx: txs[i]}
@@ -66,6 +86,13 @@ func TestGetPaginatedVotes(t *testing.T) {
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 	}
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	acc2WeightedMsgs := []sdk.Msg{
+		types.NewMsgVoteWeighted(acc2, 0, weightedOptions),
+	}
 	for _, tc := range []testCase{
 		{
 			description: "1MsgPerTxAll",
@@ -76,8 +103,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs[:1]},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 
 		{
@@ -89,8 +116,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
@@ -101,8 +128,20 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
+		},
+		{
+			description: "WeightedVotes",
+			page:        1,
+			limit:       2,
+			txs: []authtypes.StdTx{
+				{Msgs: acc1Msgs[:1]},
+				{Msgs: acc2WeightedMsgs},
+			},
+			votes: []types.Vote{
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, weightedOptions)},
 		},
 		{
 			description: "IncompleteSearchTx",
@@ -111,7 +150,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "InvalidPage",
@@ -144,7 +183,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 				marshalled[i] = tx
 			}
 
-			cli := TxSearchMock{txs: marshalled}
+			cli := TxSearchMock{txDecoder: authtypes.DefaultTxDecoder(cdc), txs: marshalled}
 			clientCtx := client.Context{}.
 				WithJSONMarshaler(cdc).
 				WithCodec(cdc).
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -18,7 +19,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 
@@ -101,3 +102,44 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 }
+
+func TestExpeditedDeposits(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(100))
+
+	votingParams := types.DefaultVotingParams()
+	votingParams.ProposalVotingPeriods = []types.ProposalVotingPeriod{
+		types.NewProposalVotingPeriod(types.ProposalTypeText, 3*types.DefaultPeriod),
+	}
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+
+	for _, expedited := range []bool{false, true} {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, expedited)
+		require.NoError(t, err)
+		require.Equal(t, expedited, proposal.IsExpedited)
+
+		// the regular minimum deposit only activates regular proposals
+		votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], depositParams.MinDeposit)
+		require.NoError(t, err)
+		require.Equal(t, !expedited, votingStarted)
+
+		if expedited {
+			remaining := depositParams.ExpeditedMinDeposit.Sub(depositParams.MinDeposit)
+			votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], remaining)
+			require.NoError(t, err)
+			require.True(t, votingStarted)
+		}
+
+		// the voting period depends on the proposal type unless the proposal is expedited
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+		expVotingPeriod := 3 * types.DefaultPeriod
+		if expedited {
+			expVotingPeriod = votingParams.ExpeditedVotingPeriod
+		}
+		require.Equal(t, proposal.VotingStartTime.Add(expVotingPeriod), proposal.VotingEndTime)
+	}
+}
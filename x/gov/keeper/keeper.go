@@ -31,6 +31,9 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Message router executing the messages of passed proposals
+	msgRouter sdk.Router
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -39,10 +42,14 @@ type Keeper struct {
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
 //
+// The message router executes the messages of passed proposals, and is usually
+// the BaseApp's router.
+//
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.Marshaler, key sdk.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	msgRouter sdk.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -63,6 +70,7 @@ func NewKeeper(
 		sk:         sk,
 		cdc:        cdc,
 		router:     rtr,
+		msgRouter:  msgRouter,
 	}
 }
 
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Migrator is a struct for handling in-place store migrations of the gov
+// module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the gov store from consensus version 1 to 2. Version 1
+// stores a single option on each vote, which version 2 replaces with weighted
+// options, so the option of every stored vote is moved to a single option of
+// weight 1.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var votes types.Votes
+	m.keeper.IterateAllVotes(ctx, func(vote types.Vote) bool {
+		if len(vote.Options) == 0 {
+			votes = append(votes, vote)
+		}
+		return false
+	})
+
+	for _, vote := range votes {
+		vote.Options = types.NewNonSplitVoteOption(vote.Option)
+		vote.Option = types.OptionEmpty
+		m.keeper.SetVote(ctx, vote)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the gov store from consensus version 2 to 3. Version 3
+// adds the params of expedited proposals, which are set relative to the
+// existing params: the expedited minimum deposit is the default multiple of the
+// minimum deposit, the expedited voting period the default one if shorter than
+// the voting period and half of it otherwise, and the expedited threshold the
+// default one if greater than the threshold and halfway between the threshold
+// and one otherwise. The per proposal type params are left empty.
+//
+// An error is returned, and no params are set, if the migrated params are
+// invalid. In particular, no expedited threshold can be greater than a
+// threshold of one, which must be lowered before migrating.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	depositParams := m.keeper.GetDepositParams(ctx)
+	multiplier := types.DefaultExpeditedMinDepositTokens.Quo(types.DefaultMinDepositTokens)
+	depositParams.ExpeditedMinDeposit = make(sdk.Coins, len(depositParams.MinDeposit))
+	for i, coin := range depositParams.MinDeposit {
+		depositParams.ExpeditedMinDeposit[i] = sdk.NewCoin(coin.Denom, coin.Amount.Mul(multiplier))
+	}
+
+	votingParams := m.keeper.GetVotingParams(ctx)
+	votingParams.ExpeditedVotingPeriod = types.DefaultExpeditedPeriod
+	if votingParams.ExpeditedVotingPeriod >= votingParams.VotingPeriod {
+		votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod / 2
+	}
+
+	tallyParams := m.keeper.GetTallyParams(ctx)
+	if tallyParams.Threshold.GTE(sdk.OneDec()) {
+		return fmt.Errorf("no expedited threshold can be greater than the threshold %s", tallyParams.Threshold)
+	}
+
+	tallyParams.ExpeditedThreshold = types.DefaultExpeditedThreshold
+	if !tallyParams.ExpeditedThreshold.GT(tallyParams.Threshold) {
+		tallyParams.ExpeditedThreshold = tallyParams.Threshold.Add(sdk.OneDec()).QuoInt64(2)
+	}
+
+	if err := depositParams.Validate(); err != nil {
+		return fmt.Errorf("invalid migrated deposit params: %w", err)
+	}
+	if err := votingParams.Validate(); err != nil {
+		return fmt.Errorf("invalid migrated voting params: %w", err)
+	}
+	if err := tallyParams.Validate(); err != nil {
+		return fmt.Errorf("invalid migrated tally params: %w", err)
+	}
+
+	m.keeper.SetDepositParams(ctx, depositParams)
+	m.keeper.SetVotingParams(ctx, votingParams)
+	m.keeper.SetTallyParams(ctx, tallyParams)
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	// store a vote with the single option of version 1
+	legacyVote := types.Vote{ProposalID: 1, Voter: addrs[0], Option: types.OptionNoWithVeto}
+	app.GovKeeper.SetVote(ctx, legacyVote)
+
+	weightedVote := types.NewVote(1, addrs[1], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	})
+	app.GovKeeper.SetVote(ctx, weightedVote)
+
+	migrator := keeper.NewMigrator(app.GovKeeper)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+
+	vote, found := app.GovKeeper.GetVote(ctx, 1, addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewVote(1, addrs[0], types.NewNonSplitVoteOption(types.OptionNoWithVeto)), vote)
+
+	// votes already holding weighted options are left untouched
+	vote, found = app.GovKeeper.GetVote(ctx, 1, addrs[1])
+	require.True(t, found)
+	require.Equal(t, weightedVote, vote)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	testCases := []struct {
+		name                     string
+		votingPeriod             time.Duration
+		threshold                sdk.Dec
+		expExpeditedVotingPeriod time.Duration
+		expExpeditedThreshold    sdk.Dec
+	}{
+		{"default expedited params", types.DefaultPeriod, types.DefaultThreshold, types.DefaultExpeditedPeriod, types.DefaultExpeditedThreshold},
+		{"short voting period and high threshold", time.Hour, sdk.NewDecWithPrec(8, 1), 30 * time.Minute, sdk.NewDecWithPrec(9, 1)},
+	}
+
+	for _, tc := range testCases {
+		// store the params of version 2, which have no expedited params
+		minDeposit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 10))
+		app.GovKeeper.SetDepositParams(ctx, types.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: types.DefaultPeriod})
+		app.GovKeeper.SetVotingParams(ctx, types.VotingParams{VotingPeriod: tc.votingPeriod})
+		app.GovKeeper.SetTallyParams(ctx, types.TallyParams{Quorum: types.DefaultQuorum, Threshold: tc.threshold, Veto: types.DefaultVeto})
+
+		migrator := keeper.NewMigrator(app.GovKeeper)
+		require.NoError(t, migrator.Migrate2to3(ctx), tc.name)
+
+		expExpeditedMinDeposit := sdk.NewCoins(sdk.NewInt64Coin("atom", 500), sdk.NewInt64Coin("stake", 50))
+		require.Equal(t, expExpeditedMinDeposit, app.GovKeeper.GetDepositParams(ctx).ExpeditedMinDeposit, tc.name)
+		require.Equal(t, tc.expExpeditedVotingPeriod, app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod, tc.name)
+		require.Equal(t, tc.expExpeditedThreshold, app.GovKeeper.GetTallyParams(ctx).ExpeditedThreshold, tc.name)
+
+		// the migrated params are valid
+		genState := types.NewGenesisState(
+			1, app.GovKeeper.GetDepositParams(ctx), app.GovKeeper.GetVotingParams(ctx), app.GovKeeper.GetTallyParams(ctx),
+		)
+		require.NoError(t, types.ValidateGenesis(genState), tc.name)
+	}
+
+	// the expedited threshold of a threshold just below one is still greater
+	app.GovKeeper.SetVotingParams(ctx, types.VotingParams{VotingPeriod: types.DefaultPeriod})
+	app.GovKeeper.SetTallyParams(ctx, types.TallyParams{Quorum: types.DefaultQuorum, Threshold: sdk.NewDecWithPrec(999999, 6), Veto: types.DefaultVeto})
+	require.NoError(t, keeper.NewMigrator(app.GovKeeper).Migrate2to3(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(9999995, 7), app.GovKeeper.GetTallyParams(ctx).ExpeditedThreshold)
+	require.NoError(t, app.GovKeeper.GetTallyParams(ctx).Validate())
+
+	// no expedited threshold is greater than a threshold of one, so the
+	// migration fails without setting any params
+	tallyParams := types.TallyParams{Quorum: types.DefaultQuorum, Threshold: sdk.OneDec(), Veto: types.DefaultVeto}
+	votingParams := types.VotingParams{VotingPeriod: types.DefaultPeriod}
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+	require.Error(t, keeper.NewMigrator(app.GovKeeper).Migrate2to3(ctx))
+	require.Equal(t, votingParams, app.GovKeeper.GetVotingParams(ctx))
+	require.Equal(t, tallyParams, app.GovKeeper.GetTallyParams(ctx))
+
+	// nor are invalid migrated params of a voting period too short to halve
+	app.GovKeeper.SetVotingParams(ctx, types.VotingParams{VotingPeriod: time.Nanosecond})
+	app.GovKeeper.SetTallyParams(ctx, types.DefaultTallyParams())
+	require.Error(t, keeper.NewMigrator(app.GovKeeper).Migrate2to3(ctx))
+	require.Equal(t, time.Duration(0), app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod)
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content and the messages executed
+// by the gov module account if the proposal passes. Expedited proposals need a
+// larger deposit and are voted on for a shorter period with a higher threshold.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content, messages []sdk.Msg, expedited bool) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
@@ -24,6 +26,20 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
 	}
 
+	// The messages are only executed once the proposal passes, so they are
+	// checked to be signed by the gov module account and to be routable.
+	govAddr := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+	for i, msg := range messages {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidSigner, "message index: %d", i)
+		}
+
+		if keeper.msgRouter.Route(ctx, msg.Route()) == nil {
+			return types.Proposal{}, sdkerrors.Wrapf(types.ErrUnroutableProposalMsg, "%s; message index: %d", msg.Route(), i)
+		}
+	}
+
 	proposalID, err := keeper.GetProposalID(ctx)
 	if err != nil {
 		return types.Proposal{}, err
@@ -32,10 +48,11 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal, err := types.NewProposal(content, messages, proposalID, submitTime, submitTime.Add(depositPeriod))
 	if err != nil {
 		return types.Proposal{}, err
 	}
+	proposal.IsExpedited = expedited
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -51,6 +68,38 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// ExecuteProposalMessages executes the messages of a passed proposal through
+// the message router on behalf of the gov module account, and returns the
+// results of the messages. The events emitted by the messages are emitted on
+// the given context.
+func (keeper Keeper) ExecuteProposalMessages(ctx sdk.Context, proposal types.Proposal) ([]*sdk.MsgData, error) {
+	msgs, err := proposal.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*sdk.MsgData, len(msgs))
+	for i, msg := range msgs {
+		handler := keeper.msgRouter.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(types.ErrUnroutableProposalMsg, "%s; message index: %d", msg.Route(), i)
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		for _, event := range res.Events {
+			ctx.EventManager().EmitEvent(sdk.Event(event))
+		}
+
+		results[i] = &sdk.MsgData{MsgType: msg.Type(), Data: res.Data}
+	}
+
+	return results, nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -182,7 +231,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.ProposalType(), proposal.IsExpedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -10,7 +10,9 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -19,7 +21,7 @@ func TestGetSetProposal(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	app.GovKeeper.SetProposal(ctx, proposal)
@@ -34,7 +36,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
@@ -77,11 +79,70 @@ func TestSubmitProposal(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content)
+		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content, nil, false)
 		require.True(t, errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func TestSubmitProposalMessages(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10000000))
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{"no messages", nil, nil},
+		{"valid message", []sdk.Msg{banktypes.NewMsgSend(govAddr, addrs[0], coins)}, nil},
+		{"signer is not gov account", []sdk.Msg{banktypes.NewMsgSend(addrs[0], govAddr, coins)}, types.ErrInvalidSigner},
+		{"multiple signers", []sdk.Msg{testdata.NewTestMsg(govAddr, addrs[0])}, types.ErrInvalidSigner},
+		{"unroutable message", []sdk.Msg{testdata.NewTestMsg(govAddr)}, types.ErrUnroutableProposalMsg},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, tc.msgs, false)
+		if tc.expectedErr != nil {
+			require.True(t, errors.Is(err, tc.expectedErr), "%s; got: %v, expected: %v", tc.name, err, tc.expectedErr)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		msgs, err := proposal.GetMessages()
+		require.NoError(t, err, tc.name)
+		require.Equal(t, len(tc.msgs), len(msgs), tc.name)
+	}
+}
+
+func TestExecuteProposalMessages(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000000))
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[0], govAddr, funds))
+
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(govAddr, addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))),
+		banktypes.NewMsgSend(govAddr, addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))),
+	}
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, msgs, false)
+	require.NoError(t, err)
+
+	results, err := app.GovKeeper.ExecuteProposalMessages(ctx, proposal)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, govAddr).IsZero())
+
+	// the gov account is now empty so executing the messages again fails
+	_, err = app.GovKeeper.ExecuteProposalMessages(ctx, proposal)
+	require.Error(t, err)
+}
+
 func TestGetProposalsFiltered(t *testing.T) {
 	proposalID := uint64(1)
 	app := simapp.Setup(false)
@@ -93,14 +154,14 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now())
+			p, err := types.NewProposal(TestProposal, nil, proposalID, time.Now(), time.Now())
 			require.NoError(t, err)
 
 			p.Status = s
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				app.GovKeeper.SetDeposit(ctx, d)
 				app.GovKeeper.SetVote(ctx, v)
 			}
@@ -47,6 +47,9 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec1, govGenesis.TallyParams.Quorum)
 	require.Equal(t, dec2, govGenesis.TallyParams.Threshold)
 	require.Equal(t, dec3, govGenesis.TallyParams.Veto)
+	require.Equal(t, "1810stake", govGenesis.DepositParams.ExpeditedMinDeposit.String())
+	require.Equal(t, "38h12m11.838212036s", govGenesis.VotingParams.ExpeditedVotingPeriod.String())
+	require.Equal(t, "0.770000000000000000", govGenesis.TallyParams.ExpeditedThreshold.String())
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalID)
 	require.Equal(t, types.Deposits(nil), govGenesis.Deposits)
 	require.Equal(t, types.Votes(nil), govGenesis.Votes)
@@ -18,4 +18,6 @@ const (
 	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
 	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
 	AttributeKeyProposalType       = "proposal_type"
+
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't pass as expedited, voted on as regular proposal
 )
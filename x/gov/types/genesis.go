@@ -72,6 +72,22 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if err := validateDepositParams(data.DepositParams); err != nil {
+		return err
+	}
+	if err := validateVotingParams(data.VotingParams); err != nil {
+		return err
+	}
+	if err := validateTallyParams(data.TallyParams); err != nil {
+		return err
+	}
+
+	for _, vote := range data.Votes {
+		if err := vote.Options.Validate(); err != nil {
+			return fmt.Errorf("invalid vote of %s on proposal %d: %w", vote.Voter, vote.ProposalID, err)
+		}
+	}
+
 	return nil
 }
 
@@ -115,6 +115,11 @@ type MsgSubmitProposal struct {
 	Content        *types.Any                                    `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit" yaml:"initial_deposit"`
 	Proposer       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	// messages are the messages executed by the gov module account if the
+	// proposal passes.
+	Messages []*types.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	// is_expedited submits the proposal as an expedited proposal.
+	IsExpedited bool `protobuf:"varint,5,opt,name=is_expedited,json=isExpedited,proto3" json:"is_expedited,omitempty" yaml:"is_expedited"`
 }
 
 func (m *MsgSubmitProposal) Reset()      { *m = MsgSubmitProposal{} }
@@ -188,6 +193,46 @@ func (m *MsgVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVote proto.InternalMessageInfo
 
+// MsgVoteWeighted defines a message to cast a vote split across several
+// weighted options
+type MsgVoteWeighted struct {
+	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,3,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{2}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal
 type MsgDeposit struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -198,7 +243,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{2}
+	return fileDescriptor_67fb57f9a603bed5, []int{3}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +272,44 @@ func (m *MsgDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeposit proto.InternalMessageInfo
 
+// WeightedVoteOption defines a unit of vote for vote split
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{4}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval
 type TextProposal struct {
@@ -237,7 +320,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{3}
+	return fileDescriptor_67fb57f9a603bed5, []int{5}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +359,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{4}
+	return fileDescriptor_67fb57f9a603bed5, []int{6}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,12 +399,22 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// messages are the messages executed by the gov module account if the
+	// proposal passes.
+	Messages []*types.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	// msg_results are the results of the executed messages of a passed proposal.
+	MsgResults []*types1.MsgData `protobuf:"bytes,11,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty" yaml:"msg_results"`
+	// failed_reason is the execution error of a proposal that passed but failed.
+	FailedReason string `protobuf:"bytes,12,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty" yaml:"failed_reason"`
+	// is_expedited is true while the proposal is voted on as an expedited
+	// proposal, and reset if it falls back to a regular proposal.
+	IsExpedited bool `protobuf:"varint,13,opt,name=is_expedited,json=isExpedited,proto3" json:"is_expedited,omitempty" yaml:"is_expedited"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{5}
+	return fileDescriptor_67fb57f9a603bed5, []int{7}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +454,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{6}
+	return fileDescriptor_67fb57f9a603bed5, []int{8}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,17 +484,21 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
 // Vote defines a vote on a governance proposal. A vote corresponds to a proposal
-// ID, the voter, and the vote option.
+// ID, the voter, and the weighted vote options.
 type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
-	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.VoteOption" json:"option,omitempty"`
+	// Deprecated: prefer to use `options` instead. This field is only kept to
+	// decode votes stored before weighted votes were introduced and is cleared
+	// by the store migration.
+	Option  VoteOption          `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.VoteOption" json:"option,omitempty"`
+	Options WeightedVoteOptions `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{7}
+	return fileDescriptor_67fb57f9a603bed5, []int{9}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,12 +533,14 @@ type DepositParams struct {
 	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit,omitempty" yaml:"min_deposit"`
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expedited_min_deposit,omitempty" yaml:"expedited_min_deposit"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{8}
+	return fileDescriptor_67fb57f9a603bed5, []int{10}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,12 +573,16 @@ var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty" yaml:"voting_period"`
+	//  Length of the voting period of expedited proposals.
+	ExpeditedVotingPeriod time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period"`
+	//  Voting periods overriding voting_period for the proposals of a content type.
+	ProposalVotingPeriods []ProposalVotingPeriod `protobuf:"bytes,3,rep,name=proposal_voting_periods,json=proposalVotingPeriods,proto3" json:"proposal_voting_periods,omitempty" yaml:"proposal_voting_periods"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{9}
+	return fileDescriptor_67fb57f9a603bed5, []int{11}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,6 +611,46 @@ func (m *VotingParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VotingParams proto.InternalMessageInfo
 
+// ProposalVotingPeriod defines the voting period of the proposals of a content type
+type ProposalVotingPeriod struct {
+	//  Proposal type of the content, as returned by Content.ProposalType.
+	ProposalType string `protobuf:"bytes,1,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty" yaml:"proposal_type"`
+	//  Length of the voting period.
+	VotingPeriod time.Duration `protobuf:"bytes,2,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period" yaml:"voting_period"`
+}
+
+func (m *ProposalVotingPeriod) Reset()      { *m = ProposalVotingPeriod{} }
+func (*ProposalVotingPeriod) ProtoMessage() {}
+func (*ProposalVotingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{12}
+}
+func (m *ProposalVotingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalVotingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalVotingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalVotingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalVotingPeriod.Merge(m, src)
+}
+func (m *ProposalVotingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalVotingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalVotingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalVotingPeriod proto.InternalMessageInfo
+
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
 	//  Minimum percentage of total stake needed to vote for a result to be considered valid.
@@ -516,12 +659,16 @@ type TallyParams struct {
 	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3.
 	Veto github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667.
+	ExpeditedThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_threshold,omitempty" yaml:"expedited_threshold"`
+	//  Quorums and thresholds overriding quorum and threshold for the proposals of a content type.
+	ProposalTallyParams []ProposalTallyParams `protobuf:"bytes,5,rep,name=proposal_tally_params,json=proposalTallyParams,proto3" json:"proposal_tally_params,omitempty" yaml:"proposal_tally_params"`
 }
 
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{10}
+	return fileDescriptor_67fb57f9a603bed5, []int{13}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,12 +697,56 @@ func (m *TallyParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 
+// ProposalTallyParams defines the quorum and threshold of the proposals of a content type
+type ProposalTallyParams struct {
+	//  Proposal type of the content, as returned by Content.ProposalType.
+	ProposalType string `protobuf:"bytes,1,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty" yaml:"proposal_type"`
+	//  Minimum percentage of total stake needed to vote for a result to be considered valid.
+	Quorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold"`
+}
+
+func (m *ProposalTallyParams) Reset()      { *m = ProposalTallyParams{} }
+func (*ProposalTallyParams) ProtoMessage() {}
+func (*ProposalTallyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{14}
+}
+func (m *ProposalTallyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTallyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTallyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTallyParams.Merge(m, src)
+}
+func (m *ProposalTallyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTallyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTallyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTallyParams proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.MsgSubmitProposal")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.gov.MsgVote")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos.gov.MsgVoteWeighted")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos.gov.MsgDeposit")
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.Proposal")
@@ -563,105 +754,136 @@ func init() {
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.VotingParams")
+	proto.RegisterType((*ProposalVotingPeriod)(nil), "cosmos.gov.ProposalVotingPeriod")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.TallyParams")
+	proto.RegisterType((*ProposalTallyParams)(nil), "cosmos.gov.ProposalTallyParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/gov.proto", fileDescriptor_67fb57f9a603bed5) }
 
 var fileDescriptor_67fb57f9a603bed5 = []byte{
-	// 1468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6b, 0x1b, 0xd7,
-	0x16, 0xd6, 0xc8, 0xbf, 0x8f, 0x64, 0x7b, 0x72, 0xed, 0x67, 0x2b, 0xf3, 0xde, 0x9b, 0x51, 0xf4,
-	0xc2, 0xc3, 0x84, 0x44, 0xce, 0x73, 0x56, 0x2f, 0x81, 0x47, 0x24, 0x6b, 0x92, 0x28, 0xc4, 0x92,
-	0x18, 0x4d, 0x14, 0x92, 0xb7, 0x18, 0xc6, 0xd2, 0x44, 0x9e, 0x56, 0x33, 0x57, 0xd5, 0x5c, 0xb9,
-	0x36, 0xd9, 0xb4, 0xbb, 0xa2, 0x42, 0x48, 0x77, 0xdd, 0x08, 0x0a, 0xcd, 0xa2, 0xed, 0xaa, 0x85,
-	0xfe, 0x11, 0xa6, 0x74, 0x11, 0x4a, 0x17, 0xa1, 0x0b, 0xa5, 0x71, 0xa0, 0x14, 0x2f, 0xba, 0xc8,
-	0xb2, 0x9b, 0x96, 0xb9, 0xf7, 0x8e, 0x35, 0x92, 0x4d, 0x1d, 0xf5, 0x07, 0x85, 0x2e, 0x02, 0xd2,
-	0xb9, 0xe7, 0xfb, 0xee, 0x39, 0x9f, 0xce, 0x77, 0x66, 0x62, 0x58, 0xac, 0x62, 0xcf, 0xc1, 0xde,
-	0x6a, 0x1d, 0x6f, 0xfb, 0xff, 0xd2, 0xcd, 0x16, 0x26, 0x18, 0x01, 0x8b, 0xa6, 0xeb, 0x78, 0x5b,
-	0x5a, 0xe0, 0x19, 0x3c, 0x44, 0x13, 0xa4, 0xc5, 0x3a, 0xae, 0x63, 0xfa, 0x71, 0xd5, 0xff, 0xc4,
-	0xa3, 0xa7, 0x59, 0x8e, 0xc1, 0x0e, 0x06, 0x00, 0x4a, 0x1d, 0xe3, 0x7a, 0xc3, 0x5a, 0xa5, 0xdf,
-	0x36, 0xdb, 0xf7, 0x57, 0x89, 0xed, 0x58, 0x1e, 0x31, 0x9d, 0x66, 0x80, 0x1d, 0x4e, 0x30, 0xdd,
-	0x5d, 0x7e, 0x24, 0x0f, 0x1f, 0xd5, 0xda, 0x2d, 0x93, 0xd8, 0xd8, 0x65, 0xe7, 0xa9, 0x8f, 0xa3,
-	0x70, 0x6a, 0xc3, 0xab, 0x97, 0xdb, 0x9b, 0x8e, 0x4d, 0x4a, 0x2d, 0xdc, 0xc4, 0x9e, 0xd9, 0x40,
-	0x57, 0x60, 0xaa, 0x8a, 0x5d, 0x62, 0xb9, 0x24, 0x21, 0x24, 0x85, 0x95, 0xd8, 0xda, 0x62, 0x9a,
-	0xf1, 0xa4, 0x03, 0x9e, 0x74, 0xc6, 0xdd, 0xcd, 0xc6, 0xbe, 0xf8, 0xfc, 0xc2, 0xd4, 0x3a, 0x4b,
-	0xd4, 0x02, 0x04, 0x7a, 0x5b, 0x80, 0x79, 0xdb, 0xb5, 0x89, 0x6d, 0x36, 0x8c, 0x9a, 0xd5, 0xc4,
-	0x9e, 0x4d, 0x12, 0xd1, 0xe4, 0xd8, 0x4a, 0x6c, 0x2d, 0x9e, 0xe6, 0x7d, 0xad, 0x63, 0xdb, 0xcd,
-	0xde, 0xdc, 0xeb, 0x29, 0x91, 0x97, 0x3d, 0x65, 0x69, 0xd7, 0x74, 0x1a, 0x97, 0x53, 0x43, 0x90,
-	0xd4, 0x27, 0xcf, 0x94, 0x95, 0xba, 0x4d, 0xb6, 0xda, 0x9b, 0xe9, 0x2a, 0x76, 0x56, 0x07, 0x94,
-	0xbc, 0xe0, 0xd5, 0x5e, 0x5f, 0x25, 0xbb, 0x4d, 0x8b, 0x51, 0x79, 0xda, 0x1c, 0x47, 0xe7, 0x18,
-	0x18, 0x6d, 0xc0, 0x74, 0x93, 0x36, 0x63, 0xb5, 0x12, 0x63, 0x49, 0x61, 0x25, 0x9e, 0xfd, 0xcf,
-	0x8f, 0x3d, 0xe5, 0xc2, 0x2b, 0xf0, 0x65, 0xaa, 0xd5, 0x4c, 0xad, 0xd6, 0xb2, 0x3c, 0x4f, 0x3b,
-	0xa4, 0xb8, 0x3c, 0xfe, 0xfd, 0x07, 0x8a, 0x90, 0xea, 0x09, 0x30, 0xb5, 0xe1, 0xd5, 0x2b, 0x98,
-	0x58, 0x48, 0x87, 0x58, 0x93, 0xab, 0x65, 0xd8, 0x35, 0xaa, 0xd2, 0x78, 0xf6, 0xd2, 0x7e, 0x4f,
-	0x81, 0x40, 0xc4, 0x7c, 0xee, 0xa0, 0xa7, 0x84, 0x93, 0x5e, 0xf6, 0x14, 0xc4, 0x5a, 0x0d, 0x05,
-	0x53, 0x1a, 0x04, 0xdf, 0xf2, 0x35, 0x74, 0x1d, 0x26, 0xb6, 0x31, 0xb1, 0x5a, 0x89, 0xe8, 0xaf,
-	0xad, 0x99, 0xe1, 0x51, 0x1a, 0x26, 0x71, 0xd3, 0xff, 0x99, 0x69, 0xf7, 0x73, 0x6b, 0x4b, 0xe9,
-	0xfe, 0x54, 0xa6, 0xfd, 0x06, 0x8a, 0xf4, 0x54, 0xe3, 0x59, 0xbc, 0xc1, 0xf7, 0xa2, 0x00, 0x1b,
-	0x5e, 0x3d, 0x10, 0xf1, 0x8f, 0xe9, 0xb1, 0x08, 0x33, 0xfc, 0x27, 0xc6, 0xbf, 0xa1, 0xcf, 0x3e,
-	0x07, 0xaa, 0xc0, 0xa4, 0xe9, 0xe0, 0xb6, 0x4b, 0x12, 0x63, 0xc7, 0x4c, 0xd9, 0x45, 0x7f, 0xca,
-	0x46, 0x9a, 0x25, 0xce, 0xc6, 0x35, 0xb9, 0x03, 0x71, 0xdd, 0xda, 0xe9, 0x5b, 0x63, 0x11, 0x26,
-	0x88, 0x4d, 0x1a, 0x16, 0x95, 0x63, 0x46, 0x63, 0x5f, 0x50, 0x12, 0x62, 0x35, 0xcb, 0xab, 0xb6,
-	0x6c, 0x26, 0x7a, 0x94, 0x9e, 0x85, 0x43, 0x97, 0xe7, 0x7d, 0xb6, 0xaf, 0xfa, 0x7e, 0x49, 0xfd,
-	0x24, 0xc0, 0x54, 0xa0, 0xb4, 0x7a, 0x9c, 0xd2, 0x67, 0x07, 0x95, 0xfe, 0xeb, 0x49, 0xfb, 0xdd,
-	0x24, 0x4c, 0x1f, 0xea, 0x9a, 0x3d, 0x4e, 0x82, 0x33, 0x47, 0x86, 0x2d, 0x4a, 0x67, 0x6c, 0x86,
-	0xaf, 0x8c, 0xa1, 0xfe, 0x43, 0x6b, 0x2b, 0x3a, 0xf2, 0xda, 0x2a, 0xc0, 0xa4, 0x47, 0x4c, 0xd2,
-	0xf6, 0xb8, 0x65, 0xa4, 0xb0, 0x65, 0x82, 0x1a, 0xca, 0x34, 0x23, 0x2b, 0xf5, 0xd7, 0xd6, 0x61,
-	0xd1, 0x0c, 0x9c, 0xd2, 0x38, 0x0b, 0xda, 0x02, 0x74, 0xdf, 0x76, 0xcd, 0x86, 0x41, 0xcc, 0x46,
-	0x63, 0xd7, 0x68, 0x59, 0x5e, 0xbb, 0x41, 0x12, 0xe3, 0xb4, 0xae, 0xe5, 0x30, 0xb7, 0xee, 0x9f,
-	0x6b, 0xf4, 0x38, 0x7b, 0x86, 0xef, 0xc4, 0xd3, 0x8c, 0xfc, 0x28, 0x41, 0x4a, 0x13, 0x69, 0x30,
-	0x04, 0x42, 0xff, 0x87, 0x98, 0x47, 0xf7, 0xb7, 0xe1, 0x3f, 0x18, 0x12, 0x13, 0xf4, 0x0a, 0xe9,
-	0x48, 0xeb, 0x7a, 0xf0, 0xd4, 0xc8, 0xca, 0xfc, 0x16, 0x3e, 0x4f, 0x21, 0x70, 0xea, 0xd1, 0x33,
-	0x45, 0xd0, 0x80, 0x45, 0x7c, 0x00, 0xb2, 0x41, 0xe4, 0xf3, 0x60, 0x58, 0x6e, 0x8d, 0xdd, 0x30,
-	0x79, 0xe2, 0x0d, 0xff, 0xe2, 0x37, 0x2c, 0xb3, 0x1b, 0x86, 0x19, 0xd8, 0x35, 0x73, 0x3c, 0xac,
-	0xba, 0x35, 0x7a, 0xd5, 0x03, 0x98, 0x25, 0x98, 0x84, 0x9e, 0x1a, 0x53, 0xc7, 0x0c, 0xdd, 0x0d,
-	0xce, 0xbc, 0xc8, 0x98, 0x07, 0x00, 0xa3, 0x3d, 0x33, 0xe2, 0x14, 0x1b, 0x58, 0xb0, 0x01, 0xa7,
-	0xb6, 0x31, 0xb1, 0xdd, 0xba, 0xff, 0x43, 0xb6, 0xb8, 0x94, 0xd3, 0x27, 0x36, 0x7a, 0x96, 0x97,
-	0x93, 0x60, 0xe5, 0x1c, 0xa1, 0x60, 0x9d, 0xce, 0xb3, 0x78, 0xd9, 0x0f, 0xd3, 0x56, 0xef, 0x03,
-	0x0f, 0xf5, 0x45, 0x9d, 0x39, 0xf1, 0xae, 0xd4, 0xe0, 0x03, 0x73, 0x88, 0x80, 0xdd, 0x34, 0xcb,
-	0xa2, 0x5c, 0x52, 0x6e, 0xb4, 0xbd, 0x28, 0xc4, 0xc2, 0x03, 0x73, 0x15, 0xc6, 0x76, 0x2d, 0x8f,
-	0x6d, 0xb0, 0x6c, 0xda, 0x67, 0xfd, 0xa6, 0xa7, 0xfc, 0xfb, 0x15, 0x84, 0xcb, 0xbb, 0x44, 0xf3,
-	0xa1, 0xe8, 0x06, 0x4c, 0x99, 0x9b, 0x1e, 0x31, 0x6d, 0xbe, 0xeb, 0x46, 0x66, 0x09, 0xe0, 0xe8,
-	0x7f, 0x10, 0x75, 0x31, 0xb5, 0xdc, 0xe8, 0x24, 0x51, 0x17, 0xa3, 0x3a, 0xc4, 0x5d, 0x6c, 0xbc,
-	0x69, 0x93, 0x2d, 0x63, 0xdb, 0x22, 0x98, 0x1a, 0x6c, 0x26, 0xab, 0x8e, 0xc6, 0xf4, 0xb2, 0xa7,
-	0x2c, 0x30, 0x51, 0xc3, 0x5c, 0x29, 0x0d, 0x5c, 0x7c, 0xc7, 0x26, 0x5b, 0x15, 0x8b, 0x60, 0x2e,
-	0xe5, 0x97, 0x02, 0x8c, 0xd3, 0x17, 0x80, 0xdf, 0x69, 0x65, 0xff, 0xc9, 0x4f, 0xfc, 0xcf, 0xa2,
-	0x30, 0xcb, 0x1d, 0x50, 0x32, 0x5b, 0xa6, 0xe3, 0xa1, 0x87, 0x02, 0xc4, 0x1c, 0xdb, 0x3d, 0xf4,
-	0xa0, 0x70, 0x8c, 0x07, 0x0d, 0x5f, 0xdd, 0x83, 0x9e, 0xf2, 0xb7, 0x50, 0xe2, 0x79, 0xec, 0xd8,
-	0xc4, 0x72, 0x9a, 0x64, 0xb7, 0xdf, 0x75, 0xe8, 0x78, 0x34, 0x6b, 0x82, 0x63, 0xbb, 0x81, 0x31,
-	0x1f, 0x0a, 0x80, 0x1c, 0x73, 0x27, 0x20, 0x32, 0x9a, 0x56, 0xcb, 0xc6, 0x35, 0xbe, 0xe0, 0x4f,
-	0x1f, 0xb1, 0x4b, 0x8e, 0xbf, 0xdf, 0xb2, 0x11, 0x38, 0xe8, 0x29, 0xff, 0x38, 0x0a, 0x1e, 0xa8,
-	0x95, 0xaf, 0xda, 0xa3, 0x59, 0xa9, 0xf7, 0x7d, 0x43, 0x89, 0x8e, 0xb9, 0x13, 0x28, 0xc4, 0xc2,
-	0xef, 0x0a, 0x10, 0xaf, 0x50, 0x97, 0x71, 0xc9, 0x1e, 0x00, 0x77, 0x5d, 0x50, 0x9b, 0x70, 0x52,
-	0x6d, 0x57, 0x78, 0x6d, 0xcb, 0x03, 0xb8, 0x81, 0xb2, 0x16, 0x07, 0x4c, 0x1e, 0xae, 0x28, 0xce,
-	0x62, 0xbc, 0x9a, 0xc7, 0x81, 0xb7, 0x79, 0x31, 0xf7, 0x60, 0xf2, 0x8d, 0x36, 0x6e, 0xb5, 0x1d,
-	0x5a, 0x45, 0x3c, 0x9b, 0x1d, 0xc1, 0x09, 0x39, 0xab, 0x7a, 0xd0, 0x53, 0x44, 0x86, 0xef, 0x57,
-	0xa3, 0x71, 0x46, 0x54, 0x85, 0x19, 0xb2, 0xd5, 0xb2, 0xbc, 0x2d, 0xdc, 0xa8, 0xf1, 0x81, 0x55,
-	0x47, 0xa6, 0x5f, 0x38, 0xa4, 0x08, 0xdd, 0xd0, 0xe7, 0x45, 0x3a, 0x8c, 0x53, 0x23, 0xb3, 0xd7,
-	0xf6, 0xab, 0x23, 0xf3, 0xcf, 0xf9, 0xe8, 0x10, 0x35, 0x65, 0x3b, 0xf7, 0x83, 0x00, 0xd0, 0x77,
-	0x01, 0x3a, 0x0f, 0xcb, 0x95, 0xa2, 0xae, 0x1a, 0xc5, 0x92, 0x9e, 0x2f, 0x16, 0x8c, 0xdb, 0x85,
-	0x72, 0x49, 0x5d, 0xcf, 0x5f, 0xcb, 0xab, 0x39, 0x31, 0x22, 0xcd, 0x77, 0xba, 0xc9, 0x18, 0x4b,
-	0x54, 0x7d, 0x0a, 0x94, 0x82, 0xf9, 0x70, 0xf6, 0x5d, 0xb5, 0x2c, 0x0a, 0xd2, 0x6c, 0xa7, 0x9b,
-	0x9c, 0x61, 0x59, 0x77, 0x2d, 0x0f, 0x9d, 0x83, 0x85, 0x70, 0x4e, 0x26, 0x5b, 0xd6, 0x33, 0xf9,
-	0x82, 0x18, 0x95, 0x4e, 0x75, 0xba, 0xc9, 0x59, 0x96, 0x97, 0xe1, 0x3b, 0x2f, 0x09, 0x73, 0xe1,
-	0xdc, 0x42, 0x51, 0x1c, 0x93, 0xe2, 0x9d, 0x6e, 0x72, 0x9a, 0xa5, 0x15, 0x30, 0x5a, 0x83, 0xc4,
-	0x60, 0x86, 0x71, 0x27, 0xaf, 0xdf, 0x30, 0x2a, 0xaa, 0x5e, 0x14, 0xc7, 0xa5, 0xc5, 0x4e, 0x37,
-	0x29, 0x06, 0xb9, 0xc1, 0x82, 0x92, 0xe2, 0xef, 0x7c, 0x28, 0x47, 0x3e, 0x7a, 0x2c, 0x47, 0x3e,
-	0x7d, 0x2c, 0x47, 0xce, 0x7d, 0x1d, 0x85, 0xb9, 0xc1, 0xb7, 0x16, 0x94, 0x86, 0xbf, 0x97, 0xb4,
-	0x62, 0xa9, 0x58, 0xce, 0xdc, 0x32, 0xca, 0x7a, 0x46, 0xbf, 0x5d, 0x1e, 0x6a, 0x9c, 0xb6, 0xc4,
-	0x92, 0x0b, 0xb6, 0xff, 0xbf, 0x40, 0x79, 0x38, 0x3f, 0xa7, 0x96, 0x8a, 0xe5, 0xbc, 0x6e, 0x94,
-	0x54, 0x2d, 0x5f, 0xcc, 0x89, 0x82, 0xb4, 0xdc, 0xe9, 0x26, 0x17, 0x18, 0x64, 0xc0, 0x25, 0xe8,
-	0xbf, 0xf0, 0xcf, 0x61, 0x70, 0xa5, 0xa8, 0xe7, 0x0b, 0xd7, 0x03, 0x6c, 0x54, 0x5a, 0xea, 0x74,
-	0x93, 0x88, 0x61, 0x2b, 0xa1, 0x91, 0x46, 0xe7, 0x61, 0x69, 0x18, 0x5a, 0xca, 0x94, 0xcb, 0x6a,
-	0x4e, 0x1c, 0x93, 0xc4, 0x4e, 0x37, 0x19, 0x67, 0x98, 0x92, 0xe9, 0x79, 0x56, 0x0d, 0x5d, 0x84,
-	0xc4, 0x70, 0xb6, 0xa6, 0xde, 0x54, 0xd7, 0x75, 0x35, 0x27, 0x8e, 0x4b, 0xa8, 0xd3, 0x4d, 0xce,
-	0xb1, 0x7c, 0xcd, 0x7a, 0xcd, 0xaa, 0x12, 0xeb, 0x58, 0xfe, 0x6b, 0x99, 0xfc, 0x2d, 0x35, 0x27,
-	0x4e, 0x84, 0xf9, 0xaf, 0x99, 0x76, 0xc3, 0xaa, 0x0d, 0xca, 0x9a, 0x2d, 0xec, 0x3d, 0x97, 0x23,
-	0x4f, 0x9f, 0xcb, 0x91, 0xb7, 0xf6, 0xe5, 0xc8, 0xde, 0xbe, 0x2c, 0x3c, 0xd9, 0x97, 0x85, 0x6f,
-	0xf7, 0x65, 0xe1, 0xd1, 0x0b, 0x39, 0xf2, 0xe4, 0x85, 0x1c, 0x79, 0xfa, 0x42, 0x8e, 0xdc, 0xfb,
-	0xe5, 0x4d, 0xb7, 0x43, 0xff, 0x62, 0x40, 0x67, 0x76, 0x73, 0x92, 0x2e, 0x87, 0x4b, 0x3f, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0xc6, 0x9e, 0x45, 0x50, 0x4c, 0x10, 0x00, 0x00,
+	// 1935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe7, 0x92, 0xb4, 0x3e, 0x1e, 0x29, 0x89, 0x1e, 0xc9, 0x12, 0xcd, 0x24, 0x5c, 0x7a, 0x1b,
+	0x14, 0x82, 0x61, 0x53, 0xa9, 0x72, 0xaa, 0x83, 0xb4, 0xe1, 0x9a, 0xb4, 0xcd, 0xd4, 0x12, 0x89,
+	0x25, 0x2d, 0x21, 0xe9, 0x61, 0xbb, 0x12, 0xc7, 0xd4, 0x36, 0xdc, 0x1d, 0x96, 0x33, 0x52, 0x24,
+	0xe4, 0xd2, 0xde, 0x0a, 0x01, 0x0d, 0xd2, 0x5b, 0x0f, 0x55, 0x51, 0x34, 0x2e, 0x50, 0xe4, 0xd4,
+	0x43, 0x8b, 0xfe, 0x0b, 0x46, 0x4f, 0x41, 0xd1, 0x83, 0xd1, 0x03, 0xdd, 0xc8, 0x3d, 0xb4, 0x3a,
+	0xf4, 0xe0, 0x63, 0x2f, 0x2d, 0x76, 0x66, 0x96, 0xdc, 0x25, 0xd7, 0x91, 0xe9, 0xa4, 0x68, 0x91,
+	0x83, 0x01, 0xcd, 0x9b, 0xf7, 0x7e, 0xef, 0x63, 0xde, 0xd7, 0xd2, 0xb0, 0xb4, 0x4b, 0xa8, 0x43,
+	0xe8, 0x5a, 0x9b, 0x1c, 0x78, 0xff, 0x8a, 0xdd, 0x1e, 0x61, 0x04, 0x81, 0xa0, 0x16, 0xdb, 0xe4,
+	0x20, 0xb7, 0x28, 0x39, 0x24, 0x89, 0x33, 0xe4, 0x96, 0xda, 0xa4, 0x4d, 0xf8, 0x9f, 0x6b, 0xde,
+	0x5f, 0x92, 0x7a, 0x59, 0xf0, 0x98, 0xe2, 0x22, 0x24, 0xa0, 0xb6, 0x09, 0x69, 0x77, 0xf0, 0x1a,
+	0x3f, 0xed, 0xec, 0xdf, 0x5f, 0x63, 0xb6, 0x83, 0x29, 0xb3, 0x9c, 0xae, 0x2f, 0x3b, 0xca, 0x60,
+	0xb9, 0x47, 0xf2, 0x2a, 0x3f, 0x7a, 0xd5, 0xda, 0xef, 0x59, 0xcc, 0x26, 0xae, 0xb8, 0xd7, 0x1e,
+	0x24, 0xe0, 0xe2, 0x06, 0x6d, 0x37, 0xf6, 0x77, 0x1c, 0x9b, 0xd5, 0x7b, 0xa4, 0x4b, 0xa8, 0xd5,
+	0x41, 0x6f, 0xc0, 0xf4, 0x2e, 0x71, 0x19, 0x76, 0x59, 0x56, 0x29, 0x28, 0xab, 0xa9, 0xf5, 0xa5,
+	0xa2, 0xc0, 0x29, 0xfa, 0x38, 0xc5, 0x92, 0x7b, 0xa4, 0xa7, 0xfe, 0xf8, 0xbb, 0xeb, 0xd3, 0x37,
+	0x05, 0xa3, 0xe1, 0x4b, 0xa0, 0x1f, 0x29, 0xb0, 0x60, 0xbb, 0x36, 0xb3, 0xad, 0x8e, 0xd9, 0xc2,
+	0x5d, 0x42, 0x6d, 0x96, 0x8d, 0x17, 0x12, 0xab, 0xa9, 0xf5, 0x74, 0x51, 0xfa, 0x75, 0x93, 0xd8,
+	0xae, 0xfe, 0xf6, 0xc3, 0xbe, 0x1a, 0x7b, 0xda, 0x57, 0x97, 0x8f, 0x2c, 0xa7, 0x73, 0x43, 0x1b,
+	0x11, 0xd1, 0x3e, 0x79, 0xac, 0xae, 0xb6, 0x6d, 0xb6, 0xb7, 0xbf, 0x53, 0xdc, 0x25, 0xce, 0x5a,
+	0x28, 0x92, 0xd7, 0x69, 0xeb, 0xbd, 0x35, 0x76, 0xd4, 0xc5, 0x02, 0x8a, 0x1a, 0xf3, 0x52, 0xba,
+	0x2c, 0x84, 0xd1, 0x06, 0xcc, 0x74, 0xb9, 0x33, 0xb8, 0x97, 0x4d, 0x14, 0x94, 0xd5, 0xb4, 0xfe,
+	0x8d, 0x7f, 0xf5, 0xd5, 0xeb, 0xcf, 0x81, 0x57, 0xda, 0xdd, 0x2d, 0xb5, 0x5a, 0x3d, 0x4c, 0xa9,
+	0x31, 0x80, 0x40, 0x6f, 0xc2, 0x8c, 0x83, 0x29, 0xb5, 0xda, 0x98, 0x66, 0x93, 0x85, 0xc4, 0xe7,
+	0x07, 0x84, 0xb6, 0xde, 0x2b, 0x6e, 0xd0, 0xb6, 0x31, 0x10, 0x41, 0x37, 0x20, 0x6d, 0x53, 0x13,
+	0x1f, 0x76, 0x71, 0xcb, 0x66, 0xb8, 0x95, 0xbd, 0x50, 0x50, 0x56, 0x67, 0xf4, 0x95, 0xa7, 0x7d,
+	0x75, 0x51, 0xfa, 0x1e, 0xb8, 0xd5, 0x8c, 0x94, 0x4d, 0x2b, 0xfe, 0xe9, 0x46, 0xf2, 0xef, 0xbf,
+	0x54, 0x15, 0xad, 0xaf, 0xc0, 0xf4, 0x06, 0x6d, 0x6f, 0x11, 0x86, 0x51, 0x13, 0x52, 0x5d, 0xf9,
+	0x50, 0xa6, 0xdd, 0xe2, 0x0f, 0x94, 0xd4, 0x5f, 0x3f, 0xed, 0xab, 0xe0, 0xbf, 0x5f, 0xb5, 0x7c,
+	0xd6, 0x57, 0x83, 0x4c, 0x4f, 0xfb, 0x2a, 0x12, 0x9a, 0x02, 0x44, 0xcd, 0x00, 0xff, 0x54, 0x6d,
+	0xa1, 0xdb, 0x70, 0xe1, 0x80, 0x30, 0xdc, 0xcb, 0xc6, 0x5f, 0x34, 0x5c, 0x42, 0x1e, 0x15, 0x61,
+	0x8a, 0x74, 0xbd, 0x0c, 0xe3, 0x81, 0x9f, 0x5f, 0x5f, 0x2e, 0x0e, 0x0b, 0xa2, 0xe8, 0x39, 0x50,
+	0xe3, 0xb7, 0x86, 0xe4, 0x92, 0x0e, 0xfe, 0x24, 0x0e, 0x0b, 0xd2, 0xc1, 0x6d, 0x6c, 0xb7, 0xf7,
+	0x18, 0x6e, 0xfd, 0xbf, 0x3b, 0x7a, 0x0f, 0xa6, 0x85, 0x0b, 0x34, 0x9b, 0xe0, 0x39, 0x91, 0x0f,
+	0x7a, 0xea, 0x7b, 0x31, 0xf4, 0x58, 0x7f, 0xc9, 0x4b, 0xf8, 0x4f, 0x1e, 0xab, 0x8b, 0xe3, 0x77,
+	0xd4, 0xf0, 0xb1, 0x64, 0x3c, 0x7e, 0x1a, 0x07, 0xd8, 0xa0, 0x6d, 0x3f, 0x9f, 0xff, 0x3b, 0xa1,
+	0xa8, 0xc1, 0xac, 0xac, 0x36, 0xf2, 0x05, 0xc2, 0x31, 0xc4, 0x40, 0x5b, 0x30, 0x65, 0x39, 0x64,
+	0xdf, 0x65, 0xd9, 0x44, 0x44, 0xc1, 0xbf, 0x26, 0xfd, 0x7f, 0xfe, 0xb2, 0x96, 0x68, 0x32, 0x26,
+	0x1f, 0x2b, 0x80, 0xc6, 0x43, 0x17, 0x48, 0x38, 0xe5, 0x79, 0x12, 0x0e, 0x6d, 0xc3, 0xd4, 0xfb,
+	0x1c, 0x85, 0xbb, 0x3c, 0xab, 0x7f, 0xdb, 0x33, 0xeb, 0x2f, 0x7d, 0xf5, 0xeb, 0xcf, 0x61, 0x56,
+	0x19, 0xef, 0x3e, 0xed, 0xab, 0x73, 0x22, 0xae, 0x02, 0x45, 0x33, 0x24, 0x9c, 0xb4, 0x72, 0x1b,
+	0xd2, 0x4d, 0x7c, 0x38, 0xec, 0xa5, 0x4b, 0x70, 0x81, 0xd9, 0xac, 0x83, 0xb9, 0x75, 0xb3, 0x86,
+	0x38, 0xa0, 0x02, 0xa4, 0x5a, 0x98, 0xee, 0xf6, 0x6c, 0x61, 0x39, 0xb7, 0xc4, 0x08, 0x92, 0x6e,
+	0x2c, 0x78, 0x68, 0x7f, 0x1a, 0x36, 0x58, 0xed, 0xdf, 0x0a, 0x4c, 0xfb, 0xf9, 0x50, 0x89, 0xca,
+	0x87, 0x57, 0xc3, 0xf9, 0xf0, 0xd5, 0x4b, 0x80, 0xb3, 0x19, 0x98, 0x19, 0xc4, 0x55, 0x8f, 0x0a,
+	0xc1, 0x95, 0xb1, 0x92, 0x88, 0xf3, 0x4a, 0x98, 0x95, 0x7d, 0x76, 0xc4, 0xff, 0xc0, 0x9c, 0x8b,
+	0x4f, 0x3c, 0xe7, 0x36, 0x61, 0x8a, 0x32, 0x8b, 0xed, 0x53, 0xd9, 0xe8, 0x72, 0xc1, 0xbc, 0xf3,
+	0x6d, 0x68, 0x70, 0x0e, 0x3d, 0x37, 0x9c, 0x73, 0x03, 0xa3, 0x85, 0xb0, 0x66, 0x48, 0x14, 0xb4,
+	0x07, 0xe8, 0xbe, 0xed, 0x5a, 0x1d, 0x93, 0x59, 0x9d, 0xce, 0x91, 0xd9, 0xc3, 0x74, 0xbf, 0xc3,
+	0xb2, 0x49, 0x6e, 0xd7, 0x4a, 0x10, 0xbb, 0xe9, 0xdd, 0x1b, 0xfc, 0x5a, 0xbf, 0x22, 0x87, 0xe8,
+	0x65, 0x01, 0x3e, 0x0e, 0xa0, 0x19, 0x19, 0x4e, 0x0c, 0x08, 0xa1, 0xef, 0x42, 0x8a, 0xf2, 0x81,
+	0x6f, 0x7a, 0x9b, 0x04, 0x1f, 0x47, 0xa9, 0xf5, 0xdc, 0x98, 0xeb, 0x4d, 0x7f, 0xcd, 0xd0, 0xf3,
+	0x52, 0x8b, 0xcc, 0xa7, 0x80, 0xb0, 0xf6, 0xd1, 0x63, 0x55, 0x31, 0x40, 0x50, 0x3c, 0x01, 0x64,
+	0x43, 0x46, 0xe6, 0x83, 0x89, 0xdd, 0x96, 0xd0, 0x30, 0x75, 0xae, 0x86, 0xaf, 0x49, 0x0d, 0x2b,
+	0x42, 0xc3, 0x28, 0x82, 0x50, 0x33, 0x2f, 0xc9, 0x15, 0xb7, 0xc5, 0x55, 0x7d, 0x00, 0x73, 0x8c,
+	0xb0, 0xc0, 0x9a, 0x31, 0x1d, 0x91, 0x74, 0x77, 0x24, 0xf2, 0x92, 0x40, 0x0e, 0x09, 0x4c, 0xb6,
+	0x64, 0xa4, 0xb9, 0xac, 0x5f, 0x82, 0x1d, 0xb8, 0x78, 0x40, 0x98, 0xed, 0xb6, 0xbd, 0x87, 0xec,
+	0xc9, 0x50, 0xce, 0x9c, 0xeb, 0xe8, 0xab, 0xd2, 0x9c, 0xac, 0x30, 0x67, 0x0c, 0x42, 0x78, 0xba,
+	0x20, 0xe8, 0x0d, 0x8f, 0xcc, 0x5d, 0xbd, 0x0f, 0x92, 0x34, 0x0c, 0xea, 0xec, 0xb9, 0xba, 0xb4,
+	0xf0, 0x86, 0x35, 0x02, 0x20, 0x34, 0xcd, 0x09, 0xaa, 0x1f, 0xd2, 0xe0, 0xa6, 0x03, 0x93, 0x6f,
+	0x3a, 0x77, 0x20, 0xe5, 0xd0, 0xb6, 0x4c, 0x3d, 0x9a, 0x4d, 0x71, 0x84, 0x05, 0xff, 0x3d, 0xbc,
+	0x81, 0x66, 0x31, 0x4b, 0x5f, 0x1e, 0xa6, 0x52, 0x80, 0x5b, 0x33, 0xc0, 0xa1, 0x6d, 0x91, 0xa2,
+	0x14, 0xbd, 0x09, 0x73, 0xf7, 0x2d, 0xbb, 0x83, 0x5b, 0x66, 0x0f, 0x5b, 0x94, 0xb8, 0xd9, 0x34,
+	0x6f, 0xd6, 0xd9, 0xe1, 0x4b, 0x86, 0xae, 0x35, 0x23, 0x2d, 0xce, 0x06, 0x3f, 0x8e, 0xad, 0x5c,
+	0x73, 0x13, 0xaf, 0x5c, 0x0f, 0xe3, 0x90, 0x0a, 0x16, 0xcd, 0x5b, 0x90, 0x38, 0xc2, 0x54, 0x74,
+	0x71, 0xbd, 0x38, 0xc1, 0xcc, 0xa8, 0xba, 0xcc, 0xf0, 0x44, 0xd1, 0x1d, 0x98, 0xb6, 0x76, 0x28,
+	0xb3, 0x6c, 0xd9, 0xef, 0x27, 0x46, 0xf1, 0xc5, 0xd1, 0xb7, 0x20, 0xee, 0x92, 0x6c, 0xe2, 0x85,
+	0x40, 0xe2, 0x2e, 0x41, 0x6d, 0x48, 0xbb, 0xc4, 0x7c, 0xdf, 0x66, 0x7b, 0xe6, 0x01, 0x66, 0x84,
+	0x37, 0x99, 0x59, 0xbd, 0x32, 0x19, 0xd2, 0x30, 0x96, 0x41, 0x2c, 0xcd, 0x00, 0x97, 0x6c, 0xdb,
+	0x6c, 0x6f, 0x0b, 0x33, 0xe2, 0x0f, 0xee, 0x38, 0x24, 0xf9, 0xea, 0xfa, 0x25, 0x8d, 0xad, 0xff,
+	0xd5, 0xae, 0x1a, 0x5c, 0xf9, 0x92, 0x5f, 0xfa, 0xca, 0xf7, 0x8f, 0x04, 0xcc, 0xc9, 0xe6, 0x52,
+	0xb7, 0x7a, 0x96, 0x43, 0xd1, 0x87, 0x0a, 0xa4, 0x1c, 0xdb, 0x1d, 0xb4, 0x37, 0x25, 0xa2, 0xbd,
+	0x99, 0x9e, 0x86, 0xb3, 0xbe, 0x7a, 0x29, 0xc0, 0x78, 0x8d, 0x38, 0x36, 0xc3, 0x4e, 0x97, 0x1d,
+	0x05, 0x0a, 0xcd, 0x76, 0x5f, 0xac, 0xeb, 0x81, 0x63, 0xbb, 0x7e, 0xcf, 0xfb, 0x50, 0x01, 0xe4,
+	0x58, 0x87, 0x3e, 0x90, 0xd9, 0xc5, 0x3d, 0x9b, 0xb4, 0xe4, 0xec, 0xbc, 0x3c, 0xd6, 0x28, 0xca,
+	0xf2, 0x5b, 0x53, 0x64, 0xd6, 0x59, 0x5f, 0x7d, 0x79, 0x5c, 0x38, 0x64, 0xab, 0x9c, 0x62, 0xe3,
+	0x5c, 0xda, 0xcf, 0xbc, 0x5e, 0x95, 0x71, 0xac, 0x43, 0x3f, 0x42, 0x9c, 0x8c, 0x7e, 0xaf, 0xc0,
+	0xa5, 0x41, 0x19, 0x9b, 0xc1, 0x58, 0x45, 0xed, 0x1f, 0x54, 0x9a, 0xa1, 0x46, 0x8a, 0x84, 0x2c,
+	0x79, 0x59, 0x58, 0x12, 0xc9, 0x38, 0x59, 0xfc, 0x16, 0x07, 0x18, 0x1b, 0x83, 0x40, 0x6a, 0x8f,
+	0x12, 0x90, 0xde, 0xe2, 0x8d, 0x57, 0x3e, 0xf5, 0x07, 0x20, 0x1b, 0xb1, 0x1f, 0x53, 0xe5, 0xbc,
+	0x98, 0xbe, 0x21, 0x9d, 0x59, 0x09, 0xc9, 0x85, 0x9c, 0x58, 0x0a, 0xf5, 0xfd, 0x60, 0x24, 0xd3,
+	0x82, 0x26, 0xa3, 0xf8, 0x2b, 0x05, 0x56, 0x86, 0x9e, 0x86, 0xed, 0x38, 0xf7, 0x6d, 0x6b, 0xd2,
+	0x8e, 0x2b, 0xcf, 0x40, 0x08, 0x59, 0x94, 0x1f, 0x0d, 0x6b, 0x84, 0x6d, 0xc3, 0x07, 0xdd, 0x0a,
+	0x1a, 0xf9, 0x6b, 0x05, 0x56, 0x06, 0x1d, 0x21, 0x24, 0xe6, 0x7f, 0x7f, 0x15, 0xa2, 0x16, 0xb0,
+	0x20, 0x86, 0xfe, 0x1d, 0xdf, 0xd6, 0x67, 0x00, 0x45, 0xd9, 0xfa, 0x0c, 0x56, 0xcd, 0xb8, 0xd4,
+	0x8d, 0x50, 0x41, 0xb5, 0x3f, 0x28, 0xb0, 0x14, 0xa5, 0xdc, 0x9b, 0x68, 0x03, 0x2c, 0x2f, 0x41,
+	0xb2, 0xca, 0xe8, 0x44, 0x0b, 0x5d, 0x6b, 0x46, 0xda, 0x3f, 0x37, 0x8f, 0xba, 0x18, 0x7d, 0x0f,
+	0xe6, 0x26, 0x7c, 0x99, 0x42, 0x78, 0xf3, 0x39, 0x2f, 0x0d, 0xb4, 0xbf, 0x25, 0xe5, 0xc4, 0x93,
+	0x39, 0xf9, 0x2e, 0x4c, 0xfd, 0x60, 0x9f, 0xf4, 0xf6, 0x1d, 0x6e, 0x69, 0x5a, 0xd7, 0x27, 0xfb,
+	0x50, 0x3a, 0xeb, 0xab, 0x19, 0x21, 0x3f, 0x0c, 0xab, 0x21, 0x11, 0xd1, 0x2e, 0xcc, 0xb2, 0xbd,
+	0x1e, 0xa6, 0x7b, 0xa4, 0xd3, 0x92, 0x6d, 0xbc, 0x32, 0x31, 0xfc, 0xe2, 0x00, 0x22, 0xa0, 0x61,
+	0x88, 0x8b, 0x9a, 0x90, 0xe4, 0xe3, 0x4d, 0xfc, 0x02, 0xf4, 0xd6, 0xc4, 0xf8, 0xf3, 0x9e, 0x74,
+	0x00, 0x9a, 0xa3, 0xa1, 0x5f, 0x28, 0x30, 0xac, 0x69, 0x73, 0xe8, 0x45, 0x92, 0x6b, 0x71, 0x26,
+	0xd6, 0xf2, 0x4a, 0x04, 0x58, 0x28, 0x11, 0x73, 0xa3, 0x45, 0x33, 0x60, 0xd3, 0x0c, 0x34, 0xa0,
+	0x36, 0x07, 0x6e, 0xff, 0x5c, 0x81, 0x4b, 0xc3, 0x54, 0xe2, 0xdf, 0x02, 0x5d, 0xfe, 0xa2, 0xd9,
+	0x0b, 0xbc, 0x4e, 0xd4, 0xa8, 0x3a, 0x09, 0x3c, 0xbc, 0x7e, 0xdb, 0xef, 0x93, 0x91, 0x28, 0x51,
+	0x7d, 0x32, 0x92, 0x51, 0x33, 0x16, 0xbb, 0xe3, 0xe8, 0xda, 0x99, 0x02, 0x8b, 0x11, 0x5a, 0xbf,
+	0x68, 0x7d, 0xdc, 0x1a, 0x64, 0xab, 0x48, 0xa7, 0xe2, 0x64, 0x0f, 0x31, 0xc8, 0xcc, 0xbb, 0xc1,
+	0xcc, 0x4c, 0xbc, 0x10, 0xd4, 0x10, 0xe0, 0xea, 0x3f, 0x15, 0x80, 0xc0, 0x6f, 0x15, 0xd7, 0x60,
+	0x65, 0xab, 0xd6, 0xac, 0x98, 0xb5, 0x7a, 0xb3, 0x5a, 0xdb, 0x34, 0xef, 0x6d, 0x36, 0xea, 0x95,
+	0x9b, 0xd5, 0x5b, 0xd5, 0x4a, 0x39, 0x13, 0xcb, 0x2d, 0x1c, 0x9f, 0x14, 0x52, 0x82, 0xb1, 0xe2,
+	0x85, 0x17, 0x69, 0xb0, 0x10, 0xe4, 0x7e, 0xa7, 0xd2, 0xc8, 0x28, 0xb9, 0xb9, 0xe3, 0x93, 0xc2,
+	0xac, 0xe0, 0x7a, 0x07, 0x53, 0x74, 0x15, 0x16, 0x83, 0x3c, 0x25, 0xbd, 0xd1, 0x2c, 0x55, 0x37,
+	0x33, 0xf1, 0xdc, 0xc5, 0xe3, 0x93, 0xc2, 0x9c, 0xe0, 0x2b, 0xc9, 0xb5, 0xb1, 0x00, 0xf3, 0x41,
+	0xde, 0xcd, 0x5a, 0x26, 0x91, 0x4b, 0x1f, 0x9f, 0x14, 0x66, 0x04, 0xdb, 0x26, 0x41, 0xeb, 0x90,
+	0x0d, 0x73, 0x98, 0xdb, 0xd5, 0xe6, 0x1d, 0x73, 0xab, 0xd2, 0xac, 0x65, 0x92, 0xb9, 0xa5, 0xe3,
+	0x93, 0x42, 0xc6, 0xe7, 0xf5, 0x77, 0xbc, 0x5c, 0xfa, 0xc7, 0x1f, 0xe7, 0x63, 0xbf, 0x79, 0x90,
+	0x8f, 0xfd, 0xf6, 0x41, 0x3e, 0x76, 0xf5, 0xcf, 0x71, 0x98, 0x0f, 0x7f, 0xfc, 0xa2, 0x22, 0xbc,
+	0x54, 0x37, 0x6a, 0xf5, 0x5a, 0xa3, 0x74, 0xd7, 0x6c, 0x34, 0x4b, 0xcd, 0x7b, 0x8d, 0x11, 0xc7,
+	0xb9, 0x4b, 0x82, 0x79, 0xd3, 0xf6, 0x7e, 0x7d, 0xce, 0x8f, 0xf2, 0x97, 0x2b, 0xf5, 0x5a, 0xa3,
+	0xda, 0x34, 0xeb, 0x15, 0xa3, 0x5a, 0x2b, 0x67, 0x94, 0xdc, 0xca, 0xf1, 0x49, 0x61, 0x51, 0x88,
+	0x84, 0x37, 0x82, 0x6f, 0xc2, 0x2b, 0xa3, 0xc2, 0x5b, 0xb5, 0x66, 0x75, 0xf3, 0xb6, 0x2f, 0x1b,
+	0xcf, 0x2d, 0x1f, 0x9f, 0x14, 0x90, 0x90, 0x0d, 0x35, 0xe8, 0x6b, 0xb0, 0x3c, 0x2a, 0x5a, 0x2f,
+	0x35, 0x1a, 0x95, 0x72, 0x26, 0x91, 0xcb, 0x1c, 0x9f, 0x14, 0xd2, 0x42, 0xa6, 0x6e, 0x51, 0x8a,
+	0x5b, 0xe8, 0x35, 0xc8, 0x8e, 0x72, 0x1b, 0x95, 0xb7, 0x2b, 0x37, 0x9b, 0x95, 0x72, 0x26, 0x99,
+	0x43, 0xc7, 0x27, 0x85, 0x79, 0xc1, 0x6f, 0xe0, 0xef, 0xe3, 0x5d, 0x86, 0x23, 0xf1, 0x6f, 0x95,
+	0xaa, 0x77, 0x2b, 0xe5, 0xcc, 0x85, 0x20, 0xfe, 0x2d, 0xfe, 0x1d, 0x13, 0x0e, 0xab, 0xbe, 0xf9,
+	0xf0, 0xb3, 0x7c, 0xec, 0xd1, 0x67, 0xf9, 0xd8, 0x0f, 0x4f, 0xf3, 0xb1, 0x87, 0xa7, 0x79, 0xe5,
+	0xd3, 0xd3, 0xbc, 0xf2, 0xd7, 0xd3, 0xbc, 0xf2, 0xd1, 0x93, 0x7c, 0xec, 0xd3, 0x27, 0xf9, 0xd8,
+	0xa3, 0x27, 0xf9, 0xd8, 0xbb, 0x9f, 0xbf, 0x95, 0x1c, 0xf2, 0xff, 0xa9, 0xe0, 0x69, 0xba, 0x33,
+	0xc5, 0xc7, 0xc5, 0xeb, 0xff, 0x19, 0x00, 0xc4, 0xe9, 0x18, 0xe6, 0xc4, 0x18, 0x00, 0x00,
 }
 
 func (this *MsgSubmitProposal) Equal(that interface{}) bool {
@@ -697,6 +919,17 @@ func (this *MsgSubmitProposal) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Proposer, that1.Proposer) {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	if this.IsExpedited != that1.IsExpedited {
+		return false
+	}
 	return true
 }
 func (this *MsgVote) Equal(that interface{}) bool {
@@ -729,6 +962,41 @@ func (this *MsgVote) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgVoteWeighted) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgVoteWeighted)
+	if !ok {
+		that2, ok := that.(MsgVoteWeighted)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
+		return false
+	}
+	if !bytes.Equal(this.Voter, that1.Voter) {
+		return false
+	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *MsgDeposit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -764,6 +1032,33 @@ func (this *MsgDeposit) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (this *TextProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -877,6 +1172,28 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	if len(this.MsgResults) != len(that1.MsgResults) {
+		return false
+	}
+	for i := range this.MsgResults {
+		if !this.MsgResults[i].Equal(that1.MsgResults[i]) {
+			return false
+		}
+	}
+	if this.FailedReason != that1.FailedReason {
+		return false
+	}
+	if this.IsExpedited != that1.IsExpedited {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -940,6 +1257,14 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
@@ -962,6 +1287,30 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsExpedited {
+		i--
+		if m.IsExpedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -1038,7 +1387,7 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1048,20 +1397,20 @@ func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1072,10 +1421,10 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1087,7 +1436,7 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TextProposal) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1097,34 +1446,46 @@ func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+	if m.ProposalID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1134,19 +1495,94 @@ func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -1193,6 +1629,51 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsExpedited {
+		i--
+		if m.IsExpedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.FailedReason) > 0 {
+		i -= len(m.FailedReason)
+		copy(dAtA[i:], m.FailedReason)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FailedReason)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err2 != nil {
 		return 0, err2
@@ -1357,6 +1838,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1397,6 +1892,20 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
 	if err8 != nil {
 		return 0, err8
@@ -1442,17 +1951,77 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if len(m.ProposalVotingPeriods) > 0 {
+		for iNdEx := len(m.ProposalVotingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalVotingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
 	if err9 != nil {
 		return 0, err9
 	}
 	i -= n9
 	i = encodeVarintGov(dAtA, i, uint64(n9))
 	i--
+	dAtA[i] = 0x12
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGov(dAtA, i, uint64(n10))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalVotingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalVotingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalVotingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGov(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProposalType) > 0 {
+		i -= len(m.ProposalType)
+		copy(dAtA[i:], m.ProposalType)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1473,6 +2042,30 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalTallyParams) > 0 {
+		for iNdEx := len(m.ProposalTallyParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalTallyParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.ExpeditedThreshold.Size()
+		i -= size
+		if _, err := m.ExpeditedThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.Veto.Size()
 		i -= size
@@ -1506,6 +2099,56 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalTallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProposalType) > 0 {
+		i -= len(m.ProposalType)
+		copy(dAtA[i:], m.ProposalType)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1537,6 +2180,15 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.IsExpedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1559,7 +2211,7 @@ func (m *MsgVote) Size() (n int) {
 	return n
 }
 
-func (m *MsgDeposit) Size() (n int) {
+func (m *MsgVoteWeighted) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1568,12 +2220,12 @@ func (m *MsgDeposit) Size() (n int) {
 	if m.ProposalID != 0 {
 		n += 1 + sovGov(uint64(m.ProposalID))
 	}
-	l = len(m.Depositor)
+	l = len(m.Voter)
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
 			l = e.Size()
 			n += 1 + l + sovGov(uint64(l))
 		}
@@ -1581,24 +2233,7 @@ func (m *MsgDeposit) Size() (n int) {
 	return n
 }
 
-func (m *TextProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	return n
-}
-
-func (m *Deposit) Size() (n int) {
+func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1620,13 +2255,66 @@ func (m *Deposit) Size() (n int) {
 	return n
 }
 
-func (m *Proposal) Size() (n int) {
+func (m *WeightedVoteOption) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ProposalID != 0 {
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *TextProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovGov(uint64(m.ProposalID))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
 		n += 1 + sovGov(uint64(m.ProposalID))
 	}
 	if m.Content != nil {
@@ -1652,6 +2340,25 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.FailedReason)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.IsExpedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1688,6 +2395,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1705,6 +2418,12 @@ func (m *DepositParams) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1716,6 +2435,29 @@ func (m *VotingParams) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.ProposalVotingPeriods) > 0 {
+		for _, e := range m.ProposalVotingPeriods {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalVotingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalType)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1731,6 +2473,31 @@ func (m *TallyParams) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.Veto.Size()
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ExpeditedThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.ProposalTallyParams) > 0 {
+		for _, e := range m.ProposalTallyParams {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalTallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalType)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Quorum.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1873,6 +2640,60 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				m.Proposer = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2022,7 +2843,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2045,10 +2866,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2072,7 +2893,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2099,14 +2920,14 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2133,8 +2954,8 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2162,7 +2983,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,17 +3006,36 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2205,29 +3045,31 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2237,23 +3079,25 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2279,7 +3123,7 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2302,17 +3146,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			m.ProposalID = 0
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2322,16 +3166,16 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
+				m.Option |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2341,28 +3185,251 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
@@ -2721,24 +3788,144 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, &types1.MsgData{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpedited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -2878,9 +4065,322 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2890,23 +4390,23 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types1.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2934,7 +4434,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2957,17 +4457,17 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			m.ProposalID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2977,16 +4477,30 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2996,31 +4510,30 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalVotingPeriods", wireType)
 			}
-			m.Option = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3030,11 +4543,26 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalVotingPeriods = append(m.ProposalVotingPeriods, ProposalVotingPeriod{})
+			if err := m.ProposalVotingPeriods[len(m.ProposalVotingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3059,7 +4587,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
+func (m *ProposalVotingPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3082,17 +4610,17 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalVotingPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalVotingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3102,29 +4630,27 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProposalType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3151,7 +4677,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3179,7 +4705,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3202,15 +4728,147 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Veto", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Veto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTallyParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3237,7 +4895,8 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.ProposalTallyParams = append(m.ProposalTallyParams, ProposalTallyParams{})
+			if err := m.ProposalTallyParams[len(m.ProposalTallyParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3265,7 +4924,7 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
+func (m *ProposalTallyParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,17 +4947,17 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalTallyParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalTallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3308,28 +4967,27 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProposalType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3356,13 +5014,13 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Veto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3389,7 +5047,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Veto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	yaml "gopkg.in/yaml.v2"
@@ -16,11 +17,13 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 )
 
 var (
 	_, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}
+	_       sdk.Msg                       = &MsgVoteWeighted{}
 	_       MsgSubmitProposalI            = &MsgSubmitProposal{}
 	_       types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
@@ -74,6 +77,23 @@ func (m *MsgSubmitProposal) SetProposer(address sdk.AccAddress) {
 	m.Proposer = address
 }
 
+// GetMessages returns the messages executed by the gov module account if the
+// proposal passes.
+func (m *MsgSubmitProposal) GetMessages() ([]sdk.Msg, error) {
+	return unpackMsgs(m.Messages)
+}
+
+// SetMessages sets the messages executed by the gov module account if the
+// proposal passes.
+func (m *MsgSubmitProposal) SetMessages(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	m.Messages = anys
+	return nil
+}
+
 func (m *MsgSubmitProposal) SetContent(content Content) error {
 	msg, ok := content.(proto.Message)
 	if !ok {
@@ -116,12 +136,46 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return err
 	}
 
+	msgs, err := m.GetMessages()
+	if err != nil {
+		return err
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message index %d: %s", i, err)
+		}
+	}
+
 	return nil
 }
 
-// GetSignBytes implements Msg
+// GetSignBytes implements Msg. The messages of the proposal, which the gov
+// codec does not know, are included with their own sign bytes.
 func (m MsgSubmitProposal) GetSignBytes() []byte {
-	bz := ModuleCdc.MustMarshalJSON(m)
+	if len(m.Messages) == 0 {
+		bz := ModuleCdc.MustMarshalJSON(m)
+		return sdk.MustSortJSON(bz)
+	}
+
+	msgs, err := m.GetMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	signMsgs := make([]json.RawMessage, len(msgs))
+	for i, msg := range msgs {
+		signMsgs[i] = msg.GetSignBytes()
+	}
+
+	m.Messages = nil
+	bz, err := json.Marshal(struct {
+		Proposal json.RawMessage   `json:"proposal"`
+		Messages []json.RawMessage `json:"messages"`
+	}{ModuleCdc.MustMarshalJSON(m), signMsgs})
+	if err != nil {
+		panic(err)
+	}
+
 	return sdk.MustSortJSON(bz)
 }
 
@@ -139,7 +193,10 @@ func (m MsgSubmitProposal) String() string {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(m.Content, &content)
+	if err := unpacker.UnpackAny(m.Content, &content); err != nil {
+		return err
+	}
+	return unpackMsgsInterfaces(unpacker, m.Messages)
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -224,3 +281,41 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// NewMsgVoteWeighted creates a message to cast a vote split across several
+// weighted options on an active proposal
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
+	return &MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+
+	return msg.Options.Validate()
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
@@ -1,11 +1,13 @@
 package types
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -59,6 +61,44 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+// invalidTestMsg is a testdata.TestMsg failing stateless validation.
+type invalidTestMsg struct {
+	*testdata.TestMsg
+}
+
+func (invalidTestMsg) ValidateBasic() error { return errors.New("invalid message") }
+
+func TestMsgSubmitProposalMessages(t *testing.T) {
+	content := ContentFromProposalType("Test Proposal", "the purpose of this proposal is to test", ProposalTypeText)
+
+	tests := []struct {
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{nil, true},
+		{[]sdk.Msg{testdata.NewTestMsg(addrs[0])}, true},
+		{[]sdk.Msg{testdata.NewTestMsg(addrs[0]), testdata.NewTestMsg(addrs[1])}, true},
+		{[]sdk.Msg{invalidTestMsg{testdata.NewTestMsg(addrs[0])}}, false},
+		{[]sdk.Msg{testdata.NewTestMsg(addrs[0]), invalidTestMsg{testdata.NewTestMsg(addrs[1])}}, false},
+	}
+
+	for i, tc := range tests {
+		msg, err := NewMsgSubmitProposal(content, coinsPos, addrs[0])
+		require.NoError(t, err)
+		require.NoError(t, msg.SetMessages(tc.msgs))
+
+		msgs, err := msg.GetMessages()
+		require.NoError(t, err)
+		require.Len(t, msgs, len(tc.msgs), "test: %v", i)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.True(t, ErrInvalidProposalMsg.Is(msg.ValidateBasic()), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -118,6 +158,50 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+func TestMsgVoteWeighted(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNoWithVeto), true},
+		{0, addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+		}, true},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDec(2)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDec(-1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.OneDec()),
+			NewWeightedVoteOption(OptionNo, sdk.ZeroDec()),
+		}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
@@ -129,4 +213,13 @@ func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	require.Equal(t,
 		`{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"abcd","title":"test"}},"initial_deposit":[]}}`,
 		string(bz))
+
+	// messages unknown to the gov codec are signed with their own sign bytes
+	require.NoError(t, msg.SetMessages([]sdk.Msg{testdata.NewTestMsg(addrs[0])}))
+	require.NotPanics(t, func() {
+		bz = msg.GetSignBytes()
+	})
+	require.Equal(t,
+		`{"messages":[["cosmos1w3jhxap3gempvr"]],"proposal":{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"abcd","title":"test"}},"initial_deposit":[]}}}`,
+		string(bz))
 }
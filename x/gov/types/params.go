@@ -12,15 +12,18 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.TokensFromConsensusPower(10)
+	DefaultExpeditedMinDepositTokens = DefaultMinDepositTokens.MulRaw(5)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultVeto                      = sdk.NewDecWithPrec(334, 3)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
 )
 
 // Parameter store key
@@ -40,10 +43,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		ExpeditedMinDeposit: expeditedMinDeposit,
 	}
 }
 
@@ -52,9 +56,19 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
 	)
 }
 
+// GetMinDeposit returns the minimum deposit for a regular or an expedited
+// proposal to enter voting period.
+func (dp DepositParams) GetMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
+	}
+	return dp.MinDeposit
+}
+
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	out, _ := yaml.Marshal(dp)
@@ -63,7 +77,13 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit)
+}
+
+// Validate checks that the deposit params are valid.
+func (dp DepositParams) Validate() error {
+	return validateDepositParams(dp)
 }
 
 func validateDepositParams(i interface{}) error {
@@ -78,27 +98,69 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.ExpeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if !v.ExpeditedMinDeposit.IsAllGT(v.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit must be greater than minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, veto, expeditedThreshold sdk.Dec, proposalTallyParams []ProposalTallyParams) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
-		Veto:      veto,
+		Quorum:              quorum,
+		Threshold:           threshold,
+		Veto:                veto,
+		ExpeditedThreshold:  expeditedThreshold,
+		ProposalTallyParams: proposalTallyParams,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto, DefaultExpeditedThreshold, nil)
+}
+
+// GetQuorum returns the quorum of the proposals of the given type.
+func (tp TallyParams) GetQuorum(proposalType string) sdk.Dec {
+	for _, ptp := range tp.ProposalTallyParams {
+		if ptp.ProposalType == proposalType {
+			return ptp.Quorum
+		}
+	}
+	return tp.Quorum
+}
+
+// GetThreshold returns the threshold of the regular or expedited proposals of
+// the given type.
+func (tp TallyParams) GetThreshold(proposalType string, expedited bool) sdk.Dec {
+	if expedited {
+		return tp.ExpeditedThreshold
+	}
+	for _, ptp := range tp.ProposalTallyParams {
+		if ptp.ProposalType == proposalType {
+			return ptp.Threshold
+		}
+	}
+	return tp.Threshold
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.Veto.Equal(other.Veto)
+	if len(tp.ProposalTallyParams) != len(other.ProposalTallyParams) {
+		return false
+	}
+	for i, ptp := range tp.ProposalTallyParams {
+		if !ptp.Equal(other.ProposalTallyParams[i]) {
+			return false
+		}
+	}
+
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.Veto.Equal(other.Veto) &&
+		tp.ExpeditedThreshold.Equal(other.ExpeditedThreshold)
 }
 
 // String implements stringer insterface
@@ -107,6 +169,11 @@ func (tp TallyParams) String() string {
 	return string(out)
 }
 
+// Validate checks that the tally params are valid.
+func (tp TallyParams) Validate() error {
+	return validateTallyParams(tp)
+}
+
 func validateTallyParams(i interface{}) error {
 	v, ok := i.(TallyParams)
 	if !ok {
@@ -131,25 +198,111 @@ func validateTallyParams(i interface{}) error {
 	if v.Veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
+	if !v.ExpeditedThreshold.GT(v.Threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than vote threshold: %s", v.ExpeditedThreshold)
+	}
+
+	seenTypes := make(map[string]bool, len(v.ProposalTallyParams))
+	for _, ptp := range v.ProposalTallyParams {
+		if err := ptp.Validate(); err != nil {
+			return err
+		}
+		if seenTypes[ptp.ProposalType] {
+			return fmt.Errorf("duplicate tally params for proposal type %s", ptp.ProposalType)
+		}
+		if !v.ExpeditedThreshold.GT(ptp.Threshold) {
+			return fmt.Errorf("expedited vote threshold must be greater than vote threshold of proposal type %s: %s", ptp.ProposalType, v.ExpeditedThreshold)
+		}
+		seenTypes[ptp.ProposalType] = true
+	}
+
+	return nil
+}
+
+// NewProposalTallyParams creates a new ProposalTallyParams object
+func NewProposalTallyParams(proposalType string, quorum, threshold sdk.Dec) ProposalTallyParams {
+	return ProposalTallyParams{
+		ProposalType: proposalType,
+		Quorum:       quorum,
+		Threshold:    threshold,
+	}
+}
+
+// Equal checks equality of ProposalTallyParams
+func (ptp ProposalTallyParams) Equal(other ProposalTallyParams) bool {
+	return ptp.ProposalType == other.ProposalType && ptp.Quorum.Equal(other.Quorum) && ptp.Threshold.Equal(other.Threshold)
+}
+
+// String implements stringer interface
+func (ptp ProposalTallyParams) String() string {
+	out, _ := yaml.Marshal(ptp)
+	return string(out)
+}
+
+// Validate checks that the quorum and threshold of the proposal type are valid
+func (ptp ProposalTallyParams) Validate() error {
+	if ptp.ProposalType == "" {
+		return fmt.Errorf("tally params proposal type cannot be empty")
+	}
+	if ptp.Quorum.IsNegative() {
+		return fmt.Errorf("quorum of proposal type %s cannot be negative: %s", ptp.ProposalType, ptp.Quorum)
+	}
+	if ptp.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("quorum of proposal type %s too large: %s", ptp.ProposalType, ptp.Quorum)
+	}
+	if !ptp.Threshold.IsPositive() {
+		return fmt.Errorf("vote threshold of proposal type %s must be positive: %s", ptp.ProposalType, ptp.Threshold)
+	}
+	if ptp.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("vote threshold of proposal type %s too large: %s", ptp.ProposalType, ptp.Threshold)
+	}
 
 	return nil
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration, proposalVotingPeriods []ProposalVotingPeriod) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
+		ProposalVotingPeriods: proposalVotingPeriods,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod, nil)
+}
+
+// GetVotingPeriod returns the voting period of the regular or expedited
+// proposals of the given type.
+func (vp VotingParams) GetVotingPeriod(proposalType string, expedited bool) time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	for _, pvp := range vp.ProposalVotingPeriods {
+		if pvp.ProposalType == proposalType {
+			return pvp.VotingPeriod
+		}
+	}
+	return vp.VotingPeriod
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	if len(vp.ProposalVotingPeriods) != len(other.ProposalVotingPeriods) {
+		return false
+	}
+	for i, pvp := range vp.ProposalVotingPeriods {
+		if pvp != other.ProposalVotingPeriods[i] {
+			return false
+		}
+	}
+
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
 }
 
 // String implements stringer interface
@@ -158,6 +311,11 @@ func (vp VotingParams) String() string {
 	return string(out)
 }
 
+// Validate checks that the voting params are valid.
+func (vp VotingParams) Validate() error {
+	return validateVotingParams(vp)
+}
+
 func validateVotingParams(i interface{}) error {
 	v, ok := i.(VotingParams)
 	if !ok {
@@ -167,10 +325,44 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("expedited voting period must be shorter than voting period: %s", v.ExpeditedVotingPeriod)
+	}
+
+	seenTypes := make(map[string]bool, len(v.ProposalVotingPeriods))
+	for _, pvp := range v.ProposalVotingPeriods {
+		if pvp.ProposalType == "" {
+			return fmt.Errorf("voting period proposal type cannot be empty")
+		}
+		if seenTypes[pvp.ProposalType] {
+			return fmt.Errorf("duplicate voting period for proposal type %s", pvp.ProposalType)
+		}
+		if v.ExpeditedVotingPeriod >= pvp.VotingPeriod {
+			return fmt.Errorf("expedited voting period must be shorter than voting period of proposal type %s: %s", pvp.ProposalType, v.ExpeditedVotingPeriod)
+		}
+		seenTypes[pvp.ProposalType] = true
+	}
 
 	return nil
 }
 
+// NewProposalVotingPeriod creates a new ProposalVotingPeriod object
+func NewProposalVotingPeriod(proposalType string, votingPeriod time.Duration) ProposalVotingPeriod {
+	return ProposalVotingPeriod{
+		ProposalType: proposalType,
+		VotingPeriod: votingPeriod,
+	}
+}
+
+// String implements stringer interface
+func (pvp ProposalVotingPeriod) String() string {
+	out, _ := yaml.Marshal(pvp)
+	return string(out)
+}
+
 // Params returns all of the governance params
 type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestProposalTypeParams(t *testing.T) {
+	votingParams := DefaultVotingParams()
+	votingParams.ProposalVotingPeriods = []ProposalVotingPeriod{
+		NewProposalVotingPeriod(ProposalTypeText, 3*DefaultPeriod),
+	}
+	require.Equal(t, 3*DefaultPeriod, votingParams.GetVotingPeriod(ProposalTypeText, false))
+	require.Equal(t, DefaultExpeditedPeriod, votingParams.GetVotingPeriod(ProposalTypeText, true))
+	require.Equal(t, DefaultPeriod, votingParams.GetVotingPeriod("other", false))
+
+	tallyParams := DefaultTallyParams()
+	tallyParams.ProposalTallyParams = []ProposalTallyParams{
+		NewProposalTallyParams(ProposalTypeText, sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(6, 1)),
+	}
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), tallyParams.GetQuorum(ProposalTypeText))
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.GetThreshold(ProposalTypeText, false))
+	require.Equal(t, DefaultExpeditedThreshold, tallyParams.GetThreshold(ProposalTypeText, true))
+	require.Equal(t, DefaultQuorum, tallyParams.GetQuorum("other"))
+	require.Equal(t, DefaultThreshold, tallyParams.GetThreshold("other", false))
+
+	depositParams := DefaultDepositParams()
+	require.Equal(t, depositParams.MinDeposit, depositParams.GetMinDeposit(false))
+	require.Equal(t, depositParams.ExpeditedMinDeposit, depositParams.GetMinDeposit(true))
+}
+
+func TestValidateExpeditedParams(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*DepositParams, *VotingParams, *TallyParams)
+		expPass  bool
+	}{
+		{"default params", func(*DepositParams, *VotingParams, *TallyParams) {}, true},
+		{
+			"expedited min deposit not greater than min deposit",
+			func(dp *DepositParams, _ *VotingParams, _ *TallyParams) { dp.ExpeditedMinDeposit = dp.MinDeposit },
+			false,
+		},
+		{
+			"expedited voting period not shorter than voting period",
+			func(_ *DepositParams, vp *VotingParams, _ *TallyParams) { vp.ExpeditedVotingPeriod = vp.VotingPeriod },
+			false,
+		},
+		{
+			"proposal voting period not longer than expedited voting period",
+			func(_ *DepositParams, vp *VotingParams, _ *TallyParams) {
+				vp.ProposalVotingPeriods = []ProposalVotingPeriod{NewProposalVotingPeriod(ProposalTypeText, time.Hour)}
+			},
+			false,
+		},
+		{
+			"duplicate proposal voting periods",
+			func(_ *DepositParams, vp *VotingParams, _ *TallyParams) {
+				vp.ProposalVotingPeriods = []ProposalVotingPeriod{
+					NewProposalVotingPeriod(ProposalTypeText, DefaultPeriod),
+					NewProposalVotingPeriod(ProposalTypeText, 2*DefaultPeriod),
+				}
+			},
+			false,
+		},
+		{
+			"expedited threshold not greater than threshold",
+			func(_ *DepositParams, _ *VotingParams, tp *TallyParams) { tp.ExpeditedThreshold = tp.Threshold },
+			false,
+		},
+		{
+			"valid proposal tally params",
+			func(_ *DepositParams, _ *VotingParams, tp *TallyParams) {
+				tp.ProposalTallyParams = []ProposalTallyParams{
+					NewProposalTallyParams(ProposalTypeText, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(6, 1)),
+				}
+			},
+			true,
+		},
+		{
+			"proposal threshold not lower than expedited threshold",
+			func(_ *DepositParams, _ *VotingParams, tp *TallyParams) {
+				tp.ProposalTallyParams = []ProposalTallyParams{
+					NewProposalTallyParams(ProposalTypeText, DefaultQuorum, tp.ExpeditedThreshold),
+				}
+			},
+			false,
+		},
+		{
+			"proposal quorum too large",
+			func(_ *DepositParams, _ *VotingParams, tp *TallyParams) {
+				tp.ProposalTallyParams = []ProposalTallyParams{
+					NewProposalTallyParams(ProposalTypeText, sdk.NewDec(2), DefaultThreshold),
+				}
+			},
+			false,
+		},
+		{
+			"empty proposal type",
+			func(_ *DepositParams, _ *VotingParams, tp *TallyParams) {
+				tp.ProposalTallyParams = []ProposalTallyParams{NewProposalTallyParams("", DefaultQuorum, DefaultThreshold)}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		dp, vp, tp := DefaultDepositParams(), DefaultVotingParams(), DefaultTallyParams()
+		tc.malleate(&dp, &vp, &tp)
+
+		err := ValidateGenesis(NewGenesisState(1, dp, vp, tp))
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -18,7 +18,7 @@ import (
 const DefaultStartingProposalID uint64 = 1
 
 // NewProposal creates a new Proposal instance
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(content Content, messages []sdk.Msg, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
 	p := Proposal{
 		ProposalID:       id,
 		Status:           StatusDepositPeriod,
@@ -40,6 +40,13 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 
 	p.Content = any
 
+	anys, err := packMsgs(messages)
+	if err != nil {
+		return Proposal{}, err
+	}
+
+	p.Messages = anys
+
 	return p, nil
 }
 
@@ -82,10 +89,19 @@ func (p Proposal) GetTitle() string {
 	return content.GetTitle()
 }
 
+// GetMessages returns the messages executed by the gov module account if the
+// proposal passes.
+func (p Proposal) GetMessages() ([]sdk.Msg, error) {
+	return unpackMsgs(p.Messages)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(p.Content, &content)
+	if err := unpacker.UnpackAny(p.Content, &content); err != nil {
+		return err
+	}
+	return unpackMsgsInterfaces(unpacker, p.Messages)
 }
 
 // Proposals is an array of proposal
@@ -324,3 +340,47 @@ func ProposalHandler(_ sdk.Context, c Content) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gov proposal type: %s", c.ProposalType())
 	}
 }
+
+// packMsgs packs the messages of a proposal into Anys.
+func packMsgs(msgs []sdk.Msg) ([]*types.Any, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+// unpackMsgs returns the cached messages of the Anys of a proposal.
+func unpackMsgs(anys []*types.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d is not a sdk.Msg", i)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// unpackMsgsInterfaces unpacks the messages of the Anys of a proposal.
+func unpackMsgsInterfaces(unpacker types.AnyUnpacker, anys []*types.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -879,58 +880,66 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/query.proto", fileDescriptor_6efb1c1bc2595eda) }
 
 var fileDescriptor_6efb1c1bc2595eda = []byte{
-	// 808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x4f, 0xd3, 0x50,
-	0x14, 0x5e, 0xc7, 0x06, 0xdb, 0x19, 0x20, 0x5e, 0x10, 0x66, 0x23, 0xdb, 0xac, 0x3e, 0x10, 0x23,
-	0x5b, 0x84, 0xa0, 0xc2, 0x8b, 0x32, 0xfc, 0x19, 0x12, 0x81, 0x49, 0x7c, 0xe0, 0x85, 0x94, 0xb5,
-	0xa9, 0x8d, 0x63, 0xb7, 0xf4, 0xde, 0x2d, 0x2e, 0x31, 0xbe, 0x9a, 0x98, 0x68, 0xfc, 0x57, 0xfc,
-	0x2f, 0x78, 0xe4, 0xd1, 0x27, 0x62, 0xc0, 0xbf, 0xc2, 0x27, 0xd3, 0xfb, 0xa3, 0x6b, 0xd7, 0x75,
-	0x4e, 0x31, 0x3e, 0x31, 0xce, 0xfd, 0xce, 0x77, 0xbf, 0xf3, 0x9d, 0xd3, 0xd3, 0xc2, 0x6c, 0x1d,
-	0x93, 0x43, 0x4c, 0x2a, 0x16, 0x6e, 0x57, 0x8e, 0x5a, 0xa6, 0xdb, 0x29, 0x3b, 0x2e, 0xa6, 0x18,
-	0x01, 0x8f, 0x97, 0x2d, 0xdc, 0x56, 0xe7, 0x05, 0x86, 0x9d, 0x57, 0x1c, 0xdd, 0xb2, 0x9b, 0x3a,
-	0xb5, 0x71, 0x93, 0x43, 0xd5, 0x19, 0x0b, 0x5b, 0x98, 0xfd, 0xac, 0x78, 0xbf, 0x64, 0x34, 0x40,
-	0x6c, 0xe1, 0x36, 0x8f, 0x6a, 0xf7, 0x60, 0x66, 0xc7, 0x63, 0xd9, 0x76, 0xb1, 0x83, 0x89, 0xde,
-	0xa8, 0x99, 0x47, 0x2d, 0x93, 0x50, 0x54, 0x84, 0x9c, 0x23, 0x42, 0xfb, 0xb6, 0x91, 0x57, 0x4a,
-	0xca, 0x42, 0xaa, 0x06, 0x32, 0xf4, 0xdc, 0xd0, 0xb6, 0xe0, 0x4a, 0x4f, 0x22, 0x71, 0x70, 0x93,
-	0x98, 0xe8, 0x2e, 0x64, 0x24, 0x8c, 0xa5, 0xe5, 0x96, 0x66, 0xca, 0x5d, 0xed, 0x65, 0x89, 0xaf,
-	0xa6, 0x8e, 0x4f, 0x8b, 0x89, 0x9a, 0x8f, 0xd5, 0xbe, 0x26, 0x7b, 0x18, 0x89, 0xd4, 0xb2, 0x01,
-	0x97, 0x7c, 0x2d, 0x84, 0xea, 0xb4, 0x45, 0x18, 0xf1, 0xe4, 0x92, 0xda, 0x8f, 0xf8, 0x25, 0x43,
-	0xd4, 0x26, 0x9d, 0xd0, 0xff, 0xe8, 0x29, 0xa4, 0xdb, 0x98, 0x9a, 0x6e, 0x3e, 0x59, 0x52, 0x16,
-	0xc6, 0xab, 0x77, 0x7e, 0x9e, 0x16, 0x17, 0x2d, 0x9b, 0xbe, 0x6e, 0x1d, 0x94, 0xeb, 0xf8, 0xb0,
-	0x22, 0xcc, 0xe1, 0x7f, 0x16, 0x89, 0xf1, 0xa6, 0x42, 0x3b, 0x8e, 0x49, 0xca, 0xeb, 0xf5, 0xfa,
-	0xba, 0x61, 0xb8, 0x26, 0x21, 0x35, 0x9e, 0x8f, 0xb6, 0x20, 0x6b, 0x98, 0x0e, 0x26, 0x36, 0xc5,
-	0x6e, 0x7e, 0xe4, 0x6f, 0xc9, 0xba, 0x1c, 0x68, 0x15, 0xa0, 0xdb, 0xc2, 0x7c, 0x8a, 0x59, 0x76,
-	0x55, 0x56, 0xc6, 0x47, 0x60, 0x5b, 0xb7, 0x4c, 0xe1, 0x46, 0x2d, 0x00, 0xd6, 0x3e, 0x2b, 0x30,
-	0xdb, 0xeb, 0x99, 0x68, 0xc3, 0x7d, 0xc8, 0x4a, 0x07, 0x3c, 0xbb, 0x46, 0x7e, 0xd3, 0x87, 0x2e,
-	0x18, 0xad, 0x85, 0xf4, 0x24, 0x99, 0x1e, 0xb5, 0x9f, 0x1e, 0x7e, 0x53, 0x48, 0xd0, 0x3b, 0x98,
-	0x62, 0x7a, 0x5e, 0x61, 0x6a, 0x0e, 0x3b, 0x4a, 0xff, 0xac, 0x35, 0xda, 0x03, 0xb8, 0x1c, 0xb8,
-	0x5d, 0x18, 0x71, 0x0b, 0x52, 0xde, 0xa9, 0x98, 0xc5, 0xa9, 0xa0, 0x07, 0x1e, 0x4e, 0xd4, 0xcf,
-	0x30, 0x1a, 0x0e, 0x10, 0x90, 0xa1, 0xf5, 0xaf, 0xf6, 0x31, 0x6c, 0xc8, 0x06, 0xbe, 0x07, 0x14,
-	0xbc, 0x50, 0x48, 0xbe, 0xcd, 0x0d, 0x91, 0x7d, 0x8b, 0xd3, 0xcc, 0x41, 0x17, 0xea, 0xd7, 0x8a,
-	0xb8, 0x7f, 0x5b, 0x77, 0xf5, 0xc3, 0x50, 0xc5, 0x2c, 0xb0, 0xef, 0x39, 0xcd, 0x2a, 0xce, 0x7a,
-	0x69, 0x5e, 0x68, 0xb7, 0xe3, 0x98, 0xda, 0x0f, 0x05, 0xa6, 0x43, 0x79, 0x42, 0xf8, 0x06, 0x4c,
-	0xb4, 0x31, 0xb5, 0x9b, 0xd6, 0x3e, 0x07, 0x0b, 0xd3, 0xf3, 0x3d, 0x05, 0xd8, 0x4d, 0x8b, 0x27,
-	0x8a, 0x42, 0xc6, 0xdb, 0x81, 0x18, 0x7a, 0x02, 0x93, 0xe2, 0xe1, 0x90, 0x2c, 0x3d, 0x96, 0x7a,
-	0x2c, 0x8f, 0x38, 0x22, 0x44, 0x33, 0x61, 0x04, 0x83, 0xe8, 0x21, 0x8c, 0x53, 0xbd, 0xd1, 0xe8,
-	0x48, 0x96, 0x11, 0xc6, 0x32, 0x17, 0x64, 0xd9, 0xf5, 0xce, 0x43, 0x1c, 0x39, 0xda, 0x0d, 0x69,
-	0x1f, 0x64, 0x99, 0xe2, 0xb6, 0xa1, 0x27, 0x22, 0xb4, 0x23, 0x92, 0x17, 0xdf, 0x11, 0xda, 0xa6,
-	0x58, 0xd3, 0xbe, 0x10, 0x61, 0xf8, 0x32, 0x8c, 0x09, 0x90, 0xb0, 0x7a, 0xba, 0x8f, 0x49, 0xa2,
-	0x34, 0x89, 0xd4, 0xdc, 0x30, 0xd9, 0x7f, 0x19, 0xf4, 0x8f, 0x8a, 0xd8, 0xee, 0xdd, 0x4b, 0x45,
-	0x09, 0x2b, 0x90, 0x11, 0xc2, 0xe4, 0xbc, 0x0f, 0xa8, 0xc1, 0x87, 0x5e, 0x68, 0xea, 0xd7, 0x60,
-	0x8e, 0x69, 0x61, 0xed, 0xaf, 0x99, 0xa4, 0xd5, 0xa0, 0x7f, 0xf0, 0xde, 0xcb, 0x47, 0x73, 0xfd,
-	0x6e, 0xa4, 0xd9, 0xf8, 0x88, 0x5e, 0x44, 0x47, 0x8d, 0xe3, 0xe5, 0xe3, 0xcb, 0xb0, 0x4b, 0x9f,
-	0xd2, 0x90, 0x66, 0x8c, 0x68, 0x07, 0x32, 0x72, 0x2b, 0xa3, 0x52, 0x30, 0xb7, 0xdf, 0x1b, 0x5a,
-	0xbd, 0x3e, 0x00, 0xc1, 0xf5, 0x68, 0x09, 0xb4, 0x0b, 0x59, 0xff, 0xd5, 0x80, 0xe2, 0x33, 0xe4,
-	0x08, 0xa8, 0xda, 0x20, 0x88, 0xcf, 0xfa, 0x18, 0x52, 0xde, 0x1a, 0x42, 0xd7, 0x22, 0xe8, 0xc0,
-	0xde, 0x57, 0xe7, 0x63, 0x4e, 0x7d, 0x9a, 0x67, 0x90, 0x66, 0x7b, 0x0f, 0xf5, 0x47, 0xfa, 0xa2,
-	0x0a, 0x71, 0xc7, 0x3e, 0xd3, 0x26, 0x8c, 0x8a, 0x87, 0x3e, 0x8a, 0x0d, 0xad, 0x36, 0xb5, 0x18,
-	0x7b, 0xee, 0x93, 0xbd, 0x80, 0x31, 0x31, 0x74, 0x28, 0x8a, 0x0e, 0x6f, 0x02, 0xb5, 0x14, 0x0f,
-	0xf0, 0xf9, 0x76, 0x20, 0x23, 0x87, 0x1e, 0xc5, 0xe2, 0x49, 0x7c, 0x5b, 0x7b, 0x9f, 0x18, 0x2d,
-	0x81, 0xf6, 0x20, 0x17, 0x98, 0x27, 0x74, 0x23, 0x92, 0x13, 0x9d, 0x6c, 0xf5, 0xe6, 0x60, 0x90,
-	0xe4, 0xae, 0x56, 0x8f, 0xcf, 0x0a, 0xca, 0xc9, 0x59, 0x41, 0xf9, 0x7e, 0x56, 0x50, 0xbe, 0x9c,
-	0x17, 0x12, 0x27, 0xe7, 0x85, 0xc4, 0xb7, 0xf3, 0x42, 0x62, 0x6f, 0x61, 0xe0, 0xfa, 0x7a, 0xcb,
-	0xbe, 0x2c, 0xd9, 0x12, 0x3b, 0x18, 0x65, 0x1f, 0x97, 0xcb, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x93, 0x13, 0x79, 0x06, 0xcd, 0x0a, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x1a, 0x1b, 0xec, 0x67, 0xa0, 0x74, 0x30, 0xe0, 0xae, 0xc0, 0x76, 0x97, 0x56, 0x72,
+	0x0b, 0x78, 0x05, 0x94, 0x16, 0xb8, 0x14, 0x0c, 0x6a, 0x55, 0xf5, 0x00, 0xdd, 0xa2, 0x1e, 0x7a,
+	0x41, 0x8b, 0xbd, 0xda, 0xac, 0x62, 0x3c, 0xcb, 0xce, 0xda, 0x8a, 0x65, 0xcc, 0x21, 0x52, 0x14,
+	0x29, 0x91, 0x48, 0xa4, 0xfc, 0x92, 0xfc, 0x0b, 0x8e, 0x48, 0xb9, 0xe4, 0x84, 0x22, 0xe0, 0x57,
+	0xe4, 0x14, 0xed, 0xec, 0x8c, 0xbd, 0x6b, 0x7b, 0x1d, 0x03, 0x51, 0x4e, 0x5e, 0xde, 0x7c, 0xf3,
+	0x7d, 0xdf, 0x7b, 0xf3, 0xe6, 0x0d, 0x30, 0x5d, 0xc4, 0xe4, 0x18, 0x13, 0x59, 0xc7, 0x35, 0xf9,
+	0xa4, 0xaa, 0x59, 0xf5, 0xbc, 0x69, 0x61, 0x1b, 0x23, 0x70, 0xe3, 0x79, 0x1d, 0xd7, 0xc4, 0x59,
+	0x1d, 0x63, 0xbd, 0xac, 0xc9, 0xaa, 0x69, 0xc8, 0x6a, 0xa5, 0x82, 0x6d, 0xd5, 0x36, 0x70, 0x85,
+	0xb8, 0x48, 0x71, 0x8e, 0x31, 0xd0, 0xdd, 0xb2, 0xa9, 0xea, 0x46, 0x85, 0xae, 0xb3, 0xe5, 0xa4,
+	0x8e, 0x75, 0x4c, 0x3f, 0x65, 0xe7, 0x8b, 0x47, 0x3d, 0xb2, 0x3a, 0xae, 0xb9, 0x51, 0xe9, 0x37,
+	0x48, 0xfe, 0xe3, 0xb0, 0xec, 0x5b, 0xd8, 0xc4, 0x44, 0x2d, 0x2b, 0xda, 0x49, 0x55, 0x23, 0x36,
+	0xca, 0x40, 0xc2, 0x64, 0xa1, 0x43, 0xa3, 0x94, 0x12, 0xb2, 0x42, 0x2e, 0xa2, 0x00, 0x0f, 0xfd,
+	0x55, 0x92, 0xf6, 0x60, 0xaa, 0x63, 0x23, 0x31, 0x71, 0x85, 0x68, 0xe8, 0x57, 0x88, 0x71, 0x18,
+	0xdd, 0x96, 0x58, 0x49, 0xe6, 0xdb, 0x99, 0xe5, 0x39, 0xbe, 0x10, 0xb9, 0xb8, 0xca, 0x84, 0x94,
+	0x16, 0x56, 0x7a, 0x1b, 0xee, 0x60, 0x24, 0xdc, 0xcb, 0x0e, 0x7c, 0xd3, 0xf2, 0x42, 0x6c, 0xd5,
+	0xae, 0x12, 0x4a, 0x3c, 0xbe, 0x22, 0xf6, 0x22, 0xfe, 0x97, 0x22, 0x94, 0x71, 0xd3, 0xf7, 0x37,
+	0xfa, 0x13, 0xa2, 0x35, 0x6c, 0x6b, 0x56, 0x2a, 0x9c, 0x15, 0x72, 0xa3, 0x85, 0xe5, 0x8f, 0x57,
+	0x99, 0x25, 0xdd, 0xb0, 0x1f, 0x55, 0x8f, 0xf2, 0x45, 0x7c, 0x2c, 0xb3, 0xe2, 0xb8, 0x3f, 0x4b,
+	0xa4, 0xf4, 0x58, 0xb6, 0xeb, 0xa6, 0x46, 0xf2, 0xdb, 0xc5, 0xe2, 0x76, 0xa9, 0x64, 0x69, 0x84,
+	0x28, 0xee, 0x7e, 0xb4, 0x07, 0xf1, 0x92, 0x66, 0x62, 0x62, 0xd8, 0xd8, 0x4a, 0x0d, 0xdd, 0x97,
+	0xac, 0xcd, 0x81, 0x36, 0x00, 0xda, 0x47, 0x98, 0x8a, 0xd0, 0x92, 0x7d, 0xc7, 0x33, 0x73, 0x1b,
+	0x64, 0x5f, 0xd5, 0x35, 0x56, 0x0d, 0xc5, 0x03, 0x96, 0xce, 0x05, 0x98, 0xee, 0xac, 0x19, 0x3b,
+	0x86, 0x75, 0x88, 0xf3, 0x0a, 0x38, 0xe5, 0x1a, 0xfa, 0xcc, 0x39, 0xb4, 0xc1, 0x68, 0xd3, 0xe7,
+	0x27, 0x4c, 0xfd, 0x88, 0xbd, 0xfc, 0xb8, 0x4a, 0x3e, 0x43, 0xa7, 0x30, 0x41, 0xfd, 0xfc, 0x87,
+	0x6d, 0x6d, 0xd0, 0x56, 0xfa, 0x62, 0x47, 0x23, 0xfd, 0x0e, 0xdf, 0x7a, 0xd4, 0x59, 0x21, 0x7e,
+	0x86, 0x88, 0xb3, 0xca, 0x7a, 0x71, 0xc2, 0x5b, 0x03, 0x07, 0xc7, 0xf2, 0xa7, 0x18, 0x09, 0x7b,
+	0x08, 0xc8, 0xc0, 0xfe, 0x37, 0x7a, 0x14, 0x6c, 0xc0, 0x03, 0x3c, 0x03, 0xe4, 0x15, 0x64, 0x96,
+	0x17, 0xdd, 0x82, 0xf0, 0x73, 0x0b, 0xf2, 0xec, 0x82, 0x1e, 0x74, 0x5e, 0x6b, 0x4c, 0x7f, 0x5f,
+	0xb5, 0xd4, 0x63, 0x5f, 0xc6, 0x34, 0x70, 0xe8, 0x54, 0x9a, 0x66, 0x1c, 0x57, 0xc0, 0x0d, 0x1d,
+	0xd4, 0x4d, 0x4d, 0xba, 0x15, 0x60, 0xd2, 0xb7, 0x8f, 0x19, 0xdf, 0x81, 0xb1, 0x1a, 0xb6, 0x8d,
+	0x8a, 0x7e, 0xe8, 0x82, 0x59, 0xd1, 0x53, 0x1d, 0x09, 0x18, 0x15, 0xdd, 0xdd, 0xc8, 0x12, 0x19,
+	0xad, 0x79, 0x62, 0xe8, 0x0f, 0x18, 0x67, 0x97, 0x83, 0xb3, 0x74, 0x94, 0xd4, 0x61, 0xd9, 0x75,
+	0x11, 0x3e, 0x9a, 0xb1, 0x92, 0x37, 0x88, 0xb6, 0x60, 0xd4, 0x56, 0xcb, 0xe5, 0x3a, 0x67, 0x19,
+	0xa2, 0x2c, 0x33, 0x5e, 0x96, 0x03, 0x67, 0xdd, 0xc7, 0x91, 0xb0, 0xdb, 0x21, 0xe9, 0x39, 0x4f,
+	0x93, 0xa9, 0x0d, 0xdc, 0x11, 0xbe, 0x19, 0x11, 0x7e, 0xf8, 0x8c, 0x90, 0xfe, 0x86, 0xa4, 0xdf,
+	0x08, 0x2b, 0xf8, 0x2a, 0x8c, 0x30, 0x10, 0x2b, 0xf5, 0x64, 0x8f, 0x22, 0xb1, 0xd4, 0x38, 0x52,
+	0xb2, 0xfc, 0x64, 0x5f, 0xa5, 0xd1, 0x5f, 0x08, 0x30, 0xd5, 0x21, 0xca, 0x52, 0x58, 0x83, 0x18,
+	0x33, 0xc6, 0xfb, 0xbd, 0x4f, 0x0e, 0x2d, 0xe8, 0x83, 0xba, 0x7e, 0x13, 0x66, 0xa8, 0x17, 0x7a,
+	0xfc, 0x8a, 0x46, 0xaa, 0x65, 0xfb, 0x0e, 0xef, 0x5e, 0xaa, 0x7b, 0x6f, 0xeb, 0x34, 0xa2, 0xb4,
+	0x7d, 0x52, 0x42, 0x40, 0xab, 0xb9, 0x78, 0x7e, 0x7d, 0x29, 0x76, 0xe5, 0x55, 0x0c, 0xa2, 0x94,
+	0x11, 0x9d, 0x42, 0x8c, 0x4f, 0x65, 0x94, 0xf5, 0xee, 0xed, 0xf5, 0x42, 0x8b, 0xdf, 0xf7, 0x41,
+	0xb8, 0x7e, 0xa4, 0x85, 0xa7, 0xef, 0x6e, 0xdf, 0x84, 0x7f, 0x44, 0xf3, 0xb2, 0xe7, 0xed, 0xe7,
+	0xb9, 0x10, 0xb9, 0xe1, 0xc9, 0xb4, 0x89, 0x4e, 0x20, 0xce, 0x09, 0x08, 0x0a, 0x26, 0xe7, 0xdd,
+	0x22, 0x4a, 0xfd, 0x20, 0xcc, 0xc0, 0x1c, 0x35, 0x30, 0x83, 0xa6, 0x7a, 0x1a, 0x40, 0x67, 0x10,
+	0x71, 0xc6, 0x19, 0x9a, 0xed, 0xa2, 0xf2, 0xbc, 0x1f, 0xe2, 0x5c, 0xc0, 0x2a, 0xd3, 0xd8, 0xa0,
+	0x1a, 0xab, 0x68, 0x79, 0x80, 0x24, 0x65, 0x3a, 0x31, 0xe5, 0x86, 0xf3, 0x63, 0x35, 0x51, 0x1d,
+	0xa2, 0x0e, 0x15, 0x41, 0xbd, 0x25, 0x5a, 0xa9, 0xa6, 0x83, 0x96, 0x99, 0x85, 0x65, 0x6a, 0x61,
+	0x01, 0xfd, 0x34, 0xb0, 0x05, 0x44, 0x60, 0x98, 0x8d, 0xa9, 0x6e, 0x72, 0xdf, 0x30, 0x16, 0x33,
+	0x81, 0xeb, 0x4c, 0x3d, 0x47, 0xd5, 0x25, 0x94, 0xf5, 0xa9, 0x53, 0x8c, 0xdc, 0xf0, 0xcc, 0xf1,
+	0x26, 0x3a, 0x17, 0x60, 0x84, 0xdd, 0x27, 0xd4, 0x4d, 0xeb, 0x1f, 0x72, 0x62, 0x36, 0x18, 0xc0,
+	0x84, 0xb7, 0xa8, 0xf0, 0x26, 0x5a, 0x1f, 0x24, 0x6d, 0x7e, 0x71, 0xe5, 0x46, 0x6b, 0xaa, 0x35,
+	0xd1, 0x33, 0x01, 0x62, 0xbb, 0xfc, 0x46, 0x07, 0x0a, 0x92, 0xe0, 0x96, 0xef, 0x9c, 0x26, 0xd2,
+	0x2f, 0xd4, 0x53, 0x1e, 0x2d, 0xde, 0xc5, 0x13, 0x7a, 0x29, 0x40, 0xc2, 0x73, 0x41, 0xd1, 0x7c,
+	0x97, 0x50, 0xf7, 0xa8, 0x10, 0x7f, 0xe8, 0x0f, 0xba, 0x4f, 0x6f, 0xd0, 0x89, 0x50, 0x28, 0x5c,
+	0x5c, 0xa7, 0x85, 0xcb, 0xeb, 0xb4, 0xf0, 0xe1, 0x3a, 0x2d, 0xbc, 0xbe, 0x49, 0x87, 0x2e, 0x6f,
+	0xd2, 0xa1, 0xf7, 0x37, 0xe9, 0xd0, 0xff, 0xb9, 0xbe, 0x0f, 0xc8, 0x13, 0xca, 0x4d, 0x9f, 0x91,
+	0xa3, 0x61, 0xfa, 0xef, 0xfd, 0xea, 0xa7, 0x01, 0x00, 0x50, 0x03, 0xdb, 0xa1, 0x6d, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestVoteUnMarshalJSON(t *testing.T) {
@@ -34,3 +36,30 @@ func TestVoteUnMarshalJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	tests := []struct {
+		options string
+		expRes  WeightedVoteOptions
+		isError bool
+	}{
+		{"Yes=1", NewNonSplitVoteOption(OptionYes), false},
+		{"Yes=0.6,No=0.4", WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+		}, false},
+		{"Yes", nil, true},
+		{"misc=1", nil, true},
+		{"Yes=misc", nil, true},
+		{"Yes=0.5,", nil, true},
+	}
+	for _, tt := range tests {
+		options, err := WeightedVoteOptionsFromString(tt.options)
+		if tt.isError {
+			require.Error(t, err, tt.options)
+		} else {
+			require.NoError(t, err, tt.options)
+			require.Equal(t, tt.expRes, options)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package ibcaccount
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+// InitGenesis binds to portid from genesis state and imports the registered
+// interchain accounts
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, state types.GenesisState) {
+	keeper.SetPort(ctx, state.PortID)
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !keeper.IsBound(ctx, state.PortID) {
+		// IBC account module binds to the ibcaccount port on InitChain
+		// and claims the returned capability
+		err := keeper.BindPort(ctx, state.PortID)
+		if err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	for _, account := range state.InterchainAccounts {
+		keeper.SetInterchainAccount(ctx, account.PortID, account.ChannelID, account.Address)
+	}
+
+	for _, account := range state.RemoteAccounts {
+		keeper.SetRemoteAccount(ctx, account.PortID, account.ChannelID, account.Address)
+	}
+}
+
+// ExportGenesis exports IBC account module's portID and registered interchain
+// accounts into its genesis state
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
+	interchainAccounts := []types.RegisteredAccount{}
+	keeper.IterateInterchainAccounts(ctx, func(account types.RegisteredAccount) bool {
+		interchainAccounts = append(interchainAccounts, account)
+		return false
+	})
+
+	remoteAccounts := []types.RegisteredAccount{}
+	keeper.IterateRemoteAccounts(ctx, func(account types.RegisteredAccount) bool {
+		remoteAccounts = append(remoteAccounts, account)
+		return false
+	})
+
+	return types.NewGenesisState(keeper.GetPort(ctx), interchainAccounts, remoteAccounts)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+// Implements IBCAccountHooks interface
+var _ types.IBCAccountHooks = Keeper{}
+
+// AfterAccountRegistered - call hook if registered
+func (k Keeper) AfterAccountRegistered(ctx sdk.Context, portID, channelID string, address sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterAccountRegistered(ctx, portID, channelID, address)
+	}
+}
+
+// AfterPacketAcknowledged - call hook if registered
+func (k Keeper) AfterPacketAcknowledged(ctx sdk.Context, packet channeltypes.Packet, ack types.IBCAccountPacketAcknowledgement) {
+	if k.hooks != nil {
+		k.hooks.AfterPacketAcknowledged(ctx, packet, ack)
+	}
+}
+
+// AfterPacketTimeout - call hook if registered
+func (k Keeper) AfterPacketTimeout(ctx sdk.Context, packet channeltypes.Packet) {
+	if k.hooks != nil {
+		k.hooks.AfterPacketTimeout(ctx, packet)
+	}
+}
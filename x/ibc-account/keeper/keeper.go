@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// Keeper defines the IBC account keeper. It registers and controls accounts on
+// counterparty chains, and hosts the accounts counterparty chains control on
+// this chain.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.Marshaler
+
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	accountKeeper types.AccountKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+	router        sdk.Router
+	hooks         types.IBCAccountHooks
+}
+
+// NewKeeper creates a new IBC account Keeper instance. The router is used to
+// execute the messages received from the controller chains, and is usually the
+// BaseApp's router.
+func NewKeeper(
+	cdc codec.Marshaler, key sdk.StoreKey,
+	channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
+	accountKeeper types.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, router sdk.Router,
+) Keeper {
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		accountKeeper: accountKeeper,
+		scopedKeeper:  scopedKeeper,
+		router:        router,
+	}
+}
+
+// SetHooks sets the IBC account hooks run on the controller chain.
+func (k *Keeper) SetHooks(hooks types.IBCAccountHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set IBC account hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// IsBound checks if the IBC account module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the IBC account module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the portID for the IBC account module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// ClaimCapability allows the IBC account module to claim a capability that IBC
+// module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// GetInterchainAccount returns the address of the interchain account hosted on
+// this chain and controlled through the given port and channel.
+func (k Keeper) GetInterchainAccount(ctx sdk.Context, portID, channelID string) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInterchainAccountKey(portID, channelID))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetInterchainAccount stores the address of the interchain account hosted on
+// this chain and controlled through the given port and channel.
+func (k Keeper) SetInterchainAccount(ctx sdk.Context, portID, channelID string, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInterchainAccountKey(portID, channelID), address)
+}
+
+// IterateInterchainAccounts iterates over the interchain accounts hosted on
+// this chain. Iteration stops when the callback returns true.
+func (k Keeper) IterateInterchainAccounts(ctx sdk.Context, cb func(account types.RegisteredAccount) (stop bool)) {
+	k.iterateAccounts(ctx, types.InterchainAccountKeyPrefix, cb)
+}
+
+// GetRemoteAccount returns the address of the interchain account registered
+// on the counterparty chain through the given port and channel.
+func (k Keeper) GetRemoteAccount(ctx sdk.Context, portID, channelID string) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRemoteAccountKey(portID, channelID))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetRemoteAccount stores the address of the interchain account registered on
+// the counterparty chain through the given port and channel.
+func (k Keeper) SetRemoteAccount(ctx sdk.Context, portID, channelID string, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRemoteAccountKey(portID, channelID), address)
+}
+
+// IterateRemoteAccounts iterates over the interchain accounts registered on
+// counterparty chains. Iteration stops when the callback returns true.
+func (k Keeper) IterateRemoteAccounts(ctx sdk.Context, cb func(account types.RegisteredAccount) (stop bool)) {
+	k.iterateAccounts(ctx, types.RemoteAccountKeyPrefix, cb)
+}
+
+func (k Keeper) iterateAccounts(ctx sdk.Context, prefix []byte, cb func(account types.RegisteredAccount) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseAccountKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(types.NewRegisteredAccount(portID, channelID, iterator.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+const timeoutHeight = 1000
+
+// KeeperTestSuite is a testing suite to test keeper functions. Chain A acts as
+// the controller chain and chain B hosts the interchain accounts.
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	clientA, clientB   string
+	channelA, channelB ibctesting.TestChannel
+}
+
+// TestKeeperTestSuite runs all the tests within this package.
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// SetupTest creates a coordinator with 2 test chains connected by an ORDERED
+// interchain account channel.
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	clientA, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, clientexported.Tendermint)
+	suite.clientA, suite.clientB = clientA, clientB
+	suite.channelA, suite.channelB = suite.coordinator.CreateChannelOnPort(
+		suite.chainA, suite.chainB, connA, connB, types.PortID, types.Version, channeltypes.ORDERED,
+	)
+}
+
+// packet returns the packet sent by chain A with the given data and sequence.
+func (suite *KeeperTestSuite) packet(data types.IBCAccountPacketData, sequence uint64) channeltypes.Packet {
+	bz, err := suite.chainA.App.AppCodec().MarshalBinaryBare(&data)
+	suite.Require().NoError(err)
+
+	return channeltypes.NewPacket(
+		bz, sequence,
+		suite.channelA.PortID, suite.channelA.ID,
+		suite.channelB.PortID, suite.channelB.ID,
+		timeoutHeight, 0,
+	)
+}
+
+// relay relays the packet sent by chain A to chain B and its acknowledgement
+// back to chain A.
+func (suite *KeeperTestSuite) relay(packet channeltypes.Packet, ack types.IBCAccountPacketAcknowledgement) {
+	// commit the packet sent on chain A and update its client on chain B
+	suite.coordinator.CommitBlock(suite.chainA)
+	err := suite.coordinator.UpdateClient(suite.chainB, suite.chainA, suite.clientB, clientexported.Tendermint)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.RecvPacket(suite.chainB, suite.chainA, packet, suite.clientA)
+	suite.Require().NoError(err)
+
+	ackBz, err := suite.chainB.App.AppCodec().MarshalBinaryBare(&ack)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.AcknowledgePacket(suite.chainA, suite.chainB, packet, ackBz, suite.clientB)
+	suite.Require().NoError(err)
+}
+
+// registerAccount registers an interchain account on chain B controlled by
+// chain A and returns its address.
+func (suite *KeeperTestSuite) registerAccount() sdk.AccAddress {
+	sequence, err := suite.chainA.App.IBCAccountKeeper.RegisterIBCAccount(
+		suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID, timeoutHeight, 0,
+	)
+	suite.Require().NoError(err)
+
+	address := types.GenerateAddress(suite.channelB.PortID, suite.channelB.ID)
+	suite.relay(
+		suite.packet(types.NewRegisterPacketData(), sequence),
+		types.NewSuccessAcknowledgement(types.REGISTER, address),
+	)
+
+	return address
+}
+
+func (suite *KeeperTestSuite) TestGenerateAddress() {
+	address := types.GenerateAddress(suite.channelB.PortID, suite.channelB.ID)
+	suite.Require().NotEmpty(address)
+	suite.Require().NotEqual(address, types.GenerateAddress(suite.channelB.PortID, ibctesting.InvalidID))
+	suite.Require().NotEqual(address, types.GenerateAddress(ibctesting.TransferPort, suite.channelB.ID))
+}
+
+func (suite *KeeperTestSuite) TestIterateAccounts() {
+	k := suite.chainA.App.IBCAccountKeeper
+	ctx := suite.chainA.GetContext()
+
+	expected := []types.RegisteredAccount{
+		types.NewRegisteredAccount(types.PortID, "channel-0", sdk.AccAddress("address0")),
+		types.NewRegisteredAccount(types.PortID, "channel-1", sdk.AccAddress("address1")),
+	}
+	for _, account := range expected {
+		k.SetInterchainAccount(ctx, account.PortID, account.ChannelID, account.Address)
+	}
+	k.SetRemoteAccount(ctx, types.PortID, "channel-2", sdk.AccAddress("address2"))
+
+	var accounts []types.RegisteredAccount
+	k.IterateInterchainAccounts(ctx, func(account types.RegisteredAccount) bool {
+		accounts = append(accounts, account)
+		return false
+	})
+	suite.Require().Equal(expected, accounts)
+
+	accounts = nil
+	k.IterateRemoteAccounts(ctx, func(account types.RegisteredAccount) bool {
+		accounts = append(accounts, account)
+		return false
+	})
+	suite.Require().Equal([]types.RegisteredAccount{
+		types.NewRegisteredAccount(types.PortID, "channel-2", sdk.AccAddress("address2")),
+	}, accounts)
+}
@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// RegisterIBCAccount sends a packet requesting the counterparty chain to
+// register an interchain account controlled through the given channel. The
+// address of the account is stored once the packet is acknowledged. The
+// sequence of the sent packet is returned.
+func (k Keeper) RegisterIBCAccount(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	if _, found := k.GetRemoteAccount(ctx, sourcePort, sourceChannel); found {
+		return 0, sdkerrors.Wrapf(types.ErrAccountAlreadyExist, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	return k.sendPacket(ctx, sourcePort, sourceChannel, types.NewRegisterPacketData(), timeoutHeight, timeoutTimestamp)
+}
+
+// RunTx sends a packet requesting the counterparty chain to execute the
+// messages with the interchain account controlled through the given channel.
+// The messages must be signed only by the interchain account. The sequence of
+// the sent packet is returned.
+func (k Keeper) RunTx(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	msgs []sdk.Msg,
+	timeoutHeight,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	address, found := k.GetRemoteAccount(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrAccountNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if err := validateSigners(address, msgs); err != nil {
+		return 0, err
+	}
+
+	data, err := types.NewRunTxPacketData(msgs)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.sendPacket(ctx, sourcePort, sourceChannel, data, timeoutHeight, timeoutTimestamp)
+}
+
+func (k Keeper) sendPacket(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	data types.IBCAccountPacketData,
+	timeoutHeight,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&data)
+	if err != nil {
+		return 0, err
+	}
+
+	packet := channeltypes.NewPacket(
+		bz,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnRecvPacket executes the packet sent by the controller chain on the host
+// chain and returns the result data of the execution. The state changes are
+// only committed if the execution succeeds.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IBCAccountPacketData) ([]byte, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	var (
+		result []byte
+		err    error
+	)
+
+	switch data.Type {
+	case types.REGISTER:
+		var address sdk.AccAddress
+		address, err = k.registerInterchainAccount(cacheCtx, packet.GetDestPort(), packet.GetDestChannel())
+		result = address
+
+	case types.RUNTX:
+		result, err = k.executeTx(cacheCtx, packet.GetDestPort(), packet.GetDestChannel(), data)
+
+	default:
+		err = sdkerrors.Wrapf(types.ErrUnknownPacketType, "%s", data.Type)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return result, nil
+}
+
+// OnAcknowledgementPacket stores the address of the interchain account once
+// its registration is acknowledged, and runs the controller hooks.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context, packet channeltypes.Packet, data types.IBCAccountPacketData, ack types.IBCAccountPacketAcknowledgement,
+) error {
+	if ack.Type != data.Type {
+		return sdkerrors.Wrapf(types.ErrUnknownPacketType, "acknowledgement type %s doesn't match packet type %s", ack.Type, data.Type)
+	}
+
+	if ack.Success && ack.Type == types.REGISTER {
+		address := sdk.AccAddress(ack.Data)
+		if address.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty interchain account address")
+		}
+
+		k.SetRemoteAccount(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), address)
+		k.AfterAccountRegistered(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), address)
+	}
+
+	k.AfterPacketAcknowledged(ctx, packet, ack)
+	return nil
+}
+
+// OnTimeoutPacket runs the controller hooks of a packet which timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.IBCAccountPacketData) error {
+	k.AfterPacketTimeout(ctx, packet)
+	return nil
+}
+
+// registerInterchainAccount creates the interchain account controlled through
+// the given channel. Only one account can be registered per channel.
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, portID, channelID string) (sdk.AccAddress, error) {
+	if _, found := k.GetInterchainAccount(ctx, portID, channelID); found {
+		return nil, sdkerrors.Wrapf(types.ErrAccountAlreadyExist, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	address := types.GenerateAddress(portID, channelID)
+
+	// an account may already exist at the address if it received funds before
+	// its registration. It is adopted as long as nobody controls it.
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if account == nil {
+		account = k.accountKeeper.NewAccountWithAddress(ctx, address)
+		k.accountKeeper.SetAccount(ctx, account)
+	} else if account.GetPubKey() != nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountAlreadyExist, "account %s is controlled by a public key", address)
+	}
+
+	k.SetInterchainAccount(ctx, portID, channelID, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterAccount,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	return address, nil
+}
+
+// executeTx executes the messages with the interchain account controlled
+// through the given channel via the router, and returns the proto encoded
+// sdk.TxMsgData holding their results.
+func (k Keeper) executeTx(ctx sdk.Context, portID, channelID string, data types.IBCAccountPacketData) ([]byte, error) {
+	address, found := k.GetInterchainAccount(ctx, portID, channelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	msgs, err := data.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateSigners(address, msgs); err != nil {
+		return nil, err
+	}
+
+	txData := &sdk.TxMsgData{
+		Data: make([]*sdk.MsgData, 0, len(msgs)),
+	}
+
+	for i, msg := range msgs {
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		msgResult, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		txData.Data = append(txData.Data, &sdk.MsgData{MsgType: msg.Type(), Data: msgResult.Data})
+		txData.MsgResponses = append(txData.MsgResponses, msgResult.MsgResponses...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRunTx,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	bz, err := proto.Marshal(txData)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal execution data")
+	}
+
+	return bz, nil
+}
+
+// validateSigners checks that the interchain account is the only signer of
+// the messages.
+func validateSigners(address sdk.AccAddress, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(address) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %d signer %s is not the interchain account %s", i, signer, address)
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalPacketData decodes the IBC account packet data, unpacking the
+// messages it carries.
+func (k Keeper) UnmarshalPacketData(bz []byte) (types.IBCAccountPacketData, error) {
+	var data types.IBCAccountPacketData
+	if err := k.cdc.UnmarshalBinaryBare(bz, &data); err != nil {
+		return types.IBCAccountPacketData{}, err
+	}
+	return data, nil
+}
+
+// UnmarshalAcknowledgement decodes the IBC account packet acknowledgement.
+func (k Keeper) UnmarshalAcknowledgement(bz []byte) (types.IBCAccountPacketAcknowledgement, error) {
+	var ack types.IBCAccountPacketAcknowledgement
+	if err := k.cdc.UnmarshalBinaryBare(bz, &ack); err != nil {
+		return types.IBCAccountPacketAcknowledgement{}, err
+	}
+	return ack, nil
+}
+
+// MarshalAcknowledgement encodes the IBC account packet acknowledgement.
+func (k Keeper) MarshalAcknowledgement(ack types.IBCAccountPacketAcknowledgement) []byte {
+	return k.cdc.MustMarshalBinaryBare(&ack)
+}
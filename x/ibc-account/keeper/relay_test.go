@@ -0,0 +1,301 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+var testCoins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+// mockHooks records the calls of the IBC account hooks.
+type mockHooks struct {
+	registered []sdk.AccAddress
+	acks       []types.IBCAccountPacketAcknowledgement
+	timeouts   []channeltypes.Packet
+}
+
+func (h *mockHooks) AfterAccountRegistered(_ sdk.Context, _, _ string, address sdk.AccAddress) {
+	h.registered = append(h.registered, address)
+}
+
+func (h *mockHooks) AfterPacketAcknowledged(_ sdk.Context, _ channeltypes.Packet, ack types.IBCAccountPacketAcknowledgement) {
+	h.acks = append(h.acks, ack)
+}
+
+func (h *mockHooks) AfterPacketTimeout(_ sdk.Context, packet channeltypes.Packet) {
+	h.timeouts = append(h.timeouts, packet)
+}
+
+func (suite *KeeperTestSuite) TestRegisterIBCAccount() {
+	address := suite.registerAccount()
+
+	// the account is created on the host chain
+	account := suite.chainB.App.AccountKeeper.GetAccount(suite.chainB.GetContext(), address)
+	suite.Require().NotNil(account)
+	suite.Require().Nil(account.GetPubKey())
+
+	hosted, found := suite.chainB.App.IBCAccountKeeper.GetInterchainAccount(suite.chainB.GetContext(), suite.channelB.PortID, suite.channelB.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(address, hosted)
+
+	// the address is stored on the controller chain once acknowledged
+	remote, found := suite.chainA.App.IBCAccountKeeper.GetRemoteAccount(suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(address, remote)
+
+	// only one account can be registered per channel
+	_, err := suite.chainA.App.IBCAccountKeeper.RegisterIBCAccount(
+		suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID, timeoutHeight, 0,
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestRegisterIBCAccountNoChannel() {
+	_, err := suite.chainA.App.IBCAccountKeeper.RegisterIBCAccount(
+		suite.chainA.GetContext(), suite.channelA.PortID, "invalidchannel", timeoutHeight, 0,
+	)
+	suite.Require().Error(err)
+
+	// the channel capability is owned by the IBC account module only
+	_, err = suite.chainA.App.IBCAccountKeeper.RegisterIBCAccount(
+		suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID, timeoutHeight, 0,
+	)
+	suite.Require().NoError(err)
+	_, ok := suite.chainA.App.ScopedTransferKeeper.GetCapability(
+		suite.chainA.GetContext(), host.ChannelCapabilityPath(suite.channelA.PortID, suite.channelA.ID),
+	)
+	suite.Require().False(ok)
+}
+
+func (suite *KeeperTestSuite) TestRunTx() {
+	address := suite.registerAccount()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	ctxB := suite.chainB.GetContext()
+	err := suite.chainB.App.BankKeeper.SetBalances(ctxB, address, testCoins)
+	suite.Require().NoError(err)
+	balance := suite.chainB.App.BankKeeper.GetAllBalances(ctxB, receiver)
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(address, receiver, testCoins)}
+	sequence, err := suite.chainA.App.IBCAccountKeeper.RunTx(
+		suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID, msgs, timeoutHeight, 0,
+	)
+	suite.Require().NoError(err)
+
+	data, err := types.NewRunTxPacketData(msgs)
+	suite.Require().NoError(err)
+
+	msgResponse, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	suite.Require().NoError(err)
+
+	txData, err := proto.Marshal(&sdk.TxMsgData{
+		Data:         []*sdk.MsgData{{MsgType: banktypes.TypeMsgSend}},
+		MsgResponses: []*codectypes.Any{msgResponse},
+	})
+	suite.Require().NoError(err)
+
+	suite.relay(suite.packet(data, sequence), types.NewSuccessAcknowledgement(types.RUNTX, txData))
+
+	ctxB = suite.chainB.GetContext()
+	suite.Require().True(suite.chainB.App.BankKeeper.GetAllBalances(ctxB, address).IsZero())
+	suite.Require().Equal(balance.Add(testCoins...), suite.chainB.App.BankKeeper.GetAllBalances(ctxB, receiver))
+}
+
+func (suite *KeeperTestSuite) TestRunTxInvalid() {
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	msgs := []sdk.Msg{banktypes.NewMsgSend(receiver, receiver, testCoins)}
+
+	// no account registered yet
+	_, err := suite.chainA.App.IBCAccountKeeper.RunTx(
+		suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID, msgs, timeoutHeight, 0,
+	)
+	suite.Require().Error(err)
+
+	address := suite.registerAccount()
+
+	testCases := []struct {
+		msg  string
+		msgs []sdk.Msg
+	}{
+		{"no messages", []sdk.Msg{}},
+		{"message not signed by the interchain account", msgs},
+		{"invalid message", []sdk.Msg{banktypes.NewMsgSend(address, receiver, sdk.Coins{})}},
+	}
+
+	for _, tc := range testCases {
+		_, err := suite.chainA.App.IBCAccountKeeper.RunTx(
+			suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID, tc.msgs, timeoutHeight, 0,
+		)
+		suite.Require().Error(err, tc.msg)
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		address sdk.AccAddress
+		data    types.IBCAccountPacketData
+	)
+
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"register account", func() {
+			data = types.NewRegisterPacketData()
+		}, true},
+		{"account already registered", func() {
+			suite.chainB.App.IBCAccountKeeper.SetInterchainAccount(
+				suite.chainB.GetContext(), suite.channelB.PortID, suite.channelB.ID, address,
+			)
+			data = types.NewRegisterPacketData()
+		}, false},
+		{"account controlled by a public key", func() {
+			account := suite.chainB.App.AccountKeeper.NewAccountWithAddress(suite.chainB.GetContext(), address)
+			suite.Require().NoError(account.SetPubKey(suite.chainB.SenderAccount.GetPubKey()))
+			suite.chainB.App.AccountKeeper.SetAccount(suite.chainB.GetContext(), account)
+			data = types.NewRegisterPacketData()
+		}, false},
+		{"run tx", func() {
+			suite.chainB.App.IBCAccountKeeper.SetInterchainAccount(
+				suite.chainB.GetContext(), suite.channelB.PortID, suite.channelB.ID, address,
+			)
+			err := suite.chainB.App.BankKeeper.SetBalances(suite.chainB.GetContext(), address, testCoins)
+			suite.Require().NoError(err)
+
+			data, err = types.NewRunTxPacketData([]sdk.Msg{banktypes.NewMsgSend(address, receiver, testCoins)})
+			suite.Require().NoError(err)
+		}, true},
+		{"run tx without registered account", func() {
+			var err error
+			data, err = types.NewRunTxPacketData([]sdk.Msg{banktypes.NewMsgSend(address, receiver, testCoins)})
+			suite.Require().NoError(err)
+		}, false},
+		{"run tx signed by another account", func() {
+			suite.chainB.App.IBCAccountKeeper.SetInterchainAccount(
+				suite.chainB.GetContext(), suite.channelB.PortID, suite.channelB.ID, address,
+			)
+
+			var err error
+			data, err = types.NewRunTxPacketData([]sdk.Msg{banktypes.NewMsgSend(receiver, address, testCoins)})
+			suite.Require().NoError(err)
+		}, false},
+		{"run tx with failing message", func() {
+			suite.chainB.App.IBCAccountKeeper.SetInterchainAccount(
+				suite.chainB.GetContext(), suite.channelB.PortID, suite.channelB.ID, address,
+			)
+			err := suite.chainB.App.BankKeeper.SetBalances(suite.chainB.GetContext(), address, testCoins)
+			suite.Require().NoError(err)
+
+			// the second message fails due to insufficient funds, reverting the first one
+			data, err = types.NewRunTxPacketData([]sdk.Msg{
+				banktypes.NewMsgSend(address, receiver, testCoins),
+				banktypes.NewMsgSend(address, receiver, testCoins),
+			})
+			suite.Require().NoError(err)
+		}, false},
+		{"unknown packet type", func() {
+			data = types.IBCAccountPacketData{Type: types.UNSPECIFIED}
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			address = types.GenerateAddress(suite.channelB.PortID, suite.channelB.ID)
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			balance := suite.chainB.App.BankKeeper.GetAllBalances(ctx, address)
+
+			packet := channeltypes.NewPacket(
+				nil, 1,
+				suite.channelA.PortID, suite.channelA.ID,
+				suite.channelB.PortID, suite.channelB.ID,
+				timeoutHeight, 0,
+			)
+			_, err := suite.chainB.App.IBCAccountKeeper.OnRecvPacket(ctx, packet, data)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				return
+			}
+
+			suite.Require().Error(err)
+			// state changes are reverted on failure
+			suite.Require().Equal(balance, suite.chainB.App.BankKeeper.GetAllBalances(ctx, address))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	address := types.GenerateAddress(suite.channelB.PortID, suite.channelB.ID)
+	packet := suite.packet(types.NewRegisterPacketData(), 1)
+
+	testCases := []struct {
+		msg           string
+		data          types.IBCAccountPacketData
+		ack           types.IBCAccountPacketAcknowledgement
+		expRegistered bool
+		expPass       bool
+	}{
+		{"registration succeeded", types.NewRegisterPacketData(), types.NewSuccessAcknowledgement(types.REGISTER, address), true, true},
+		{"registration failed", types.NewRegisterPacketData(), types.NewErrorAcknowledgement(types.REGISTER, types.ErrAccountAlreadyExist), false, true},
+		{"run tx failed", types.IBCAccountPacketData{Type: types.RUNTX}, types.NewErrorAcknowledgement(types.RUNTX, types.ErrAccountNotFound), false, true},
+		{"empty address", types.NewRegisterPacketData(), types.NewSuccessAcknowledgement(types.REGISTER, nil), false, false},
+		{"mismatched ack type", types.NewRegisterPacketData(), types.NewSuccessAcknowledgement(types.RUNTX, nil), false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			hooks := &mockHooks{}
+			k := suite.chainA.App.IBCAccountKeeper
+			k.SetHooks(hooks)
+
+			ctx := suite.chainA.GetContext()
+			err := k.OnAcknowledgementPacket(ctx, packet, tc.data, tc.ack)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]types.IBCAccountPacketAcknowledgement{tc.ack}, hooks.acks)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			remote, found := k.GetRemoteAccount(ctx, suite.channelA.PortID, suite.channelA.ID)
+			suite.Require().Equal(tc.expRegistered, found)
+			if tc.expRegistered {
+				suite.Require().Equal(address, remote)
+				suite.Require().Equal([]sdk.AccAddress{address}, hooks.registered)
+			} else {
+				suite.Require().Empty(hooks.registered)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	hooks := &mockHooks{}
+	k := suite.chainA.App.IBCAccountKeeper
+	k.SetHooks(hooks)
+
+	packet := suite.packet(types.NewRegisterPacketData(), 1)
+	err := k.OnTimeoutPacket(suite.chainA.GetContext(), packet, types.NewRegisterPacketData())
+	suite.Require().NoError(err)
+	suite.Require().Equal([]channeltypes.Packet{packet}, hooks.timeouts)
+}
@@ -0,0 +1,1013 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/account/account.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Type defines the action requested by an interchain account packet.
+type Type int32
+
+const (
+	// zero-value for the packet type
+	UNSPECIFIED Type = 0
+	// requests the host chain to register an interchain account for the channel.
+	REGISTER Type = 1
+	// requests the host chain to execute the messages with the interchain account
+	// registered for the channel.
+	RUNTX Type = 2
+)
+
+var Type_name = map[int32]string{
+	0: "TYPE_UNSPECIFIED",
+	1: "TYPE_REGISTER",
+	2: "TYPE_RUNTX",
+}
+
+var Type_value = map[string]int32{
+	"TYPE_UNSPECIFIED": 0,
+	"TYPE_REGISTER":    1,
+	"TYPE_RUNTX":       2,
+}
+
+func (x Type) String() string {
+	return proto.EnumName(Type_name, int32(x))
+}
+
+func (Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_be5ed7ee65e0e021, []int{0}
+}
+
+// IBCAccountPacketData defines the packet payload sent by the controller chain
+// to the host chain.
+type IBCAccountPacketData struct {
+	Type Type `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.account.Type" json:"type,omitempty"`
+	// the messages to execute on the host chain, only used for RUNTX packets.
+	Msgs []*types.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *IBCAccountPacketData) Reset()         { *m = IBCAccountPacketData{} }
+func (m *IBCAccountPacketData) String() string { return proto.CompactTextString(m) }
+func (*IBCAccountPacketData) ProtoMessage()    {}
+func (*IBCAccountPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be5ed7ee65e0e021, []int{0}
+}
+func (m *IBCAccountPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCAccountPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCAccountPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCAccountPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCAccountPacketData.Merge(m, src)
+}
+func (m *IBCAccountPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCAccountPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCAccountPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCAccountPacketData proto.InternalMessageInfo
+
+func (m *IBCAccountPacketData) GetType() Type {
+	if m != nil {
+		return m.Type
+	}
+	return UNSPECIFIED
+}
+
+func (m *IBCAccountPacketData) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// IBCAccountPacketAcknowledgement contains the result of the execution of an
+// interchain account packet on the host chain. The error msg is empty on success.
+type IBCAccountPacketAcknowledgement struct {
+	Type    Type `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.account.Type" json:"type,omitempty"`
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// data is the address of the interchain account for REGISTER packets and the
+	// proto encoded sdk.TxData holding the messages results for RUNTX packets.
+	Data  []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *IBCAccountPacketAcknowledgement) Reset()         { *m = IBCAccountPacketAcknowledgement{} }
+func (m *IBCAccountPacketAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*IBCAccountPacketAcknowledgement) ProtoMessage()    {}
+func (*IBCAccountPacketAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be5ed7ee65e0e021, []int{1}
+}
+func (m *IBCAccountPacketAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCAccountPacketAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCAccountPacketAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCAccountPacketAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCAccountPacketAcknowledgement.Merge(m, src)
+}
+func (m *IBCAccountPacketAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCAccountPacketAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCAccountPacketAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCAccountPacketAcknowledgement proto.InternalMessageInfo
+
+func (m *IBCAccountPacketAcknowledgement) GetType() Type {
+	if m != nil {
+		return m.Type
+	}
+	return UNSPECIFIED
+}
+
+func (m *IBCAccountPacketAcknowledgement) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *IBCAccountPacketAcknowledgement) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *IBCAccountPacketAcknowledgement) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// RegisteredAccount associates the address of an interchain account with the
+// port and channel it is controlled through. It is used in genesis.
+type RegisteredAccount struct {
+	PortID    string                                        `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelID string                                        `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Address   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+}
+
+func (m *RegisteredAccount) Reset()         { *m = RegisteredAccount{} }
+func (m *RegisteredAccount) String() string { return proto.CompactTextString(m) }
+func (*RegisteredAccount) ProtoMessage()    {}
+func (*RegisteredAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be5ed7ee65e0e021, []int{2}
+}
+func (m *RegisteredAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredAccount.Merge(m, src)
+}
+func (m *RegisteredAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredAccount proto.InternalMessageInfo
+
+func (m *RegisteredAccount) GetPortID() string {
+	if m != nil {
+		return m.PortID
+	}
+	return ""
+}
+
+func (m *RegisteredAccount) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *RegisteredAccount) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ibc.account.Type", Type_name, Type_value)
+	proto.RegisterType((*IBCAccountPacketData)(nil), "ibc.account.IBCAccountPacketData")
+	proto.RegisterType((*IBCAccountPacketAcknowledgement)(nil), "ibc.account.IBCAccountPacketAcknowledgement")
+	proto.RegisterType((*RegisteredAccount)(nil), "ibc.account.RegisteredAccount")
+}
+
+func init() { proto.RegisterFile("ibc/account/account.proto", fileDescriptor_be5ed7ee65e0e021) }
+
+var fileDescriptor_be5ed7ee65e0e021 = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0x6f, 0x76, 0xb3, 0xdb, 0xed, 0x74, 0x5d, 0xdb, 0xa1, 0x87, 0x36, 0x48, 0x12, 0x02, 0x0b,
+	0x45, 0x68, 0xa2, 0x2b, 0x7b, 0xf1, 0x96, 0xfe, 0x51, 0xa2, 0xb8, 0x94, 0xd9, 0x2e, 0xa8, 0x97,
+	0x92, 0x4c, 0xc6, 0x6c, 0x68, 0x9b, 0x29, 0x99, 0x29, 0xda, 0x6f, 0x20, 0xc5, 0x83, 0x5f, 0xa0,
+	0x27, 0xbf, 0x82, 0x1f, 0x42, 0x3c, 0xed, 0x51, 0x3c, 0x14, 0x69, 0xbf, 0x81, 0x47, 0x4f, 0x92,
+	0x99, 0x04, 0x17, 0x0f, 0xe2, 0x69, 0xde, 0xef, 0xbd, 0xdf, 0x6f, 0xde, 0xef, 0xcd, 0x3c, 0xd0,
+	0x8a, 0x03, 0xec, 0xf8, 0x18, 0xd3, 0x45, 0xc2, 0x8b, 0xd3, 0x9e, 0xa7, 0x94, 0x53, 0x58, 0x8d,
+	0x03, 0x6c, 0xe7, 0x29, 0xad, 0x11, 0xd1, 0x88, 0x8a, 0xbc, 0x93, 0x45, 0x92, 0xa2, 0xb5, 0x30,
+	0x65, 0x33, 0xca, 0xc6, 0xb2, 0x20, 0x41, 0x51, 0x8a, 0x28, 0x8d, 0xa6, 0xc4, 0x11, 0x28, 0x58,
+	0xbc, 0x71, 0xfc, 0x64, 0x29, 0x4b, 0x16, 0x07, 0x0d, 0xaf, 0xdb, 0x73, 0xe5, 0xcd, 0x43, 0x1f,
+	0x4f, 0x08, 0xef, 0xfb, 0xdc, 0x87, 0xa7, 0x40, 0xe5, 0xcb, 0x39, 0x69, 0x2a, 0xa6, 0xd2, 0x3e,
+	0x39, 0xab, 0xdb, 0xb7, 0xfa, 0xdb, 0xa3, 0xe5, 0x9c, 0x20, 0x51, 0x86, 0xe7, 0x40, 0x9d, 0xb1,
+	0x88, 0x35, 0xf7, 0xcc, 0xfd, 0x76, 0xf5, 0xac, 0x61, 0xcb, 0x46, 0x76, 0xd1, 0xc8, 0x76, 0x93,
+	0x65, 0xb7, 0xfa, 0xf5, 0x73, 0xa7, 0xcc, 0xc2, 0x89, 0xfd, 0x82, 0x45, 0x48, 0xd0, 0xad, 0x0f,
+	0x0a, 0x30, 0xfe, 0x6e, 0xeb, 0xe2, 0x49, 0x42, 0xdf, 0x4e, 0x49, 0x18, 0x91, 0x19, 0x49, 0xf8,
+	0xff, 0x3a, 0x68, 0x82, 0x32, 0x5b, 0x60, 0x4c, 0x58, 0x66, 0x42, 0x69, 0x1f, 0xa1, 0x02, 0x42,
+	0x08, 0xd4, 0xd0, 0xe7, 0x7e, 0x73, 0xdf, 0x54, 0xda, 0xc7, 0x48, 0xc4, 0xb0, 0x01, 0x0e, 0x48,
+	0x9a, 0xd2, 0xb4, 0xa9, 0x9a, 0x4a, 0xbb, 0x82, 0x24, 0xb0, 0xbe, 0x2b, 0xa0, 0x8e, 0x48, 0x14,
+	0x33, 0x4e, 0x52, 0x12, 0xe6, 0xae, 0xe0, 0x39, 0x28, 0xcf, 0x69, 0xca, 0xc7, 0x71, 0x28, 0x3c,
+	0x54, 0xba, 0xf7, 0xb6, 0x1b, 0xe3, 0x70, 0x48, 0x53, 0xee, 0xf5, 0x7f, 0x6e, 0x8c, 0x93, 0xa5,
+	0x3f, 0x9b, 0x3e, 0xb6, 0x72, 0x8a, 0x85, 0x0e, 0xb3, 0xc8, 0x0b, 0xa1, 0x0b, 0x00, 0xbe, 0xf6,
+	0x93, 0x84, 0x4c, 0x33, 0xe5, 0x9e, 0x50, 0x5a, 0xdb, 0x8d, 0x51, 0xe9, 0xc9, 0xac, 0x10, 0xd7,
+	0xa5, 0xf8, 0x0f, 0xd1, 0x42, 0x95, 0x1c, 0x78, 0x21, 0x7c, 0x0e, 0xca, 0x7e, 0x18, 0xa6, 0xd9,
+	0x4c, 0xc2, 0x7c, 0xf7, 0xe1, 0xaf, 0x8d, 0xd1, 0x89, 0x62, 0x7e, 0xbd, 0x08, 0x6c, 0x4c, 0x67,
+	0xf9, 0xef, 0xe6, 0x47, 0x87, 0x85, 0x13, 0x27, 0x7b, 0x0b, 0x66, 0xbb, 0x18, 0xbb, 0x52, 0x88,
+	0x8a, 0x1b, 0xee, 0x53, 0xa0, 0x66, 0xcf, 0x05, 0x4f, 0x41, 0x6d, 0xf4, 0x6a, 0x38, 0x18, 0x5f,
+	0x5d, 0x5c, 0x0e, 0x07, 0x3d, 0xef, 0x89, 0x37, 0xe8, 0xd7, 0x4a, 0xda, 0xdd, 0xd5, 0xda, 0xac,
+	0xde, 0x4a, 0x41, 0x03, 0xdc, 0x11, 0x34, 0x34, 0x78, 0xea, 0x5d, 0x8e, 0x06, 0xa8, 0xa6, 0x68,
+	0xc7, 0xab, 0xb5, 0x79, 0x54, 0x60, 0xd8, 0x02, 0x40, 0x12, 0xae, 0x2e, 0x46, 0x2f, 0x6b, 0x7b,
+	0x5a, 0x65, 0xb5, 0x36, 0x0f, 0x04, 0xd0, 0xd4, 0xf7, 0x9f, 0xf4, 0x52, 0xf7, 0xd9, 0x97, 0xad,
+	0xae, 0xdc, 0x6c, 0x75, 0xe5, 0xc7, 0x56, 0x57, 0x3e, 0xee, 0xf4, 0xd2, 0xcd, 0x4e, 0x2f, 0x7d,
+	0xdb, 0xe9, 0xa5, 0xd7, 0x0f, 0xfe, 0x39, 0xc2, 0x3b, 0x27, 0x0e, 0x70, 0xa7, 0xd8, 0x7f, 0x31,
+	0x50, 0x70, 0x28, 0x36, 0xe9, 0xd1, 0xef, 0x01, 0x00, 0xde, 0x9f, 0x09, 0xd1, 0x1b, 0x03, 0x00,
+	0x00,
+}
+
+func (m *IBCAccountPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCAccountPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCAccountPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCAccountPacketAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCAccountPacketAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCAccountPacketAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortID) > 0 {
+		i -= len(m.PortID)
+		copy(dAtA[i:], m.PortID)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.PortID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IBCAccountPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovAccount(uint64(m.Type))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IBCAccountPacketAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovAccount(uint64(m.Type))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortID)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func sovAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccount(x uint64) (n int) {
+	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IBCAccountPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCAccountPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCAccountPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCAccountPacketAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCAccountPacketAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCAccountPacketAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccount = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC account sentinel errors
+var (
+	ErrInvalidVersion          = sdkerrors.Register(ModuleName, 2, "invalid ICS27 version")
+	ErrInvalidChannelOrder     = sdkerrors.Register(ModuleName, 3, "invalid channel ordering")
+	ErrUnknownPacketType       = sdkerrors.Register(ModuleName, 4, "unknown packet type")
+	ErrAccountAlreadyExist     = sdkerrors.Register(ModuleName, 5, "interchain account already exists")
+	ErrAccountNotFound         = sdkerrors.Register(ModuleName, 6, "interchain account not found")
+	ErrInvalidOutgoingMessages = sdkerrors.Register(ModuleName, 7, "invalid outgoing messages")
+)
@@ -0,0 +1,14 @@
+package types
+
+// IBC account events
+const (
+	EventTypeTimeout         = "timeout"
+	EventTypePacket          = "ibc_account_packet"
+	EventTypeRegisterAccount = "register_ibc_account"
+	EventTypeRunTx           = "run_ibc_account_tx"
+
+	AttributeKeyPacketType = "packet_type"
+	AttributeKeyAddress    = "address"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAckError   = "error"
+)
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// GenesisState defines the IBC account genesis state
+type GenesisState struct {
+	PortID string `json:"port_id" yaml:"port_id"`
+	// accounts hosted on this chain and controlled by counterparty chains
+	InterchainAccounts []RegisteredAccount `json:"interchain_accounts" yaml:"interchain_accounts"`
+	// accounts hosted on counterparty chains and controlled by this chain
+	RemoteAccounts []RegisteredAccount `json:"remote_accounts" yaml:"remote_accounts"`
+}
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(portID string, interchainAccounts, remoteAccounts []RegisteredAccount) GenesisState {
+	return GenesisState{
+		PortID:             portID,
+		InterchainAccounts: interchainAccounts,
+		RemoteAccounts:     remoteAccounts,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with "ibcaccount" as the default
+// PortID and no registered accounts.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(PortID, []RegisteredAccount{}, []RegisteredAccount{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortID); err != nil {
+		return err
+	}
+
+	if err := validateAccounts("interchain", gs.PortID, gs.InterchainAccounts); err != nil {
+		return err
+	}
+
+	return validateAccounts("remote", gs.PortID, gs.RemoteAccounts)
+}
+
+func validateAccounts(kind, portID string, accounts []RegisteredAccount) error {
+	channels := make(map[string]bool)
+
+	for i, account := range accounts {
+		if err := account.Validate(); err != nil {
+			return fmt.Errorf("invalid %s account %d: %w", kind, i, err)
+		}
+		if account.PortID != portID {
+			return fmt.Errorf("invalid %s account %d: port %s doesn't match the module port %s", kind, i, account.PortID, portID)
+		}
+		if channels[account.ChannelID] {
+			return fmt.Errorf("duplicate %s account for channel %s", kind, account.ChannelID)
+		}
+
+		channels[account.ChannelID] = true
+	}
+
+	return nil
+}
+
+// NewRegisteredAccount creates a new RegisteredAccount instance.
+func NewRegisteredAccount(portID, channelID string, address sdk.AccAddress) RegisteredAccount {
+	return RegisteredAccount{
+		PortID:    portID,
+		ChannelID: channelID,
+		Address:   address,
+	}
+}
+
+// Validate performs a basic validation of the registered account fields.
+func (ra RegisteredAccount) Validate() error {
+	if err := host.PortIdentifierValidator(ra.PortID); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(ra.ChannelID); err != nil {
+		return err
+	}
+	if ra.Address.Empty() {
+		return fmt.Errorf("empty address")
+	}
+	return nil
+}
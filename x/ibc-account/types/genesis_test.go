@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	addr := sdk.AccAddress("address")
+
+	testCases := []struct {
+		name     string
+		genState types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState(
+				types.PortID,
+				[]types.RegisteredAccount{types.NewRegisteredAccount(types.PortID, "channelone", addr)},
+				[]types.RegisteredAccount{types.NewRegisteredAccount(types.PortID, "channelone", addr)},
+			),
+			true,
+		},
+		{
+			"invalid port",
+			types.GenesisState{
+				PortID: "(INVALIDPORT)",
+			},
+			false,
+		},
+		{
+			"invalid channel",
+			types.NewGenesisState(
+				types.PortID,
+				[]types.RegisteredAccount{types.NewRegisteredAccount(types.PortID, "(INVALIDCHANNEL)", addr)},
+				nil,
+			),
+			false,
+		},
+		{
+			"empty address",
+			types.NewGenesisState(
+				types.PortID,
+				nil,
+				[]types.RegisteredAccount{types.NewRegisteredAccount(types.PortID, "channelone", nil)},
+			),
+			false,
+		},
+		{
+			"account on another port",
+			types.NewGenesisState(
+				types.PortID,
+				[]types.RegisteredAccount{types.NewRegisteredAccount("portidone", "channelone", addr)},
+				nil,
+			),
+			false,
+		},
+		{
+			"duplicate account",
+			types.NewGenesisState(
+				types.PortID,
+				nil,
+				[]types.RegisteredAccount{
+					types.NewRegisteredAccount(types.PortID, "channelone", addr),
+					types.NewRegisteredAccount(types.PortID, "channelone", addr),
+				},
+			),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
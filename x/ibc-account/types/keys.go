@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC account name
+	ModuleName = "ibcaccount"
+
+	// Version defines the current version the IBC account
+	// module supports
+	Version = "ics27-1"
+
+	// PortID is the default port id that the IBC account module binds to
+	PortID = "ibcaccount"
+
+	// StoreKey is the store key string for IBC account
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for IBC account
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC account
+	QuerierRoute = ModuleName
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte{0x01}
+
+	// InterchainAccountKeyPrefix defines the prefix under which the addresses of
+	// the accounts hosted on this chain are stored, by port and channel
+	InterchainAccountKeyPrefix = []byte{0x02}
+
+	// RemoteAccountKeyPrefix defines the prefix under which the addresses of the
+	// accounts registered on counterparty chains are stored, by port and channel
+	RemoteAccountKeyPrefix = []byte{0x03}
+)
+
+// GenerateAddress returns the address of the interchain account hosted for the
+// specified port and channel.
+func GenerateAddress(portID, channelID string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/%s/%s", ModuleName, portID, channelID))))
+}
+
+// GetInterchainAccountKey returns the store key of the interchain account
+// hosted for the specified port and channel.
+func GetInterchainAccountKey(portID, channelID string) []byte {
+	return append(InterchainAccountKeyPrefix, channelPath(portID, channelID)...)
+}
+
+// GetRemoteAccountKey returns the store key of the account registered on the
+// counterparty chain through the specified port and channel.
+func GetRemoteAccountKey(portID, channelID string) []byte {
+	return append(RemoteAccountKeyPrefix, channelPath(portID, channelID)...)
+}
+
+// ParseAccountKey returns the port and channel identifiers of an interchain or
+// remote account store key.
+func ParseAccountKey(key []byte) (portID, channelID string, err error) {
+	if len(key) == 0 {
+		return "", "", fmt.Errorf("empty account key")
+	}
+
+	split := strings.Split(string(key[1:]), "/")
+	if len(split) != 2 {
+		return "", "", fmt.Errorf("invalid account key %X", key)
+	}
+
+	return split[0], split[1], nil
+}
+
+func channelPath(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", portID, channelID))
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = IBCAccountPacketData{}
+
+// NewRegisterPacketData constructs a new IBCAccountPacketData requesting the
+// registration of an interchain account.
+func NewRegisterPacketData() IBCAccountPacketData {
+	return IBCAccountPacketData{Type: REGISTER}
+}
+
+// NewRunTxPacketData constructs a new IBCAccountPacketData requesting the
+// execution of the messages by an interchain account.
+func NewRunTxPacketData(msgs []sdk.Msg) (IBCAccountPacketData, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return IBCAccountPacketData{}, err
+		}
+		anys[i] = any
+	}
+
+	return IBCAccountPacketData{Type: RUNTX, Msgs: anys}, nil
+}
+
+// ValidateBasic is used for validating the IBC account packet data.
+func (pd IBCAccountPacketData) ValidateBasic() error {
+	switch pd.Type {
+	case REGISTER:
+		if len(pd.Msgs) != 0 {
+			return sdkerrors.Wrap(ErrInvalidOutgoingMessages, "register packet cannot contain messages")
+		}
+
+	case RUNTX:
+		msgs, err := pd.GetMessages()
+		if err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			return sdkerrors.Wrap(ErrInvalidOutgoingMessages, "no messages to execute")
+		}
+		for i, msg := range msgs {
+			if err := msg.ValidateBasic(); err != nil {
+				return sdkerrors.Wrapf(err, "message index: %d", i)
+			}
+		}
+
+	default:
+		return sdkerrors.Wrapf(ErrUnknownPacketType, "%s", pd.Type)
+	}
+
+	return nil
+}
+
+// GetMessages returns the messages to execute.
+func (pd IBCAccountPacketData) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(pd.Msgs))
+	for i, any := range pd.Msgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message %d is not a sdk.Msg", i)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (pd IBCAccountPacketData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range pd.Msgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewSuccessAcknowledgement constructs a new acknowledgement of a successfully
+// executed packet, carrying the result data of its execution.
+func NewSuccessAcknowledgement(packetType Type, data []byte) IBCAccountPacketAcknowledgement {
+	return IBCAccountPacketAcknowledgement{
+		Type:    packetType,
+		Success: true,
+		Data:    data,
+	}
+}
+
+// NewErrorAcknowledgement constructs a new acknowledgement of a packet whose
+// execution failed.
+func NewErrorAcknowledgement(packetType Type, err error) IBCAccountPacketAcknowledgement {
+	return IBCAccountPacketAcknowledgement{
+		Type:    packetType,
+		Success: false,
+		Error:   err.Error(),
+	}
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("address1")
+	addr2 = sdk.AccAddress("address2")
+	coins = sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+)
+
+func TestPacketDataValidateBasic(t *testing.T) {
+	runTx, err := types.NewRunTxPacketData([]sdk.Msg{banktypes.NewMsgSend(addr1, addr2, coins)})
+	require.NoError(t, err)
+	emptyRunTx, err := types.NewRunTxPacketData(nil)
+	require.NoError(t, err)
+	invalidRunTx, err := types.NewRunTxPacketData([]sdk.Msg{banktypes.NewMsgSend(addr1, addr2, sdk.Coins{})})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		data    types.IBCAccountPacketData
+		expPass bool
+	}{
+		{"valid register packet", types.NewRegisterPacketData(), true},
+		{"valid run tx packet", runTx, true},
+		{"register packet with messages", types.IBCAccountPacketData{Type: types.REGISTER, Msgs: runTx.Msgs}, false},
+		{"run tx packet without messages", emptyRunTx, false},
+		{"run tx packet with invalid message", invalidRunTx, false},
+		{"unknown packet type", types.IBCAccountPacketData{Type: types.UNSPECIFIED}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.data.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestPacketDataEncoding(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	msg := banktypes.NewMsgSend(addr1, addr2, coins)
+
+	data, err := types.NewRunTxPacketData([]sdk.Msg{msg})
+	require.NoError(t, err)
+
+	bz, err := cdc.MarshalBinaryBare(&data)
+	require.NoError(t, err)
+
+	var decoded types.IBCAccountPacketData
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decoded))
+
+	msgs, err := decoded.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{msg}, msgs)
+}
@@ -22,3 +22,21 @@ func NewTxCmd() *cobra.Command {
 
 	return txCmd
 }
+
+// GetQueryCmd returns the query commands for IBC fungible token transfer
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-transfer",
+		Short:                      "IBC fungible token transfer query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryDenomTrace(),
+		GetCmdQueryDenomTraces(),
+	)
+
+	return queryCmd
+}
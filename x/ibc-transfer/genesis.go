@@ -8,10 +8,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
 )
 
-// InitGenesis binds to portid from genesis state
+// InitGenesis binds to portid from genesis state and sets the denomination traces
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, state types.GenesisState) {
 	keeper.SetPort(ctx, state.PortID)
 
+	for _, trace := range state.DenomTraces {
+		keeper.SetDenomTrace(ctx, trace)
+	}
+
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
 	if !keeper.IsBound(ctx, state.PortID) {
@@ -30,9 +34,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, state types.GenesisState
 	}
 }
 
-// ExportGenesis exports transfer module's portID into its geneis state
+// ExportGenesis exports transfer module's portID and denom trace info into its genesis state.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
-	return types.GenesisState{
-		PortID: keeper.GetPort(ctx),
-	}
+	return types.NewGenesisState(
+		keeper.GetPort(ctx),
+		keeper.GetAllDenomTraces(ctx),
+	)
 }
@@ -14,7 +14,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	transfer "github.com/cosmos/cosmos-sdk/x/ibc-transfer"
 	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
 	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
@@ -47,9 +46,11 @@ var (
 	testAddr1 = sdk.AccAddress([]byte("testaddr1"))
 	testAddr2 = sdk.AccAddress([]byte("testaddr2"))
 
-	testCoins, _          = sdk.ParseCoins("100atom")
-	testPrefixedCoins1, _ = sdk.ParseCoins(fmt.Sprintf("100%satom", types.GetDenomPrefix(testPort1, testChannel1)))
-	testPrefixedCoins2, _ = sdk.ParseCoins(fmt.Sprintf("100%satom", types.GetDenomPrefix(testPort2, testChannel2)))
+	testCoins, _ = sdk.ParseCoins("100atom")
+
+	// testTrace is the trace of the vouchers received by chainA over testPort1/testChannel1
+	testTrace        = types.ParseDenomTrace(fmt.Sprintf("%satom", types.GetDenomPrefix(testPort1, testChannel1)))
+	testVoucherCoins = sdk.NewCoins(sdk.NewInt64Coin(testTrace.IBCDenom(), 100))
 )
 
 type HandlerTestSuite struct {
@@ -79,7 +80,7 @@ func (suite *HandlerTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Nil(err, "transfer module could not claim capability")
 
 	ctx := suite.chainA.GetContext()
-	msg := types.NewMsgTransfer(testPort1, testChannel1, testPrefixedCoins2, testAddr1, testAddr2.String(), 110, 0)
+	msg := types.NewMsgTransfer(testPort1, testChannel1, testCoins, testAddr1, testAddr2.String(), 110, 0)
 	res, err := handler(ctx, msg)
 	suite.Require().Error(err)
 	suite.Require().Nil(res, "%+v", res) // channel does not exist
@@ -105,16 +106,15 @@ func (suite *HandlerTestSuite) TestHandleMsgTransfer() {
 	suite.Require().NotNil(res, "%+v", res) // successfully executed
 
 	// test when the source is false
-	msg = types.NewMsgTransfer(testPort1, testChannel1, testPrefixedCoins2, testAddr1, testAddr2.String(), 110, 0)
-	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, testPrefixedCoins2)
+	msg = types.NewMsgTransfer(testPort1, testChannel1, testVoucherCoins, testAddr1, testAddr2.String(), 110, 0)
+	suite.chainA.App.BankKeeper.SetSupply(ctx, testVoucherCoins[0])
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, testVoucherCoins)
 
 	res, err = handler(ctx, msg)
 	suite.Require().Error(err)
-	suite.Require().Nil(res, "%+v", res) // incorrect denom prefix
+	suite.Require().Nil(res, "%+v", res) // denomination trace not found
 
-	msg = types.NewMsgTransfer(testPort1, testChannel1, testPrefixedCoins1, testAddr1, testAddr2.String(), 110, 0)
-	suite.chainA.App.BankKeeper.SetSupply(ctx, banktypes.NewSupply(testPrefixedCoins1))
-	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, testPrefixedCoins1)
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, testTrace)
 
 	res, err = handler(ctx, msg)
 	suite.Require().NoError(err)
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryDenomTrace() {
+	ctx, queryClient := suite.chainA.GetContext(), suite.queryClient
+
+	_, err := queryClient.DenomTrace(gocontext.Background(), &types.QueryDenomTraceRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomTrace(gocontext.Background(), &types.QueryDenomTraceRequest{Hash: "!@#!@#"})
+	suite.Require().Error(err)
+
+	req := &types.QueryDenomTraceRequest{Hash: prefixTrace.Hash().String()}
+	_, err = queryClient.DenomTrace(gocontext.Background(), req)
+	suite.Require().Error(err)
+
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, prefixTrace)
+
+	res, err := queryClient.DenomTrace(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&prefixTrace, res.DenomTrace)
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTraces() {
+	ctx, queryClient := suite.chainA.GetContext(), suite.queryClient
+
+	res, err := queryClient.DenomTraces(gocontext.Background(), &types.QueryDenomTracesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.DenomTraces)
+
+	expTraces := types.Traces{
+		prefixTrace,
+		prefixTrace2,
+		types.ParseDenomTrace("transfer/channelone/transfer/channeltwo/atom"),
+	}
+	for _, trace := range expTraces {
+		suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, trace)
+	}
+
+	req := &types.QueryDenomTracesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	}
+	res, err = queryClient.DenomTraces(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DenomTraces, 2)
+	suite.Require().Equal(uint64(len(expTraces)), res.Pagination.Total)
+
+	res, err = queryClient.DenomTraces(gocontext.Background(), &types.QueryDenomTracesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expTraces.Sort(), res.DenomTraces)
+}
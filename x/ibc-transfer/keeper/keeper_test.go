@@ -11,6 +11,7 @@ import (
 	lite "github.com/tendermint/tendermint/lite2"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -42,12 +43,16 @@ const (
 
 // define variables used for testing
 var (
-	testAddr1, _ = sdk.AccAddressFromBech32("cosmos1scqhwpgsmr6vmztaa7suurfl52my6nd2kmrudl")
-	testAddr2, _ = sdk.AccAddressFromBech32("cosmos1scqhwpgsmr6vmztaa7suurfl52my6nd2kmrujl")
+	testAddr1 = sdk.AccAddress(crypto.AddressHash([]byte("testaddr1")))
+	testAddr2 = sdk.AccAddress(crypto.AddressHash([]byte("testaddr2")))
 
 	testCoins, _ = sdk.ParseCoins("100atom")
-	prefixCoins  = sdk.NewCoins(sdk.NewCoin("bank/firstchannel/atom", sdk.NewInt(100)))
-	prefixCoins2 = sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	testCoin     = testCoins[0]
+
+	// prefixTrace is the trace of a voucher received over testPort1/testChannel1
+	prefixTrace = types.ParseDenomTrace("bank/firstchannel/atom")
+	// prefixTrace2 is the trace of a voucher received over testPort2/testChannel2
+	prefixTrace2 = types.ParseDenomTrace("testportid/secondchannel/atom")
 )
 
 type KeeperTestSuite struct {
@@ -57,17 +62,19 @@ type KeeperTestSuite struct {
 
 	chainA *TestChain
 	chainB *TestChain
+
+	queryClient types.QueryClient
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
 	suite.chainA = NewTestChain(testClientIDA)
 	suite.chainB = NewTestChain(testClientIDB)
 
-	// reset prefixCoins at each setup
-	prefixCoins = sdk.NewCoins(sdk.NewCoin("bank/firstchannel/atom", sdk.NewInt(100)))
-	prefixCoins2 = sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
-
 	suite.cdc = suite.chainA.App.Codec()
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.App.TransferKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
 }
 
 // nolint: unused
@@ -96,6 +103,36 @@ func (suite *KeeperTestSuite) TestGetTransferAccount() {
 	suite.Equal(expectedMaccAddr, macc.GetAddress())
 }
 
+func (suite *KeeperTestSuite) TestDenomTraces() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.App.TransferKeeper
+
+	_, found := keeper.GetDenomTrace(ctx, prefixTrace.Hash())
+	suite.Require().False(found)
+	suite.Require().False(keeper.HasDenomTrace(ctx, prefixTrace.Hash()))
+
+	keeper.SetDenomTrace(ctx, prefixTrace2)
+	keeper.SetDenomTrace(ctx, prefixTrace)
+
+	trace, found := keeper.GetDenomTrace(ctx, prefixTrace.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(prefixTrace, trace)
+	suite.Require().True(keeper.HasDenomTrace(ctx, prefixTrace.Hash()))
+
+	// traces are returned sorted by their full denomination path
+	suite.Require().Equal(types.Traces{prefixTrace, prefixTrace2}, keeper.GetAllDenomTraces(ctx))
+
+	path, err := keeper.DenomPathFromHash(ctx, prefixTrace.IBCDenom())
+	suite.Require().NoError(err)
+	suite.Require().Equal(prefixTrace.GetFullDenomPath(), path)
+
+	_, err = keeper.DenomPathFromHash(ctx, types.ParseDenomTrace("transfer/channelone/atom").IBCDenom())
+	suite.Require().Error(err)
+
+	_, err = keeper.DenomPathFromHash(ctx, "ibc/invalidhash")
+	suite.Require().Error(err)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
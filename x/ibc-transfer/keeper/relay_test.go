@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
 	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
@@ -12,60 +11,69 @@ import (
 )
 
 func (suite *KeeperTestSuite) TestSendTransfer() {
-	testCoins2 := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
+	voucher := sdk.NewCoin(prefixTrace.IBCDenom(), sdk.NewInt(100))
 	capName := host.ChannelCapabilityPath(testPort1, testChannel1)
 
 	testCases := []struct {
-		msg           string
-		amount        sdk.Coins
-		malleate      func()
-		isSourceChain bool
-		expPass       bool
+		msg      string
+		amount   sdk.Coin
+		malleate func()
+		expPass  bool
 	}{
-		{"successful transfer from source chain", testCoins2,
+		{"successful transfer from source chain", testCoin,
 			func() {
 				suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
 				suite.chainA.CreateClient(suite.chainB)
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectiontypes.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
 				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
-			}, true, true},
-		{"successful transfer from external chain", prefixCoins,
+			}, true},
+		{"successful transfer from external chain", voucher,
 			func() {
-				suite.chainA.App.BankKeeper.SetSupply(suite.chainA.GetContext(), banktypes.NewSupply(prefixCoins))
-				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, prefixCoins)
+				suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), prefixTrace)
+				suite.chainA.App.BankKeeper.SetSupply(suite.chainA.GetContext(), voucher)
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, sdk.NewCoins(voucher))
 				suite.Require().NoError(err)
 				suite.chainA.CreateClient(suite.chainB)
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectiontypes.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
 				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
-			}, false, true},
-		{"source channel not found", testCoins,
-			func() {}, true, false},
-		{"next seq send not found", testCoins,
+			}, true},
+		{"source channel not found", testCoin,
+			func() {}, false},
+		{"next seq send not found", testCoin,
 			func() {
 				suite.chainA.CreateClient(suite.chainB)
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectiontypes.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
-			}, true, false},
-		// createOutgoingPacket tests
+			}, false},
+		{"denom trace not found", voucher,
+			func() {
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, sdk.NewCoins(voucher))
+				suite.Require().NoError(err)
+				suite.chainA.CreateClient(suite.chainB)
+				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectiontypes.OPEN)
+				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
+				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+			}, false},
 		// - source chain
-		{"send coins failed", testCoins,
+		{"send coins failed", testCoin,
 			func() {
 				suite.chainA.CreateClient(suite.chainB)
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectiontypes.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
 				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
-			}, true, false},
+			}, false},
 		// - receiving chain
-		{"send from module account failed", testCoins,
+		{"send from module account failed", voucher,
 			func() {
+				suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), prefixTrace)
 				suite.chainA.CreateClient(suite.chainB)
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connectiontypes.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
 				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
-			}, false, false},
-		{"channel capability not found", testCoins,
+			}, false},
+		{"channel capability not found", testCoin,
 			func() {
 				suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
 				suite.chainA.CreateClient(suite.chainB)
@@ -75,7 +83,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				// Release channel capability
 				cap, _ := suite.chainA.App.ScopedTransferKeeper.GetCapability(suite.chainA.GetContext(), capName)
 				suite.chainA.App.ScopedTransferKeeper.ReleaseCapability(suite.chainA.GetContext(), cap)
-			}, true, false},
+			}, false},
 	}
 
 	for i, tc := range testCases {
@@ -105,54 +113,80 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 	}
 }
 
+// TestOnRecvPacket tests that native tokens sent by the counterparty are minted
+// as vouchers with a stored denomination trace, and that tokens returning to
+// this chain are unescrowed.
 func (suite *KeeperTestSuite) TestOnRecvPacket() {
-	data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr1.String(), testAddr2.String())
+	var data types.FungibleTokenPacketData
+
+	// the packet is received over testPort2/testChannel2, so vouchers of the
+	// counterparty native token are traced as prefixTrace2
+	voucherDenom := prefixTrace2.IBCDenom()
 
 	testCases := []struct {
 		msg      string
 		malleate func()
+		expDenom string
 		expPass  bool
 	}{
-		{"success receive from source chain",
-			func() {}, true},
-		// onRecvPacket
-		// - source chain
-		{"no dest prefix on coin denom",
+		{"success receive on sink chain",
+			func() {}, voucherDenom, true},
+		{"success receive of a multi-hop voucher on sink chain",
 			func() {
-				data.Amount = testCoins
-			}, false},
-		{"mint failed",
+				data.Denom = "transfer/channelone/atom"
+			}, types.ParseDenomTrace("testportid/secondchannel/transfer/channelone/atom").IBCDenom(), true},
+		{"invalid receiver address",
 			func() {
-				data.Amount = prefixCoins2
-				data.Amount[0].Amount = sdk.ZeroInt()
-			}, false},
-		// - receiving chain
-		{"incorrect dest prefix on coin denom",
+				data.Receiver = "gaia1scqhwpgsmr6vmztaa7suurfl52my6nd2kmrudl"
+			}, voucherDenom, false},
+		{"zero amount",
 			func() {
-				data.Amount = prefixCoins
-			}, false},
-		{"success receive from external chain",
+				data.Amount = 0
+			}, voucherDenom, false},
+		{"invalid denom trace",
+			func() {
+				data.Denom = "transfer/(invalidchannel)/atom"
+			}, voucherDenom, false},
+		// - receiving chain is source
+		{"success receive on source chain",
 			func() {
-				data.Amount = prefixCoins
+				data.Denom = prefixTrace.GetFullDenomPath()
 				escrow := types.GetEscrowAddress(testPort2, testChannel2)
 				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
 				suite.Require().NoError(err)
-			}, true},
+			}, testCoin.Denom, true},
+		{"success receive of a multi-hop voucher on source chain",
+			func() {
+				data.Denom = "bank/firstchannel/transfer/channelone/atom"
+				voucher := sdk.NewCoin(types.ParseDenomTrace("transfer/channelone/atom").IBCDenom(), sdk.NewInt(100))
+				escrow := types.GetEscrowAddress(testPort2, testChannel2)
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, sdk.NewCoins(voucher))
+				suite.Require().NoError(err)
+			}, types.ParseDenomTrace("transfer/channelone/atom").IBCDenom(), true},
+		{"empty escrow account on source chain",
+			func() {
+				data.Denom = prefixTrace.GetFullDenomPath()
+			}, testCoin.Denom, false},
 	}
 
-	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
-
 	for i, tc := range testCases {
 		tc := tc
 		i := i
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
+
+			data = types.NewFungibleTokenPacketData(testCoin.Denom, testCoin.Amount.Uint64(), testAddr1.String(), testAddr2.String())
 			tc.malleate()
 
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
 			err := suite.chainA.App.TransferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
 
 			if tc.expPass {
 				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+				balance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr2, tc.expDenom)
+				suite.Require().Equal(testCoin.Amount, balance.Amount)
 			} else {
 				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
 			}
@@ -163,7 +197,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 // TestOnAcknowledgementPacket tests that successful acknowledgement is a no-op
 // and failure acknowledment leads to refund
 func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
-	data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr1.String(), testAddr2.String())
+	var data types.FungibleTokenPacketData
 
 	successAck := types.FungibleTokenPacketAcknowledgement{
 		Success: true,
@@ -177,53 +211,46 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 		msg      string
 		ack      types.FungibleTokenPacketAcknowledgement
 		malleate func()
-		source   bool
+		expDenom string
 		success  bool // success of ack
 	}{
 		{"success ack causes no-op", successAck,
-			func() {}, true, true},
+			func() {}, testCoin.Denom, true},
 		{"successful refund from source chain", failedAck,
 			func() {
 				escrow := types.GetEscrowAddress(testPort1, testChannel1)
-				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
 				suite.Require().NoError(err)
-			}, true, false},
+			}, testCoin.Denom, false},
 		{"successful refund from external chain", failedAck,
 			func() {
-				data.Amount = prefixCoins
-			}, false, false},
+				data.Denom = prefixTrace.GetFullDenomPath()
+			}, prefixTrace.IBCDenom(), false},
 	}
 
-	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
-
 	for i, tc := range testCases {
 		tc := tc
 		i := i
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
+			data = types.NewFungibleTokenPacketData(testCoin.Denom, testCoin.Amount.Uint64(), testAddr1.String(), testAddr2.String())
 			tc.malleate()
 
-			var denom string
-			if tc.source {
-				prefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
-				denom = prefixCoins2[0].Denom[len(prefix):]
-			} else {
-				denom = data.Amount[0].Denom
-			}
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
 
-			preCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, denom)
+			preCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, tc.expDenom)
 
 			err := suite.chainA.App.TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, tc.ack)
 			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
 
-			postCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, denom)
+			postCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, tc.expDenom)
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
 
 			if tc.success {
 				suite.Require().Equal(sdk.ZeroInt(), deltaAmount, "successful ack changed balance")
 			} else {
-				suite.Require().Equal(prefixCoins2[0].Amount, deltaAmount, "failed ack did not trigger refund")
+				suite.Require().Equal(testCoin.Amount, deltaAmount, "failed ack did not trigger refund")
 			}
 		})
 	}
@@ -231,67 +258,53 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 // TestOnTimeoutPacket test private refundPacket function since it is a simple wrapper over it
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
-	data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr1.String(), testAddr2.String())
-	testCoins2 := sdk.NewCoins(sdk.NewCoin("bank/firstchannel/atom", sdk.NewInt(100)))
+	var data types.FungibleTokenPacketData
 
 	testCases := []struct {
 		msg      string
 		malleate func()
-		source   bool
+		expDenom string
 		expPass  bool
 	}{
 		{"successful timeout from source chain",
 			func() {
 				escrow := types.GetEscrowAddress(testPort1, testChannel1)
-				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
 				suite.Require().NoError(err)
-			}, true, true},
+			}, testCoin.Denom, true},
 		{"successful timeout from external chain",
 			func() {
-				data.Amount = testCoins2
-			}, false, true},
-		{"no source prefix on coin denom",
-			func() {
-				data.Amount = prefixCoins2
-			}, false, false},
+				data.Denom = prefixTrace.GetFullDenomPath()
+			}, prefixTrace.IBCDenom(), true},
 		{"unescrow failed",
+			func() {}, testCoin.Denom, false},
+		{"invalid sender address",
 			func() {
-			}, true, false},
-		{"mint failed",
-			func() {
-				data.Amount[0].Denom = prefixCoins2[0].Denom
-				data.Amount[0].Amount = sdk.ZeroInt()
-			}, true, false},
+				data.Sender = "gaia1scqhwpgsmr6vmztaa7suurfl52my6nd2kmrudl"
+			}, testCoin.Denom, false},
 	}
 
-	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
-
 	for i, tc := range testCases {
 		tc := tc
 		i := i
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
+			data = types.NewFungibleTokenPacketData(testCoin.Denom, testCoin.Amount.Uint64(), testAddr1.String(), testAddr2.String())
 			tc.malleate()
 
-			var denom string
-			if tc.source {
-				prefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
-				denom = prefixCoins2[0].Denom[len(prefix):]
-			} else {
-				denom = data.Amount[0].Denom
-			}
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
 
-			preCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, denom)
+			preCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, tc.expDenom)
 
 			err := suite.chainA.App.TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
 
-			postCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, denom)
+			postCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, tc.expDenom)
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
 
 			if tc.expPass {
 				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
-				suite.Require().Equal(prefixCoins2[0].Amount.Int64(), deltaAmount.Int64(), "successful timeout did not trigger refund")
+				suite.Require().Equal(testCoin.Amount.Int64(), deltaAmount.Int64(), "successful timeout did not trigger refund")
 			} else {
 				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
 			}
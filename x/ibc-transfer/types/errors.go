@@ -10,4 +10,5 @@ var (
 	ErrOnlyOneDenomAllowed     = sdkerrors.Register(ModuleName, 3, "only one denom allowed")
 	ErrInvalidDenomForTransfer = sdkerrors.Register(ModuleName, 4, "invalid denomination for cross-chain transfer")
 	ErrInvalidVersion          = sdkerrors.Register(ModuleName, 5, "invalid ICS20 version")
+	ErrTraceNotFound           = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
 )
@@ -4,21 +4,34 @@ import (
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 )
 
-// GenesisState is currently only used to ensure that the InitGenesis gets run
-// by the module manager
+// GenesisState defines the ibc-transfer genesis state: the port the module
+// binds to and the denomination traces of the vouchers minted by the module.
 type GenesisState struct {
-	PortID string `json:"port_id" yaml:"port_id"`
+	PortID      string `json:"port_id" yaml:"port_id"`
+	DenomTraces Traces `json:"denom_traces" yaml:"denom_traces"`
+}
+
+// NewGenesisState creates a new ibc-transfer GenesisState instance.
+func NewGenesisState(portID string, denomTraces Traces) GenesisState {
+	return GenesisState{
+		PortID:      portID,
+		DenomTraces: denomTraces,
+	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		PortID: PortID,
+		PortID:      PortID,
+		DenomTraces: Traces{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return host.PortIdentifierValidator(gs.PortID)
+	if err := host.PortIdentifierValidator(gs.PortID); err != nil {
+		return err
+	}
+	return gs.DenomTraces.Validate()
 }
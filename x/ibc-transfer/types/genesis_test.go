@@ -26,6 +26,14 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesis with traces",
+			types.NewGenesisState("portidone", types.Traces{
+				types.ParseDenomTrace("transfer/channelone/uatom"),
+				types.ParseDenomTrace("transfer/channelone/transfer/channeltwo/uatom"),
+			}),
+			true,
+		},
 		{
 			"invalid client",
 			types.GenesisState{
@@ -33,6 +41,21 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid denom trace",
+			types.NewGenesisState("portidone", types.Traces{
+				{Path: "transfer", BaseDenom: "uatom"},
+			}),
+			false,
+		},
+		{
+			"duplicated denom trace",
+			types.NewGenesisState("portidone", types.Traces{
+				types.ParseDenomTrace("transfer/channelone/uatom"),
+				types.ParseDenomTrace("transfer/channelone/uatom"),
+			}),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -27,10 +28,17 @@ const (
 
 	// QuerierRoute is the querier route for IBC transfer
 	QuerierRoute = ModuleName
+
+	// DenomPrefix is the prefix used for internal SDK coin representation.
+	DenomPrefix = "ibc"
 )
 
-// PortKey defines the key to store the port ID in store
-var PortKey = []byte{0x01}
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte{0x01}
+	// DenomTraceKey defines the key to store the denomination trace info in store
+	DenomTraceKey = []byte{0x02}
+)
 
 // GetEscrowAddress returns the escrow address for the specified channel
 //
@@ -45,3 +53,22 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 func GetDenomPrefix(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/", portID, channelID)
 }
+
+// SenderChainIsSource returns false if the denomination originally came
+// from the receiving chain and true otherwise.
+func SenderChainIsSource(sourcePort, sourceChannel, denom string) bool {
+	// This is the prefix that would have been prefixed to the denomination
+	// on sender chain IF and only if the token originally came from the
+	// receiving chain.
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, denom)
+}
+
+// ReceiverChainIsSource returns true if the denomination originally came
+// from the receiving chain and false otherwise.
+func ReceiverChainIsSource(sourcePort, sourceChannel, denom string) bool {
+	// The prefix passed in should contain the SourcePort and SourceChannel.
+	// If the receiver chain originally sent the token to the sender chain
+	// the denom will have the sender's SourcePort and SourceChannel as the
+	// prefix.
+	return strings.HasPrefix(denom, GetDenomPrefix(sourcePort, sourceChannel))
+}
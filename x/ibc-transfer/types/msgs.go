@@ -59,7 +59,10 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if msg.Receiver == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
-	return nil
+	if len(msg.Amount) != 1 {
+		return sdkerrors.Wrapf(ErrOnlyOneDenomAllowed, "%d denoms included", len(msg.Amount))
+	}
+	return ValidateIBCDenom(msg.Amount[0].Denom)
 }
 
 // GetSignBytes implements sdk.Msg
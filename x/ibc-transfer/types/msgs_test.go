@@ -28,6 +28,9 @@ var (
 	emptyAddr sdk.AccAddress
 
 	coins, _          = sdk.ParseCoins("100atom")
+	ibcCoins          = sdk.NewCoins(sdk.NewInt64Coin("ibc/7f1d3fcf4ae79e1554d670d1ad949a9ba4e4a3c76c63093e17e446a46061a7a2", 100))
+	invalidIBCCoins   = sdk.NewCoins(sdk.NewInt64Coin("notibc/7f1d3fcf4ae79e1554d670d1ad949a9ba4e4a3c76c63093e17e446a46061a7a2", 100))
+	multiDenomCoins   = sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 10))
 	invalidDenomCoins = sdk.Coins{sdk.Coin{Denom: "ato-m", Amount: sdk.NewInt(100)}}
 	negativeCoins     = sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(100)}, sdk.Coin{Denom: "atoms", Amount: sdk.NewInt(-100)}}
 )
@@ -61,6 +64,9 @@ func TestMsgTransferValidation(t *testing.T) {
 		NewMsgTransfer(validPort, validChannel, coins, emptyAddr, addr2, 10, 0),         // missing sender address
 		NewMsgTransfer(validPort, validChannel, coins, addr1, "", 10, 0),                // missing recipient address
 		NewMsgTransfer(validPort, validChannel, sdk.Coins{}, addr1, addr2, 10, 0),       // not possitive coin
+		NewMsgTransfer(validPort, validChannel, ibcCoins, addr1, addr2, 10, 0),          // valid ibc denom
+		NewMsgTransfer(validPort, validChannel, invalidIBCCoins, addr1, addr2, 10, 0),   // invalid ibc denom prefix
+		NewMsgTransfer(validPort, validChannel, multiDenomCoins, addr1, addr2, 10, 0),   // more than one denom
 	}
 
 	testCases := []struct {
@@ -79,6 +85,10 @@ func TestMsgTransferValidation(t *testing.T) {
 		{testMsgs[8], false, "amount contains negative coin"},
 		{testMsgs[9], false, "missing sender address"},
 		{testMsgs[10], false, "missing recipient address"},
+		{testMsgs[11], false, "not possitive coin"},
+		{testMsgs[12], true, ""},
+		{testMsgs[13], false, "invalid ibc denom prefix"},
+		{testMsgs[14], false, "more than one denom"},
 	}
 
 	for i, tc := range testCases {
@@ -23,21 +23,23 @@ var (
 
 // NewFungibleTokenPacketData contructs a new FungibleTokenPacketData instance
 func NewFungibleTokenPacketData(
-	amount sdk.Coins, sender, receiver string) FungibleTokenPacketData {
+	denom string, amount uint64,
+	sender, receiver string,
+) FungibleTokenPacketData {
 	return FungibleTokenPacketData{
+		Denom:    denom,
 		Amount:   amount,
 		Sender:   sender,
 		Receiver: receiver,
 	}
 }
 
-// ValidateBasic is used for validating the token transfer
+// ValidateBasic is used for validating the token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
 func (ftpd FungibleTokenPacketData) ValidateBasic() error {
-	if !ftpd.Amount.IsAllPositive() {
-		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, ftpd.Amount.String())
-	}
-	if !ftpd.Amount.IsValid() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, ftpd.Amount.String())
+	if ftpd.Amount == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "amount cannot be 0")
 	}
 	if strings.TrimSpace(ftpd.Sender) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
@@ -45,7 +47,7 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	if strings.TrimSpace(ftpd.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
 	}
-	return nil
+	return ValidatePrefixedDenom(ftpd.Denom)
 }
 
 // GetBytes is a helper for serialising
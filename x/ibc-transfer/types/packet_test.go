@@ -6,14 +6,21 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	denom  = "transfer/gaiachannel/atom"
+	amount = uint64(100)
+)
+
 // TestFungibleTokenPacketDataValidateBasic tests ValidateBasic for FungibleTokenPacketData
 func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 	testPacketDataTransfer := []FungibleTokenPacketData{
-		NewFungibleTokenPacketData(coins, addr1.String(), addr2),              // valid msg
-		NewFungibleTokenPacketData(invalidDenomCoins, addr1.String(), addr2),  // invalid amount
-		NewFungibleTokenPacketData(negativeCoins, addr1.String(), addr2),      // amount contains negative coin
-		NewFungibleTokenPacketData(coins, emptyAddr.String(), addr2),          // missing sender address
-		NewFungibleTokenPacketData(coins, addr1.String(), emptyAddr.String()), // missing recipient address
+		NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2),                            // valid msg
+		NewFungibleTokenPacketData("transfer/channelone/atom", amount, addr1.String(), addr2),       // valid prefixed denom
+		NewFungibleTokenPacketData(denom, 0, addr1.String(), addr2),                                 // zero amount
+		NewFungibleTokenPacketData("", amount, addr1.String(), addr2),                               // empty denom
+		NewFungibleTokenPacketData("transfer/(invalidchannel)/atom", amount, addr1.String(), addr2), // invalid trace
+		NewFungibleTokenPacketData(denom, amount, emptyAddr.String(), addr2),                        // missing sender address
+		NewFungibleTokenPacketData(denom, amount, addr1.String(), emptyAddr.String()),               // missing recipient address
 	}
 
 	testCases := []struct {
@@ -22,10 +29,12 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		errMsg     string
 	}{
 		{testPacketDataTransfer[0], true, ""},
-		{testPacketDataTransfer[1], false, "invalid amount"},
-		{testPacketDataTransfer[2], false, "amount contains negative coin"},
-		{testPacketDataTransfer[3], false, "missing sender address"},
-		{testPacketDataTransfer[4], false, "missing recipient address"},
+		{testPacketDataTransfer[1], true, ""},
+		{testPacketDataTransfer[2], false, "zero amount"},
+		{testPacketDataTransfer[3], false, "empty denom"},
+		{testPacketDataTransfer[4], false, "invalid trace"},
+		{testPacketDataTransfer[5], false, "missing sender address"},
+		{testPacketDataTransfer[6], false, "missing recipient address"},
 	}
 
 	for i, tc := range testCases {
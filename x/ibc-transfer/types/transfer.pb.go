@@ -131,12 +131,15 @@ func (m *MsgTransfer) GetTimeoutTimestamp() uint64 {
 // FungibleTokenPacketData defines a struct for the packet payload
 // See FungibleTokenPacketData spec: https://github.com/cosmos/ics/tree/master/spec/ics-020-fungible-token-transfer#data-structures
 type FungibleTokenPacketData struct {
-	// the tokens to be transferred
-	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// the token denomination to be transferred, prefixed with the full trace of
+	// ports and channels it was transferred through
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the token amount to be transferred
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// the sender address
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 	// the recipient address on the destination chain
-	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
 
 func (m *FungibleTokenPacketData) Reset()         { *m = FungibleTokenPacketData{} }
@@ -172,11 +175,18 @@ func (m *FungibleTokenPacketData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FungibleTokenPacketData proto.InternalMessageInfo
 
-func (m *FungibleTokenPacketData) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *FungibleTokenPacketData) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FungibleTokenPacketData) GetAmount() uint64 {
 	if m != nil {
 		return m.Amount
 	}
-	return nil
+	return 0
 }
 
 func (m *FungibleTokenPacketData) GetSender() string {
@@ -248,47 +258,108 @@ func (m *FungibleTokenPacketAcknowledgement) GetError() string {
 	return ""
 }
 
+// DenomTrace contains the base denomination for ICS20 fungible tokens and the source tracing
+// information path.
+type DenomTrace struct {
+	// path defines the chain of port/channel identifiers used for tracing the source of the fungible
+	// token.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// base denomination of the relayed fungible token.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+}
+
+func (m *DenomTrace) Reset()         { *m = DenomTrace{} }
+func (m *DenomTrace) String() string { return proto.CompactTextString(m) }
+func (*DenomTrace) ProtoMessage()    {}
+func (*DenomTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08134a70fd29e656, []int{3}
+}
+func (m *DenomTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTrace.Merge(m, src)
+}
+func (m *DenomTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTrace proto.InternalMessageInfo
+
+func (m *DenomTrace) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DenomTrace) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.transfer.MsgTransfer")
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.transfer.FungibleTokenPacketData")
 	proto.RegisterType((*FungibleTokenPacketAcknowledgement)(nil), "ibc.transfer.FungibleTokenPacketAcknowledgement")
+	proto.RegisterType((*DenomTrace)(nil), "ibc.transfer.DenomTrace")
 }
 
 func init() { proto.RegisterFile("ibc/transfer/transfer.proto", fileDescriptor_08134a70fd29e656) }
 
 var fileDescriptor_08134a70fd29e656 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x49, 0x9b, 0x96, 0x4b, 0x8a, 0xe0, 0x28, 0xe5, 0x08, 0xc8, 0x8e, 0x3c, 0x79, 0x49,
-	0x4c, 0x61, 0x40, 0x62, 0x22, 0x29, 0x42, 0x54, 0x08, 0xa9, 0xb2, 0x22, 0x06, 0x96, 0xca, 0x3e,
-	0xff, 0x70, 0x4e, 0x89, 0xef, 0xa2, 0xbb, 0x33, 0xd0, 0x6f, 0xc1, 0x97, 0x60, 0xe1, 0x93, 0x74,
-	0xec, 0xc0, 0xc0, 0x64, 0x50, 0xf2, 0x0d, 0x32, 0x32, 0x21, 0xff, 0x89, 0x89, 0xa5, 0x08, 0x31,
-	0x74, 0xba, 0x7b, 0xef, 0xfd, 0x7e, 0xcf, 0xf7, 0x7b, 0xbe, 0x43, 0x0f, 0x59, 0x40, 0x5d, 0x2d,
-	0x7d, 0xae, 0x3e, 0x80, 0xac, 0x36, 0x83, 0xb9, 0x14, 0x5a, 0xe0, 0x0e, 0x0b, 0xe8, 0x60, 0xcd,
-	0x75, 0x0f, 0x23, 0x11, 0x89, 0x5c, 0x70, 0xb3, 0x5d, 0x51, 0xd3, 0xbd, 0x4b, 0x85, 0x8a, 0x85,
-	0x72, 0x8b, 0xa5, 0x20, 0xed, 0xef, 0x4d, 0xd4, 0x7e, 0xab, 0xa2, 0x71, 0xd9, 0x8a, 0x9f, 0xa1,
-	0xb6, 0x12, 0x89, 0xa4, 0x70, 0x3e, 0x17, 0x52, 0x13, 0xa3, 0x67, 0x38, 0x37, 0x47, 0x47, 0xab,
-	0xd4, 0xc2, 0x17, 0x7e, 0x3c, 0x7b, 0x6e, 0x6f, 0x88, 0xb6, 0x87, 0x0a, 0x74, 0x26, 0xa4, 0xc6,
-	0x2f, 0xd0, 0xad, 0x52, 0xa3, 0x13, 0x9f, 0x73, 0x98, 0x91, 0x1b, 0x79, 0xef, 0x83, 0x55, 0x6a,
-	0xdd, 0xab, 0xf5, 0x96, 0xba, 0xed, 0x1d, 0x14, 0xc4, 0x49, 0x81, 0xf1, 0x3b, 0xd4, 0xf2, 0x63,
-	0x91, 0x70, 0x4d, 0x9a, 0xbd, 0xa6, 0xd3, 0x7e, 0xd2, 0x19, 0x94, 0x27, 0x3d, 0x11, 0x8c, 0x8f,
-	0x1e, 0x5f, 0xa6, 0x56, 0xe3, 0xdb, 0x4f, 0xcb, 0x89, 0x98, 0x9e, 0x24, 0xc1, 0x80, 0x8a, 0xd8,
-	0xad, 0x0d, 0xd4, 0x57, 0xe1, 0xd4, 0xd5, 0x17, 0x73, 0x28, 0x1a, 0x94, 0x57, 0xba, 0xe1, 0x53,
-	0xd4, 0x52, 0xc0, 0x43, 0x90, 0x64, 0xa7, 0x67, 0x38, 0x9d, 0xd1, 0xf1, 0xef, 0xd4, 0xea, 0xff,
-	0x87, 0xcb, 0x90, 0xd2, 0x61, 0x18, 0x4a, 0x50, 0xca, 0x2b, 0x0d, 0x70, 0x17, 0xed, 0x4b, 0xa0,
-	0xc0, 0x3e, 0x82, 0x24, 0xbb, 0xd9, 0x78, 0x5e, 0x85, 0xb3, 0x00, 0x34, 0x8b, 0x41, 0x24, 0xfa,
-	0x7c, 0x02, 0x2c, 0x9a, 0x68, 0xd2, 0xea, 0x19, 0xce, 0xce, 0x66, 0x00, 0x75, 0xdd, 0xf6, 0x0e,
-	0x4a, 0xe2, 0x75, 0x8e, 0xf1, 0x29, 0xba, 0xb3, 0xae, 0xc8, 0x56, 0xa5, 0xfd, 0x78, 0x4e, 0xf6,
-	0x72, 0x93, 0x47, 0xab, 0xd4, 0x22, 0x75, 0x93, 0xaa, 0xc4, 0xf6, 0x6e, 0x97, 0xdc, 0xb8, 0xa2,
-	0xbe, 0x1a, 0xe8, 0xfe, 0xab, 0x84, 0x47, 0x2c, 0x98, 0xc1, 0x58, 0x4c, 0x81, 0x9f, 0xf9, 0x74,
-	0x0a, 0xfa, 0xa5, 0xaf, 0xfd, 0x8d, 0x9c, 0x8d, 0x6b, 0xcd, 0xf9, 0xa8, 0xca, 0x39, 0xff, 0xf3,
-	0x5b, 0x43, 0x6b, 0xd6, 0x43, 0xb3, 0xc7, 0xc8, 0xde, 0x72, 0xcc, 0x21, 0x9d, 0x72, 0xf1, 0x69,
-	0x06, 0x61, 0x04, 0x31, 0x70, 0x8d, 0x09, 0xda, 0x53, 0x09, 0xa5, 0xa0, 0x54, 0x7e, 0x21, 0xf7,
-	0xbd, 0x35, 0xc4, 0x87, 0x68, 0x17, 0xa4, 0x14, 0xeb, 0x4f, 0x16, 0x60, 0xf4, 0xe6, 0x72, 0x61,
-	0x1a, 0x57, 0x0b, 0xd3, 0xf8, 0xb5, 0x30, 0x8d, 0x2f, 0x4b, 0xb3, 0x71, 0xb5, 0x34, 0x1b, 0x3f,
-	0x96, 0x66, 0xe3, 0xfd, 0xf1, 0x3f, 0xa7, 0xfa, 0xec, 0xb2, 0x80, 0xf6, 0xff, 0xbe, 0xb1, 0x6c,
-	0xc8, 0xa0, 0x95, 0x3f, 0x94, 0xa7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x95, 0x43, 0xad,
-	0x80, 0x03, 0x00, 0x00,
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x49, 0x9a, 0xb6, 0x9b, 0x14, 0xd1, 0xa5, 0x0d, 0x26, 0x20, 0x3b, 0xf2, 0x29, 0x97,
+	0xc4, 0x14, 0x90, 0x90, 0x38, 0x91, 0xb4, 0x42, 0x54, 0x08, 0xa9, 0xb2, 0xa2, 0x1e, 0xb8, 0x44,
+	0xeb, 0xf5, 0xe0, 0x58, 0x89, 0x77, 0xa3, 0xdd, 0x35, 0x50, 0xf1, 0x13, 0x7c, 0x07, 0x5f, 0xd2,
+	0x63, 0x0f, 0x1c, 0x38, 0x19, 0x94, 0xfc, 0x41, 0x8e, 0x9c, 0x90, 0xbd, 0x8e, 0xdb, 0x48, 0x15,
+	0xea, 0xc9, 0xfb, 0xe6, 0xcd, 0x3c, 0xcf, 0xbc, 0x9d, 0x45, 0x4f, 0x22, 0x9f, 0xba, 0x4a, 0x10,
+	0x26, 0x3f, 0x81, 0x28, 0x0f, 0xfd, 0xb9, 0xe0, 0x8a, 0xe3, 0x66, 0xe4, 0xd3, 0xfe, 0x3a, 0xd6,
+	0x3e, 0x08, 0x79, 0xc8, 0x73, 0xc2, 0xcd, 0x4e, 0x3a, 0xa7, 0xfd, 0x90, 0x72, 0x19, 0x73, 0xe9,
+	0xea, 0x8f, 0x0e, 0x3a, 0x3f, 0xab, 0xa8, 0xf1, 0x41, 0x86, 0xa3, 0xa2, 0x14, 0xbf, 0x42, 0x0d,
+	0xc9, 0x13, 0x41, 0x61, 0x3c, 0xe7, 0x42, 0x99, 0x46, 0xc7, 0xe8, 0xee, 0x0e, 0x5b, 0xab, 0xd4,
+	0xc6, 0x17, 0x24, 0x9e, 0xbd, 0x76, 0x6e, 0x90, 0x8e, 0x87, 0x34, 0x3a, 0xe3, 0x42, 0xe1, 0x37,
+	0xe8, 0x7e, 0xc1, 0xd1, 0x09, 0x61, 0x0c, 0x66, 0xe6, 0xbd, 0xbc, 0xf6, 0xf1, 0x2a, 0xb5, 0x0f,
+	0x37, 0x6a, 0x0b, 0xde, 0xf1, 0xf6, 0x74, 0xe0, 0x58, 0x63, 0x7c, 0x8e, 0xea, 0x24, 0xe6, 0x09,
+	0x53, 0x66, 0xb5, 0x53, 0xed, 0x36, 0x9e, 0x37, 0xfb, 0x45, 0xa7, 0xc7, 0x3c, 0x62, 0xc3, 0x67,
+	0x97, 0xa9, 0x5d, 0xf9, 0xf1, 0xdb, 0xee, 0x86, 0x91, 0x9a, 0x24, 0x7e, 0x9f, 0xf2, 0xd8, 0xdd,
+	0x18, 0xa8, 0x27, 0x83, 0xa9, 0xab, 0x2e, 0xe6, 0xa0, 0x0b, 0xa4, 0x57, 0xa8, 0xe1, 0x53, 0x54,
+	0x97, 0xc0, 0x02, 0x10, 0x66, 0xad, 0x63, 0x74, 0x9b, 0xc3, 0xa3, 0xbf, 0xa9, 0xdd, 0xbb, 0x83,
+	0xca, 0x80, 0xd2, 0x41, 0x10, 0x08, 0x90, 0xd2, 0x2b, 0x04, 0x70, 0x1b, 0xed, 0x08, 0xa0, 0x10,
+	0x7d, 0x06, 0x61, 0x6e, 0x65, 0xe3, 0x79, 0x25, 0xce, 0x0c, 0x50, 0x51, 0x0c, 0x3c, 0x51, 0xe3,
+	0x09, 0x44, 0xe1, 0x44, 0x99, 0xf5, 0x8e, 0xd1, 0xad, 0xdd, 0x34, 0x60, 0x93, 0x77, 0xbc, 0xbd,
+	0x22, 0xf0, 0x2e, 0xc7, 0xf8, 0x14, 0xed, 0xaf, 0x33, 0xb2, 0xaf, 0x54, 0x24, 0x9e, 0x9b, 0xdb,
+	0xb9, 0xc8, 0xd3, 0x55, 0x6a, 0x9b, 0x9b, 0x22, 0x65, 0x8a, 0xe3, 0x3d, 0x28, 0x62, 0xa3, 0x32,
+	0xf4, 0x0d, 0x3d, 0x7a, 0x9b, 0xb0, 0x30, 0xf2, 0x67, 0x30, 0xe2, 0x53, 0x60, 0x67, 0x84, 0x4e,
+	0x41, 0x9d, 0x10, 0x45, 0xf0, 0x01, 0xda, 0x0a, 0x80, 0xf1, 0x58, 0xdf, 0xad, 0xa7, 0x01, 0x6e,
+	0x95, 0xe6, 0x67, 0xd7, 0x56, 0x2b, 0xcd, 0x6b, 0x95, 0xe6, 0x55, 0xf3, 0xf4, 0xdb, 0x9c, 0xa8,
+	0x6d, 0x3a, 0xe1, 0x8c, 0x90, 0x73, 0xcb, 0xcf, 0x07, 0x74, 0xca, 0xf8, 0x97, 0x19, 0x04, 0x21,
+	0xc4, 0xc0, 0x14, 0x36, 0xd1, 0xb6, 0x4c, 0x28, 0x05, 0x29, 0xf3, 0x4e, 0x76, 0xbc, 0x35, 0xcc,
+	0x3a, 0x04, 0x21, 0xb8, 0xd0, 0x1b, 0xe4, 0x69, 0xe0, 0x9c, 0x23, 0x74, 0x92, 0xb5, 0x3a, 0x12,
+	0x84, 0x02, 0xc6, 0xa8, 0x36, 0x27, 0x6a, 0x52, 0x0c, 0x91, 0x9f, 0xf1, 0x4b, 0x84, 0x7c, 0x22,
+	0x61, 0xac, 0xc7, 0xd3, 0xeb, 0x77, 0xb8, 0x4a, 0xed, 0x7d, 0x6d, 0xdc, 0x35, 0xe7, 0x78, 0xbb,
+	0x19, 0xc8, 0xe5, 0x86, 0xef, 0x2f, 0x17, 0x96, 0x71, 0xb5, 0xb0, 0x8c, 0x3f, 0x0b, 0xcb, 0xf8,
+	0xbe, 0xb4, 0x2a, 0x57, 0x4b, 0xab, 0xf2, 0x6b, 0x69, 0x55, 0x3e, 0x1e, 0xfd, 0x77, 0x49, 0xbe,
+	0xba, 0x91, 0x4f, 0x7b, 0xd7, 0x0f, 0x32, 0xdb, 0x19, 0xbf, 0x9e, 0xbf, 0xaa, 0x17, 0xff, 0x06,
+	0x00, 0x82, 0x6c, 0xa8, 0xf1, 0xad, 0x03, 0x00, 0x00,
 }
 
 func (m *MsgTransfer) Marshal() (dAtA []byte, err error) {
@@ -391,28 +462,26 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.Receiver)
 		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Receiver)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
 		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTransfer(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Amount != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -457,6 +526,43 @@ func (m *FungibleTokenPacketAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -511,11 +617,12 @@ func (m *FungibleTokenPacketData) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTransfer(uint64(l))
-		}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTransfer(uint64(m.Amount))
 	}
 	l = len(m.Sender)
 	if l > 0 {
@@ -544,6 +651,23 @@ func (m *FungibleTokenPacketAcknowledgement) Size() (n int) {
 	return n
 }
 
+func (m *DenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
 func sovTransfer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -836,9 +960,9 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -848,27 +972,44 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTransfer
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTransfer
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -900,7 +1041,7 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
@@ -1061,6 +1202,123 @@ func (m *FungibleTokenPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTransfer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -162,12 +162,14 @@ type ClientType byte
 const (
 	Tendermint ClientType = iota + 1 // 1
 	Localhost
+	SoloMachine
 )
 
 // string representation of the client types
 const (
-	ClientTypeTendermint string = "tendermint"
-	ClientTypeLocalHost  string = "localhost"
+	ClientTypeTendermint  string = "tendermint"
+	ClientTypeLocalHost   string = "localhost"
+	ClientTypeSoloMachine string = "solomachine"
 )
 
 func (ct ClientType) String() string {
@@ -176,6 +178,8 @@ func (ct ClientType) String() string {
 		return ClientTypeTendermint
 	case Localhost:
 		return ClientTypeLocalHost
+	case SoloMachine:
+		return ClientTypeSoloMachine
 	default:
 		return ""
 	}
@@ -211,6 +215,8 @@ func ClientTypeFromString(clientType string) ClientType {
 		return Tendermint
 	case ClientTypeLocalHost:
 		return Localhost
+	case ClientTypeSoloMachine:
+		return SoloMachine
 	default:
 		return 0
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/keeper"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
 )
@@ -40,6 +41,14 @@ func HandleMsgCreateClient(ctx sdk.Context, k keeper.Keeper, msg exported.MsgCre
 		// msg client id is always "localhost"
 		clientState = localhosttypes.NewClientState(ctx.ChainID(), ctx.BlockHeight())
 		consensusHeight = uint64(ctx.BlockHeight())
+	case exported.SoloMachine:
+		smMsg, ok := msg.(solomachinetypes.MsgCreateClient)
+		if !ok {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidClientType, "got %T, expected %T", msg, solomachinetypes.MsgCreateClient{})
+		}
+
+		clientState = solomachinetypes.InitializeFromMsg(smMsg)
+		consensusHeight = msg.GetConsensusState().GetHeight()
 	default:
 		return nil, sdkerrors.Wrapf(types.ErrInvalidClientType, "unsupported client type (%s)", msg.GetClientType())
 	}
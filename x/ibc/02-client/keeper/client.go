@@ -7,6 +7,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	solomachine "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
 	tendermint "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
@@ -82,6 +84,10 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 			ctx.BlockHeight(),
 		)
 		consensusHeight = uint64(ctx.BlockHeight())
+	case exported.SoloMachine:
+		clientState, consensusState, err = solomachine.CheckHeaderAndUpdateState(
+			clientState, header,
+		)
 	default:
 		err = types.ErrInvalidClientType
 	}
@@ -94,7 +100,7 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 
 	// we don't set consensus state for localhost client
 	if header != nil && clientType != exported.Localhost {
-		k.SetClientConsensusState(ctx, clientID, header.GetHeight(), consensusState)
+		k.SetClientConsensusState(ctx, clientID, consensusState.GetHeight(), consensusState)
 		consensusHeight = consensusState.GetHeight()
 	}
 
@@ -133,6 +139,9 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 			clientState, consensusState, misbehaviour, consensusState.GetHeight(), ctx.BlockTime(), ctx.ConsensusParams(),
 		)
 
+	case solomachinetypes.Evidence:
+		clientState, err = solomachine.CheckMisbehaviourAndUpdateState(clientState, misbehaviour)
+
 	default:
 		err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized IBC client evidence type: %T", e)
 	}
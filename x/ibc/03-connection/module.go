@@ -1,8 +1,11 @@
 package connection
 
 import (
+	"context"
+
 	"github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -26,11 +29,18 @@ func GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
-// RegisterRESTRoutes registers the REST routes for the IBC connections.
+// RegisterRESTRoutes registers the REST routes for IBC connections.
 func RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
 	rest.RegisterRoutes(clientCtx, rtr)
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC-gateway routes for IBC connections.
+func RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
 // RegisterQueryService registers the gRPC query service for IBC connections.
 func RegisterQueryService(server grpc.Server, queryServer types.QueryServer) {
 	types.RegisterQueryServer(server, queryServer)
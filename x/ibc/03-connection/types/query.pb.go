@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -392,41 +393,44 @@ func init() {
 func init() { proto.RegisterFile("ibc/connection/query.proto", fileDescriptor_5ee60d8b08ce3606) }
 
 var fileDescriptor_5ee60d8b08ce3606 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xce, 0x26, 0x6d, 0xd5, 0x4c, 0xfc, 0xfb, 0x51, 0x56, 0x55, 0x31, 0x96, 0xe2, 0x04, 0x1f,
-	0x20, 0x3d, 0xc4, 0x46, 0xad, 0x40, 0x02, 0x09, 0x21, 0x95, 0x22, 0x11, 0x2e, 0x84, 0x15, 0x27,
-	0x2e, 0x91, 0xff, 0x2c, 0xb6, 0x05, 0xf5, 0xba, 0xd9, 0x8d, 0x44, 0xdf, 0x82, 0x77, 0xe0, 0x01,
-	0xe0, 0x19, 0x38, 0x71, 0xec, 0x91, 0x53, 0x85, 0x9c, 0x17, 0x41, 0xde, 0x75, 0xba, 0x6e, 0x93,
-	0x2a, 0x5c, 0x38, 0x65, 0x3d, 0xf3, 0x7d, 0x33, 0xdf, 0x7e, 0xb3, 0x19, 0xb0, 0xd2, 0x20, 0xf4,
-	0x42, 0x96, 0x65, 0x34, 0x14, 0x29, 0xcb, 0xbc, 0xd3, 0x19, 0x9d, 0x9e, 0xb9, 0xf9, 0x94, 0x09,
-	0x86, 0xff, 0x4f, 0x83, 0xd0, 0xd5, 0x39, 0x6b, 0x37, 0x66, 0x31, 0x93, 0x29, 0xaf, 0x3c, 0x29,
-	0x94, 0xd5, 0x0d, 0x19, 0x3f, 0x61, 0x5c, 0x31, 0xbd, 0xdc, 0x8f, 0xd3, 0xcc, 0x2f, 0xe1, 0x55,
-	0xba, 0x77, 0xad, 0x81, 0x3e, 0x2a, 0x80, 0xf3, 0x06, 0xf6, 0xde, 0x96, 0xd4, 0x17, 0x97, 0x09,
-	0x42, 0x4f, 0x67, 0x94, 0x0b, 0xfc, 0x08, 0xfe, 0xd3, 0xe8, 0x49, 0x1a, 0x99, 0xa8, 0x8f, 0x06,
-	0xed, 0xa3, 0x9d, 0xe2, 0xa2, 0x67, 0x68, 0xf4, 0xe8, 0x98, 0x18, 0x1a, 0x36, 0x8a, 0x9c, 0xef,
-	0x08, 0xee, 0x2c, 0x55, 0xe4, 0x39, 0xcb, 0x38, 0xc5, 0xcf, 0x00, 0x34, 0x56, 0xd6, 0xeb, 0x1c,
-	0x74, 0xdd, 0xab, 0xf7, 0x74, 0x35, 0xef, 0x65, 0x16, 0x91, 0x1a, 0x01, 0xef, 0xc2, 0x66, 0x3e,
-	0x65, 0xec, 0x83, 0xd9, 0xec, 0xa3, 0x81, 0x41, 0xd4, 0x07, 0xee, 0x02, 0xc8, 0xc3, 0x24, 0xf7,
-	0x45, 0x62, 0xb6, 0x4a, 0x91, 0xa4, 0x2d, 0x23, 0x63, 0x5f, 0x24, 0xf8, 0x1e, 0x18, 0x2a, 0x9d,
-	0xd0, 0x34, 0x4e, 0x84, 0xb9, 0xd1, 0x47, 0x83, 0x0d, 0xd2, 0x91, 0xb1, 0x57, 0x32, 0xe4, 0xbc,
-	0x5b, 0x52, 0xcc, 0x17, 0x26, 0x3c, 0x01, 0xd0, 0x9e, 0x56, 0x8a, 0xef, 0xba, 0xca, 0x73, 0x57,
-	0x4d, 0x6b, 0xec, 0xc7, 0xb4, 0x82, 0x93, 0x1a, 0xd8, 0xf9, 0x86, 0xc0, 0x5c, 0x2e, 0x5b, 0x39,
-	0xf1, 0x1c, 0x3a, 0xfa, 0x62, 0xdc, 0x44, 0xfd, 0xd6, 0x7a, 0x2b, 0xea, 0x0c, 0xfc, 0xf4, 0x8a,
-	0xb0, 0xa6, 0x14, 0x66, 0xad, 0x12, 0xa6, 0x1a, 0xd6, 0x95, 0xe1, 0x3d, 0xd8, 0xaa, 0xcc, 0x28,
-	0xdd, 0x6a, 0x91, 0xea, 0xcb, 0x79, 0x0d, 0x5d, 0x25, 0xf8, 0x53, 0x4a, 0x33, 0xb1, 0xc2, 0x8d,
-	0x7d, 0x68, 0x87, 0x32, 0xa7, 0x9f, 0x83, 0x51, 0x5c, 0xf4, 0xb6, 0x15, 0x61, 0x74, 0x4c, 0xb6,
-	0x55, 0x7a, 0x14, 0x39, 0x5f, 0x11, 0xd8, 0x37, 0x15, 0xab, 0x3c, 0xd8, 0x87, 0x9d, 0xda, 0x03,
-	0x2b, 0xa7, 0xa7, 0x8c, 0x68, 0x93, 0x5b, 0x3a, 0x5e, 0xce, 0x90, 0xff, 0xab, 0xc9, 0x1f, 0xfc,
-	0x68, 0xc2, 0xa6, 0x54, 0x89, 0x27, 0x00, 0x5a, 0x23, 0xbe, 0x7f, 0x7d, 0x12, 0xab, 0xff, 0x23,
-	0xd6, 0x83, 0xb5, 0x38, 0x75, 0x57, 0xa7, 0x81, 0x03, 0xe8, 0xd4, 0x4c, 0xc0, 0xeb, 0x98, 0x0b,
-	0xcf, 0xad, 0xc1, 0x7a, 0xe0, 0x65, 0x0f, 0x01, 0xb7, 0x97, 0xec, 0xc6, 0xc3, 0xd5, 0x05, 0x6e,
-	0x98, 0xb1, 0xe5, 0xfe, 0x2d, 0x7c, 0xd1, 0xf5, 0x68, 0xfc, 0xb3, 0xb0, 0xd1, 0x79, 0x61, 0xa3,
-	0xdf, 0x85, 0x8d, 0xbe, 0xcc, 0xed, 0xc6, 0xf9, 0xdc, 0x6e, 0xfc, 0x9a, 0xdb, 0x8d, 0xf7, 0x8f,
-	0xe3, 0x54, 0x24, 0xb3, 0xc0, 0x0d, 0xd9, 0x89, 0x57, 0xed, 0x29, 0xf5, 0x33, 0xe4, 0xd1, 0x47,
-	0xef, 0xb3, 0x57, 0x2e, 0xa7, 0x87, 0x87, 0xc3, 0xda, 0x7e, 0x12, 0x67, 0x39, 0xe5, 0xc1, 0x96,
-	0xdc, 0x4d, 0x87, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xed, 0x4b, 0x0d, 0x29, 0x1f, 0x05, 0x00,
-	0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0x76, 0x9b, 0xd6, 0xd7, 0x02, 0xc3, 0x9a, 0x46, 0x08, 0x6b, 0x5a, 0x02, 0x82,
+	0x0e, 0xa9, 0x09, 0xda, 0x04, 0x12, 0x48, 0x08, 0x69, 0x0c, 0x89, 0x72, 0xa1, 0x58, 0x9c, 0xb8,
+	0x4c, 0x69, 0x6a, 0xd2, 0x88, 0x2d, 0xce, 0x6a, 0x57, 0x62, 0x47, 0x90, 0xb8, 0x23, 0x71, 0xe7,
+	0xc2, 0x07, 0x80, 0x03, 0x1f, 0x82, 0xe3, 0x24, 0x2e, 0x9c, 0x26, 0xd4, 0xf2, 0x41, 0x50, 0x6c,
+	0x0f, 0x67, 0x6b, 0xab, 0x72, 0xe1, 0x14, 0xe7, 0xbd, 0xff, 0x7b, 0xfe, 0xe5, 0xff, 0x1c, 0x83,
+	0x1d, 0x77, 0x43, 0x3f, 0x64, 0x49, 0x42, 0x43, 0x11, 0xb3, 0xc4, 0x3f, 0x18, 0xd2, 0xc1, 0xa1,
+	0x97, 0x0e, 0x98, 0x60, 0xf8, 0x7c, 0xdc, 0x0d, 0x3d, 0x93, 0xb3, 0xd7, 0x23, 0xc6, 0xa2, 0x3d,
+	0xea, 0x07, 0x69, 0xec, 0x07, 0x49, 0xc2, 0x44, 0x90, 0x85, 0xb9, 0x52, 0xdb, 0xab, 0x11, 0x8b,
+	0x98, 0x5c, 0xfa, 0xd9, 0x4a, 0x47, 0x6b, 0x21, 0xe3, 0xfb, 0x8c, 0xab, 0xbe, 0x7e, 0x1a, 0x44,
+	0x71, 0x22, 0xab, 0x74, 0xba, 0x7e, 0x66, 0x7b, 0xb3, 0x54, 0x02, 0xf7, 0x19, 0xac, 0x3d, 0xcf,
+	0x4a, 0x1f, 0xfd, 0x4d, 0x10, 0x7a, 0x30, 0xa4, 0x5c, 0xe0, 0x3b, 0x70, 0xce, 0xa8, 0x77, 0xe3,
+	0x9e, 0x85, 0x1a, 0xa8, 0x59, 0xde, 0x5e, 0x19, 0x1d, 0xd7, 0xab, 0x46, 0xdd, 0xde, 0x21, 0x55,
+	0x23, 0x6b, 0xf7, 0xdc, 0xaf, 0x08, 0x2e, 0x4d, 0x74, 0xe4, 0x29, 0x4b, 0x38, 0xc5, 0x0f, 0x00,
+	0x8c, 0x56, 0xf6, 0xab, 0x6c, 0xd6, 0xbc, 0xd3, 0x2e, 0x78, 0xa6, 0xee, 0x71, 0xd2, 0x23, 0xb9,
+	0x02, 0xbc, 0x0a, 0x8b, 0xe9, 0x80, 0xb1, 0x57, 0x56, 0xb1, 0x81, 0x9a, 0x55, 0xa2, 0x5e, 0x70,
+	0x0d, 0x40, 0x2e, 0x76, 0xd3, 0x40, 0xf4, 0xad, 0x52, 0x06, 0x49, 0xca, 0x32, 0xd2, 0x09, 0x44,
+	0x1f, 0x5f, 0x85, 0xaa, 0x4a, 0xf7, 0x69, 0x1c, 0xf5, 0x85, 0xb5, 0xd0, 0x40, 0xcd, 0x05, 0x52,
+	0x91, 0xb1, 0x27, 0x32, 0xe4, 0xbe, 0x98, 0x20, 0xe6, 0x27, 0x26, 0xdc, 0x03, 0x30, 0x9e, 0x6a,
+	0xe2, 0xcb, 0x9e, 0xf2, 0xdc, 0x53, 0xb3, 0xec, 0x04, 0x11, 0xd5, 0x72, 0x92, 0x13, 0xbb, 0x5f,
+	0x10, 0x58, 0x93, 0x6d, 0xb5, 0x13, 0x0f, 0xa1, 0x62, 0x3e, 0x8c, 0x5b, 0xa8, 0x51, 0x9a, 0x6f,
+	0x45, 0xbe, 0x02, 0xdf, 0x3f, 0x05, 0x56, 0x94, 0x60, 0xf6, 0x34, 0x30, 0xb5, 0x61, 0x9e, 0x0c,
+	0xaf, 0xc1, 0x92, 0x36, 0x23, 0x73, 0xab, 0x44, 0xf4, 0x9b, 0xfb, 0x14, 0x6a, 0x0a, 0x78, 0x2f,
+	0xa6, 0x89, 0x98, 0xe2, 0xc6, 0x06, 0x94, 0x43, 0x99, 0x33, 0xc7, 0xa1, 0x3a, 0x3a, 0xae, 0x2f,
+	0xab, 0x82, 0xf6, 0x0e, 0x59, 0x56, 0xe9, 0x76, 0xcf, 0xfd, 0x8c, 0xc0, 0x99, 0xd5, 0x4c, 0x7b,
+	0xb0, 0x01, 0x2b, 0xb9, 0x03, 0x96, 0x4d, 0x4f, 0x19, 0x51, 0x26, 0x17, 0x4c, 0x3c, 0x9b, 0x21,
+	0xff, 0x5f, 0x93, 0xdf, 0xfc, 0x56, 0x82, 0x45, 0x49, 0x89, 0xdf, 0x22, 0x00, 0x03, 0x89, 0x6f,
+	0x9c, 0x1d, 0xc5, 0xf4, 0x9f, 0xc4, 0xbe, 0x39, 0x57, 0xa7, 0x3e, 0xd6, 0x75, 0xdf, 0xfd, 0xf8,
+	0xfd, 0xb1, 0xb8, 0x8e, 0x6d, 0x7f, 0xe6, 0x1f, 0x89, 0xdf, 0x23, 0xa8, 0xe4, 0x8c, 0xc2, 0xf3,
+	0x9a, 0x9f, 0xcc, 0xc5, 0x6e, 0xce, 0x17, 0x6a, 0x8c, 0x6b, 0x12, 0xa3, 0x86, 0xaf, 0xcc, 0xc6,
+	0xe0, 0xf8, 0x13, 0x82, 0x8b, 0x13, 0x63, 0xc3, 0xad, 0xe9, 0x9b, 0xcc, 0x38, 0x2b, 0xb6, 0xf7,
+	0xaf, 0x72, 0x4d, 0x76, 0x4b, 0x92, 0x5d, 0xc7, 0xee, 0x04, 0x99, 0x3a, 0x71, 0x39, 0xc0, 0xed,
+	0xce, 0xf7, 0x91, 0x83, 0x8e, 0x46, 0x0e, 0xfa, 0x35, 0x72, 0xd0, 0x87, 0xb1, 0x53, 0x38, 0x1a,
+	0x3b, 0x85, 0x9f, 0x63, 0xa7, 0xf0, 0xf2, 0x6e, 0x14, 0x8b, 0xfe, 0xb0, 0xeb, 0x85, 0x6c, 0xdf,
+	0xd7, 0x37, 0xa3, 0x7a, 0xb4, 0x78, 0xef, 0xb5, 0xff, 0x46, 0xf6, 0xbe, 0xbd, 0xd5, 0xca, 0xb5,
+	0x17, 0x87, 0x29, 0xe5, 0xdd, 0x25, 0x79, 0x1b, 0x6e, 0xfd, 0x19, 0x00, 0xeb, 0xec, 0x68, 0x1d,
+	0xaf, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -1181,65 +1182,73 @@ func init() {
 func init() { proto.RegisterFile("ibc/channel/query.proto", fileDescriptor_2150995751d4f15a) }
 
 var fileDescriptor_2150995751d4f15a = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x9b, 0x6c, 0xda, 0xbc, 0x14, 0xb1, 0x4c, 0x5b, 0x48, 0xcd, 0xd6, 0x4d, 0xcd, 0x25,
-	0xec, 0x6e, 0x1d, 0xd4, 0xe5, 0xc2, 0xde, 0xb6, 0xdd, 0x03, 0x39, 0x80, 0xb2, 0xb3, 0xda, 0x0b,
-	0x12, 0x8a, 0x5c, 0x67, 0x36, 0xb1, 0xd2, 0xcc, 0xb8, 0x99, 0xe9, 0xd2, 0xc2, 0x6f, 0x40, 0x42,
-	0x5c, 0xb8, 0x71, 0x84, 0x13, 0x48, 0xdc, 0xf8, 0x09, 0x1c, 0xf7, 0xc8, 0x85, 0x0a, 0xa5, 0x7f,
-	0x83, 0x03, 0xf2, 0xcc, 0xd8, 0xb1, 0x1d, 0xbb, 0xca, 0x1e, 0x2c, 0x38, 0x25, 0x7e, 0xf3, 0xcd,
-	0x7b, 0xdf, 0xfb, 0xe6, 0x9b, 0xf1, 0x18, 0xde, 0xf3, 0x4f, 0xbd, 0xae, 0x37, 0x76, 0x29, 0x25,
-	0x67, 0xdd, 0xf3, 0x0b, 0x32, 0xbb, 0x72, 0x82, 0x19, 0x13, 0x0c, 0x35, 0xfd, 0x53, 0xcf, 0xd1,
-	0x03, 0xe6, 0xf6, 0x88, 0x8d, 0x98, 0x8c, 0x77, 0xc3, 0x7f, 0x0a, 0x62, 0xee, 0x79, 0x8c, 0x4f,
-	0x19, 0x57, 0xd3, 0xba, 0x81, 0x3b, 0xf2, 0xa9, 0x2b, 0x7c, 0x46, 0xf5, 0xf0, 0x6e, 0x32, 0xb5,
-	0xfe, 0x55, 0x43, 0xf6, 0x18, 0xb6, 0x9e, 0x85, 0x93, 0x4e, 0x54, 0x14, 0x93, 0xf3, 0x0b, 0xc2,
-	0x05, 0xfa, 0x00, 0xd6, 0x03, 0x36, 0x13, 0x03, 0x7f, 0xd8, 0x32, 0xda, 0x46, 0xa7, 0x71, 0x0c,
-	0xf3, 0xeb, 0xfd, 0x7a, 0x9f, 0xcd, 0x44, 0xef, 0x29, 0xae, 0x87, 0x43, 0xbd, 0x21, 0x7a, 0x08,
-	0xa0, 0x93, 0x85, 0xb8, 0x35, 0x89, 0x7b, 0x6b, 0x7e, 0xbd, 0xdf, 0xd0, 0xc9, 0x7a, 0x4f, 0x71,
-	0x43, 0x03, 0x7a, 0x43, 0xfb, 0x47, 0x03, 0xb6, 0xd3, 0xa5, 0x78, 0xc0, 0x28, 0x27, 0xc8, 0x81,
-	0x75, 0x8d, 0x92, 0xb5, 0x9a, 0x47, 0xdb, 0x4e, 0xa2, 0x63, 0x27, 0x82, 0x47, 0x20, 0xb4, 0x0d,
-	0x77, 0x82, 0x19, 0x63, 0x2f, 0x65, 0xc5, 0x4d, 0xac, 0x1e, 0xd0, 0x1e, 0x80, 0xfc, 0x33, 0x08,
-	0x5c, 0x31, 0x6e, 0x55, 0x43, 0x32, 0xb8, 0x21, 0x23, 0x7d, 0x57, 0x8c, 0xd1, 0x01, 0x6c, 0xaa,
-	0xe1, 0x31, 0xf1, 0x47, 0x63, 0xd1, 0xaa, 0xb5, 0x8d, 0x4e, 0x0d, 0x37, 0x65, 0xec, 0x53, 0x19,
-	0xb2, 0x9f, 0xa5, 0xf9, 0xf1, 0x48, 0x8b, 0x4f, 0x00, 0x16, 0x8a, 0x6a, 0x8a, 0xbb, 0x8e, 0x52,
-	0xdc, 0x51, 0x0b, 0xd5, 0x77, 0x47, 0x44, 0xc3, 0x71, 0x02, 0x6c, 0xff, 0x6c, 0xc0, 0x4e, 0x26,
-	0xa7, 0x6e, 0xfa, 0x31, 0x6c, 0xe8, 0x7e, 0x78, 0xcb, 0x68, 0x57, 0x3b, 0xcd, 0x23, 0x2b, 0xd5,
-	0x75, 0x6f, 0x48, 0xa8, 0xf0, 0x5f, 0xfa, 0x64, 0x18, 0xf5, 0x1f, 0xe3, 0xd1, 0xe3, 0x14, 0xa1,
-	0x35, 0x49, 0xc8, 0xcc, 0x23, 0xa4, 0x6a, 0x25, 0x19, 0xa1, 0x77, 0xa1, 0xae, 0x15, 0x08, 0x25,
-	0xaa, 0x62, 0xfd, 0x64, 0x7f, 0x03, 0x96, 0x22, 0xca, 0x28, 0x25, 0x5e, 0x08, 0xcd, 0xca, 0x60,
-	0x01, 0x78, 0xf1, 0xa0, 0x72, 0x05, 0x4e, 0x44, 0x32, 0x32, 0xad, 0xbd, 0x89, 0x4c, 0xbf, 0x19,
-	0xb0, 0x5f, 0x58, 0xfd, 0x7f, 0x2a, 0xd8, 0xb7, 0x06, 0xdc, 0x93, 0x9c, 0xfb, 0xae, 0x37, 0x21,
-	0xe2, 0x84, 0x4d, 0xa7, 0xbe, 0x98, 0x12, 0x2a, 0xca, 0xdb, 0x42, 0xc8, 0x84, 0x0d, 0x1e, 0x66,
-	0xa7, 0x1e, 0x91, 0x6c, 0x6a, 0x38, 0x7e, 0xb6, 0x7f, 0x30, 0x60, 0xaf, 0x80, 0x8f, 0x56, 0x50,
-	0x2e, 0x60, 0x14, 0x95, 0x9c, 0x36, 0x71, 0x22, 0x52, 0xda, 0xbe, 0xfa, 0xa5, 0x88, 0x19, 0x2f,
-	0x51, 0xaa, 0xb4, 0x1b, 0xab, 0x6f, 0xe2, 0xc6, 0xdf, 0x0d, 0xbd, 0x17, 0x72, 0xf8, 0x6a, 0x29,
-	0x8f, 0xa1, 0xb9, 0x10, 0x2e, 0xf2, 0x63, 0x3b, 0xe5, 0x47, 0x35, 0xf9, 0x89, 0x37, 0x49, 0xac,
-	0x44, 0x72, 0x52, 0x29, 0xa6, 0xfc, 0xde, 0x80, 0x83, 0x04, 0xf5, 0x27, 0xde, 0x84, 0xb2, 0xaf,
-	0xce, 0xc8, 0x70, 0x44, 0xfe, 0x43, 0x67, 0xfe, 0x64, 0x80, 0x7d, 0x1b, 0x29, 0xad, 0x69, 0x07,
-	0xde, 0x76, 0xd3, 0x43, 0xda, 0xa3, 0xd9, 0x70, 0x69, 0x46, 0xfd, 0x27, 0xda, 0xd2, 0x2f, 0xe8,
-	0x8c, 0x9c, 0xb9, 0x57, 0x64, 0xa8, 0x18, 0x97, 0xe9, 0xd3, 0x2f, 0xe1, 0xfd, 0x40, 0x16, 0x19,
-	0x2c, 0xbc, 0x31, 0x88, 0xa4, 0xe3, 0xad, 0x6a, 0xbb, 0xda, 0xa9, 0x1d, 0xef, 0xcd, 0xaf, 0xf7,
-	0x77, 0xb3, 0x6e, 0x7c, 0x1e, 0x81, 0xf0, 0x6e, 0x50, 0x34, 0x84, 0xee, 0xc3, 0xdd, 0x8c, 0x7a,
-	0x5c, 0x76, 0xbe, 0x81, 0x97, 0xe2, 0xf6, 0x0b, 0xbd, 0x4d, 0x97, 0xbb, 0xd7, 0x2b, 0x74, 0x0f,
-	0x1a, 0x0b, 0x66, 0xa1, 0xe7, 0x6b, 0x78, 0x11, 0x48, 0x78, 0x72, 0x2d, 0xe5, 0x49, 0xa1, 0xcf,
-	0xf6, 0xcf, 0xc9, 0x65, 0x4c, 0x0c, 0x13, 0x8f, 0xf8, 0xaf, 0x48, 0x89, 0xb7, 0x8d, 0x5f, 0x0d,
-	0x68, 0x17, 0x97, 0xd5, 0x0d, 0x1d, 0xc1, 0x0e, 0x25, 0x97, 0x0b, 0xbd, 0x07, 0x33, 0x05, 0x90,
-	0x2c, 0x6a, 0x78, 0x8b, 0x2e, 0xcf, 0x2d, 0xcd, 0x7c, 0x3c, 0x7a, 0x01, 0xab, 0x16, 0x4e, 0xce,
-	0xfc, 0x70, 0x21, 0x85, 0x2b, 0xca, 0x54, 0xe9, 0x95, 0x3e, 0x2e, 0xa2, 0xa2, 0xa1, 0x2a, 0x94,
-	0x5f, 0xf0, 0x92, 0xeb, 0x1e, 0xfd, 0x55, 0x87, 0x3b, 0xb2, 0x30, 0xea, 0xc3, 0xba, 0x46, 0xa0,
-	0xf4, 0xf9, 0x99, 0x73, 0x2b, 0x35, 0x0f, 0x6e, 0x41, 0xa8, 0x25, 0xb5, 0x2b, 0xe8, 0x39, 0x6c,
-	0x44, 0x97, 0x07, 0x54, 0x3c, 0x21, 0xda, 0xd3, 0xa6, 0x7d, 0x1b, 0x24, 0x4e, 0x7a, 0x0e, 0x68,
-	0xf9, 0x6e, 0x82, 0x1e, 0xe4, 0xcc, 0x2d, 0xba, 0x3f, 0x99, 0x0f, 0x57, 0x03, 0xc7, 0x25, 0x27,
-	0x70, 0x37, 0xbb, 0xe5, 0xd1, 0x87, 0xcb, 0x39, 0x0a, 0xae, 0x1f, 0xe6, 0xfd, 0x55, 0xa0, 0x71,
-	0x31, 0x0a, 0xef, 0x2c, 0xbd, 0xed, 0xd0, 0x0a, 0x29, 0xe2, 0xee, 0x1e, 0xac, 0x84, 0x8d, 0xeb,
-	0x7d, 0x0d, 0x3b, 0xb9, 0x6f, 0x03, 0xe4, 0x14, 0xe5, 0xc9, 0x7f, 0x97, 0x99, 0xdd, 0x95, 0xf1,
-	0x49, 0x61, 0xb3, 0x47, 0x5c, 0x9e, 0xb0, 0x05, 0x2f, 0x81, 0x3c, 0x61, 0x8b, 0x4e, 0x4c, 0xbb,
-	0x82, 0x04, 0x6c, 0xe5, 0x9c, 0x40, 0x28, 0xc7, 0x0c, 0xc5, 0xe7, 0xa3, 0x79, 0xb8, 0x22, 0x3a,
-	0xaa, 0x7a, 0xfc, 0xd9, 0x1f, 0x73, 0xcb, 0x78, 0x3d, 0xb7, 0x8c, 0xbf, 0xe7, 0x96, 0xf1, 0xdd,
-	0x8d, 0x55, 0x79, 0x7d, 0x63, 0x55, 0xfe, 0xbc, 0xb1, 0x2a, 0x5f, 0x3c, 0x1a, 0xf9, 0x62, 0x7c,
-	0x71, 0xea, 0x78, 0x6c, 0xda, 0xd5, 0x1f, 0x8d, 0xea, 0xe7, 0x90, 0x0f, 0x27, 0xdd, 0xcb, 0x6e,
-	0xf8, 0xa5, 0xf8, 0xd1, 0xc7, 0x87, 0xd1, 0xc7, 0xa2, 0xb8, 0x0a, 0x08, 0x3f, 0xad, 0xcb, 0x6f,
-	0xc5, 0x47, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x46, 0x7e, 0x04, 0x78, 0xa3, 0x0e, 0x00, 0x00,
+	// 1044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x3b, 0x4d, 0xb6, 0x6d, 0x5e, 0x8b, 0x28, 0xd3, 0x74, 0x37, 0x35, 0x8d, 0x93, 0x1a,
+	0x04, 0xd9, 0x76, 0x6b, 0x2f, 0x5d, 0x84, 0xb4, 0x7b, 0xdb, 0x76, 0x0f, 0xe4, 0x00, 0xea, 0x1a,
+	0xed, 0x05, 0x09, 0x45, 0xae, 0x33, 0xeb, 0x58, 0x4d, 0x3c, 0xde, 0xcc, 0x64, 0x69, 0x55, 0xed,
+	0x85, 0x3b, 0x12, 0x3f, 0x84, 0xb8, 0xa0, 0x3d, 0xb2, 0x27, 0xd0, 0x72, 0xe3, 0x4f, 0xe0, 0xb8,
+	0x12, 0x17, 0x4e, 0x15, 0x4a, 0xf9, 0x33, 0x38, 0x20, 0x8f, 0xc7, 0x89, 0x9d, 0xd8, 0x51, 0x38,
+	0x58, 0x70, 0x8a, 0xfd, 0xe6, 0x3b, 0xf3, 0x3e, 0xf3, 0x9d, 0xe7, 0x99, 0x09, 0xdc, 0x70, 0x4f,
+	0x6c, 0xc3, 0xee, 0x58, 0x9e, 0x47, 0xba, 0xc6, 0x93, 0x01, 0xe9, 0x9f, 0xeb, 0x7e, 0x9f, 0x72,
+	0x8a, 0x57, 0xdd, 0x13, 0x5b, 0x97, 0x0d, 0xca, 0xb6, 0x43, 0xa9, 0xd3, 0x25, 0x86, 0xe5, 0xbb,
+	0x86, 0xe5, 0x79, 0x94, 0x5b, 0xdc, 0xa5, 0x1e, 0x0b, 0xa5, 0x4a, 0xd9, 0xa1, 0x0e, 0x15, 0x8f,
+	0x46, 0xf0, 0x24, 0xa3, 0x55, 0x9b, 0xb2, 0x1e, 0x65, 0xe1, 0xa0, 0x86, 0x6f, 0x39, 0xae, 0x27,
+	0x7a, 0xc9, 0xe6, 0xad, 0x78, 0x62, 0xf9, 0x1b, 0x36, 0x69, 0x1d, 0xd8, 0x78, 0x18, 0x74, 0x3a,
+	0x0a, 0xa3, 0x26, 0x79, 0x32, 0x20, 0x8c, 0xe3, 0xb7, 0x60, 0xd9, 0xa7, 0x7d, 0xde, 0x72, 0xdb,
+	0x15, 0x54, 0x47, 0x8d, 0xd2, 0x21, 0x0c, 0x2f, 0x6b, 0x4b, 0xc7, 0xb4, 0xcf, 0x9b, 0x0f, 0xcc,
+	0xa5, 0xa0, 0xa9, 0xd9, 0xc6, 0xb7, 0x00, 0xe4, 0x60, 0x81, 0x6e, 0x51, 0xe8, 0x5e, 0x1b, 0x5e,
+	0xd6, 0x4a, 0x72, 0xb0, 0xe6, 0x03, 0xb3, 0x24, 0x05, 0xcd, 0xb6, 0xf6, 0x1c, 0x41, 0x39, 0x99,
+	0x8a, 0xf9, 0xd4, 0x63, 0x04, 0xeb, 0xb0, 0x2c, 0x55, 0x22, 0xd7, 0xea, 0x41, 0x59, 0x8f, 0xf9,
+	0xa1, 0x47, 0xf2, 0x48, 0x84, 0xcb, 0x70, 0xcd, 0xef, 0x53, 0xfa, 0x58, 0x64, 0x5c, 0x33, 0xc3,
+	0x17, 0x5c, 0x05, 0x10, 0x0f, 0x2d, 0xdf, 0xe2, 0x9d, 0x4a, 0x21, 0x80, 0x31, 0x4b, 0x22, 0x72,
+	0x6c, 0xf1, 0x0e, 0xde, 0x81, 0xb5, 0xb0, 0xb9, 0x43, 0x5c, 0xa7, 0xc3, 0x2b, 0xc5, 0x3a, 0x6a,
+	0x14, 0xcd, 0x55, 0x11, 0xfb, 0x50, 0x84, 0xb4, 0x87, 0x49, 0x3e, 0x16, 0x79, 0x71, 0x17, 0x60,
+	0xec, 0xa8, 0x44, 0xdc, 0xd2, 0x43, 0xc7, 0xf5, 0x70, 0x19, 0x8f, 0x2d, 0x87, 0x48, 0xb9, 0x19,
+	0x13, 0x6b, 0x2f, 0x10, 0x6c, 0x4e, 0x8c, 0x29, 0x27, 0x7d, 0x0f, 0x56, 0xe4, 0x7c, 0x58, 0x05,
+	0xd5, 0x0b, 0x8d, 0xd5, 0x03, 0x35, 0x31, 0xeb, 0x66, 0x9b, 0x78, 0xdc, 0x7d, 0xec, 0x92, 0x76,
+	0x34, 0xff, 0x91, 0x1e, 0xdf, 0x4b, 0x00, 0x2d, 0x0a, 0x20, 0x25, 0x0d, 0x28, 0xcc, 0x15, 0x27,
+	0xc2, 0xd7, 0x61, 0x49, 0x3a, 0x10, 0x58, 0x54, 0x30, 0xe5, 0x9b, 0x76, 0x01, 0x6a, 0x08, 0x4a,
+	0x3d, 0x8f, 0xd8, 0x81, 0x74, 0xd2, 0x06, 0x15, 0xc0, 0x1e, 0x35, 0x86, 0x55, 0x61, 0xc6, 0x22,
+	0xf8, 0x6e, 0x0a, 0xd5, 0x9c, 0x36, 0xfd, 0x82, 0xa0, 0x96, 0x99, 0xfd, 0x7f, 0x6a, 0xd8, 0x97,
+	0x08, 0xb6, 0x05, 0xf3, 0xb1, 0x65, 0x9f, 0x12, 0x7e, 0x44, 0x7b, 0x3d, 0x97, 0xf7, 0x88, 0xc7,
+	0xf3, 0xfb, 0x84, 0xb0, 0x02, 0x2b, 0x2c, 0x18, 0xdd, 0xb3, 0x89, 0xa0, 0x29, 0x9a, 0xa3, 0x77,
+	0xed, 0x7b, 0x04, 0xd5, 0x0c, 0x1e, 0xe9, 0xa0, 0x58, 0xc0, 0x28, 0x2a, 0x98, 0xd6, 0xcc, 0x58,
+	0x24, 0xb7, 0xef, 0xea, 0xa7, 0x2c, 0x32, 0x96, 0xa3, 0x55, 0xc9, 0x6a, 0x2c, 0xfc, 0x9b, 0x6a,
+	0xfc, 0x15, 0x81, 0x9a, 0xc5, 0x2b, 0xad, 0x3c, 0x84, 0xd5, 0xb1, 0x71, 0x51, 0x3d, 0xd6, 0x13,
+	0xf5, 0x18, 0x76, 0xbe, 0x6f, 0x9f, 0xc6, 0x56, 0x22, 0xde, 0x29, 0x97, 0xa2, 0xfc, 0x06, 0xc1,
+	0x4e, 0x0c, 0xfd, 0xbe, 0x7d, 0xea, 0xd1, 0xcf, 0xbb, 0xa4, 0xed, 0x90, 0xff, 0xb0, 0x32, 0x7f,
+	0x44, 0xa0, 0xcd, 0x82, 0x92, 0x9e, 0x36, 0xe0, 0x75, 0x2b, 0xd9, 0x24, 0x6b, 0x74, 0x32, 0x9c,
+	0x5b, 0xa1, 0xfe, 0x1d, 0x7d, 0xd2, 0x8f, 0xbc, 0x3e, 0xe9, 0x5a, 0xe7, 0xa4, 0x1d, 0x12, 0xe7,
+	0x59, 0xa7, 0x9f, 0xc1, 0x9b, 0xbe, 0x48, 0xd2, 0x1a, 0xd7, 0x46, 0x2b, 0xb2, 0x8e, 0x55, 0x0a,
+	0xf5, 0x42, 0xa3, 0x78, 0x58, 0x1d, 0x5e, 0xd6, 0xb6, 0x26, 0xab, 0xf1, 0x93, 0x48, 0x64, 0x6e,
+	0xf9, 0x59, 0x4d, 0x78, 0x17, 0xd6, 0x27, 0xdc, 0x63, 0x62, 0xe6, 0x2b, 0xe6, 0x54, 0x5c, 0x7b,
+	0x04, 0xd5, 0x8c, 0xd9, 0xcb, 0x15, 0xda, 0x86, 0xd2, 0x98, 0x2c, 0xa8, 0xf9, 0xa2, 0x39, 0x0e,
+	0xc4, 0x6a, 0x72, 0x31, 0x51, 0x93, 0x5c, 0xee, 0xed, 0x1f, 0x93, 0xb3, 0x11, 0x98, 0x49, 0x6c,
+	0xe2, 0x3e, 0x25, 0x39, 0xde, 0x36, 0x7e, 0x46, 0x50, 0xcf, 0x4e, 0x2b, 0x27, 0x74, 0x00, 0x9b,
+	0x1e, 0x39, 0x1b, 0xfb, 0xdd, 0xea, 0x87, 0x02, 0x41, 0x51, 0x34, 0x37, 0xbc, 0xe9, 0xbe, 0xb9,
+	0x15, 0x1f, 0x8b, 0x0e, 0xe0, 0x70, 0x0a, 0x47, 0x5d, 0x37, 0x58, 0x48, 0x6e, 0xf1, 0x3c, 0x5d,
+	0x7a, 0x2a, 0xb7, 0x8b, 0x28, 0x69, 0xe0, 0x8a, 0xc7, 0x06, 0x2c, 0xe7, 0xbc, 0x07, 0x2f, 0x4b,
+	0x70, 0x4d, 0x24, 0xc6, 0x5d, 0x58, 0x96, 0x0a, 0x9c, 0xdc, 0x3f, 0x53, 0x6e, 0xa5, 0xca, 0xce,
+	0x0c, 0x45, 0xb8, 0xa4, 0xda, 0xf6, 0x17, 0xbf, 0xff, 0xf5, 0xed, 0xe2, 0x75, 0x5c, 0x36, 0x52,
+	0xee, 0xbc, 0xd8, 0x87, 0x95, 0xa3, 0xe8, 0x52, 0x90, 0x3d, 0x58, 0xf4, 0xbd, 0x2b, 0xda, 0x2c,
+	0x89, 0x4c, 0x58, 0x15, 0x09, 0x6f, 0xe0, 0xcd, 0xb4, 0x84, 0x0c, 0xbf, 0x40, 0x80, 0xa7, 0x6f,
+	0x35, 0x78, 0x2f, 0x65, 0xe4, 0xac, 0x9b, 0x97, 0x72, 0x6b, 0x3e, 0xb1, 0x04, 0xfa, 0x40, 0x00,
+	0xdd, 0xc6, 0x7a, 0x12, 0x68, 0xd4, 0x81, 0x19, 0x17, 0xe3, 0x97, 0x67, 0x63, 0xd2, 0x1f, 0x10,
+	0xac, 0x4f, 0xee, 0x31, 0xf8, 0xe6, 0x74, 0xea, 0x8c, 0xfb, 0x8e, 0xb2, 0x3b, 0x8f, 0x54, 0x32,
+	0xbe, 0x27, 0x18, 0xf7, 0xf0, 0xcd, 0x04, 0xe3, 0xd4, 0x46, 0x68, 0x5c, 0x44, 0x5f, 0xe6, 0x33,
+	0xfc, 0x1d, 0x82, 0x37, 0xa6, 0x0e, 0x64, 0x3c, 0x47, 0xd2, 0x91, 0x8d, 0x7b, 0x73, 0x69, 0x25,
+	0xe1, 0xbb, 0x82, 0x70, 0x07, 0xd7, 0x66, 0x13, 0x32, 0xfc, 0x12, 0xc1, 0x66, 0xea, 0xc1, 0x86,
+	0xf5, 0xac, 0x7c, 0xe9, 0xc7, 0xb2, 0x62, 0xcc, 0xad, 0x9f, 0xb9, 0xd2, 0x92, 0x71, 0x62, 0x7b,
+	0x8f, 0x5b, 0xf9, 0x35, 0x82, 0xf5, 0xc9, 0x4d, 0x3e, 0x6d, 0xa5, 0x33, 0x8e, 0x41, 0x65, 0x77,
+	0x1e, 0xa9, 0x64, 0x7c, 0x47, 0x30, 0xd6, 0xb1, 0x9a, 0x60, 0x1c, 0x44, 0xf2, 0x96, 0x2f, 0xd3,
+	0x3f, 0x47, 0xb0, 0x91, 0xb2, 0x55, 0xe3, 0x94, 0xda, 0xcf, 0x3e, 0x48, 0x94, 0xfd, 0x39, 0xd5,
+	0x12, 0x6e, 0x57, 0xc0, 0xbd, 0x8d, 0xb5, 0x04, 0x5c, 0xea, 0x91, 0x70, 0xf8, 0xd1, 0x6f, 0x43,
+	0x15, 0xbd, 0x1a, 0xaa, 0xe8, 0xcf, 0xa1, 0x8a, 0xbe, 0xba, 0x52, 0x17, 0x5e, 0x5d, 0xa9, 0x0b,
+	0x7f, 0x5c, 0xa9, 0x0b, 0x9f, 0xde, 0x71, 0x5c, 0xde, 0x19, 0x9c, 0xe8, 0x36, 0xed, 0x19, 0xf2,
+	0x7f, 0x78, 0xf8, 0xb3, 0xcf, 0xda, 0xa7, 0xc6, 0x99, 0x18, 0xfb, 0xf6, 0xfb, 0xfb, 0xd1, 0xf0,
+	0xfc, 0xdc, 0x27, 0xec, 0x64, 0x49, 0xfc, 0xfd, 0xbe, 0xf3, 0xcf, 0x00, 0x72, 0x74, 0xb6, 0x65,
+	0x14, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,13 @@
+/*
+Package solomachine implements a concrete `ClientState`, `ConsensusState`,
+`Header` and `Misbehaviour` types for the solo machine light client.
+
+A solo machine is a standalone, off-chain entity (e.g. a phone or a custody
+service) identified by a single or multisig public key. Instead of merkle
+proofs, it proves its state by signing the path and value of each piece of
+state being verified, together with a sequence that is incremented after
+every successful verification. Headers rotate the public key and update the
+timestamp of the solo machine, and two signatures over different data at the
+same sequence constitute a misbehaviour which freezes the client.
+*/
+package solomachine
@@ -0,0 +1,68 @@
+package solomachine
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+// CheckMisbehaviourAndUpdateState determines whether or not the currently
+// registered public key signed over two different messages at the same
+// sequence. If so, the client is frozen at the misbehaviour sequence.
+//
+// NOTE: signatures are verified against the current public key of the client,
+// so misbehaviour committed with a public key that has since been rotated
+// cannot be submitted.
+func CheckMisbehaviourAndUpdateState(
+	clientState clientexported.ClientState,
+	misbehaviour clientexported.Misbehaviour,
+) (clientexported.ClientState, error) {
+
+	// cast the interface to specific types before checking for misbehaviour
+	smClientState, ok := clientState.(types.ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected type %T, got %T", types.ClientState{}, clientState)
+	}
+
+	if smClientState.IsFrozen() {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrClientFrozen, "client is already frozen at sequence %d", smClientState.FrozenSequence)
+	}
+
+	evidence, ok := misbehaviour.(types.Evidence)
+	if !ok {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected type %T, got %T", types.Evidence{}, misbehaviour)
+	}
+
+	if err := checkMisbehaviour(smClientState, evidence); err != nil {
+		return nil, err
+	}
+
+	smClientState.FrozenSequence = evidence.Sequence
+	return smClientState, nil
+}
+
+// checkMisbehaviour checks if the evidence provided is a valid solo machine
+// misbehaviour: both signatures must be valid signatures of the current public
+// key over their data at the evidence sequence.
+func checkMisbehaviour(clientState types.ClientState, evidence types.Evidence) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+
+	pubKey := clientState.ConsensusState.PubKey
+
+	if err := types.VerifySignature(
+		pubKey, evidence.SignatureOne.SignBytes(evidence.Sequence), evidence.SignatureOne.Signature,
+	); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidEvidence, sdkerrors.Wrap(err, "failed to verify signature one").Error())
+	}
+
+	if err := types.VerifySignature(
+		pubKey, evidence.SignatureTwo.SignBytes(evidence.Sequence), evidence.SignatureTwo.Signature,
+	); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidEvidence, sdkerrors.Wrap(err, "failed to verify signature two").Error())
+	}
+
+	return nil
+}
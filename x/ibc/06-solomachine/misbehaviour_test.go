@@ -0,0 +1,126 @@
+package solomachine_test
+
+import (
+	solomachine "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestCheckMisbehaviourAndUpdateState() {
+	for _, solo := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		testCases := []struct {
+			name     string
+			malleate func() (types.ClientState, types.Evidence)
+			expPass  bool
+		}{
+			{
+				"valid misbehaviour evidence",
+				func() (types.ClientState, types.Evidence) {
+					return solo.ClientState(), solo.CreateEvidence()
+				},
+				true,
+			},
+			{
+				"client is already frozen",
+				func() (types.ClientState, types.Evidence) {
+					clientState := solo.ClientState()
+					clientState.FrozenSequence = 1
+					return clientState, solo.CreateEvidence()
+				},
+				false,
+			},
+			{
+				"invalid evidence",
+				func() (types.ClientState, types.Evidence) {
+					evidence := solo.CreateEvidence()
+					evidence.SignatureTwo = evidence.SignatureOne
+					return solo.ClientState(), evidence
+				},
+				false,
+			},
+			{
+				"invalid signature one",
+				func() (types.ClientState, types.Evidence) {
+					evidence := solo.CreateEvidence()
+					evidence.SignatureOne.Data = []byte("INVALID DATA")
+					return solo.ClientState(), evidence
+				},
+				false,
+			},
+			{
+				"invalid signature two",
+				func() (types.ClientState, types.Evidence) {
+					evidence := solo.CreateEvidence()
+					evidence.SignatureTwo.Data = []byte("INVALID DATA")
+					return solo.ClientState(), evidence
+				},
+				false,
+			},
+			{
+				"signatures are at another sequence",
+				func() (types.ClientState, types.Evidence) {
+					evidence := solo.CreateEvidence()
+					evidence.Sequence++
+					return solo.ClientState(), evidence
+				},
+				false,
+			},
+			{
+				"signatures are from another public key",
+				func() (types.ClientState, types.Evidence) {
+					clientState := solo.ClientState()
+					// rotate the solo machine keys
+					solo.CreateHeader()
+					return clientState, solo.CreateEvidence()
+				},
+				false,
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				clientState, evidence := tc.malleate()
+
+				newClientState, err := solomachine.CheckMisbehaviourAndUpdateState(clientState, evidence)
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+					suite.Require().True(newClientState.IsFrozen())
+					suite.Require().Equal(evidence.Sequence, newClientState.(types.ClientState).FrozenSequence)
+				} else {
+					suite.Require().Error(err)
+					suite.Require().Nil(newClientState)
+				}
+			})
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestCheckMisbehaviourAndUpdateStateInvalidTypes() {
+	_, err := solomachine.CheckMisbehaviourAndUpdateState(ibctmtypes.ClientState{}, suite.solomachine.CreateEvidence())
+	suite.Require().Error(err)
+
+	_, err = solomachine.CheckMisbehaviourAndUpdateState(suite.solomachine.ClientState(), ibctmtypes.Evidence{})
+	suite.Require().Error(err)
+}
+
+func (suite *SoloMachineTestSuite) TestClientMisbehaviour() {
+	clientKeeper := suite.app.IBCKeeper.ClientKeeper
+
+	_, err := clientKeeper.CreateClient(suite.ctx, suite.solomachine.ClientState(), suite.solomachine.ConsensusState())
+	suite.Require().NoError(err)
+
+	err = clientKeeper.CheckMisbehaviourAndUpdateState(suite.ctx, suite.solomachine.CreateEvidence())
+	suite.Require().NoError(err)
+
+	clientState, found := clientKeeper.GetClientState(suite.ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().True(clientState.IsFrozen())
+
+	// frozen clients cannot be updated
+	_, err = clientKeeper.UpdateClient(suite.ctx, clientID, suite.solomachine.CreateHeader())
+	suite.Require().Error(err)
+}
@@ -0,0 +1,40 @@
+package solomachine_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+const (
+	clientID = "solomachineclient"
+)
+
+type SoloMachineTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+
+	solomachine      *ibctesting.Solomachine // single public key
+	solomachineMulti *ibctesting.Solomachine // multisig public key
+}
+
+func (suite *SoloMachineTestSuite) SetupTest() {
+	isCheckTx := false
+	suite.app = simapp.Setup(isCheckTx)
+	suite.ctx = suite.app.BaseApp.NewContext(isCheckTx, abci.Header{Height: 1})
+
+	suite.solomachine = ibctesting.NewSolomachine(suite.T(), clientID, 1)
+	suite.solomachineMulti = ibctesting.NewSolomachine(suite.T(), clientID, 4)
+}
+
+func TestSoloMachineTestSuite(t *testing.T) {
+	suite.Run(t, new(SoloMachineTestSuite))
+}
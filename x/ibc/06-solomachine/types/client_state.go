@@ -0,0 +1,341 @@
+package types
+
+import (
+	ics23 "github.com/confio/ics23/go"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+var _ clientexported.ClientState = ClientState{}
+
+// ClientState of a solo machine tracks its latest consensus state and a
+// possible frozen sequence.
+//
+// The proofs verified by the solo machine client are signatures by the public
+// key of the consensus state at its current sequence. Each successful
+// verification increments the sequence and updates the stored client state.
+type ClientState struct {
+	// Client ID
+	ID string `json:"id" yaml:"id"`
+
+	// Sequence at which the client was frozen due to a misbehaviour
+	FrozenSequence uint64 `json:"frozen_sequence" yaml:"frozen_sequence"`
+
+	ConsensusState ConsensusState `json:"consensus_state" yaml:"consensus_state"`
+}
+
+// InitializeFromMsg creates a solo machine client state from a MsgCreateClient
+func InitializeFromMsg(msg MsgCreateClient) ClientState {
+	return NewClientState(msg.ClientID, msg.ConsensusState)
+}
+
+// NewClientState creates a new ClientState instance
+func NewClientState(id string, consensusState ConsensusState) ClientState {
+	return ClientState{
+		ID:             id,
+		FrozenSequence: 0,
+		ConsensusState: consensusState,
+	}
+}
+
+// GetID returns the solo machine client state identifier.
+func (cs ClientState) GetID() string {
+	return cs.ID
+}
+
+// GetChainID returns an empty string since solo machines are not chains.
+func (cs ClientState) GetChainID() string {
+	return ""
+}
+
+// ClientType is solo machine.
+func (cs ClientState) ClientType() clientexported.ClientType {
+	return clientexported.SoloMachine
+}
+
+// GetLatestHeight returns the latest sequence of the solo machine.
+func (cs ClientState) GetLatestHeight() uint64 {
+	return cs.ConsensusState.Sequence
+}
+
+// IsFrozen returns true if the frozen sequence has been set.
+func (cs ClientState) IsFrozen() bool {
+	return cs.FrozenSequence != 0
+}
+
+// Validate performs a basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if err := host.ClientIdentifierValidator(cs.ID); err != nil {
+		return err
+	}
+	return cs.ConsensusState.ValidateBasic()
+}
+
+// GetProofSpecs returns nil since solo machines do not use merkle proofs.
+func (cs ClientState) GetProofSpecs() []*ics23.ProofSpec {
+	return nil
+}
+
+// VerifyClientConsensusState verifies a proof of the consensus state of the
+// client stored on the solo machine.
+func (cs ClientState) VerifyClientConsensusState(
+	store sdk.KVStore,
+	_ codec.Marshaler,
+	aminoCdc *codec.Codec,
+	_ commitmentexported.Root,
+	height uint64,
+	counterpartyClientIdentifier string,
+	consensusHeight uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	consensusState clientexported.ConsensusState,
+) error {
+	clientPrefixedPath := "clients/" + counterpartyClientIdentifier + "/" + host.ConsensusStatePath(consensusHeight)
+	path, err := commitmenttypes.ApplyPrefix(prefix, clientPrefixedPath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := aminoCdc.MarshalBinaryBare(consensusState)
+	if err != nil {
+		return err
+	}
+
+	if err := cs.verifyAndIncrementSequence(store, height, proof, path, bz); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedClientConsensusStateVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyConnectionState verifies a proof of the connection state of the
+// specified connection end stored on the solo machine.
+func (cs ClientState) VerifyConnectionState(
+	store sdk.KVStore,
+	cdc codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	connectionID string,
+	connectionEnd connectionexported.ConnectionI,
+	_ clientexported.ConsensusState,
+) error {
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.ConnectionPath(connectionID))
+	if err != nil {
+		return err
+	}
+
+	connection, ok := connectionEnd.(connectiontypes.ConnectionEnd)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid connection type %T", connectionEnd)
+	}
+
+	bz, err := cdc.MarshalBinaryBare(&connection)
+	if err != nil {
+		return err
+	}
+
+	if err := cs.verifyAndIncrementSequence(store, height, proof, path, bz); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedConnectionStateVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyChannelState verifies a proof of the channel state of the specified
+// channel end, under the specified port, stored on the solo machine.
+func (cs ClientState) VerifyChannelState(
+	store sdk.KVStore,
+	cdc codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	channel channelexported.ChannelI,
+	_ clientexported.ConsensusState,
+) error {
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.ChannelPath(portID, channelID))
+	if err != nil {
+		return err
+	}
+
+	channelEnd, ok := channel.(channeltypes.Channel)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid channel type %T", channel)
+	}
+
+	bz, err := cdc.MarshalBinaryBare(&channelEnd)
+	if err != nil {
+		return err
+	}
+
+	if err := cs.verifyAndIncrementSequence(store, height, proof, path, bz); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedChannelStateVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
+// the specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketCommitment(
+	store sdk.KVStore,
+	_ codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitmentBytes []byte,
+	_ clientexported.ConsensusState,
+) error {
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketCommitmentPath(portID, channelID, sequence))
+	if err != nil {
+		return err
+	}
+
+	if err := cs.verifyAndIncrementSequence(store, height, proof, path, commitmentBytes); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedPacketCommitmentVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgement verifies a proof of an incoming packet
+// acknowledgement at the specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketAcknowledgement(
+	store sdk.KVStore,
+	_ codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+	_ clientexported.ConsensusState,
+) error {
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketAcknowledgementPath(portID, channelID, sequence))
+	if err != nil {
+		return err
+	}
+
+	if err := cs.verifyAndIncrementSequence(
+		store, height, proof, path, channeltypes.CommitAcknowledgement(acknowledgement),
+	); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedPacketAckVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgementAbsence verifies a proof of the absence of an
+// incoming packet acknowledgement at the specified port, specified channel, and
+// specified sequence. The solo machine attests the absence by signing an empty
+// value under the acknowledgement path.
+func (cs ClientState) VerifyPacketAcknowledgementAbsence(
+	store sdk.KVStore,
+	_ codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	_ clientexported.ConsensusState,
+) error {
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketAcknowledgementPath(portID, channelID, sequence))
+	if err != nil {
+		return err
+	}
+
+	if err := cs.verifyAndIncrementSequence(store, height, proof, path, nil); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedPacketAckAbsenceVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyNextSequenceRecv verifies a proof of the next sequence number to be
+// received of the specified channel at the specified port.
+func (cs ClientState) VerifyNextSequenceRecv(
+	store sdk.KVStore,
+	_ codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+	_ clientexported.ConsensusState,
+) error {
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.NextSequenceRecvPath(portID, channelID))
+	if err != nil {
+		return err
+	}
+
+	bz := sdk.Uint64ToBigEndian(nextSequenceRecv)
+
+	if err := cs.verifyAndIncrementSequence(store, height, proof, path, bz); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedNextSeqRecvVerification, err.Error())
+	}
+
+	return nil
+}
+
+// verifyAndIncrementSequence verifies that the proof is a signature of the
+// value under the given path at the current sequence of the client. On success
+// the sequence is incremented and the updated client state is written to the
+// client store, so that the signature cannot be used again.
+func (cs ClientState) verifyAndIncrementSequence(
+	store sdk.KVStore,
+	height uint64,
+	proof []byte,
+	path commitmenttypes.MerklePath,
+	value []byte,
+) error {
+	if cs.GetLatestHeight() < height {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state (%s) sequence < proof height (%d < %d)", cs.ID, cs.GetLatestHeight(), height,
+		)
+	}
+
+	if cs.IsFrozen() {
+		return clienttypes.ErrClientFrozen
+	}
+
+	if len(proof) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProof, "proof cannot be empty")
+	}
+
+	signBytes := PathSignBytes(cs.ConsensusState.Sequence, path, value)
+	if err := VerifySignature(cs.ConsensusState.PubKey, signBytes, proof); err != nil {
+		return err
+	}
+
+	cs.ConsensusState.Sequence++
+	setClientState(store, cs)
+
+	return nil
+}
+
+// setClientState stores the client state in the client prefixed store, using
+// the same encoding as the client keeper.
+func setClientState(store sdk.KVStore, clientState ClientState) {
+	bz := SubModuleCdc.MustMarshalBinaryBare(clientState)
+	store.Set(host.KeyClientState(), bz)
+}
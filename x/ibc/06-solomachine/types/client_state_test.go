@@ -0,0 +1,370 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+const (
+	counterpartyClientID = "counterpartyclient"
+	testConnectionID     = "connectionid"
+	testPortID           = "testportid"
+	testChannelID        = "testchannelid"
+	testSequence         = 1
+)
+
+var prefix = commitmenttypes.NewMerklePrefix([]byte("ibc"))
+
+// verificationTestCase defines the cases shared by all the verification
+// functions of the solo machine client state.
+type verificationTestCase struct {
+	name        string
+	clientState types.ClientState
+	prefix      commitmentexported.Prefix
+	height      uint64
+	proof       []byte
+	expPass     bool
+}
+
+// verificationTestCases returns the shared verification test cases for the
+// given solo machine, where the value under the path is signed at the current
+// sequence of the solo machine.
+func verificationTestCases(
+	solomachine *ibctesting.Solomachine, path commitmenttypes.MerklePath, value []byte,
+) []verificationTestCase {
+	clientState := solomachine.ClientState()
+	proof := solomachine.GenerateSignature(types.PathSignBytes(solomachine.Sequence, path, value))
+
+	frozenClientState := solomachine.ClientState()
+	frozenClientState.FrozenSequence = 1
+
+	return []verificationTestCase{
+		{
+			"successful verification",
+			clientState,
+			prefix,
+			solomachine.Sequence,
+			proof,
+			true,
+		},
+		{
+			"proof height is lower than the client sequence",
+			clientState,
+			prefix,
+			solomachine.Sequence - 1,
+			proof,
+			true,
+		},
+		{
+			"client is frozen",
+			frozenClientState,
+			prefix,
+			solomachine.Sequence,
+			proof,
+			false,
+		},
+		{
+			"proof height is greater than the client sequence",
+			clientState,
+			prefix,
+			solomachine.Sequence + 1,
+			proof,
+			false,
+		},
+		{
+			"prefix is nil",
+			clientState,
+			nil,
+			solomachine.Sequence,
+			proof,
+			false,
+		},
+		{
+			"proof is nil",
+			clientState,
+			prefix,
+			solomachine.Sequence,
+			nil,
+			false,
+		},
+		{
+			"proof is signed at another sequence",
+			clientState,
+			prefix,
+			solomachine.Sequence,
+			solomachine.GenerateSignature(types.PathSignBytes(solomachine.Sequence+1, path, value)),
+			false,
+		},
+		{
+			"proof is signed over another value",
+			clientState,
+			prefix,
+			solomachine.Sequence,
+			solomachine.GenerateSignature(types.PathSignBytes(solomachine.Sequence, path, []byte("invalid value"))),
+			false,
+		},
+	}
+}
+
+// checkVerification checks the result of a verification and, on success, that
+// the client sequence was incremented in the client store.
+func (suite *SoloMachineTestSuite) checkVerification(tc verificationTestCase, err error) {
+	if !tc.expPass {
+		suite.Require().Error(err)
+		return
+	}
+
+	suite.Require().NoError(err)
+
+	clientState, found := suite.app.IBCKeeper.ClientKeeper.GetClientState(suite.ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(tc.clientState.ConsensusState.Sequence+1, clientState.GetLatestHeight())
+}
+
+func (suite *SoloMachineTestSuite) TestClientState() {
+	clientState := suite.solomachine.ClientState()
+
+	suite.Require().Equal(clientID, clientState.GetID())
+	suite.Require().Empty(clientState.GetChainID())
+	suite.Require().Equal(clientexported.SoloMachine, clientState.ClientType())
+	suite.Require().Equal(suite.solomachine.Sequence, clientState.GetLatestHeight())
+	suite.Require().False(clientState.IsFrozen())
+	suite.Require().Nil(clientState.GetProofSpecs())
+}
+
+func (suite *SoloMachineTestSuite) TestValidate() {
+	testCases := []struct {
+		name        string
+		clientState types.ClientState
+		expPass     bool
+	}{
+		{
+			name:        "valid client state",
+			clientState: suite.solomachine.ClientState(),
+			expPass:     true,
+		},
+		{
+			name:        "valid multisig client state",
+			clientState: suite.solomachineMulti.ClientState(),
+			expPass:     true,
+		},
+		{
+			name:        "invalid client id",
+			clientState: types.NewClientState("(testClientID)", suite.solomachine.ConsensusState()),
+			expPass:     false,
+		},
+		{
+			name:        "invalid consensus state",
+			clientState: types.NewClientState(clientID, types.ConsensusState{}),
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.clientState.Validate()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyClientConsensusState() {
+	consensusHeight := uint64(10)
+	consensusState := suite.solomachineMulti.ConsensusState()
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, "clients/"+counterpartyClientID+"/"+host.ConsensusStatePath(consensusHeight))
+	suite.Require().NoError(err)
+
+	value, err := suite.aminoCdc.MarshalBinaryBare(consensusState)
+	suite.Require().NoError(err)
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		for _, tc := range verificationTestCases(solomachine, path, value) {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				err := tc.clientState.VerifyClientConsensusState(
+					suite.store(), suite.cdc, suite.aminoCdc, nil, tc.height, counterpartyClientID, consensusHeight, tc.prefix, tc.proof, consensusState,
+				)
+
+				suite.checkVerification(tc, err)
+			})
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyConnectionState() {
+	counterparty := connectiontypes.NewCounterparty(counterpartyClientID, testConnectionID, prefix)
+	conn := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, testConnectionID, clientID, counterparty, []string{"1.0.0"})
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.ConnectionPath(testConnectionID))
+	suite.Require().NoError(err)
+
+	value, err := suite.cdc.MarshalBinaryBare(&conn)
+	suite.Require().NoError(err)
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		for _, tc := range verificationTestCases(solomachine, path, value) {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				err := tc.clientState.VerifyConnectionState(
+					suite.store(), suite.cdc, tc.height, tc.prefix, tc.proof, testConnectionID, conn, nil,
+				)
+
+				suite.checkVerification(tc, err)
+			})
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyChannelState() {
+	counterparty := channeltypes.NewCounterparty(testPortID, testChannelID)
+	ch := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, counterparty, []string{testConnectionID}, "1.0.0")
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.ChannelPath(testPortID, testChannelID))
+	suite.Require().NoError(err)
+
+	value, err := suite.cdc.MarshalBinaryBare(&ch)
+	suite.Require().NoError(err)
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		for _, tc := range verificationTestCases(solomachine, path, value) {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				err := tc.clientState.VerifyChannelState(
+					suite.store(), suite.cdc, tc.height, tc.prefix, tc.proof, testPortID, testChannelID, ch, nil,
+				)
+
+				suite.checkVerification(tc, err)
+			})
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyPacketCommitment() {
+	commitmentBytes := []byte("COMMITMENT BYTES")
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketCommitmentPath(testPortID, testChannelID, testSequence))
+	suite.Require().NoError(err)
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		for _, tc := range verificationTestCases(solomachine, path, commitmentBytes) {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				err := tc.clientState.VerifyPacketCommitment(
+					suite.store(), suite.cdc, tc.height, tc.prefix, tc.proof, testPortID, testChannelID, testSequence, commitmentBytes, nil,
+				)
+
+				suite.checkVerification(tc, err)
+			})
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyPacketAcknowledgement() {
+	ack := []byte("ACK")
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketAcknowledgementPath(testPortID, testChannelID, testSequence))
+	suite.Require().NoError(err)
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		for _, tc := range verificationTestCases(solomachine, path, channeltypes.CommitAcknowledgement(ack)) {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				err := tc.clientState.VerifyPacketAcknowledgement(
+					suite.store(), suite.cdc, tc.height, tc.prefix, tc.proof, testPortID, testChannelID, testSequence, ack, nil,
+				)
+
+				suite.checkVerification(tc, err)
+			})
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyPacketAcknowledgementAbsence() {
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketAcknowledgementPath(testPortID, testChannelID, testSequence))
+	suite.Require().NoError(err)
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		for _, tc := range verificationTestCases(solomachine, path, nil) {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				err := tc.clientState.VerifyPacketAcknowledgementAbsence(
+					suite.store(), suite.cdc, tc.height, tc.prefix, tc.proof, testPortID, testChannelID, testSequence, nil,
+				)
+
+				suite.checkVerification(tc, err)
+			})
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyNextSequenceRecv() {
+	nextSeqRecv := uint64(testSequence + 1)
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.NextSequenceRecvPath(testPortID, testChannelID))
+	suite.Require().NoError(err)
+
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		for _, tc := range verificationTestCases(solomachine, path, sdk.Uint64ToBigEndian(nextSeqRecv)) {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				err := tc.clientState.VerifyNextSequenceRecv(
+					suite.store(), suite.cdc, tc.height, tc.prefix, tc.proof, testPortID, testChannelID, nextSeqRecv, nil,
+				)
+
+				suite.checkVerification(tc, err)
+			})
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyWithSequentialProofs() {
+	clientState := suite.solomachine.ClientState()
+	suite.app.IBCKeeper.ClientKeeper.SetClientState(suite.ctx, clientState)
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketCommitmentPath(testPortID, testChannelID, testSequence))
+	suite.Require().NoError(err)
+
+	commitmentBytes := []byte("COMMITMENT BYTES")
+	proofOne := suite.solomachine.CreateProof(path, commitmentBytes)
+	proofTwo := suite.solomachine.CreateProof(path, commitmentBytes)
+
+	verify := func(proof []byte) error {
+		cs, found := suite.app.IBCKeeper.ClientKeeper.GetClientState(suite.ctx, clientID)
+		suite.Require().True(found)
+
+		return cs.VerifyPacketCommitment(
+			suite.store(), suite.cdc, clientState.GetLatestHeight(), prefix, proof, testPortID, testChannelID, testSequence, commitmentBytes, nil,
+		)
+	}
+
+	suite.Require().NoError(verify(proofOne))
+	// proofs cannot be replayed once the sequence has been incremented
+	suite.Require().Error(verify(proofOne))
+	suite.Require().NoError(verify(proofTwo))
+
+	cs, found := suite.app.IBCKeeper.ClientKeeper.GetClientState(suite.ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.solomachine.Sequence, cs.GetLatestHeight())
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+)
+
+var _ clientexported.ConsensusState = ConsensusState{}
+
+// ConsensusState defines a solo machine consensus state. The public key is
+// used to verify all the signatures produced by the solo machine, starting
+// from the given sequence.
+type ConsensusState struct {
+	// Sequence of the next signature expected from the solo machine
+	Sequence uint64 `json:"sequence" yaml:"sequence"`
+	// PubKey that verifies the solo machine signatures. It can be a multisig
+	// public key.
+	PubKey crypto.PubKey `json:"pub_key" yaml:"pub_key"`
+	// Timestamp (in nanoseconds) attested by the solo machine
+	Timestamp uint64 `json:"timestamp" yaml:"timestamp"`
+}
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(sequence uint64, pubKey crypto.PubKey, timestamp uint64) ConsensusState {
+	return ConsensusState{
+		Sequence:  sequence,
+		PubKey:    pubKey,
+		Timestamp: timestamp,
+	}
+}
+
+// ClientType returns SoloMachine
+func (ConsensusState) ClientType() clientexported.ClientType {
+	return clientexported.SoloMachine
+}
+
+// GetHeight returns the sequence of the consensus state, which is used as its
+// height.
+func (cs ConsensusState) GetHeight() uint64 {
+	return cs.Sequence
+}
+
+// GetRoot returns nil since solo machines do not have roots.
+func (cs ConsensusState) GetRoot() commitmentexported.Root {
+	return nil
+}
+
+// GetTimestamp returns the timestamp (in nanoseconds) attested by the solo machine.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// ValidateBasic defines a basic validation for the solo machine consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.Sequence == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "sequence cannot be 0")
+	}
+	if cs.Timestamp == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be 0")
+	}
+	if cs.PubKey == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "public key cannot be nil")
+	}
+	return nil
+}
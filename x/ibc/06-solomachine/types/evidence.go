@@ -0,0 +1,117 @@
+package types
+
+import (
+	"bytes"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	evidenceexported "github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+var (
+	_ evidenceexported.Evidence   = Evidence{}
+	_ clientexported.Misbehaviour = Evidence{}
+)
+
+// Evidence of a solo machine misbehaviour: two signatures over different data
+// produced at the same sequence.
+type Evidence struct {
+	ClientID     string           `json:"client_id" yaml:"client_id"`
+	Sequence     uint64           `json:"sequence" yaml:"sequence"`
+	SignatureOne SignatureAndData `json:"signature_one" yaml:"signature_one"`
+	SignatureTwo SignatureAndData `json:"signature_two" yaml:"signature_two"`
+}
+
+// SignatureAndData contains a signature and the path and data it signs.
+type SignatureAndData struct {
+	Signature []byte `json:"signature" yaml:"signature"`
+	Path      string `json:"path" yaml:"path"`
+	Data      []byte `json:"data" yaml:"data"`
+}
+
+// ClientType is solo machine light client
+func (ev Evidence) ClientType() clientexported.ClientType {
+	return clientexported.SoloMachine
+}
+
+// GetClientID returns the ID of the client that committed a misbehaviour.
+func (ev Evidence) GetClientID() string {
+	return ev.ClientID
+}
+
+// Route implements Evidence interface
+func (ev Evidence) Route() string {
+	return clienttypes.SubModuleName
+}
+
+// Type implements Evidence interface
+func (ev Evidence) Type() string {
+	return "client_misbehaviour"
+}
+
+// String implements Evidence interface
+func (ev Evidence) String() string {
+	bz, err := yaml.Marshal(ev)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// Hash implements Evidence interface
+func (ev Evidence) Hash() tmbytes.HexBytes {
+	bz := SubModuleCdc.MustMarshalBinaryBare(ev)
+	return tmhash.Sum(bz)
+}
+
+// GetHeight returns the sequence at which misbehaviour occurred
+func (ev Evidence) GetHeight() int64 {
+	return int64(ev.Sequence)
+}
+
+// ValidateBasic implements Evidence interface
+func (ev Evidence) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(ev.ClientID); err != nil {
+		return sdkerrors.Wrap(err, "evidence client ID is invalid")
+	}
+	if ev.Sequence == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidEvidence, "sequence cannot be 0")
+	}
+	if err := ev.SignatureOne.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "signature one failed basic validation")
+	}
+	if err := ev.SignatureTwo.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "signature two failed basic validation")
+	}
+	// the same data signed twice is not a misbehaviour
+	if ev.SignatureOne.Path == ev.SignatureTwo.Path && bytes.Equal(ev.SignatureOne.Data, ev.SignatureTwo.Data) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidEvidence, "evidence signs the same path and data twice")
+	}
+	return nil
+}
+
+// SignBytes returns the bytes the signature is expected to be produced over at
+// the given sequence.
+func (sd SignatureAndData) SignBytes(sequence uint64) []byte {
+	return GetSignBytes(sequence, sd.Path, sd.Data)
+}
+
+// ValidateBasic ensures that the signature is non-empty and that it signs
+// either a path or some data.
+func (sd SignatureAndData) ValidateBasic() error {
+	if len(sd.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignatureAndData, "signature cannot be empty")
+	}
+	// data is only empty for proofs of absence, which always have a path
+	if len(sd.Data) == 0 && sd.Path == "" {
+		return sdkerrors.Wrap(ErrInvalidSignatureAndData, "path and data for signature cannot both be empty")
+	}
+	return nil
+}
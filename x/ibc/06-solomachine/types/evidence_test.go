@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+func (suite *SoloMachineTestSuite) TestEvidence() {
+	evidence := suite.solomachine.CreateEvidence()
+
+	suite.Require().Equal(clientexported.SoloMachine, evidence.ClientType())
+	suite.Require().Equal(suite.solomachine.ClientID, evidence.GetClientID())
+	suite.Require().Equal("client_misbehaviour", evidence.Type())
+	suite.Require().Equal(int64(suite.solomachine.Sequence), evidence.GetHeight())
+	suite.Require().NotEmpty(evidence.Hash())
+	suite.Require().NotEmpty(evidence.String())
+}
+
+func (suite *SoloMachineTestSuite) TestEvidenceValidateBasic() {
+	testCases := []struct {
+		name             string
+		malleateEvidence func(evidence *types.Evidence)
+		expPass          bool
+	}{
+		{
+			"valid evidence",
+			func(*types.Evidence) {},
+			true,
+		},
+		{
+			"invalid client ID",
+			func(evidence *types.Evidence) {
+				evidence.ClientID = "(badclientid)"
+			},
+			false,
+		},
+		{
+			"sequence is zero",
+			func(evidence *types.Evidence) {
+				evidence.Sequence = 0
+			},
+			false,
+		},
+		{
+			"signature one is empty",
+			func(evidence *types.Evidence) {
+				evidence.SignatureOne.Signature = []byte{}
+			},
+			false,
+		},
+		{
+			"signature two is empty",
+			func(evidence *types.Evidence) {
+				evidence.SignatureTwo.Signature = []byte{}
+			},
+			false,
+		},
+		{
+			"signature one path and data are empty",
+			func(evidence *types.Evidence) {
+				evidence.SignatureOne.Data = []byte{}
+			},
+			false,
+		},
+		{
+			"signature two data is empty with a path",
+			func(evidence *types.Evidence) {
+				evidence.SignatureTwo.Path = "/ibc/connections/connectionid"
+				evidence.SignatureTwo.Data = []byte{}
+			},
+			true,
+		},
+		{
+			"signatures sign the same path and data",
+			func(evidence *types.Evidence) {
+				evidence.SignatureTwo.Data = evidence.SignatureOne.Data
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			evidence := suite.solomachine.CreateEvidence()
+			tc.malleateEvidence(&evidence)
+
+			err := evidence.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+var _ clientexported.Header = Header{}
+
+// Header defines a solo machine consensus header. It is signed at the current
+// sequence by the current public key of the client and rotates it to the new
+// public key.
+type Header struct {
+	// Sequence the header is signed at
+	Sequence     uint64        `json:"sequence" yaml:"sequence"`
+	Signature    []byte        `json:"signature" yaml:"signature"`
+	NewPubKey    crypto.PubKey `json:"new_pub_key" yaml:"new_pub_key"`
+	NewTimestamp uint64        `json:"new_timestamp" yaml:"new_timestamp"`
+}
+
+// ClientType defines that the Header is a solo machine header.
+func (Header) ClientType() clientexported.ClientType {
+	return clientexported.SoloMachine
+}
+
+// GetHeight returns the sequence the header is signed at.
+func (h Header) GetHeight() uint64 {
+	return h.Sequence
+}
+
+// ConsensusState returns the consensus state that results from applying the
+// header, which is valid starting from the next sequence.
+func (h Header) ConsensusState() ConsensusState {
+	return NewConsensusState(h.Sequence+1, h.NewPubKey, h.NewTimestamp)
+}
+
+// ValidateBasic ensures that the sequence, signature, timestamp and public
+// key are all non-empty.
+func (h Header) ValidateBasic() error {
+	if h.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "sequence cannot be 0")
+	}
+	if len(h.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "signature cannot be empty")
+	}
+	if h.NewTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "new timestamp cannot be 0")
+	}
+	if h.NewPubKey == nil {
+		return sdkerrors.Wrap(ErrInvalidHeader, "new public key cannot be nil")
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
+	header := suite.solomachine.CreateHeader()
+
+	suite.Require().Equal(clientexported.SoloMachine, header.ClientType())
+	suite.Require().Equal(suite.solomachine.Sequence-1, header.GetHeight())
+
+	testCases := []struct {
+		name    string
+		header  types.Header
+		expPass bool
+	}{
+		{
+			"valid header",
+			header,
+			true,
+		},
+		{
+			"sequence is zero",
+			types.Header{
+				Sequence:     0,
+				Signature:    header.Signature,
+				NewPubKey:    header.NewPubKey,
+				NewTimestamp: header.NewTimestamp,
+			},
+			false,
+		},
+		{
+			"signature is empty",
+			types.Header{
+				Sequence:     header.Sequence,
+				Signature:    []byte{},
+				NewPubKey:    header.NewPubKey,
+				NewTimestamp: header.NewTimestamp,
+			},
+			false,
+		},
+		{
+			"timestamp is zero",
+			types.Header{
+				Sequence:     header.Sequence,
+				Signature:    header.Signature,
+				NewPubKey:    header.NewPubKey,
+				NewTimestamp: 0,
+			},
+			false,
+		},
+		{
+			"public key is nil",
+			types.Header{
+				Sequence:     header.Sequence,
+				Signature:    header.Signature,
+				NewPubKey:    nil,
+				NewTimestamp: header.NewTimestamp,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.header.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestHeaderConsensusState() {
+	header := suite.solomachineMulti.CreateHeader()
+	consensusState := header.ConsensusState()
+
+	suite.Require().Equal(header.Sequence+1, consensusState.Sequence)
+	suite.Require().Equal(header.NewPubKey, consensusState.PubKey)
+	suite.Require().Equal(header.NewTimestamp, consensusState.Timestamp)
+	suite.Require().Equal(suite.solomachineMulti.ConsensusState(), consensusState)
+}
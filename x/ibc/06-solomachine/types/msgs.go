@@ -0,0 +1,151 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// Message types for the IBC solo machine client
+const (
+	TypeMsgCreateClient string = "create_client"
+	TypeMsgUpdateClient string = "update_client"
+)
+
+var (
+	_ clientexported.MsgCreateClient = &MsgCreateClient{}
+	_ clientexported.MsgUpdateClient = &MsgUpdateClient{}
+)
+
+// MsgCreateClient defines a message to create a solo machine client
+type MsgCreateClient struct {
+	ClientID       string         `json:"client_id" yaml:"client_id"`
+	ConsensusState ConsensusState `json:"consensus_state" yaml:"consensus_state"`
+	Signer         sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// this is a constant to satisfy the linter
+const TODO = "TODO"
+
+// dummy implementation of proto.Message
+func (msg MsgCreateClient) Reset()         {}
+func (msg MsgCreateClient) String() string { return TODO }
+func (msg MsgCreateClient) ProtoMessage()  {}
+
+// NewMsgCreateClient creates a new MsgCreateClient instance
+func NewMsgCreateClient(id string, consensusState ConsensusState, signer sdk.AccAddress) MsgCreateClient {
+	return MsgCreateClient{
+		ClientID:       id,
+		ConsensusState: consensusState,
+		Signer:         signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateClient) Route() string {
+	return host.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgCreateClient) Type() string {
+	return TypeMsgCreateClient
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateClient) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if err := msg.ConsensusState.ValidateBasic(); err != nil {
+		return err
+	}
+	return host.ClientIdentifierValidator(msg.ClientID)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// GetClientID implements clientexported.MsgCreateClient
+func (msg MsgCreateClient) GetClientID() string {
+	return msg.ClientID
+}
+
+// GetClientType implements clientexported.MsgCreateClient
+func (msg MsgCreateClient) GetClientType() string {
+	return clientexported.ClientTypeSoloMachine
+}
+
+// GetConsensusState implements clientexported.MsgCreateClient
+func (msg MsgCreateClient) GetConsensusState() clientexported.ConsensusState {
+	return msg.ConsensusState
+}
+
+// MsgUpdateClient defines a message to update a solo machine client
+type MsgUpdateClient struct {
+	ClientID string         `json:"client_id" yaml:"client_id"`
+	Header   Header         `json:"header" yaml:"header"`
+	Signer   sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// dummy implementation of proto.Message
+func (msg MsgUpdateClient) Reset()         {}
+func (msg MsgUpdateClient) String() string { return TODO }
+func (msg MsgUpdateClient) ProtoMessage()  {}
+
+// NewMsgUpdateClient creates a new MsgUpdateClient instance
+func NewMsgUpdateClient(id string, header Header, signer sdk.AccAddress) MsgUpdateClient {
+	return MsgUpdateClient{
+		ClientID: id,
+		Header:   header,
+		Signer:   signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateClient) Route() string {
+	return host.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgUpdateClient) Type() string {
+	return TypeMsgUpdateClient
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateClient) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if err := msg.Header.ValidateBasic(); err != nil {
+		return err
+	}
+	return host.ClientIdentifierValidator(msg.ClientID)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// GetClientID implements clientexported.MsgUpdateClient
+func (msg MsgUpdateClient) GetClientID() string {
+	return msg.ClientID
+}
+
+// GetHeader implements clientexported.MsgUpdateClient
+func (msg MsgUpdateClient) GetHeader() clientexported.Header {
+	return msg.Header
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+func (suite *SoloMachineTestSuite) TestMsgCreateClientValidateBasic() {
+	signer := sdk.AccAddress("signer")
+
+	cases := []struct {
+		msg     types.MsgCreateClient
+		expPass bool
+		errMsg  string
+	}{
+		{types.NewMsgCreateClient(clientID, suite.solomachine.ConsensusState(), signer), true, "success msg should pass"},
+		{types.NewMsgCreateClient(clientID, suite.solomachineMulti.ConsensusState(), signer), true, "multisig msg should pass"},
+		{types.NewMsgCreateClient("(BADCHAIN)", suite.solomachine.ConsensusState(), signer), false, "invalid client id passed"},
+		{types.NewMsgCreateClient(clientID, types.ConsensusState{}, signer), false, "invalid consensus state passed"},
+		{types.NewMsgCreateClient(clientID, suite.solomachine.ConsensusState(), nil), false, "empty address passed"},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "Msg %d failed: %v", i, tc.errMsg)
+		} else {
+			suite.Require().Error(err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestMsgUpdateClientValidateBasic() {
+	signer := sdk.AccAddress("signer")
+	header := suite.solomachine.CreateHeader()
+
+	cases := []struct {
+		msg     types.MsgUpdateClient
+		expPass bool
+		errMsg  string
+	}{
+		{types.NewMsgUpdateClient(clientID, header, signer), true, "success msg should pass"},
+		{types.NewMsgUpdateClient("(badClient)", header, signer), false, "invalid client id passed"},
+		{types.NewMsgUpdateClient(clientID, types.Header{}, signer), false, "invalid header passed"},
+		{types.NewMsgUpdateClient(clientID, header, nil), false, "empty address passed"},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "Msg %d failed: %v", i, tc.errMsg)
+		} else {
+			suite.Require().Error(err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+// SignBytes defines the bytes signed by the solo machine. Every signature
+// commits to the sequence it was produced at, which prevents it from being
+// replayed once the client has moved on to the next sequence.
+type SignBytes struct {
+	Sequence uint64 `json:"sequence" yaml:"sequence"`
+	// Path of the signed value. It is empty for headers.
+	Path string `json:"path" yaml:"path"`
+	Data []byte `json:"data" yaml:"data"`
+}
+
+// HeaderData defines the data signed by the solo machine in order to update
+// its public key and timestamp.
+type HeaderData struct {
+	NewPubKey    crypto.PubKey `json:"new_pub_key" yaml:"new_pub_key"`
+	NewTimestamp uint64        `json:"new_timestamp" yaml:"new_timestamp"`
+}
+
+// GetSignBytes returns the amino encoded bytes of the data signed by the solo
+// machine at the given sequence.
+func GetSignBytes(sequence uint64, path string, data []byte) []byte {
+	return SubModuleCdc.MustMarshalBinaryBare(SignBytes{
+		Sequence: sequence,
+		Path:     path,
+		Data:     data,
+	})
+}
+
+// PathSignBytes returns the sign bytes of the value stored under the given
+// commitment path at the given sequence. A nil value is used for proofs of
+// absence.
+func PathSignBytes(sequence uint64, path commitmenttypes.MerklePath, value []byte) []byte {
+	return GetSignBytes(sequence, path.String(), value)
+}
+
+// HeaderSignBytes returns the sign bytes of the given header.
+func HeaderSignBytes(header Header) []byte {
+	data := SubModuleCdc.MustMarshalBinaryBare(HeaderData{
+		NewPubKey:    header.NewPubKey,
+		NewTimestamp: header.NewTimestamp,
+	})
+
+	return GetSignBytes(header.Sequence, "", data)
+}
+
+// VerifySignature verifies that the signature was produced over the sign bytes
+// by the private key(s) of the given public key. Multisig public keys expect
+// the signature to be an amino encoded multisignature.
+func VerifySignature(pubKey crypto.PubKey, signBytes, signature []byte) error {
+	if pubKey == nil {
+		return sdkerrors.Wrap(ErrSignatureVerificationFailed, "public key cannot be nil")
+	}
+	if len(signature) == 0 {
+		return sdkerrors.Wrap(ErrSignatureVerificationFailed, "signature cannot be empty")
+	}
+	if !pubKey.VerifyBytes(signBytes, signature) {
+		return ErrSignatureVerificationFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+const (
+	clientID = "solomachineclient"
+)
+
+type SoloMachineTestSuite struct {
+	suite.Suite
+
+	app      *simapp.SimApp
+	ctx      sdk.Context
+	aminoCdc *codec.Codec
+	cdc      codec.Marshaler
+
+	solomachine      *ibctesting.Solomachine // single public key
+	solomachineMulti *ibctesting.Solomachine // multisig public key
+}
+
+func (suite *SoloMachineTestSuite) SetupTest() {
+	isCheckTx := false
+	suite.app = simapp.Setup(isCheckTx)
+
+	suite.aminoCdc = suite.app.Codec()
+	suite.cdc = suite.app.AppCodec()
+	suite.ctx = suite.app.BaseApp.NewContext(isCheckTx, abci.Header{Height: 1})
+
+	suite.solomachine = ibctesting.NewSolomachine(suite.T(), clientID, 1)
+	suite.solomachineMulti = ibctesting.NewSolomachine(suite.T(), clientID, 4)
+}
+
+// store returns the client store of the solo machine client on the test app.
+func (suite *SoloMachineTestSuite) store() sdk.KVStore {
+	return suite.app.IBCKeeper.ClientKeeper.ClientStore(suite.ctx, clientID)
+}
+
+func TestSoloMachineTestSuite(t *testing.T) {
+	suite.Run(t, new(SoloMachineTestSuite))
+}
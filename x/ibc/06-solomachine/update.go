@@ -0,0 +1,85 @@
+package solomachine
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+// CheckHeaderAndUpdateState checks if the provided header is valid and updates
+// the consensus state if appropriate. It returns an error if:
+// - the client or header provided are not parseable to solo machine types
+// - the client is frozen
+// - the header sequence does not match the current sequence
+// - the header timestamp is lower than the current timestamp
+// - the header signature is not valid for the current public key
+func CheckHeaderAndUpdateState(
+	clientState clientexported.ClientState, header clientexported.Header,
+) (clientexported.ClientState, clientexported.ConsensusState, error) {
+	smClientState, ok := clientState.(types.ClientState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientType, "expected type %T, got %T", types.ClientState{}, clientState,
+		)
+	}
+
+	smHeader, ok := header.(types.Header)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader, "expected type %T, got %T", types.Header{}, header,
+		)
+	}
+
+	if err := checkHeader(smClientState, smHeader); err != nil {
+		return nil, nil, err
+	}
+
+	smClientState, consensusState := update(smClientState, smHeader)
+	return smClientState, consensusState, nil
+}
+
+// checkHeader checks if the solo machine header is valid.
+func checkHeader(clientState types.ClientState, header types.Header) error {
+	if clientState.IsFrozen() {
+		return clienttypes.ErrClientFrozen
+	}
+
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// assert update sequence is current sequence
+	if header.Sequence != clientState.ConsensusState.Sequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidSequence,
+			"header sequence does not match the client state sequence (%d != %d)", header.Sequence, clientState.ConsensusState.Sequence,
+		)
+	}
+
+	// assert the solo machine time does not go backwards
+	if header.NewTimestamp < clientState.ConsensusState.Timestamp {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidHeader,
+			"header timestamp is less than the consensus state timestamp (%d < %d)", header.NewTimestamp, clientState.ConsensusState.Timestamp,
+		)
+	}
+
+	// assert the header was signed by the current public key
+	if err := types.VerifySignature(
+		clientState.ConsensusState.PubKey, types.HeaderSignBytes(header), header.Signature,
+	); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidHeader, err.Error())
+	}
+
+	return nil
+}
+
+// update the consensus state to the new public key and timestamp, and
+// increment the sequence
+func update(clientState types.ClientState, header types.Header) (types.ClientState, types.ConsensusState) {
+	consensusState := header.ConsensusState()
+	clientState.ConsensusState = consensusState
+
+	return clientState, consensusState
+}
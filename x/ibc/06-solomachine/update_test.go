@@ -0,0 +1,142 @@
+package solomachine_test
+
+import (
+	solomachine "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateState() {
+	for _, solo := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		testCases := []struct {
+			name     string
+			malleate func() (types.ClientState, types.Header)
+			expPass  bool
+		}{
+			{
+				"successful update",
+				func() (types.ClientState, types.Header) {
+					return solo.ClientState(), solo.CreateHeader()
+				},
+				true,
+			},
+			{
+				"wrong client state type",
+				func() (types.ClientState, types.Header) {
+					return types.ClientState{}, solo.CreateHeader()
+				},
+				false,
+			},
+			{
+				"client is frozen",
+				func() (types.ClientState, types.Header) {
+					clientState := solo.ClientState()
+					clientState.FrozenSequence = 1
+					return clientState, solo.CreateHeader()
+				},
+				false,
+			},
+			{
+				"invalid header",
+				func() (types.ClientState, types.Header) {
+					clientState := solo.ClientState()
+					header := solo.CreateHeader()
+					header.NewPubKey = nil
+					return clientState, header
+				},
+				false,
+			},
+			{
+				"header sequence does not match the client sequence",
+				func() (types.ClientState, types.Header) {
+					clientState := solo.ClientState()
+					clientState.ConsensusState.Sequence++
+					return clientState, solo.CreateHeader()
+				},
+				false,
+			},
+			{
+				"header timestamp is less than the consensus state timestamp",
+				func() (types.ClientState, types.Header) {
+					clientState := solo.ClientState()
+					clientState.ConsensusState.Timestamp = solo.Time + 1
+					return clientState, solo.CreateHeader()
+				},
+				false,
+			},
+			{
+				"signature uses the wrong public key",
+				func() (types.ClientState, types.Header) {
+					clientState := solo.ClientState()
+					header := solo.CreateHeader()
+					// the solo machine has rotated to the new keys
+					header.Signature = solo.GenerateSignature(types.HeaderSignBytes(header))
+					return clientState, header
+				},
+				false,
+			},
+			{
+				"invalid signature",
+				func() (types.ClientState, types.Header) {
+					clientState := solo.ClientState()
+					header := solo.CreateHeader()
+					header.Signature = []byte("invalid signature")
+					return clientState, header
+				},
+				false,
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				clientState, header := tc.malleate()
+
+				newClientState, consensusState, err := solomachine.CheckHeaderAndUpdateState(clientState, header)
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+					suite.Require().Equal(header.Sequence+1, newClientState.GetLatestHeight())
+					suite.Require().Equal(header.ConsensusState(), consensusState)
+					suite.Require().Equal(solo.ConsensusState(), consensusState)
+				} else {
+					suite.Require().Error(err)
+					suite.Require().Nil(newClientState)
+					suite.Require().Nil(consensusState)
+				}
+			})
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateStateInvalidTypes() {
+	_, _, err := solomachine.CheckHeaderAndUpdateState(ibctmtypes.ClientState{}, suite.solomachine.CreateHeader())
+	suite.Require().Error(err)
+
+	_, _, err = solomachine.CheckHeaderAndUpdateState(suite.solomachine.ClientState(), ibctmtypes.Header{})
+	suite.Require().Error(err)
+}
+
+func (suite *SoloMachineTestSuite) TestUpdateClient() {
+	clientKeeper := suite.app.IBCKeeper.ClientKeeper
+
+	_, err := clientKeeper.CreateClient(suite.ctx, suite.solomachineMulti.ClientState(), suite.solomachineMulti.ConsensusState())
+	suite.Require().NoError(err)
+
+	header := suite.solomachineMulti.CreateHeader()
+
+	clientState, err := clientKeeper.UpdateClient(suite.ctx, clientID, header)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.solomachineMulti.Sequence, clientState.GetLatestHeight())
+
+	// the new consensus state is stored at the new sequence
+	consensusState, found := clientKeeper.GetClientConsensusState(suite.ctx, clientID, suite.solomachineMulti.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.solomachineMulti.ConsensusState(), consensusState)
+
+	// the header cannot be replayed
+	_, err = clientKeeper.UpdateClient(suite.ctx, clientID, header)
+	suite.Require().Error(err)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
 	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
 	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
@@ -399,11 +400,19 @@ func (suite *HandlerTestSuite) TestHandleTimeoutPacket() {
 
 			tc.malleate()
 
+			// fund the escrow account so that the transfer module can refund the
+			// native tokens of the timed out packet
+			escrow := ibctransfertypes.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+			_, err := suite.chainA.App.BankKeeper.AddCoins(
+				suite.chainA.GetContext(), escrow, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			)
+			suite.Require().NoError(err)
+
 			proof, proofHeight := suite.chainB.QueryProof(packetKey)
 
 			msg := channeltypes.NewMsgTimeout(packet, 1, proof, proofHeight, suite.chainA.SenderAccount.GetAddress())
 
-			_, err := handler(suite.chainA.GetContext(), msg)
+			_, err = handler(suite.chainA.GetContext(), msg)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
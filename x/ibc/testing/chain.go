@@ -40,6 +40,7 @@ const (
 	UnbondingPeriod time.Duration = time.Hour * 24 * 7 * 3
 	MaxClockDrift   time.Duration = time.Second * 10
 
+	TransferPort   = ibctransfertypes.PortID
 	ChannelVersion = ibctransfertypes.Version
 	InvalidID      = "IDisInvalid"
 
@@ -517,7 +518,7 @@ func (chain *TestChain) ChanOpenInit(
 ) error {
 	msg := channeltypes.NewMsgChannelOpenInit(
 		ch.PortID, ch.ID,
-		ChannelVersion, order, []string{connectionID},
+		ch.Version, order, []string{connectionID},
 		counterparty.PortID, counterparty.ID,
 		chain.SenderAccount.GetAddress(),
 	)
@@ -535,9 +536,9 @@ func (chain *TestChain) ChanOpenTry(
 
 	msg := channeltypes.NewMsgChannelOpenTry(
 		ch.PortID, ch.ID,
-		ChannelVersion, order, []string{connectionID},
+		ch.Version, order, []string{connectionID},
 		counterpartyCh.PortID, counterpartyCh.ID,
-		ChannelVersion,
+		counterpartyCh.Version,
 		proof, height,
 		chain.SenderAccount.GetAddress(),
 	)
@@ -553,7 +554,7 @@ func (chain *TestChain) ChanOpenAck(
 
 	msg := channeltypes.NewMsgChannelOpenAck(
 		ch.PortID, ch.ID,
-		ChannelVersion,
+		counterpartyCh.Version,
 		proof, height,
 		chain.SenderAccount.GetAddress(),
 	)
@@ -592,7 +593,7 @@ func (chain *TestChain) ChanCloseInit(
 // GetPacketData returns a ibc-transfer marshalled packet to be used for
 // callback testing
 func (chain *TestChain) GetPacketData(counterparty *TestChain) []byte {
-	packet := ibctransfertypes.NewFungibleTokenPacketData(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), chain.SenderAccount.GetAddress().String(), counterparty.SenderAccount.GetAddress().String())
+	packet := ibctransfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, 100, chain.SenderAccount.GetAddress().String(), counterparty.SenderAccount.GetAddress().String())
 
 	return packet.GetBytes()
 }
@@ -636,6 +637,39 @@ func (chain *TestChain) PacketExecuted(
 	return nil
 }
 
+// RecvPacket will construct and execute a MsgPacket for a packet sent by the counterparty
+// chain.
+func (chain *TestChain) RecvPacket(
+	counterparty *TestChain,
+	packet channeltypes.Packet,
+) error {
+	proof, height := counterparty.QueryProof(host.KeyPacketCommitment(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	msg := channeltypes.NewMsgPacket(
+		packet,
+		proof, height,
+		chain.SenderAccount.GetAddress(),
+	)
+	return chain.SendMsg(msg)
+}
+
+// AcknowledgePacket will construct and execute a MsgAcknowledgement for a packet sent by
+// this chain and acknowledged by the counterparty chain.
+func (chain *TestChain) AcknowledgePacket(
+	counterparty *TestChain,
+	packet channeltypes.Packet,
+	ack []byte,
+) error {
+	proof, height := counterparty.QueryProof(host.KeyPacketAcknowledgement(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	msg := channeltypes.NewMsgAcknowledgement(
+		packet, ack,
+		proof, height,
+		chain.SenderAccount.GetAddress(),
+	)
+	return chain.SendMsg(msg)
+}
+
 // AcknowledgementExecuted simulates deleting a packet commitment with the
 // given packet sequence.
 func (chain *TestChain) AcknowledgementExecuted(
@@ -170,8 +170,20 @@ func (coord *Coordinator) CreateChannel(
 	connA, connB *TestConnection,
 	order channeltypes.Order,
 ) (TestChannel, TestChannel) {
+	return coord.CreateChannelOnPort(chainA, chainB, connA, connB, TransferPort, ChannelVersion, order)
+}
+
+// CreateChannelOnPort constructs and executes channel handshake messages in order to create
+// OPEN channels on chainA and chainB, both on the given port and using the given version. The
+// function expects the channels to be successfully opened otherwise testing will fail.
+func (coord *Coordinator) CreateChannelOnPort(
+	chainA, chainB *TestChain,
+	connA, connB *TestConnection,
+	portID, version string,
+	order channeltypes.Order,
+) (TestChannel, TestChannel) {
 
-	channelA, channelB, err := coord.ChanOpenInit(chainA, chainB, connA, connB, order)
+	channelA, channelB, err := coord.ChanOpenInitOnPort(chainA, chainB, connA, connB, portID, version, order)
 	require.NoError(coord.t, err)
 
 	err = coord.ChanOpenTry(chainB, chainA, channelB, channelA, connB, order)
@@ -232,6 +244,47 @@ func (coord *Coordinator) PacketExecuted(
 	return nil
 }
 
+// RecvPacket receives a packet sent by the counterparty chain on the source chain by
+// executing a MsgPacket, which runs the application callbacks and writes the
+// acknowledgement. The counterparty client for the source chain is updated.
+func (coord *Coordinator) RecvPacket(
+	source, counterparty *TestChain,
+	packet channeltypes.Packet,
+	counterpartyClientID string,
+) error {
+	if err := source.RecvPacket(counterparty, packet); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	// update source client on counterparty connection
+	return coord.UpdateClient(
+		counterparty, source,
+		counterpartyClientID, clientexported.Tendermint,
+	)
+}
+
+// AcknowledgePacket acknowledges a packet sent by the source chain by executing a
+// MsgAcknowledgement with the acknowledgement written on the counterparty chain, which
+// runs the application callbacks. The counterparty client for the source chain is updated.
+func (coord *Coordinator) AcknowledgePacket(
+	source, counterparty *TestChain,
+	packet channeltypes.Packet,
+	ack []byte,
+	counterpartyClientID string,
+) error {
+	if err := source.AcknowledgePacket(counterparty, packet, ack); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	// update source client on counterparty connection
+	return coord.UpdateClient(
+		counterparty, source,
+		counterpartyClientID, clientexported.Tendermint,
+	)
+}
+
 // AcknowledgementExecuted deletes the packet commitment with the given
 // packet sequence since the acknowledgement has been verified.
 func (coord *Coordinator) AcknowledgementExecuted(
@@ -407,8 +460,23 @@ func (coord *Coordinator) ChanOpenInit(
 	connection, counterpartyConnection *TestConnection,
 	order channeltypes.Order,
 ) (TestChannel, TestChannel, error) {
-	sourceChannel := connection.AddTestChannel()
-	counterpartyChannel := counterpartyConnection.AddTestChannel()
+	return coord.ChanOpenInitOnPort(source, counterparty, connection, counterpartyConnection, TransferPort, ChannelVersion, order)
+}
+
+// ChanOpenInitOnPort initializes a channel on the source chain with the state INIT
+// using the OpenInit handshake call. The channels on both chains use the given port
+// and version.
+//
+// NOTE: The counterparty testing channel will be created even if it is not created in the
+// application state.
+func (coord *Coordinator) ChanOpenInitOnPort(
+	source, counterparty *TestChain,
+	connection, counterpartyConnection *TestConnection,
+	portID, version string,
+	order channeltypes.Order,
+) (TestChannel, TestChannel, error) {
+	sourceChannel := connection.AddTestChannelOnPort(portID, version)
+	counterpartyChannel := counterpartyConnection.AddTestChannelOnPort(portID, version)
 
 	// create port capability
 	source.CreatePortCapability(sourceChannel.PortID)
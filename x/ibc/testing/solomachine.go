@@ -0,0 +1,396 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+const (
+	// SolomachineConnectionID is the identifier of the solo machine end of the
+	// connections opened with the solo machine.
+	SolomachineConnectionID = "solomachineconn"
+)
+
+// SolomachinePrefix is the commitment prefix of the paths of the values
+// signed by the solo machine.
+var SolomachinePrefix = commitmenttypes.NewMerklePrefix([]byte("solomachine"))
+
+// Solomachine is a testing helper used to simulate a counterparty
+// solo machine client. It holds the private keys of the solo machine and
+// signs at its current sequence, which is incremented after every signature
+// expected to be verified by the client.
+type Solomachine struct {
+	t *testing.T
+
+	ClientID    string
+	PrivateKeys []crypto.PrivKey // keys used for signing
+	PublicKeys  []crypto.PubKey  // keys used for generating solo machine pub key
+	PublicKey   crypto.PubKey    // key used for verification
+	Sequence    uint64
+	Time        uint64
+}
+
+// NewSolomachine returns a new solomachine instance with an `nKeys` amount of
+// generated private/public key pairs and a sequence starting at 1. If nKeys
+// is greater than 1 then a multisig public key is used.
+func NewSolomachine(t *testing.T, clientID string, nKeys uint64) *Solomachine {
+	privKeys, pubKeys, pk := GenerateKeys(t, nKeys)
+
+	return &Solomachine{
+		t:           t,
+		ClientID:    clientID,
+		PrivateKeys: privKeys,
+		PublicKeys:  pubKeys,
+		PublicKey:   pk,
+		Sequence:    1,
+		Time:        10,
+	}
+}
+
+// GenerateKeys generates a new set of secp256k1 private keys and public keys.
+// If the number of keys is greater than one then the public key returned is a
+// k of n multisig public key, with k equal to n. The public key returned is
+// the single public key otherwise.
+func GenerateKeys(t *testing.T, n uint64) ([]crypto.PrivKey, []crypto.PubKey, crypto.PubKey) {
+	require.NotEqual(t, uint64(0), n, "generation of zero keys is not allowed")
+
+	privKeys := make([]crypto.PrivKey, n)
+	pubKeys := make([]crypto.PubKey, n)
+	for i := uint64(0); i < n; i++ {
+		privKeys[i] = secp256k1.GenPrivKey()
+		pubKeys[i] = privKeys[i].PubKey()
+	}
+
+	var pk crypto.PubKey
+	if len(privKeys) > 1 {
+		pk = multisig.NewPubKeyMultisigThreshold(len(privKeys), pubKeys)
+	} else {
+		pk = privKeys[0].PubKey()
+	}
+
+	return privKeys, pubKeys, pk
+}
+
+// ClientState returns a new solo machine ClientState instance.
+func (solo *Solomachine) ClientState() solomachinetypes.ClientState {
+	return solomachinetypes.NewClientState(solo.ClientID, solo.ConsensusState())
+}
+
+// ConsensusState returns a new solo machine ConsensusState instance.
+func (solo *Solomachine) ConsensusState() solomachinetypes.ConsensusState {
+	return solomachinetypes.NewConsensusState(solo.Sequence, solo.PublicKey, solo.Time)
+}
+
+// CreateHeader generates a new private/public key pair and creates the
+// necessary signature to construct a valid solo machine header. The solo
+// machine then rotates to the new keys and increments its sequence.
+func (solo *Solomachine) CreateHeader() solomachinetypes.Header {
+	// generate new private keys and signature for header
+	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
+
+	header := solomachinetypes.Header{
+		Sequence:     solo.Sequence,
+		NewPubKey:    newPubKey,
+		NewTimestamp: solo.Time,
+	}
+	header.Signature = solo.GenerateSignature(solomachinetypes.HeaderSignBytes(header))
+
+	// assumes successful header update
+	solo.Sequence++
+	solo.PrivateKeys = newPrivKeys
+	solo.PublicKeys = newPubKeys
+	solo.PublicKey = newPubKey
+
+	return header
+}
+
+// CreateEvidence constructs valid evidence for the solo machine at its current
+// sequence: two signatures over different data.
+func (solo *Solomachine) CreateEvidence() solomachinetypes.Evidence {
+	dataOne := []byte("DATA ONE")
+	dataTwo := []byte("DATA TWO")
+
+	signatureOne := solomachinetypes.SignatureAndData{
+		Signature: solo.GenerateSignature(solomachinetypes.GetSignBytes(solo.Sequence, "", dataOne)),
+		Data:      dataOne,
+	}
+	signatureTwo := solomachinetypes.SignatureAndData{
+		Signature: solo.GenerateSignature(solomachinetypes.GetSignBytes(solo.Sequence, "", dataTwo)),
+		Data:      dataTwo,
+	}
+
+	return solomachinetypes.Evidence{
+		ClientID:     solo.ClientID,
+		Sequence:     solo.Sequence,
+		SignatureOne: signatureOne,
+		SignatureTwo: signatureTwo,
+	}
+}
+
+// CreateProof signs the value stored under the given path at the current
+// sequence and increments the sequence. The returned signature is a valid
+// proof for the solo machine client.
+func (solo *Solomachine) CreateProof(path commitmenttypes.MerklePath, value []byte) []byte {
+	proof := solo.GenerateSignature(solomachinetypes.PathSignBytes(solo.Sequence, path, value))
+
+	// assumes successful verification
+	solo.Sequence++
+
+	return proof
+}
+
+// CreatePathProof signs the value stored under the given path of the solo
+// machine, prefixed by the solo machine commitment prefix, at the current
+// sequence and increments the sequence.
+func (solo *Solomachine) CreatePathProof(path string, value []byte) []byte {
+	merklePath, err := commitmenttypes.ApplyPrefix(SolomachinePrefix, path)
+	require.NoError(solo.t, err)
+
+	return solo.CreateProof(merklePath, value)
+}
+
+// GenerateSignature uses the stored private keys to generate a signature
+// over the sign bytes with each key. If the amount of keys is greater than
+// 1 then an amino encoded multisignature is returned.
+func (solo *Solomachine) GenerateSignature(signBytes []byte) []byte {
+	sigs := make([][]byte, len(solo.PrivateKeys))
+	for i, key := range solo.PrivateKeys {
+		sig, err := key.Sign(signBytes)
+		require.NoError(solo.t, err)
+
+		sigs[i] = sig
+	}
+
+	if len(sigs) == 1 {
+		// single public key
+		return sigs[0]
+	}
+
+	// multisig public key
+	bitArray := cryptotypes.NewCompactBitArray(len(sigs))
+	for i := range sigs {
+		bitArray.SetIndex(i, true)
+	}
+
+	return multisig.Cdc.MustMarshalBinaryBare(multisig.AminoMultisignature{
+		BitArray: bitArray,
+		Sigs:     sigs,
+	})
+}
+
+// CreateSolomachineClient creates a client of the solo machine on the chain.
+func (coord *Coordinator) CreateSolomachineClient(chain *TestChain, solo *Solomachine) error {
+	msg := solomachinetypes.NewMsgCreateClient(solo.ClientID, solo.ConsensusState(), chain.SenderAccount.GetAddress())
+	if err := chain.SendMsg(msg); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	return nil
+}
+
+// UpdateSolomachineClient updates the client of the solo machine on the chain
+// with a new header of the solo machine, which stores a consensus state at the
+// current sequence of the solo machine. The proofs of the solo machine must be
+// verified at the height of a stored consensus state, so the client is updated
+// before every step verifying a proof.
+func (coord *Coordinator) UpdateSolomachineClient(chain *TestChain, solo *Solomachine) error {
+	msg := solomachinetypes.NewMsgUpdateClient(solo.ClientID, solo.CreateHeader(), chain.SenderAccount.GetAddress())
+	if err := chain.SendMsg(msg); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	return nil
+}
+
+// CreateSolomachineConnection opens a connection between the chain and the
+// solo machine, whose client of the chain has the given identifier. The
+// handshake is initiated by the chain: the solo machine performs the OpenTry
+// and OpenConfirm steps off chain, and proves its TRYOPEN connection end and
+// the consensus state of its client of the chain to the chain. The function
+// expects the connection to be successfully opened otherwise testing will fail.
+func (coord *Coordinator) CreateSolomachineConnection(
+	chain *TestChain, solo *Solomachine,
+	counterpartyClientID string,
+) (*TestConnection, *TestConnection) {
+	connection := chain.AddTestConnection(solo.ClientID, counterpartyClientID)
+	counterpartyConnection := &TestConnection{
+		ID:                   SolomachineConnectionID,
+		ClientID:             counterpartyClientID,
+		CounterpartyClientID: solo.ClientID,
+	}
+
+	msgInit := connectiontypes.NewMsgConnectionOpenInit(
+		connection.ID, connection.ClientID,
+		counterpartyConnection.ID, connection.CounterpartyClientID,
+		SolomachinePrefix,
+		chain.SenderAccount.GetAddress(),
+	)
+	require.NoError(coord.t, chain.SendMsg(msgInit))
+	coord.IncrementTime()
+
+	require.NoError(coord.t, coord.UpdateSolomachineClient(chain, solo))
+	proofHeight := solo.Sequence
+
+	cdc, aminoCdc := chain.App.IBCKeeper.Codecs()
+
+	counterparty := connectiontypes.NewCounterparty(connection.ClientID, connection.ID, chain.GetPrefix())
+	tryConnection := connectiontypes.NewConnectionEnd(
+		connectiontypes.TRYOPEN, counterpartyConnection.ID, counterpartyConnection.ClientID,
+		counterparty, []string{ConnectionVersion},
+	)
+	proofTry := solo.CreatePathProof(
+		host.ConnectionPath(counterpartyConnection.ID), cdc.MustMarshalBinaryBare(&tryConnection),
+	)
+
+	// the consensus height must be lower than the height of the last committed
+	// block, against which the message is checked
+	consensusHeight := chain.LastHeader.GetHeight() - 1
+	consensusState, found := chain.App.IBCKeeper.ClientKeeper.GetSelfConsensusState(chain.GetContext(), consensusHeight)
+	require.True(coord.t, found)
+	proofConsensus := solo.CreatePathProof(
+		"clients/"+counterpartyClientID+"/"+host.ConsensusStatePath(consensusHeight),
+		aminoCdc.MustMarshalBinaryBare(consensusState),
+	)
+
+	msgAck := connectiontypes.NewMsgConnectionOpenAck(
+		connection.ID,
+		proofTry, proofConsensus,
+		proofHeight, consensusHeight,
+		ConnectionVersion,
+		chain.SenderAccount.GetAddress(),
+	)
+	require.NoError(coord.t, chain.SendMsg(msgAck))
+	coord.IncrementTime()
+
+	return connection, counterpartyConnection
+}
+
+// CreateSolomachineChannel opens a channel on the transfer port between the
+// chain and the solo machine over the given connections. The handshake is
+// initiated by the chain: the solo machine performs the OpenTry and
+// OpenConfirm steps off chain, and proves its TRYOPEN channel end to the chain.
+// The function expects the channel to be successfully opened otherwise testing
+// will fail.
+func (coord *Coordinator) CreateSolomachineChannel(
+	chain *TestChain, solo *Solomachine,
+	connection, counterpartyConnection *TestConnection,
+	order channeltypes.Order,
+) (TestChannel, TestChannel) {
+	channel := connection.AddTestChannel()
+	counterpartyChannel := counterpartyConnection.AddTestChannel()
+
+	chain.CreatePortCapability(channel.PortID)
+	coord.IncrementTime()
+
+	require.NoError(coord.t, chain.ChanOpenInit(channel, counterpartyChannel, order, connection.ID))
+	coord.IncrementTime()
+
+	require.NoError(coord.t, coord.UpdateSolomachineClient(chain, solo))
+	proofHeight := solo.Sequence
+
+	cdc, _ := chain.App.IBCKeeper.Codecs()
+
+	tryChannel := channeltypes.NewChannel(
+		channeltypes.TRYOPEN, order, channeltypes.NewCounterparty(channel.PortID, channel.ID),
+		[]string{counterpartyConnection.ID}, counterpartyChannel.Version,
+	)
+	proofTry := solo.CreatePathProof(
+		host.ChannelPath(counterpartyChannel.PortID, counterpartyChannel.ID), cdc.MustMarshalBinaryBare(&tryChannel),
+	)
+
+	msgAck := channeltypes.NewMsgChannelOpenAck(
+		channel.PortID, channel.ID,
+		counterpartyChannel.Version,
+		proofTry, proofHeight,
+		chain.SenderAccount.GetAddress(),
+	)
+	require.NoError(coord.t, chain.SendMsg(msgAck))
+	coord.IncrementTime()
+
+	return channel, counterpartyChannel
+}
+
+// SendSolomachinePacket sends a packet from the chain to the solo machine
+// through the channel keeper. The client of the solo machine is updated first,
+// as the packet timeout is checked against its latest consensus state.
+func (coord *Coordinator) SendSolomachinePacket(
+	chain *TestChain, solo *Solomachine,
+	packet channeltypes.Packet,
+) error {
+	if err := coord.UpdateSolomachineClient(chain, solo); err != nil {
+		return err
+	}
+
+	if err := chain.SendPacket(packet); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	return nil
+}
+
+// AcknowledgeSolomachinePacket acknowledges a packet sent by the chain to the
+// solo machine by executing a MsgAcknowledgement with the given
+// acknowledgement, which the solo machine proves it wrote.
+func (coord *Coordinator) AcknowledgeSolomachinePacket(
+	chain *TestChain, solo *Solomachine,
+	packet channeltypes.Packet,
+	ack []byte,
+) error {
+	if err := coord.UpdateSolomachineClient(chain, solo); err != nil {
+		return err
+	}
+	proofHeight := solo.Sequence
+
+	proof := solo.CreatePathProof(
+		host.PacketAcknowledgementPath(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()),
+		channeltypes.CommitAcknowledgement(ack),
+	)
+
+	msg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, chain.SenderAccount.GetAddress())
+	if err := chain.SendMsg(msg); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	return nil
+}
+
+// RecvSolomachinePacket receives a packet sent by the solo machine on the chain
+// by executing a MsgPacket, with the proof of the packet commitment of the
+// solo machine.
+func (coord *Coordinator) RecvSolomachinePacket(
+	chain *TestChain, solo *Solomachine,
+	packet channeltypes.Packet,
+) error {
+	if err := coord.UpdateSolomachineClient(chain, solo); err != nil {
+		return err
+	}
+	proofHeight := solo.Sequence
+
+	proof := solo.CreatePathProof(
+		host.PacketCommitmentPath(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()),
+		channeltypes.CommitPacket(packet),
+	)
+
+	msg := channeltypes.NewMsgPacket(packet, proof, proofHeight, chain.SenderAccount.GetAddress())
+	if err := chain.SendMsg(msg); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+const (
+	solomachineClientID  = "solomachine"
+	counterpartyClientID = "testchainclient"
+)
+
+// TestSolomachineHandshakeAndPackets opens a connection and a channel between
+// a chain and a solo machine, and relays a packet in each direction.
+func TestSolomachineHandshakeAndPackets(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(0))
+	solo := ibctesting.NewSolomachine(t, solomachineClientID, 2)
+
+	require.NoError(t, coord.CreateSolomachineClient(chain, solo))
+
+	connection, soloConnection := coord.CreateSolomachineConnection(chain, solo, counterpartyClientID)
+	require.Equal(t, connectiontypes.OPEN, chain.GetConnection(connection).State)
+
+	channel, soloChannel := coord.CreateSolomachineChannel(chain, solo, connection, soloConnection, channeltypes.UNORDERED)
+	require.Equal(t, channeltypes.OPEN, chain.GetChannel(channel).State)
+
+	ack := ibctransfertypes.FungibleTokenPacketAcknowledgement{Success: true}.GetBytes()
+
+	// chain -> solo machine
+	packet := channeltypes.NewPacket(
+		ibctransfertypes.NewFungibleTokenPacketData(
+			"stake", 100, chain.SenderAccount.GetAddress().String(), "solomachinereceiver",
+		).GetBytes(),
+		1, channel.PortID, channel.ID, soloChannel.PortID, soloChannel.ID, 100, 0,
+	)
+	require.NoError(t, coord.SendSolomachinePacket(chain, solo, packet))
+	require.NotNil(t, chain.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(
+		chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+	))
+
+	require.NoError(t, coord.AcknowledgeSolomachinePacket(chain, solo, packet, ack))
+	require.Nil(t, chain.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(
+		chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+	))
+
+	// solo machine -> chain
+	packet = channeltypes.NewPacket(
+		ibctransfertypes.NewFungibleTokenPacketData(
+			"solotoken", 100, "solomachinesender", chain.SenderAccount.GetAddress().String(),
+		).GetBytes(),
+		1, soloChannel.PortID, soloChannel.ID, channel.PortID, channel.ID, 100, 0,
+	)
+	require.NoError(t, coord.RecvSolomachinePacket(chain, solo, packet))
+	require.Equal(t, channeltypes.CommitAcknowledgement(ack), chain.GetAcknowledgement(packet))
+}
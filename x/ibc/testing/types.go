@@ -20,7 +20,14 @@ type TestConnection struct {
 // the port is set to "transfer" to be compatible with the ICS-transfer module, this should
 // eventually be updated as described in the issue: https://github.com/cosmos/cosmos-sdk/issues/6509
 func (conn *TestConnection) AddTestChannel() TestChannel {
-	channel := conn.NextTestChannel()
+	return conn.AddTestChannelOnPort(TransferPort, ChannelVersion)
+}
+
+// AddTestChannelOnPort appends a new TestChannel on the given port, which contains references
+// to the port and channel ID used for channel creation and interaction. The channel version is
+// the version negotiated with the application module bound to the port.
+func (conn *TestConnection) AddTestChannelOnPort(portID, version string) TestChannel {
+	channel := conn.NextTestChannelOnPort(portID, version)
 	conn.Channels = append(conn.Channels, channel)
 	return channel
 }
@@ -30,11 +37,17 @@ func (conn *TestConnection) AddTestChannel() TestChannel {
 // has not created the associated channel in app state, but would still like to refer to the
 // non-existent channel usually to test for its non-existence.
 func (conn *TestConnection) NextTestChannel() TestChannel {
-	portID := "transfer"
+	return conn.NextTestChannelOnPort(TransferPort, ChannelVersion)
+}
+
+// NextTestChannelOnPort returns the next test channel to be created on this connection on the
+// given port, but does not add it to the list of created channels.
+func (conn *TestConnection) NextTestChannelOnPort(portID, version string) TestChannel {
 	channelID := fmt.Sprintf("%s-%d", conn.ID, len(conn.Channels))
 	return TestChannel{
 		PortID:               portID,
 		ID:                   channelID,
+		Version:              version,
 		ClientID:             conn.ClientID,
 		CounterpartyClientID: conn.CounterpartyClientID,
 	}
@@ -51,12 +64,13 @@ func (conn *TestConnection) FirstOrNextTestChannel() TestChannel {
 	return conn.NextTestChannel()
 }
 
-// TestChannel is a testing helper struct to keep track of the portID and channelID
+// TestChannel is a testing helper struct to keep track of the portID, channelID and version
 // used in creating and interacting with a channel. The clientID and counterparty
 // client ID are also tracked to cut down on querying and argument passing.
 type TestChannel struct {
 	PortID               string
 	ID                   string
+	Version              string
 	ClientID             string
 	CounterpartyClientID string
 }
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -273,31 +274,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/query.proto", fileDescriptor_ec77771d7d9ad084) }
 
 var fileDescriptor_ec77771d7d9ad084 = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0x48, 0xe8, 0x81, 0x24, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0xe2, 0xfa, 0x20, 0x16, 0x44, 0x89, 0x94, 0x18, 0xb2, 0x5e, 0x10, 0x01, 0x11, 0x57, 0x12,
-	0xe1, 0x12, 0x0a, 0x04, 0x99, 0x14, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x1c, 0x94, 0x5a, 0x58, 0x9a,
-	0x5a, 0x5c, 0xa2, 0xe4, 0xc1, 0x25, 0x8c, 0x22, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x2a, 0x64,
-	0xc8, 0xc5, 0x56, 0x00, 0x16, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x12, 0xd6, 0x43, 0xb2,
-	0x58, 0x0f, 0xa2, 0xd8, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x42, 0x25, 0x71, 0x2e,
-	0x51, 0xb0, 0x49, 0x9e, 0x79, 0x69, 0x39, 0x89, 0x25, 0x99, 0xf9, 0x79, 0x30, 0x2b, 0xd2, 0xb8,
-	0xc4, 0xd0, 0x25, 0xa0, 0xb6, 0xf8, 0x70, 0x71, 0x66, 0xc2, 0x04, 0xc1, 0x16, 0xf1, 0x38, 0xe9,
-	0x81, 0xcc, 0xbc, 0x75, 0x4f, 0x5e, 0x2d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
-	0x57, 0x1f, 0xea, 0x21, 0x08, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac,
-	0xe7, 0x92, 0x9a, 0x1c, 0x84, 0x30, 0x40, 0x49, 0x8e, 0x4b, 0x06, 0x6c, 0x8f, 0x63, 0x5e, 0x5e,
-	0x69, 0x62, 0x4e, 0x40, 0x51, 0x7e, 0x59, 0x66, 0x71, 0x66, 0x7e, 0x1e, 0xdc, 0xab, 0x35, 0x5c,
-	0xb2, 0x38, 0xe4, 0xa1, 0xce, 0x89, 0xe6, 0x12, 0x4c, 0x04, 0xcb, 0xc5, 0x17, 0xc0, 0x25, 0xc9,
-	0x74, 0x96, 0x40, 0x22, 0x9a, 0x25, 0x46, 0xb3, 0x99, 0xb8, 0x58, 0xc1, 0xd6, 0x0b, 0xf9, 0x72,
-	0xb1, 0x41, 0x02, 0x50, 0x48, 0x1e, 0x25, 0x54, 0x31, 0x63, 0x47, 0x4a, 0x01, 0xb7, 0x02, 0x88,
-	0x9b, 0x95, 0x18, 0x84, 0xc2, 0xb8, 0x38, 0xe1, 0x21, 0x2b, 0xa4, 0x84, 0xa9, 0x01, 0x3d, 0x3e,
-	0xa4, 0x94, 0xf1, 0xaa, 0x81, 0x9b, 0x9b, 0xcd, 0x25, 0x80, 0x1e, 0x52, 0x42, 0x9a, 0x98, 0x5a,
-	0x71, 0x84, 0xb6, 0x94, 0x16, 0x31, 0x4a, 0x61, 0x96, 0x39, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x26, 0xde, 0x10, 0xaf, 0x80, 0x24, 0x73, 0x70, 0xc0, 0x27,
-	0xb1, 0x81, 0x13, 0xba, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x16, 0x13, 0xf8, 0x3e, 0x03,
-	0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4f, 0x8b, 0xda, 0x40,
+	0x1c, 0x4d, 0xa4, 0x15, 0x1c, 0x7b, 0xb0, 0xe3, 0x5f, 0x52, 0x3b, 0x4a, 0x0a, 0x52, 0x0b, 0xcd,
+	0x50, 0xfb, 0x09, 0x6a, 0x7b, 0x68, 0xa1, 0x07, 0xeb, 0xb1, 0x3d, 0x94, 0xd1, 0xc6, 0x18, 0x6a,
+	0x66, 0x62, 0x66, 0x52, 0x2a, 0xf4, 0xd4, 0x4f, 0xd0, 0xb2, 0x5f, 0xca, 0xa3, 0xb0, 0x97, 0x65,
+	0x0f, 0xb2, 0xe8, 0x7e, 0x8b, 0xbd, 0x2c, 0x99, 0x89, 0xae, 0x49, 0x50, 0x96, 0xbd, 0x24, 0x3f,
+	0x7e, 0xef, 0xf1, 0xde, 0xcb, 0xef, 0x11, 0x50, 0x1f, 0x33, 0xee, 0x31, 0x8e, 0x3d, 0x97, 0x0a,
+	0x3c, 0x0f, 0xed, 0x60, 0x61, 0xf9, 0x01, 0x13, 0x0c, 0x16, 0x15, 0x60, 0x45, 0x80, 0xd1, 0x74,
+	0x18, 0x73, 0x66, 0x36, 0x26, 0xbe, 0x8b, 0x09, 0xa5, 0x4c, 0x10, 0xe1, 0x32, 0xca, 0x15, 0xd5,
+	0xa8, 0x38, 0xcc, 0x61, 0x72, 0xc4, 0xd1, 0x14, 0x6f, 0x6b, 0x87, 0xca, 0xd1, 0x43, 0xed, 0xcd,
+	0x0a, 0x80, 0x5f, 0x22, 0x9f, 0x01, 0x09, 0x88, 0xc7, 0x87, 0xf6, 0x3c, 0xb4, 0xb9, 0x30, 0x3f,
+	0x82, 0x72, 0x62, 0xcb, 0x7d, 0x46, 0xb9, 0x0d, 0xdf, 0x80, 0xbc, 0x2f, 0x37, 0x0d, 0xbd, 0xad,
+	0xbf, 0x2c, 0xf6, 0xca, 0xd6, 0x41, 0x2c, 0x4b, 0x91, 0xfb, 0x8f, 0x96, 0xeb, 0x96, 0x36, 0x8c,
+	0x89, 0x66, 0x1d, 0x54, 0xa5, 0xd2, 0x27, 0x3a, 0x99, 0xc9, 0x98, 0x3b, 0x8b, 0x09, 0xa8, 0xa5,
+	0x81, 0xd8, 0xe5, 0x33, 0x28, 0xb8, 0xbb, 0xa5, 0x34, 0x7a, 0xd2, 0xb7, 0x22, 0xcd, 0xcb, 0x75,
+	0xab, 0xe3, 0xb8, 0x62, 0x1a, 0x8e, 0xac, 0x31, 0xf3, 0x70, 0xfc, 0x41, 0xea, 0xf5, 0x9a, 0xff,
+	0xf8, 0x89, 0xc5, 0xc2, 0xb7, 0xb9, 0xf5, 0xc1, 0x1e, 0x0f, 0xef, 0x04, 0x4c, 0x04, 0x9a, 0xd2,
+	0xe7, 0x1d, 0xa5, 0x21, 0x99, 0x0d, 0x02, 0xf6, 0xcb, 0xe5, 0xd1, 0xb5, 0x76, 0x39, 0xfe, 0x80,
+	0xe7, 0x47, 0xf0, 0x38, 0xce, 0x37, 0xf0, 0x94, 0x48, 0xec, 0xbb, 0xbf, 0x07, 0x1f, 0x18, 0xab,
+	0x44, 0x52, 0x26, 0xbd, 0x9b, 0x1c, 0x78, 0x2c, 0xed, 0xe1, 0x14, 0xe4, 0xd5, 0x01, 0x61, 0x2b,
+	0x71, 0xd5, 0x6c, 0x3b, 0x46, 0xfb, 0x38, 0x41, 0x65, 0x36, 0x9f, 0xfd, 0x3d, 0xbf, 0x3e, 0xcb,
+	0x55, 0x61, 0x19, 0x1f, 0xd6, 0xae, 0x2a, 0x81, 0x02, 0x14, 0xf6, 0x47, 0x87, 0x66, 0x56, 0x2b,
+	0x5d, 0x95, 0xf1, 0xe2, 0x24, 0x27, 0xb6, 0x44, 0xd2, 0xb2, 0x01, 0x6b, 0x09, 0xcb, 0x7d, 0x0f,
+	0xf0, 0xbf, 0x0e, 0x4a, 0xe9, 0x1b, 0xc3, 0x6e, 0x56, 0xf9, 0x48, 0x4f, 0xc6, 0xab, 0xfb, 0x50,
+	0xe3, 0x2c, 0x1d, 0x99, 0xa5, 0x0d, 0x51, 0x22, 0x4b, 0xa6, 0xc5, 0xfe, 0xfb, 0xe5, 0x06, 0xe9,
+	0xab, 0x0d, 0xd2, 0xaf, 0x36, 0x48, 0xff, 0xb7, 0x45, 0xda, 0x6a, 0x8b, 0xb4, 0x8b, 0x2d, 0xd2,
+	0xbe, 0x76, 0x4f, 0x36, 0xfa, 0x5b, 0x09, 0xca, 0x62, 0x47, 0x79, 0xf9, 0x23, 0xbd, 0xbd, 0x1d,
+	0x00, 0x2e, 0x09, 0x10, 0x5e, 0xbc, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
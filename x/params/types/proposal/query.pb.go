@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -134,25 +135,26 @@ func init() {
 func init() { proto.RegisterFile("cosmos/params/query.proto", fileDescriptor_3bc356506c43c13a) }
 
 var fileDescriptor_3bc356506c43c13a = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x48, 0xe9, 0x41, 0xa4, 0xa4, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0x32, 0xfa, 0x20, 0x16, 0x44, 0x91, 0x94, 0x14, 0xaa, 0x7e, 0x08, 0x05, 0x91,
-	0x53, 0x72, 0xe2, 0x12, 0x0a, 0x04, 0x99, 0x17, 0x00, 0x16, 0x0c, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d,
-	0x2e, 0x11, 0x92, 0xe2, 0xe2, 0x28, 0x2e, 0x4d, 0x2a, 0x2e, 0x48, 0x4c, 0x4e, 0x95, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x0c, 0x82, 0xf3, 0x85, 0x04, 0xb8, 0x98, 0xb3, 0x53, 0x2b, 0x25, 0x98, 0xc0,
-	0xc2, 0x20, 0xa6, 0x92, 0x3f, 0x97, 0x30, 0x8a, 0x19, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42,
-	0x16, 0x5c, 0x6c, 0x10, 0xab, 0xc0, 0x46, 0x70, 0x1b, 0x49, 0xe9, 0xa1, 0x38, 0x56, 0x0f, 0xac,
-	0xdc, 0x39, 0x23, 0x31, 0x2f, 0x3d, 0xd5, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x7a,
-	0xa3, 0x28, 0x2e, 0x56, 0xb0, 0x81, 0x42, 0x81, 0x5c, 0x6c, 0x10, 0x43, 0x85, 0x14, 0xd1, 0x34,
-	0x63, 0x3a, 0x5a, 0x4a, 0x09, 0x9f, 0x12, 0x88, 0x9b, 0x94, 0x18, 0x9c, 0xfc, 0x4e, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x24, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x62, 0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0x02, 0x16, 0x7c,
-	0x25, 0x95, 0x05, 0xa9, 0xc5, 0xfa, 0x05, 0x45, 0xf9, 0x05, 0xf9, 0xc5, 0x89, 0x39, 0x49, 0x6c,
-	0xe0, 0x70, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x88, 0x12, 0x84, 0xa6, 0xa5, 0x01, 0x00,
-	0x00,
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xcf, 0x4a, 0x03, 0x31,
+	0x10, 0xc6, 0x77, 0xab, 0x16, 0x8d, 0x08, 0x12, 0x15, 0x6b, 0xd0, 0xa8, 0x7b, 0xf2, 0xe2, 0x06,
+	0xaa, 0x07, 0xcf, 0xf5, 0xee, 0x9f, 0x1e, 0xbd, 0xa5, 0x35, 0xa4, 0xa5, 0x6d, 0x26, 0xdd, 0xc9,
+	0xa2, 0xbd, 0xfa, 0x04, 0x82, 0x2f, 0xd5, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0xd6, 0x07, 0x91, 0x4d,
+	0x5a, 0x61, 0x55, 0x3c, 0xcd, 0x64, 0xbe, 0x6f, 0x7e, 0x33, 0x19, 0xb2, 0xd7, 0x06, 0x1c, 0x00,
+	0x0a, 0x2b, 0x33, 0x39, 0x40, 0x31, 0xcc, 0x55, 0x36, 0x4a, 0x6d, 0x06, 0x0e, 0xe8, 0x46, 0x90,
+	0xd2, 0x20, 0xb1, 0x7d, 0x0d, 0xa0, 0xfb, 0x4a, 0x48, 0xdb, 0x15, 0xd2, 0x18, 0x70, 0xd2, 0x75,
+	0xc1, 0x60, 0x30, 0xb3, 0x6d, 0x0d, 0x1a, 0x7c, 0x2a, 0x8a, 0x6c, 0x5e, 0x65, 0x65, 0x7a, 0x08,
+	0x41, 0x4b, 0x1a, 0x84, 0xde, 0x16, 0xd3, 0x6e, 0x7c, 0xb1, 0xa9, 0x86, 0xb9, 0x42, 0x47, 0x19,
+	0x59, 0xc5, 0xbc, 0x85, 0x56, 0xb6, 0x55, 0x2d, 0x3e, 0x8a, 0x4f, 0xd6, 0x9a, 0xdf, 0x6f, 0xba,
+	0x49, 0x96, 0x7a, 0x6a, 0x54, 0xab, 0xf8, 0x72, 0x91, 0x26, 0xd7, 0x64, 0xab, 0xc4, 0x40, 0x0b,
+	0x06, 0x15, 0xbd, 0x20, 0xd5, 0x30, 0xca, 0x23, 0xd6, 0xeb, 0x2c, 0x2d, 0x7d, 0x25, 0xf5, 0xf6,
+	0xcb, 0x8e, 0x34, 0x5a, 0x35, 0x96, 0xc7, 0xef, 0x87, 0x51, 0x73, 0xee, 0xaf, 0x3f, 0x90, 0x15,
+	0x0f, 0xa4, 0x86, 0x54, 0x03, 0x94, 0x1e, 0xff, 0x68, 0xfe, 0xbd, 0x34, 0x4b, 0xfe, 0xb3, 0x84,
+	0x9d, 0x92, 0x83, 0xa7, 0xd7, 0xcf, 0x97, 0xca, 0x2e, 0xdd, 0x11, 0x7f, 0xdd, 0xa4, 0x71, 0x35,
+	0x9e, 0xf2, 0x78, 0x32, 0xe5, 0xf1, 0xc7, 0x94, 0xc7, 0xcf, 0x33, 0x1e, 0x4d, 0x66, 0x3c, 0x7a,
+	0x9b, 0xf1, 0xe8, 0xee, 0x5c, 0x77, 0x5d, 0x27, 0x6f, 0xa5, 0x6d, 0x18, 0x2c, 0x5a, 0x43, 0x38,
+	0xc5, 0xfb, 0x9e, 0x78, 0x5c, 0x70, 0xdc, 0xc8, 0x2a, 0x14, 0x36, 0x03, 0x0b, 0x28, 0xfb, 0xad,
+	0xaa, 0x3f, 0xf2, 0xd9, 0xd7, 0x00, 0xff, 0xeb, 0x90, 0xf3, 0xe0, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
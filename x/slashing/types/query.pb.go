@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -315,36 +316,40 @@ func init() {
 func init() { proto.RegisterFile("cosmos/slashing/query.proto", fileDescriptor_12bf00fd6c136588) }
 
 var fileDescriptor_12bf00fd6c136588 = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xb1, 0x8e, 0xd3, 0x40,
-	0x10, 0xb5, 0x8f, 0x23, 0xc5, 0x24, 0x02, 0xb4, 0x9c, 0x74, 0x77, 0x46, 0xf8, 0x90, 0x01, 0xe9,
-	0x40, 0x3a, 0x5b, 0x0a, 0xa2, 0x80, 0x06, 0x11, 0x0a, 0x84, 0x44, 0x71, 0x18, 0x99, 0x82, 0x26,
-	0xda, 0xd8, 0xce, 0x66, 0x85, 0xb3, 0xeb, 0x78, 0x9d, 0x88, 0xfc, 0x05, 0x25, 0x3f, 0xc1, 0x7f,
-	0xa4, 0x4c, 0x49, 0x15, 0xa1, 0xe4, 0x2f, 0xa8, 0x50, 0x76, 0xd7, 0x89, 0x13, 0x47, 0x21, 0x57,
-	0x79, 0x3d, 0xf3, 0x66, 0xde, 0x7b, 0x3b, 0xb3, 0xf0, 0x20, 0xe4, 0xa2, 0xcf, 0x85, 0x27, 0x12,
-	0x2c, 0x7a, 0x94, 0x11, 0x6f, 0x30, 0x8c, 0xb3, 0xb1, 0x9b, 0x66, 0x3c, 0xe7, 0xe8, 0xae, 0x4a,
-	0xba, 0x45, 0xd2, 0x7a, 0xa8, 0xd1, 0x12, 0xe4, 0xa5, 0x98, 0x50, 0x86, 0x73, 0xca, 0x99, 0xc2,
-	0x5b, 0x27, 0x84, 0x13, 0x2e, 0x8f, 0xde, 0xf2, 0xa4, 0xa3, 0xf6, 0x36, 0x45, 0x71, 0x50, 0x79,
-	0xe7, 0x04, 0xd0, 0xa7, 0x65, 0xbf, 0x6b, 0x9c, 0xe1, 0xbe, 0xf0, 0xe3, 0xc1, 0x30, 0x16, 0xb9,
-	0xf3, 0x11, 0xee, 0x6f, 0x44, 0x45, 0xca, 0x99, 0x88, 0xd1, 0x4b, 0xa8, 0xa5, 0x32, 0x72, 0x66,
-	0x3e, 0x32, 0x2f, 0xeb, 0xcd, 0x53, 0x77, 0x4b, 0xa3, 0xab, 0x0a, 0x5a, 0xc7, 0x93, 0xd9, 0x85,
-	0xe1, 0x6b, 0xb0, 0x93, 0xc2, 0xa9, 0xec, 0xf6, 0x99, 0x12, 0x46, 0x19, 0xf9, 0xc0, 0xba, 0x5c,
-	0x13, 0xa1, 0x00, 0x1a, 0x21, 0x67, 0xa2, 0x8d, 0xa3, 0x28, 0x8b, 0x85, 0xea, 0xdb, 0x68, 0x35,
-	0xff, 0xce, 0x2e, 0x5c, 0x42, 0xf3, 0xde, 0xb0, 0xe3, 0x86, 0xbc, 0xef, 0x69, 0x0f, 0xea, 0x73,
-	0x25, 0xa2, 0x6f, 0x5e, 0x3e, 0x4e, 0x63, 0xe1, 0xbe, 0xe3, 0x4c, 0xbc, 0x55, 0x95, 0x7e, 0x3d,
-	0x5c, 0xff, 0x38, 0x03, 0x38, 0xab, 0x32, 0x6a, 0x13, 0x01, 0xdc, 0x1b, 0xe1, 0xa4, 0x2d, 0x54,
-	0xaa, 0x4d, 0x59, 0x97, 0x6b, 0x3b, 0x4f, 0x2b, 0x76, 0xbe, 0xe0, 0x84, 0x46, 0x38, 0xe7, 0x59,
-	0xa9, 0x91, 0x36, 0x77, 0x67, 0x84, 0x93, 0x52, 0xd4, 0x09, 0xaa, 0x94, 0xc5, 0x75, 0xa2, 0x57,
-	0x00, 0xeb, 0x71, 0x69, 0xb2, 0xf3, 0x82, 0x4c, 0xcd, 0xfc, 0x1a, 0x93, 0x58, 0xc3, 0xfd, 0x12,
-	0xd8, 0xf9, 0x69, 0xc2, 0xf9, 0x8e, 0xbe, 0xda, 0xcb, 0x1b, 0x38, 0xd6, 0xfa, 0x6f, 0xdd, 0x54,
-	0xbf, 0x2c, 0x44, 0xaf, 0x37, 0x94, 0x1d, 0x49, 0x65, 0xd6, 0x2e, 0x65, 0x8a, 0xb0, 0x2c, 0xad,
-	0xf9, 0xeb, 0x08, 0x6e, 0x4b, 0x69, 0x28, 0x80, 0x9a, 0x1a, 0x3c, 0x7a, 0x5c, 0x91, 0x50, 0xdd,
-	0x2e, 0xeb, 0xc9, 0x7e, 0x90, 0xa2, 0x72, 0x0c, 0x14, 0x41, 0xbd, 0xa4, 0x1b, 0x5d, 0xee, 0x2e,
-	0xab, 0x6e, 0x95, 0xf5, 0xec, 0x00, 0xe4, 0x8a, 0x85, 0x40, 0xa3, 0x7c, 0xb7, 0xe8, 0xff, 0xc5,
-	0x2b, 0x23, 0xcf, 0x0f, 0x81, 0x16, 0x44, 0xad, 0xf7, 0x93, 0xb9, 0x6d, 0x4e, 0xe7, 0xb6, 0xf9,
-	0x67, 0x6e, 0x9b, 0x3f, 0x16, 0xb6, 0x31, 0x5d, 0xd8, 0xc6, 0xef, 0x85, 0x6d, 0x7c, 0xbd, 0xda,
-	0xbb, 0xeb, 0xdf, 0xd7, 0x8f, 0x57, 0xae, 0x7d, 0xa7, 0x26, 0x9f, 0xee, 0x8b, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x59, 0xd0, 0x27, 0x68, 0x3f, 0x04, 0x00, 0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xb3, 0x6d, 0xcd, 0x61, 0x12, 0x54, 0xc6, 0x42, 0x9a, 0x55, 0x37, 0x65, 0xfd, 0x43,
+	0x15, 0xb2, 0x03, 0x91, 0x1e, 0xf4, 0x22, 0xc6, 0x83, 0x08, 0x1e, 0x6a, 0x24, 0x1e, 0xbc, 0x84,
+	0x69, 0xb2, 0x9d, 0x0e, 0x6e, 0xe6, 0xdd, 0xec, 0xbb, 0x29, 0x16, 0xf1, 0xe2, 0x5d, 0x10, 0xbc,
+	0xe8, 0x47, 0xf0, 0x9b, 0xf4, 0x58, 0xf0, 0xe2, 0xa9, 0x48, 0xe2, 0xa7, 0xf0, 0x24, 0x9d, 0x99,
+	0x35, 0xdb, 0x6c, 0x88, 0xed, 0x29, 0x93, 0x79, 0xdf, 0x79, 0x9e, 0xdf, 0xb3, 0xf3, 0xee, 0x92,
+	0xeb, 0x7d, 0xc0, 0x21, 0x20, 0xc3, 0x88, 0xe3, 0xbe, 0x54, 0x82, 0x8d, 0xc6, 0x61, 0x72, 0x18,
+	0xc4, 0x09, 0xa4, 0x40, 0xaf, 0x98, 0x62, 0x90, 0x15, 0xdd, 0x1b, 0x02, 0x40, 0x44, 0x21, 0xe3,
+	0xb1, 0x64, 0x5c, 0x29, 0x48, 0x79, 0x2a, 0x41, 0xa1, 0x69, 0x77, 0x6f, 0x5a, 0x2d, 0x2d, 0xc1,
+	0x62, 0x2e, 0xa4, 0xd2, 0x75, 0x5b, 0x5e, 0x17, 0x20, 0x40, 0x2f, 0xd9, 0xe9, 0xca, 0xee, 0x7a,
+	0xf3, 0x00, 0xd9, 0xc2, 0xd4, 0xfd, 0x75, 0x42, 0x5f, 0x9e, 0xea, 0xed, 0xf0, 0x84, 0x0f, 0xb1,
+	0x13, 0x8e, 0xc6, 0x21, 0xa6, 0xfe, 0x0b, 0x72, 0xed, 0xcc, 0x2e, 0xc6, 0xa0, 0x30, 0xa4, 0xdb,
+	0xa4, 0x1c, 0xeb, 0x9d, 0x0d, 0x67, 0xd3, 0xd9, 0xaa, 0xb4, 0x6a, 0xc1, 0x5c, 0x82, 0xc0, 0x1c,
+	0x68, 0xaf, 0x1d, 0x9d, 0x34, 0x4a, 0x1d, 0xdb, 0xec, 0xc7, 0xa4, 0xa6, 0xd5, 0x5e, 0x49, 0xa1,
+	0xa4, 0x12, 0xcf, 0xd5, 0x1e, 0x58, 0x23, 0xda, 0x25, 0xd5, 0x3e, 0x28, 0xec, 0xf1, 0xc1, 0x20,
+	0x09, 0xd1, 0xe8, 0x56, 0xdb, 0xad, 0x3f, 0x27, 0x8d, 0x40, 0xc8, 0x74, 0x7f, 0xbc, 0x1b, 0xf4,
+	0x61, 0xc8, 0x6c, 0x06, 0xf3, 0xd3, 0xc4, 0xc1, 0x5b, 0x96, 0x1e, 0xc6, 0x21, 0x06, 0x4f, 0x41,
+	0xe1, 0x13, 0x73, 0xb2, 0x53, 0xe9, 0xcf, 0xfe, 0xf8, 0x23, 0xb2, 0x51, 0x74, 0xb4, 0x21, 0xba,
+	0xe4, 0xea, 0x01, 0x8f, 0x7a, 0x68, 0x4a, 0x3d, 0xa9, 0xf6, 0xc0, 0xc6, 0xb9, 0x53, 0x88, 0xf3,
+	0x9a, 0x47, 0x72, 0xc0, 0x53, 0x48, 0x72, 0x42, 0x36, 0xdc, 0xe5, 0x03, 0x1e, 0xe5, 0x76, 0xfd,
+	0x6e, 0xd1, 0x32, 0x7b, 0x9c, 0xf4, 0x21, 0x21, 0xb3, 0xeb, 0xb2, 0x66, 0xf5, 0xcc, 0xcc, 0x4c,
+	0xc4, 0x0e, 0x17, 0xa1, 0x6d, 0xef, 0xe4, 0x9a, 0xfd, 0xaf, 0x0e, 0xa9, 0x2f, 0xd0, 0xb5, 0x59,
+	0x1e, 0x93, 0x35, 0xcb, 0xbf, 0x7a, 0x51, 0x7e, 0x7d, 0x90, 0x3e, 0x3a, 0x43, 0xb6, 0xa2, 0xc9,
+	0xdc, 0x45, 0x64, 0xc6, 0x30, 0x8f, 0xd6, 0xfa, 0xbe, 0x4a, 0x2e, 0x69, 0x34, 0x9a, 0x92, 0xb2,
+	0xb9, 0x78, 0x7a, 0xab, 0x80, 0x50, 0x9c, 0x2e, 0xf7, 0xf6, 0xf2, 0x26, 0x63, 0xe5, 0x37, 0x3e,
+	0xfe, 0xf8, 0xfd, 0x65, 0xa5, 0x4e, 0x6b, 0x6c, 0x7e, 0x84, 0xcd, 0x58, 0xd1, 0x6f, 0x0e, 0xa9,
+	0xe4, 0x72, 0xd1, 0xad, 0xc5, 0xb2, 0xc5, 0xa9, 0x73, 0xef, 0x9d, 0xa3, 0xd3, 0x52, 0x6c, 0x6b,
+	0x0a, 0x46, 0x9b, 0x05, 0x8a, 0xfc, 0x00, 0x21, 0x7b, 0x9f, 0x1f, 0xe3, 0x0f, 0xf4, 0x93, 0x43,
+	0xaa, 0xf9, 0x1b, 0xa3, 0xff, 0xb7, 0xfc, 0xf7, 0x78, 0xee, 0x9f, 0xa7, 0xd5, 0xe2, 0xdd, 0xd5,
+	0x78, 0x9b, 0xd4, 0x5b, 0x8e, 0xd7, 0x7e, 0x76, 0x34, 0xf1, 0x9c, 0xe3, 0x89, 0xe7, 0xfc, 0x9a,
+	0x78, 0xce, 0xe7, 0xa9, 0x57, 0x3a, 0x9e, 0x7a, 0xa5, 0x9f, 0x53, 0xaf, 0xf4, 0xa6, 0xb9, 0xf4,
+	0x3d, 0x7b, 0x37, 0x13, 0xd4, 0xaf, 0xdc, 0x6e, 0x59, 0x7f, 0x36, 0x1e, 0xfc, 0x1d, 0x00, 0x96,
+	0xc5, 0x7a, 0x9c, 0xd9, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
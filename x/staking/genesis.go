@@ -91,6 +91,16 @@ func InitGenesis(
 		}
 	}
 
+	for _, rewards := range data.TokenizeShareRewards {
+		keeper.SetTokenizeShareRewards(ctx, rewards.ValidatorAddress, rewards.CumulativeRewardRatio)
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+	}
+
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordID)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -170,14 +180,17 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		Params:               keeper.GetParams(ctx),
-		LastTotalPower:       keeper.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           keeper.GetAllValidators(ctx),
-		Delegations:          keeper.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    keeper.GetParams(ctx),
+		LastTotalPower:            keeper.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                keeper.GetAllValidators(ctx),
+		Delegations:               keeper.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		TokenizeShareRewards:      keeper.GetAllTokenizeShareRewards(ctx),
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordID: keeper.GetLastTokenizeShareRecordID(ctx),
+		Exported:                  true,
 	}
 }
 
@@ -204,6 +217,22 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
+	for _, rewards := range data.TokenizeShareRewards {
+		if !rewards.CumulativeRewardRatio.IsValid() {
+			return fmt.Errorf("invalid tokenize share rewards of validator %s: %s", rewards.ValidatorAddress, rewards.CumulativeRewardRatio)
+		}
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		if record.ID == 0 || record.ID > data.LastTokenizeShareRecordID {
+			return fmt.Errorf("invalid tokenize share record id %d, last id %d", record.ID, data.LastTokenizeShareRecordID)
+		}
+
+		if !record.CumulativeRewardRatio.IsValid() {
+			return fmt.Errorf("invalid tokenize share record %d rewards: %s", record.ID, record.CumulativeRewardRatio)
+		}
+	}
+
 	return data.Params.Validate()
 }
 
@@ -7,7 +7,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
-	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -17,7 +16,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -29,14 +27,14 @@ func bootstrapHandlerGenesisTest(t *testing.T, power int64, numAddrs int, accAmo
 	addrDels, addrVals := generateAddresses(app, ctx, numAddrs, accAmount)
 
 	amt := sdk.TokensFromConsensusPower(power)
-	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
+	totalSupply := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
-	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), totalSupply)
+	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(totalSupply))
 	require.NoError(t, err)
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
+	app.BankKeeper.SetSupply(ctx, totalSupply)
 
 	return app, ctx, addrDels, addrVals
 }
@@ -117,11 +115,10 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
+	resData := &types.MsgUndelegateResponse{}
+	require.NoError(t, resData.Unmarshal(res.Data))
 
-	finishTime, err := gogotypes.TimestampFromProto(ts)
-	require.NoError(t, err)
+	finishTime := resData.CompletionTime
 
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
@@ -263,11 +260,10 @@ func TestLegacyValidatorDelegations(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
+	resData := &types.MsgUndelegateResponse{}
+	require.NoError(t, resData.Unmarshal(res.Data))
 
-	finishTime, err := gogotypes.TimestampFromProto(ts)
-	require.NoError(t, err)
+	finishTime := resData.CompletionTime
 
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
@@ -508,11 +504,10 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
+		resData := &types.MsgUndelegateResponse{}
+		require.NoError(t, resData.Unmarshal(res.Data))
 
-		finishTime, err := gogotypes.TimestampFromProto(ts)
-		require.NoError(t, err)
+		finishTime := resData.CompletionTime
 
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
@@ -626,11 +621,8 @@ func TestMultipleMsgCreateValidator(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		_, err = gogotypes.TimestampFromProto(ts)
-		require.NoError(t, err)
+		resData := &types.MsgUndelegateResponse{}
+		require.NoError(t, resData.Unmarshal(res.Data))
 
 		// adds validator into unbonding queue
 		staking.EndBlocker(ctx, app.StakingKeeper)
@@ -684,11 +676,10 @@ func TestMultipleMsgDelegate(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
+		resData := &types.MsgUndelegateResponse{}
+		require.NoError(t, resData.Unmarshal(res.Data))
 
-		finishTime, err := gogotypes.TimestampFromProto(ts)
-		require.NoError(t, err)
+		finishTime := resData.CompletionTime
 
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
@@ -723,11 +714,10 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
+	resData := &types.MsgUndelegateResponse{}
+	require.NoError(t, resData.Unmarshal(res.Data))
 
-	finishTime, err := gogotypes.TimestampFromProto(ts)
-	require.NoError(t, err)
+	finishTime := resData.CompletionTime
 
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
@@ -743,11 +733,10 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts = &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
+	resData = &types.MsgUndelegateResponse{}
+	require.NoError(t, resData.Unmarshal(res.Data))
 
-	finishTime, err = gogotypes.TimestampFromProto(ts)
-	require.NoError(t, err)
+	finishTime = resData.CompletionTime
 
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
@@ -791,11 +780,10 @@ func TestValidatorQueue(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
+	resData := &types.MsgUndelegateResponse{}
+	require.NoError(t, resData.Unmarshal(res.Data))
 
-	finishTime, err := gogotypes.TimestampFromProto(ts)
-	require.NoError(t, err)
+	finishTime := resData.CompletionTime
 
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
@@ -897,11 +885,10 @@ func TestUnbondingFromUnbondingValidator(t *testing.T) {
 	require.NotNil(t, res)
 
 	// change the ctx to Block Time one second before the validator would have unbonded
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
+	resData := &types.MsgUndelegateResponse{}
+	require.NoError(t, resData.Unmarshal(res.Data))
 
-	finishTime, err := gogotypes.TimestampFromProto(ts)
-	require.NoError(t, err)
+	finishTime := resData.CompletionTime
 
 	ctx = ctx.WithBlockTime(finishTime.Add(time.Second * -1))
 
@@ -1505,3 +1492,331 @@ func TestInvalidCoinDenom(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 3, 10000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delAddr, ownerAddr := valAddrs[0], delAddrs[1], delAddrs[2]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	delTokens := sdk.TokensFromConsensusPower(100)
+	res, err = handler(ctx, NewTestMsgDelegate(delAddr, validatorAddr, delTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+
+	shareDenom := types.GetShareTokenDenom(validatorAddr, 1)
+
+	// only bond tokens can be tokenized
+	tokenizeTokens := sdk.TokensFromConsensusPower(50)
+	msgTokenize := types.NewMsgTokenizeShares(delAddr, validatorAddr, sdk.NewCoin("churros", tokenizeTokens), ownerAddr)
+	_, err = handler(ctx, msgTokenize)
+	require.Error(t, err)
+
+	// tokenize half of the delegation to the share owner
+	msgTokenize = types.NewMsgTokenizeShares(delAddr, validatorAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokenizeTokens), ownerAddr)
+	res, err = handler(ctx, msgTokenize)
+	require.NoError(t, err)
+
+	tokenizeRes := &types.MsgTokenizeSharesResponse{}
+	require.NoError(t, tokenizeRes.Unmarshal(res.Data))
+	require.Equal(t, sdk.NewCoin(shareDenom, tokenizeTokens), tokenizeRes.Amount)
+	require.Equal(t, tokenizeTokens, app.BankKeeper.GetBalance(ctx, ownerAddr, shareDenom).Amount)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(tokenizeTokens).ToDec(), delegation.Shares)
+
+	poolAddr := app.StakingKeeper.GetTokenizedSharesPool(ctx).GetAddress()
+	poolDelegation, found := app.StakingKeeper.GetDelegation(ctx, poolAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens.ToDec(), poolDelegation.Shares)
+	require.Equal(t, tokenizeTokens, app.StakingKeeper.TotalTokenizedTokens(ctx))
+
+	// the tokens backing the share tokens stay bonded to the validator
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, initBond.Add(delTokens), validator.Tokens)
+
+	// redeem part of the share tokens into a delegation of the share owner
+	redeemTokens := sdk.TokensFromConsensusPower(20)
+	msgRedeem := types.NewMsgRedeemTokensForShares(ownerAddr, sdk.NewCoin(shareDenom, redeemTokens))
+	res, err = handler(ctx, msgRedeem)
+	require.NoError(t, err)
+
+	redeemRes := &types.MsgRedeemTokensForSharesResponse{}
+	require.NoError(t, redeemRes.Unmarshal(res.Data))
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, redeemTokens), redeemRes.Amount)
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens), app.BankKeeper.GetBalance(ctx, ownerAddr, shareDenom).Amount)
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens), app.BankKeeper.GetSupply(ctx, shareDenom).Amount)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, ownerAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, redeemTokens.ToDec(), delegation.Shares)
+
+	// slashing the validator lowers the value of the remaining share tokens
+	validator, found = app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	app.StakingKeeper.Slash(ctx, consAddr, 0, validator.GetConsensusPower(), sdk.NewDecWithPrec(5, 1))
+
+	remaining := tokenizeTokens.Sub(redeemTokens)
+	require.Equal(t, remaining.QuoRaw(2), app.StakingKeeper.TotalTokenizedTokens(ctx))
+
+	msgRedeem = types.NewMsgRedeemTokensForShares(ownerAddr, sdk.NewCoin(shareDenom, remaining))
+	res, err = handler(ctx, msgRedeem)
+	require.NoError(t, err)
+
+	require.NoError(t, redeemRes.Unmarshal(res.Data))
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, remaining.QuoRaw(2)), redeemRes.Amount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, ownerAddr, shareDenom).IsZero())
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, poolAddr, validatorAddr)
+	require.False(t, found)
+
+	// the record is deleted once all its share tokens are redeemed
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+
+	// share tokens of an unknown validator or record cannot be redeemed
+	msgRedeem = types.NewMsgRedeemTokensForShares(ownerAddr, sdk.NewCoin(types.GetShareTokenDenom(valAddrs[1], 1), redeemTokens))
+	_, err = handler(ctx, msgRedeem)
+	require.Error(t, err)
+
+	msgRedeem = types.NewMsgRedeemTokensForShares(ownerAddr, sdk.NewCoin(shareDenom, redeemTokens))
+	_, err = handler(ctx, msgRedeem)
+	require.True(t, types.ErrTokenizeShareRecordNotFound.Is(err))
+}
+
+func TestTokenizeShareRewards(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 5, 10000000000000)
+
+	// the distribution hooks withdraw the rewards of the modified delegations
+	app.StakingKeeper = *app.StakingKeeper.SetHooks(app.DistrKeeper.Hooks())
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delAddr, firstOwner, secondOwner, buyer := valAddrs[0], delAddrs[1], delAddrs[2], delAddrs[3], delAddrs[4]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	delTokens := sdk.TokensFromConsensusPower(100)
+	res, err = handler(ctx, NewTestMsgDelegate(delAddr, validatorAddr, delTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// each allocation pays 1 reward per 1000 tokens of the validator
+	rewardDenom := "reward"
+	rewardTokens := sdk.NewInt(1100000)
+
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(rewardDenom, rewardTokens.MulRaw(2)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	allocateRewards := func() {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		validator := app.StakingKeeper.Validator(ctx, validatorAddr)
+		app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoins(sdk.NewDecCoin(rewardDenom, rewardTokens)))
+	}
+
+	tokenize := func(tokens sdk.Int, owner sdk.AccAddress) sdk.Coin {
+		msgTokenize := types.NewMsgTokenizeShares(delAddr, validatorAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokens), owner)
+		res, err := handler(ctx, msgTokenize)
+		require.NoError(t, err)
+
+		tokenizeRes := &types.MsgTokenizeSharesResponse{}
+		require.NoError(t, tokenizeRes.Unmarshal(res.Data))
+		return tokenizeRes.Amount
+	}
+
+	redeem := func(owner sdk.AccAddress, shareToken sdk.Coin) sdk.Int {
+		before := app.BankKeeper.GetBalance(ctx, owner, rewardDenom).Amount
+		_, err := handler(ctx, types.NewMsgRedeemTokensForShares(owner, shareToken))
+		require.NoError(t, err)
+
+		return app.BankKeeper.GetBalance(ctx, owner, rewardDenom).Amount.Sub(before)
+	}
+
+	firstShares := tokenize(sdk.TokensFromConsensusPower(50), firstOwner)
+	require.Equal(t, types.GetShareTokenDenom(validatorAddr, 1), firstShares.Denom)
+
+	allocateRewards()
+
+	// the share tokens minted after the rewards accrued are not owed them, even
+	// when sold and redeemed in the same block
+	secondShares := tokenize(sdk.TokensFromConsensusPower(25), secondOwner)
+	require.Equal(t, types.GetShareTokenDenom(validatorAddr, 2), secondShares.Denom)
+
+	halfSecondShares := sdk.NewCoin(secondShares.Denom, secondShares.Amount.QuoRaw(2))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, secondOwner, buyer, sdk.NewCoins(halfSecondShares)))
+	require.True(t, redeem(buyer, halfSecondShares).IsZero())
+
+	// the records and the rewards per share are exported with the genesis
+	genesis := staking.ExportGenesis(ctx, app.StakingKeeper)
+	require.Len(t, genesis.TokenizeShareRecords, 2)
+	require.Equal(t, uint64(2), genesis.LastTokenizeShareRecordID)
+	require.Equal(t, []types.TokenizeShareRewards{{
+		ValidatorAddress:      validatorAddr,
+		CumulativeRewardRatio: sdk.NewDecCoins(sdk.NewDecCoinFromDec(rewardDenom, sdk.NewDecWithPrec(1, 3))),
+	}}, genesis.TokenizeShareRewards)
+
+	allocateRewards()
+
+	// each holder is paid the rewards accrued by its share tokens only: the
+	// first holder's 50 power earned 50 rewards of each allocation, and the
+	// second holder's remaining 12.5 power earned 12.5 of the second one
+	require.Equal(t, sdk.NewInt(100000).String(), redeem(firstOwner, firstShares).String())
+	require.Equal(t, sdk.NewInt(12500).String(), redeem(secondOwner, halfSecondShares).String())
+
+	poolAddr := app.StakingKeeper.GetTokenizedSharesPool(ctx).GetAddress()
+	require.True(t, app.BankKeeper.GetBalance(ctx, poolAddr, rewardDenom).IsZero())
+	require.Empty(t, app.StakingKeeper.GetAllTokenizeShareRecords(ctx))
+}
+
+func TestTokenizeSharesCap(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 10000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delAddr := valAddrs[0], delAddrs[1]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handler(ctx, NewTestMsgDelegate(delAddr, validatorAddr, initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// half of the bonded tokens are delegated by the delegator, so tokenizing
+	// them all exceeds the default cap of a quarter of the bonded tokens
+	msgTokenize := types.NewMsgTokenizeShares(delAddr, validatorAddr, sdk.NewCoin(sdk.DefaultBondDenom, initBond), delAddr)
+	_, err = handler(ctx, msgTokenize)
+	require.True(t, types.ErrTokenizeSharesCapExceeded.Is(err))
+
+	quarter := sdk.TokensFromConsensusPower(initPower / 2)
+	msgTokenize = types.NewMsgTokenizeShares(delAddr, validatorAddr, sdk.NewCoin(sdk.DefaultBondDenom, quarter), delAddr)
+	_, err = handler(ctx, msgTokenize)
+	require.NoError(t, err)
+
+	// the cap accounts for the shares tokenized before
+	oneCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	msgTokenize = types.NewMsgTokenizeShares(delAddr, validatorAddr, oneCoin, delAddr)
+	_, err = handler(ctx, msgTokenize)
+	require.True(t, types.ErrTokenizeSharesCapExceeded.Is(err))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.TokenizeShareCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = handler(ctx, msgTokenize)
+	require.NoError(t, err)
+}
+
+func TestTokenizeSharesRedelegationInProgress(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 3, 10000000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	srcAddr, dstAddr, delAddr := valAddrs[0], valAddrs[1], delAddrs[2]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(srcAddr, PKs[0], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handler(ctx, NewTestMsgCreateValidator(dstAddr, PKs[1], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	delTokens := sdk.TokensFromConsensusPower(100)
+	res, err = handler(ctx, NewTestMsgDelegate(delAddr, srcAddr, delTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	redTokens := sdk.TokensFromConsensusPower(50)
+	msgBeginRedelegate := types.NewMsgBeginRedelegate(delAddr, srcAddr, dstAddr, sdk.NewCoin(sdk.DefaultBondDenom, redTokens))
+	res, err = handler(ctx, msgBeginRedelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the shares of the destination validator can't be tokenized while the
+	// redelegation is in progress
+	tokenizeTokens := sdk.TokensFromConsensusPower(10)
+	msgTokenize := types.NewMsgTokenizeShares(delAddr, dstAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokenizeTokens), delAddr)
+	_, err = handler(ctx, msgTokenize)
+	require.True(t, types.ErrRedelegationInProgress.Is(err))
+
+	// the shares of the source validator can still be tokenized
+	msgTokenize = types.NewMsgTokenizeShares(delAddr, srcAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokenizeTokens), delAddr)
+	_, err = handler(ctx, msgTokenize)
+	require.NoError(t, err)
+
+	// complete the redelegation
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.StakingKeeper.UnbondingTime(ctx)))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	msgTokenize = types.NewMsgTokenizeShares(delAddr, dstAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokenizeTokens), delAddr)
+	_, err = handler(ctx, msgTokenize)
+	require.NoError(t, err)
+}
+
+func TestMinCommissionRate(t *testing.T) {
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 100, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = minRate
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the commission rate of a new validator can't be below the minimum
+	msgCreateValidator := NewTestMsgCreateValidator(valAddrs[0], PKs[0], initBond)
+	_, err := handler(ctx, msgCreateValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err))
+
+	commission := types.NewCommissionRates(minRate, sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator = types.NewMsgCreateValidator(
+		valAddrs[0], PKs[0], sdk.NewCoin(sdk.DefaultBondDenom, initBond), types.Description{}, commission, sdk.OneInt(),
+	)
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// nor can a validator lower its commission rate below the minimum
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(48 * time.Hour))
+
+	newRate := sdk.NewDecWithPrec(1, 2)
+	msgEditValidator := types.NewMsgEditValidator(valAddrs[0], types.Description{}, &newRate, nil)
+	_, err = handler(ctx, msgEditValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err))
+
+	newRate = sdk.NewDecWithPrec(1, 1)
+	msgEditValidator = types.NewMsgEditValidator(valAddrs[0], types.Description{}, &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
@@ -42,7 +42,7 @@ func NewKeeper(
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
-	// ensure bonded and not bonded module accounts are set
+	// ensure bonded, not bonded and tokenized shares module accounts are set
 	if addr := ak.GetModuleAddress(types.BondedPoolName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.BondedPoolName))
 	}
@@ -51,6 +51,10 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.NotBondedPoolName))
 	}
 
+	if addr := ak.GetModuleAddress(types.TokenizedSharesPoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.TokenizedSharesPoolName))
+	}
+
 	return Keeper{
 		storeKey:           key,
 		cdc:                cdc,
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations of the staking
+// module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the staking store from consensus version 1 to 2.
+// Version 2 adds the TokenizeShareCap param, which is set to its default.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !m.keeper.paramstore.Has(ctx, types.KeyTokenizeShareCap) {
+		m.keeper.paramstore.Set(ctx, types.KeyTokenizeShareCap, types.DefaultTokenizeShareCap)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the staking store from consensus version 2 to 3.
+// Version 3 adds the MinCommissionRate param, which is set to its default, and
+// lifts the commission rate of the validators below it.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if !m.keeper.paramstore.Has(ctx, types.KeyMinCommissionRate) {
+		m.keeper.paramstore.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	}
+
+	m.keeper.ApplyMinCommissionRate(ctx)
+
+	return nil
+}
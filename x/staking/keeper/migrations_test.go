@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	// remove the param added by version 2
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	store.Delete(types.KeyTokenizeShareCap)
+	require.Panics(t, func() { app.StakingKeeper.GetParams(ctx) })
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+	require.Equal(t, types.DefaultParams(), app.StakingKeeper.GetParams(ctx))
+
+	// migrating keeps the param already set
+	params := types.DefaultParams()
+	params.TokenizeShareCap = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	require.NoError(t, migrator.Migrate1to2(ctx))
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	// remove the param added by version 3
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	store.Delete(types.KeyMinCommissionRate)
+	require.Panics(t, func() { app.StakingKeeper.GetParams(ctx) })
+
+	migrator := keeper.NewMigrator(app.StakingKeeper)
+	require.NoError(t, migrator.Migrate2to3(ctx))
+	require.Equal(t, types.DefaultParams(), app.StakingKeeper.GetParams(ctx))
+
+	lastRate, found := app.StakingKeeper.GetLastMinCommissionRate(ctx)
+	require.True(t, found)
+	require.Equal(t, types.DefaultMinCommissionRate, lastRate)
+
+	// migrating keeps the param already set and lifts the validators below it
+	addrVals := simapp.ConvertAddrsToValAddrs(simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000)))
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	params := types.DefaultParams()
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	require.NoError(t, migrator.Migrate2to3(ctx))
+	require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, params.MinCommissionRate, validator.Commission.Rate)
+}
@@ -39,6 +39,19 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// TokenizeShareCap - Maximum fraction of the bonded tokens that may be held by
+// tokenized delegations
+func (k Keeper) TokenizeShareCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyTokenizeShareCap, &res)
+	return
+}
+
+// MinCommissionRate - Minimum commission rate of the validators
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -47,6 +60,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.TokenizeShareCap(ctx),
+		k.MinCommissionRate(ctx),
 	)
 }
 
@@ -0,0 +1,319 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTokenizedSharesPool returns the module account holding the delegations
+// backing the share tokens.
+func (k Keeper) GetTokenizedSharesPool(ctx sdk.Context) (tokenizedSharesPool authtypes.ModuleAccountI) {
+	return k.authKeeper.GetModuleAccount(ctx, types.TokenizedSharesPoolName)
+}
+
+// TotalTokenizedTokens returns the amount of bond tokens backing all share
+// tokens, valued at the current exchange rate of each validator.
+func (k Keeper) TotalTokenizedTokens(ctx sdk.Context) sdk.Int {
+	total := sdk.ZeroDec()
+
+	poolAddr := k.authKeeper.GetModuleAddress(types.TokenizedSharesPoolName)
+	k.IterateDelegations(ctx, poolAddr, func(_ int64, del exported.DelegationI) (stop bool) {
+		if validator, found := k.GetValidator(ctx, del.GetValidatorAddr()); found {
+			total = total.Add(validator.TokensFromShares(del.GetShares()))
+		}
+
+		return false
+	})
+
+	return total.TruncateInt()
+}
+
+// GetTokenizeShareRewards returns the cumulative rewards per share of the
+// delegation of the tokenized shares pool to the given validator.
+func (k Keeper) GetTokenizeShareRewards(ctx sdk.Context, valAddr sdk.ValAddress) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRewardsKey(valAddr))
+
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+
+	var rewards types.TokenizeShareRewards
+	k.cdc.MustUnmarshalBinaryBare(bz, &rewards)
+
+	return rewards.CumulativeRewardRatio
+}
+
+// SetTokenizeShareRewards sets the cumulative rewards per share of the
+// delegation of the tokenized shares pool to the given validator.
+func (k Keeper) SetTokenizeShareRewards(ctx sdk.Context, valAddr sdk.ValAddress, ratio sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&types.TokenizeShareRewards{ValidatorAddress: valAddr, CumulativeRewardRatio: ratio})
+	store.Set(types.GetTokenizeShareRewardsKey(valAddr), bz)
+}
+
+// AddTokenizeShareRewards adds the rewards withdrawn by the delegation of the
+// tokenized shares pool to the given validator, holding the given shares, to
+// the cumulative rewards per share of the delegation.
+func (k Keeper) AddTokenizeShareRewards(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec, rewards sdk.Coins) {
+	if rewards.IsZero() || !shares.IsPositive() {
+		return
+	}
+
+	ratio := sdk.NewDecCoinsFromCoins(rewards...).QuoDecTruncate(shares)
+	k.SetTokenizeShareRewards(ctx, valAddr, k.GetTokenizeShareRewards(ctx, valAddr).Add(ratio...))
+}
+
+// GetAllTokenizeShareRewards returns the cumulative rewards per share of the
+// delegations of the tokenized shares pool to all validators.
+func (k Keeper) GetAllTokenizeShareRewards(ctx sdk.Context) (allRewards []types.TokenizeShareRewards) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRewardsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.TokenizeShareRewards
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rewards)
+		allRewards = append(allRewards, rewards)
+	}
+
+	return allRewards
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given ID.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordKey(id))
+
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+
+	return record, true
+}
+
+// SetTokenizeShareRecord sets the tokenize share record, keyed by its ID.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordKey(record.ID), k.cdc.MustMarshalBinaryBare(&record))
+}
+
+// DeleteTokenizeShareRecord deletes the tokenize share record with the given ID.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(id))
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetLastTokenizeShareRecordID returns the ID of the last tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the ID of the last tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// trackTokenizeShareRewards runs fn, which modifies the delegation of the
+// tokenized shares pool to the given validator, and adds the rewards that the
+// distribution hooks withdraw to the pool meanwhile to the cumulative rewards
+// per share of the delegation.
+func (k Keeper) trackTokenizeShareRewards(ctx sdk.Context, valAddr sdk.ValAddress, fn func() error) error {
+	poolAddr := k.GetTokenizedSharesPool(ctx).GetAddress()
+	balances := k.bankKeeper.GetAllBalances(ctx, poolAddr)
+
+	shares := sdk.ZeroDec()
+	if delegation, found := k.GetDelegation(ctx, poolAddr, valAddr); found {
+		shares = delegation.Shares
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	k.AddTokenizeShareRewards(ctx, valAddr, shares, k.bankKeeper.GetAllBalances(ctx, poolAddr).Sub(balances))
+
+	return nil
+}
+
+// TokenizeShares moves the delegator shares worth amount bond tokens from the
+// delegation of delAddr to valAddr into the tokenized shares pool, and mints
+// one share token per moved share to owner, in the denomination of a new
+// tokenize share record. The share tokens are redeemable for the shares at any
+// time, so their value follows the validator exchange rate, including any
+// slashing, and they are owed the rewards of the shares accrued from then on.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the tokens redelegated to the validator must remain slashable for the
+	// infractions of the source validator until the redelegation completes
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	// vesting tokens must remain locked, so only the delegated free tokens of a
+	// vesting account may be tokenized
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		validator := k.mustGetValidator(ctx, valAddr)
+		delegation, _ := k.GetDelegation(ctx, delAddr, valAddr)
+
+		delegated := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		free := delegated.Sub(acc.GetDelegatedVesting().AmountOf(k.BondDenom(ctx)))
+		if amount.GT(free) {
+			return sdk.Coin{}, types.ErrTokenizeVestingDelegation
+		}
+	}
+
+	maxTokenized := k.TokenizeShareCap(ctx).MulInt(k.TotalBondedTokens(ctx))
+	if k.TotalTokenizedTokens(ctx).Add(amount).ToDec().GT(maxTokenized) {
+		return sdk.Coin{}, types.ErrTokenizeSharesCapExceeded
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	// the tokens never leave the validator, so they stay in their current pool
+	var newShares sdk.Dec
+	poolAddr := k.GetTokenizedSharesPool(ctx).GetAddress()
+	err = k.trackTokenizeShareRewards(ctx, valAddr, func() (err error) {
+		newShares, err = k.Delegate(ctx, poolAddr, returnAmount, validator.GetStatus(), validator, false)
+		return err
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record := types.TokenizeShareRecord{
+		ID:                    k.GetLastTokenizeShareRecordID(ctx) + 1,
+		ValidatorAddress:      valAddr,
+		CumulativeRewardRatio: k.GetTokenizeShareRewards(ctx, valAddr),
+	}
+
+	shareToken := sdk.NewCoin(types.GetShareTokenDenom(valAddr, record.ID), newShares.TruncateInt())
+	if shareToken.IsZero() {
+		return sdk.Coin{}, types.ErrTinyTokenizeAmount
+	}
+
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, record.ID)
+
+	coins := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.MintCoins(ctx, types.TokenizedSharesPoolName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizedSharesPoolName, owner, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns the given share tokens of delAddr and moves the
+// matching shares from the tokenized shares pool back into a delegation of
+// delAddr to the validator of the share tokens. The rewards per share accrued
+// since the tokenize share record of the share tokens was created are paid to
+// delAddr for the burned share tokens. It returns the amount of bond tokens
+// delegated.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	valAddr, recordID, err := types.ParseShareTokenDenom(shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, recordID)
+	if !found || !record.ValidatorAddress.Equals(valAddr) {
+		return sdk.Coin{}, types.ErrTokenizeShareRecordNotFound
+	}
+
+	coins := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.TokenizedSharesPoolName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.TokenizedSharesPoolName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	var returnAmount sdk.Int
+	poolAddr := k.GetTokenizedSharesPool(ctx).GetAddress()
+	err = k.trackTokenizeShareRewards(ctx, valAddr, func() (err error) {
+		returnAmount, err = k.Unbond(ctx, poolAddr, valAddr, shareToken.Amount.ToDec())
+		return err
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// each share token is owed the rewards per share accrued since its record
+	// was created, truncated so that the pool always holds the owed rewards
+	ratio := k.GetTokenizeShareRewards(ctx, valAddr).Sub(record.CumulativeRewardRatio)
+	owed, _ := ratio.MulDecTruncate(shareToken.Amount.ToDec()).TruncateDecimal()
+
+	if !owed.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizedSharesPoolName, delAddr, owed); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if k.bankKeeper.GetSupply(ctx, shareToken.Denom).Amount.IsZero() {
+		k.DeleteTokenizeShareRecord(ctx, record.ID)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, validator.GetStatus(), validator, false); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(k.BondDenom(ctx), returnAmount), nil
+}
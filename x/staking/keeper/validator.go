@@ -8,6 +8,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -173,12 +174,67 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, err
 	}
 
+	if minRate := k.MinCommissionRate(ctx); newRate.LT(minRate) {
+		return commission, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "got %s, expected at least %s", newRate, minRate)
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
 	return commission, nil
 }
 
+// GetLastMinCommissionRate returns the min commission rate last applied to the
+// validators by ApplyMinCommissionRate.
+func (k Keeper) GetLastMinCommissionRate(ctx sdk.Context) (rate sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastMinCommissionRateKey)
+
+	if bz == nil {
+		return rate, false
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &dp)
+
+	return dp.Dec, true
+}
+
+// SetLastMinCommissionRate sets the min commission rate last applied to the
+// validators.
+func (k Keeper) SetLastMinCommissionRate(ctx sdk.Context, rate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: rate})
+	store.Set(types.LastMinCommissionRateKey, bz)
+}
+
+// ApplyMinCommissionRate lifts the commission rate of the validators below the
+// MinCommissionRate param to it, raising their max rate too if needed. It only
+// iterates the validators when the param changed since it was last applied.
+func (k Keeper) ApplyMinCommissionRate(ctx sdk.Context) {
+	minRate := k.MinCommissionRate(ctx)
+	if lastRate, found := k.GetLastMinCommissionRate(ctx); found && lastRate.Equal(minRate) {
+		return
+	}
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.Commission.Rate.GTE(minRate) {
+			continue
+		}
+
+		k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+
+		k.SetValidator(ctx, validator)
+	}
+
+	k.SetLastMinCommissionRate(ctx, minRate)
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
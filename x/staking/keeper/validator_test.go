@@ -11,7 +11,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -22,14 +21,14 @@ func bootstrapValidatorTest(t *testing.T, power int64, numAddrs int) (*simapp.Si
 	addrDels, addrVals := generateAddresses(app, ctx, numAddrs)
 
 	amt := sdk.TokensFromConsensusPower(power)
-	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
+	totalSupply := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
-	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), totalSupply)
+	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(totalSupply))
 	require.NoError(t, err)
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
+	app.BankKeeper.SetSupply(ctx, totalSupply)
 
 	return app, ctx, addrDels, addrVals
 }
@@ -1129,3 +1128,50 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyMinCommissionRate(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapValidatorTest(t, 1000, 20)
+
+	commission1 := types.NewCommission(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 2))
+	commission2 := types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1))
+
+	val1 := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	val2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
+
+	val1, _ = val1.SetInitialCommission(commission1)
+	val2, _ = val2.SetInitialCommission(commission2)
+
+	app.StakingKeeper.SetValidator(ctx, val1)
+	app.StakingKeeper.SetValidator(ctx, val2)
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = minRate
+	app.StakingKeeper.SetParams(ctx, params)
+
+	app.StakingKeeper.ApplyMinCommissionRate(ctx)
+
+	lastRate, found := app.StakingKeeper.GetLastMinCommissionRate(ctx)
+	require.True(t, found)
+	require.Equal(t, minRate, lastRate)
+
+	// the validator below the minimum is lifted to it, along with its max rate
+	val, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, minRate, val.Commission.Rate)
+	require.Equal(t, minRate, val.Commission.MaxRate)
+	require.Equal(t, commission1.UpdateTime, val.Commission.UpdateTime)
+
+	val, found = app.StakingKeeper.GetValidator(ctx, addrVals[1])
+	require.True(t, found)
+	require.Equal(t, commission2.CommissionRates, val.Commission.CommissionRates)
+
+	// the validators are left alone while the param doesn't change
+	val1.Commission = commission1
+	app.StakingKeeper.SetValidator(ctx, val1)
+	app.StakingKeeper.ApplyMinCommissionRate(ctx)
+
+	val, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, val.Commission.Rate.IsZero())
+}
@@ -57,4 +57,11 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 45, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
+	ErrInvalidShareTokenDenom          = sdkerrors.Register(ModuleName, 48, "invalid share token denomination")
+	ErrTinyTokenizeAmount              = sdkerrors.Register(ModuleName, 49, "too few tokens to tokenize (truncates to zero share tokens)")
+	ErrTokenizeSharesCapExceeded       = sdkerrors.Register(ModuleName, 50, "tokenized shares cap exceeded")
+	ErrTokenizeVestingDelegation       = sdkerrors.Register(ModuleName, 51, "cannot tokenize delegated vesting tokens")
+	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 52, "commission cannot be less than the min commission rate")
+	ErrRedelegationInProgress          = sdkerrors.Register(ModuleName, 53, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
+	ErrTokenizeShareRecordNotFound     = sdkerrors.Register(ModuleName, 54, "tokenize share record not found")
 )
@@ -43,7 +43,12 @@ var (
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
-	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+	HistoricalInfoKey        = []byte{0x50} // prefix for the historical info
+	LastMinCommissionRateKey = []byte{0x51} // key for the min commission rate last applied to the validators
+
+	TokenizeShareRewardsKey      = []byte{0x61} // prefix for the cumulative rewards per share of the tokenized shares pool delegation to a validator
+	TokenizeShareRecordKey       = []byte{0x62} // prefix for each key to a tokenize share record
+	LastTokenizeShareRecordIDKey = []byte{0x63} // key for the ID of the last tokenize share record
 )
 
 // gets the key for the validator with address
@@ -293,3 +298,16 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRewardsKey gets the key for the cumulative rewards per share
+// of the tokenized shares pool delegation to a validator
+// VALUE: staking/TokenizeShareRewards
+func GetTokenizeShareRewardsKey(valAddr sdk.ValAddress) []byte {
+	return append(TokenizeShareRewardsKey, valAddr.Bytes()...)
+}
+
+// GetTokenizeShareRecordKey gets the key for the tokenize share record with ID
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
@@ -32,12 +32,22 @@ const (
 	DefaultHistoricalEntries uint32 = 100
 )
 
+// DefaultTokenizeShareCap is the default maximum fraction of the bonded tokens
+// that may be held by tokenized delegations.
+var DefaultTokenizeShareCap = sdk.NewDecWithPrec(25, 2)
+
+// DefaultMinCommissionRate is the default minimum commission rate of the
+// validators, which doesn't restrict the commission rates.
+var DefaultMinCommissionRate = sdk.ZeroDec()
+
 var (
 	KeyUnbondingTime     = []byte("UnbondingTime")
 	KeyMaxValidators     = []byte("MaxValidators")
 	KeyMaxEntries        = []byte("MaxEntries")
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
+	KeyTokenizeShareCap  = []byte("TokenizeShareCap")
+	KeyMinCommissionRate = []byte("MinCommissionRate")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -48,13 +58,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	tokenizeShareCap, minCommissionRate sdk.Dec,
+) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
 		MaxValidators:     maxValidators,
 		MaxEntries:        maxEntries,
 		HistoricalEntries: historicalEntries,
 		BondDenom:         bondDenom,
+		TokenizeShareCap:  tokenizeShareCap,
+		MinCommissionRate: minCommissionRate,
 	}
 }
 
@@ -66,6 +81,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyTokenizeShareCap, &p.TokenizeShareCap, validateTokenizeShareCap),
+		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
 	}
 }
 
@@ -77,6 +94,8 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
+		DefaultTokenizeShareCap,
+		DefaultMinCommissionRate,
 	)
 }
 
@@ -124,6 +143,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateTokenizeShareCap(p.TokenizeShareCap); err != nil {
+		return err
+	}
+
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -191,3 +218,45 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateTokenizeShareCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("tokenize share cap cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("tokenize share cap cannot be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("tokenize share cap too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("minimum commission rate cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("minimum commission rate cannot be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum commission rate too large: %s", v)
+	}
+
+	return nil
+}
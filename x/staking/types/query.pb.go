@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -1484,76 +1485,89 @@ func init() {
 func init() { proto.RegisterFile("cosmos/staking/query.proto", fileDescriptor_802d43a0c79dce0e) }
 
 var fileDescriptor_802d43a0c79dce0e = []byte{
-	// 1099 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0xc1, 0x52, 0x1e, 0x6d, 0x54, 0xc6, 0xae, 0x49, 0xb7, 0xd4, 0x76, 0xb7, 0x50,
-	0xda, 0x42, 0xed, 0x34, 0xf4, 0xd2, 0x4a, 0x08, 0x25, 0x54, 0x08, 0x0e, 0x48, 0xed, 0x22, 0x02,
-	0x2a, 0x48, 0x66, 0xe3, 0x5d, 0xd6, 0x2b, 0x3b, 0x1e, 0x67, 0x67, 0x0d, 0x14, 0x89, 0x2b, 0x5c,
-	0x39, 0x21, 0x6e, 0xdc, 0xf9, 0x01, 0x70, 0x80, 0x03, 0x07, 0x24, 0x7a, 0xe0, 0x50, 0x09, 0x90,
-	0x38, 0x05, 0x94, 0xfc, 0x03, 0x8e, 0x3d, 0x21, 0xcf, 0xbe, 0x1d, 0xaf, 0x77, 0x67, 0x37, 0x9b,
-	0xd8, 0xa9, 0x92, 0x93, 0xed, 0x99, 0xf7, 0xbe, 0xf7, 0xe6, 0x7b, 0x6f, 0xde, 0x7b, 0x63, 0xd0,
-	0xda, 0x8c, 0x6f, 0x31, 0xde, 0xe4, 0xbe, 0xd9, 0x75, 0xfb, 0x4e, 0x73, 0x7b, 0x68, 0x7b, 0x0f,
-	0x1a, 0x03, 0x8f, 0xf9, 0x8c, 0x2e, 0x05, 0x7b, 0x0d, 0xdc, 0xd3, 0x2e, 0xa0, 0xac, 0x90, 0x69,
-	0x0e, 0x4c, 0xc7, 0xed, 0x9b, 0xbe, 0xcb, 0xfa, 0x81, 0xb8, 0x56, 0x76, 0x98, 0xc3, 0xc4, 0xd7,
-	0xe6, 0xe8, 0x1b, 0xae, 0x3e, 0x17, 0x33, 0x80, 0x9f, 0xc1, 0xae, 0xde, 0x85, 0xca, 0xbd, 0x11,
-	0xda, 0x86, 0xd9, 0x73, 0x2d, 0xd3, 0x67, 0x1e, 0x37, 0xec, 0xed, 0xa1, 0xcd, 0x7d, 0x5a, 0x81,
-	0x22, 0xf7, 0x4d, 0x7f, 0xc8, 0x97, 0x49, 0x9d, 0x5c, 0x59, 0x34, 0xf0, 0x17, 0xbd, 0x05, 0x30,
-	0xb6, 0xbc, 0x3c, 0x57, 0x27, 0x57, 0x9e, 0x5e, 0x3d, 0xd7, 0x40, 0x4f, 0x03, 0xef, 0xef, 0x9a,
-	0x8e, 0x8d, 0x30, 0x46, 0x44, 0x58, 0xff, 0x86, 0xc0, 0xb3, 0x09, 0x6b, 0x7c, 0xc0, 0xfa, 0xdc,
-	0xa6, 0xaf, 0x01, 0x7c, 0x22, 0x57, 0x97, 0x49, 0x7d, 0x3e, 0x0a, 0x1b, 0xfa, 0x2c, 0xf5, 0xd6,
-	0x17, 0x1e, 0xee, 0xd4, 0x0a, 0x46, 0x44, 0x85, 0xde, 0x56, 0xf8, 0xa5, 0xa9, 0xfc, 0x0a, 0x0c,
-	0x4e, 0x38, 0xb6, 0x0d, 0x67, 0x27, 0xfd, 0x0a, 0x49, 0x78, 0x1f, 0x96, 0xa4, 0x89, 0x96, 0x69,
-	0x59, 0x9e, 0x20, 0xe3, 0xd4, 0xfa, 0x8d, 0xc7, 0x3b, 0xb5, 0xeb, 0x8e, 0xeb, 0x77, 0x86, 0x9b,
-	0x8d, 0x36, 0xdb, 0x6a, 0x22, 0xc7, 0xc1, 0xc7, 0x75, 0x6e, 0x75, 0x9b, 0xfe, 0x83, 0x81, 0xcd,
-	0x47, 0x0e, 0xaf, 0x59, 0x96, 0x67, 0x73, 0x6e, 0x9c, 0x96, 0x40, 0xa3, 0x15, 0xfd, 0xbd, 0x38,
-	0xf1, 0x92, 0x89, 0x57, 0x61, 0x51, 0x8a, 0x0a, 0x73, 0x39, 0x88, 0x18, 0x6b, 0xe8, 0x3f, 0x12,
-	0xa8, 0x4f, 0x22, 0xdf, 0xb1, 0x7b, 0xb6, 0x23, 0x0e, 0xca, 0x8f, 0xfc, 0x5c, 0xd3, 0xa4, 0xc7,
-	0x1f, 0x04, 0x2e, 0x66, 0x78, 0x8e, 0xf4, 0x78, 0x50, 0xb6, 0xe4, 0x72, 0xcb, 0xc3, 0xe5, 0x30,
-	0x65, 0xf4, 0x38, 0x53, 0x63, 0x88, 0x10, 0x61, 0xfd, 0xfc, 0x88, 0xb2, 0xef, 0xff, 0xa9, 0x95,
-	0x92, 0x7b, 0xdc, 0x28, 0x59, 0xc9, 0xc5, 0xa9, 0x72, 0xeb, 0x17, 0x02, 0x57, 0x27, 0x4f, 0xf5,
-	0x6e, 0x7f, 0x93, 0xf5, 0x2d, 0xb7, 0xef, 0x9c, 0x94, 0xc0, 0xfc, 0x4a, 0xe0, 0x5a, 0x9e, 0x23,
-	0x60, 0x84, 0xee, 0x43, 0x69, 0x18, 0xee, 0x27, 0x02, 0x74, 0x29, 0x1e, 0x20, 0x05, 0x14, 0x26,
-	0x35, 0x95, 0x28, 0xb3, 0x89, 0xc4, 0xef, 0x04, 0xef, 0x5c, 0x34, 0xee, 0x92, 0x76, 0x8c, 0xfb,
-	0xe1, 0x68, 0x5f, 0x6b, 0xb7, 0x25, 0xed, 0x12, 0x48, 0xd0, 0x9e, 0x0c, 0xe8, 0xdc, 0x8c, 0x2a,
-	0xc8, 0x97, 0x61, 0x35, 0x4d, 0xa6, 0x31, 0xed, 0x42, 0x49, 0x71, 0x49, 0xb0, 0x9a, 0xe4, 0xb9,
-	0x23, 0x95, 0xc7, 0x3b, 0x35, 0x9a, 0x5c, 0x37, 0x68, 0xf2, 0x7a, 0xe8, 0x7f, 0x11, 0xa8, 0x09,
-	0x47, 0x14, 0xa1, 0x3c, 0xc9, 0x04, 0xdb, 0x58, 0x48, 0x95, 0xc7, 0x42, 0xa2, 0xd7, 0xa0, 0x18,
-	0x64, 0x29, 0x72, 0x7b, 0x80, 0xf4, 0x46, 0xc5, 0x71, 0xc1, 0xbe, 0x13, 0x9e, 0x4b, 0x5d, 0x17,
-	0x8e, 0x88, 0xbf, 0x29, 0xea, 0xc2, 0xcf, 0x61, 0xc1, 0x56, 0x7b, 0x8e, 0x14, 0x7d, 0x30, 0x75,
-	0xc1, 0x0e, 0xf8, 0x3a, 0xba, 0xca, 0x2c, 0xdd, 0xdf, 0xa7, 0x32, 0x1f, 0xbf, 0x08, 0xc8, 0xca,
-	0xbc, 0xcf, 0x11, 0x8e, 0x79, 0x65, 0xfe, 0x6f, 0x0e, 0xce, 0x89, 0x63, 0x18, 0xb6, 0xf5, 0x24,
-	0x99, 0x6f, 0x01, 0xe5, 0x5e, 0xbb, 0x35, 0xab, 0xfa, 0x71, 0x86, 0x7b, 0xed, 0x8d, 0x89, 0xa6,
-	0xdb, 0x02, 0x6a, 0x71, 0x3f, 0x6e, 0x60, 0xfe, 0xd0, 0x06, 0x2c, 0xee, 0x6f, 0x64, 0x74, 0xf5,
-	0x85, 0x83, 0xe4, 0xce, 0x4f, 0x04, 0x34, 0x15, 0xe9, 0x98, 0x2b, 0x26, 0x54, 0x3c, 0x3b, 0xe3,
-	0xe2, 0x3e, 0x1f, 0x4f, 0x97, 0x28, 0x4c, 0xec, 0xea, 0x9e, 0xf5, 0xec, 0x59, 0x5f, 0xde, 0x1f,
-	0xc2, 0xa6, 0x23, 0x33, 0x3f, 0xf9, 0x84, 0x39, 0x96, 0x57, 0xf6, 0xbb, 0x44, 0xb9, 0x3f, 0x6e,
-	0xaf, 0xa1, 0x3f, 0x09, 0x54, 0x53, 0x3c, 0x3c, 0xc9, 0xed, 0xfc, 0xa3, 0xd4, 0x84, 0x99, 0xd5,
-	0xd3, 0xeb, 0x26, 0x5e, 0xa8, 0x37, 0x5d, 0xee, 0x33, 0xcf, 0x6d, 0x9b, 0xbd, 0xb7, 0xfa, 0x1f,
-	0xb3, 0xc8, 0x83, 0xba, 0x63, 0xbb, 0x4e, 0xc7, 0x17, 0xc8, 0xf3, 0x06, 0xfe, 0xd2, 0xef, 0xc1,
-	0x79, 0xa5, 0x16, 0xfa, 0xb4, 0x0a, 0x0b, 0x1d, 0x97, 0xfb, 0xe8, 0x4e, 0x35, 0xee, 0x4e, 0x4c,
-	0x4b, 0xc8, 0xea, 0x14, 0xce, 0x08, 0xc8, 0xbb, 0x8c, 0xf5, 0xd0, 0xbc, 0xfe, 0x3a, 0x3c, 0x13,
-	0x59, 0x43, 0xf0, 0x06, 0x2c, 0x0c, 0x18, 0xeb, 0x21, 0x78, 0x39, 0x0e, 0x3e, 0x92, 0xc5, 0x63,
-	0x0a, 0x39, 0xbd, 0x0c, 0x34, 0x00, 0x31, 0x3d, 0x73, 0x2b, 0xbc, 0x67, 0xfa, 0x57, 0x04, 0x4a,
-	0x13, 0xcb, 0x88, 0x7e, 0x13, 0x8a, 0x03, 0xb1, 0x82, 0xf8, 0x95, 0x04, 0xbe, 0xd8, 0x0d, 0xe7,
-	0xa1, 0x40, 0x76, 0x9a, 0xd4, 0x5d, 0xfd, 0xed, 0x14, 0x3c, 0x25, 0x3c, 0xa1, 0x2d, 0x80, 0xf1,
-	0xbd, 0xa2, 0x97, 0xe3, 0x96, 0xd5, 0x7f, 0x7a, 0x68, 0x2f, 0xee, 0x2b, 0x87, 0x33, 0x6f, 0x81,
-	0x7e, 0x08, 0x8b, 0x72, 0x9d, 0xbe, 0x90, 0xad, 0x17, 0xc2, 0x5f, 0xde, 0x4f, 0x4c, 0xa2, 0x7f,
-	0x01, 0x65, 0xd5, 0x2b, 0x98, 0xae, 0x64, 0x23, 0x24, 0xe7, 0x16, 0xed, 0xc6, 0x01, 0x34, 0xa4,
-	0xf9, 0x6f, 0x09, 0x5c, 0xc8, 0x7c, 0xec, 0xd1, 0x5b, 0xd9, 0xb0, 0x19, 0x93, 0x94, 0x76, 0xfb,
-	0x30, 0xaa, 0xd2, 0xb5, 0x16, 0xc0, 0x78, 0x23, 0x25, 0xb0, 0x89, 0xf7, 0x47, 0x4a, 0x60, 0x93,
-	0xb3, 0xa6, 0x5e, 0xa0, 0x9f, 0x43, 0x49, 0xe1, 0x02, 0x6d, 0x2a, 0x11, 0xd2, 0x9f, 0x3c, 0xda,
-	0x4a, 0x7e, 0x85, 0x68, 0xd8, 0x55, 0xb3, 0x74, 0x4a, 0xd8, 0x33, 0x1e, 0x0c, 0x29, 0x61, 0xcf,
-	0x1a, 0xd4, 0x31, 0xec, 0x99, 0x93, 0x64, 0x4a, 0xd8, 0xf3, 0x0c, 0xd0, 0x29, 0x61, 0xcf, 0x35,
-	0xb8, 0xea, 0x05, 0xda, 0x81, 0xd3, 0x13, 0x73, 0x0a, 0xbd, 0xaa, 0x84, 0x53, 0x0d, 0x90, 0xda,
-	0xb5, 0x3c, 0xa2, 0xd1, 0xf8, 0x2b, 0x5a, 0x73, 0x4a, 0xfc, 0xd3, 0xa7, 0x0f, 0x6d, 0x25, 0xbf,
-	0x82, 0xb4, 0xfd, 0x29, 0xd0, 0xa4, 0x00, 0x6d, 0xe4, 0x44, 0x0a, 0x2d, 0x37, 0x73, 0xcb, 0x4b,
-	0xc3, 0x5d, 0x58, 0x9a, 0xec, 0x24, 0x54, 0x4d, 0x9a, 0xb2, 0xb5, 0x69, 0x2f, 0xe5, 0x92, 0x95,
-	0xc6, 0xde, 0x86, 0x85, 0x51, 0x67, 0xa1, 0x75, 0xa5, 0x5a, 0xa4, 0x69, 0x69, 0x17, 0x33, 0x24,
-	0x24, 0xdc, 0x3b, 0x50, 0x0c, 0x1a, 0x09, 0xd5, 0xd5, 0xe2, 0xd1, 0x66, 0xa5, 0x5d, 0xca, 0x94,
-	0x09, 0x41, 0xd7, 0xdf, 0x78, 0xb8, 0x5b, 0x25, 0x8f, 0x76, 0xab, 0xe4, 0xdf, 0xdd, 0x2a, 0xf9,
-	0x7a, 0xaf, 0x5a, 0x78, 0xb4, 0x57, 0x2d, 0xfc, 0xbd, 0x57, 0x2d, 0xdc, 0x7f, 0x39, 0x73, 0x0a,
-	0xf9, 0x4c, 0xfe, 0xd1, 0x2e, 0xe6, 0x91, 0xcd, 0xa2, 0xf8, 0x9f, 0xfd, 0x95, 0xff, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x9c, 0xed, 0xaa, 0xa9, 0xe8, 0x17, 0x00, 0x00,
+	// 1302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x6f, 0x1b, 0xd5,
+	0x13, 0xcf, 0x4b, 0xf2, 0x8d, 0x94, 0xf9, 0xd2, 0xa8, 0x8c, 0x5d, 0x93, 0x6e, 0x53, 0xc7, 0xdd,
+	0x42, 0x9b, 0xa6, 0x8d, 0x37, 0x4d, 0x23, 0x20, 0x45, 0x50, 0x25, 0xad, 0xa0, 0xe1, 0x42, 0x6a,
+	0x89, 0x80, 0x8a, 0x90, 0xd9, 0x78, 0xb7, 0xce, 0x2a, 0xce, 0xae, 0xb3, 0x6f, 0x83, 0xa8, 0xa2,
+	0x1c, 0xe0, 0x00, 0x47, 0x90, 0x90, 0x10, 0x12, 0x07, 0xce, 0x70, 0x41, 0x5c, 0x00, 0x89, 0x1e,
+	0x38, 0x20, 0xd4, 0x03, 0x87, 0x4a, 0x05, 0x89, 0x53, 0x0a, 0x09, 0x7f, 0x01, 0xc7, 0x9e, 0x90,
+	0xdf, 0xbe, 0x5d, 0xef, 0x8f, 0xb7, 0x9b, 0x75, 0xe2, 0xa0, 0xe4, 0x14, 0xfb, 0xbd, 0x79, 0x9f,
+	0xf9, 0xcc, 0xbc, 0x99, 0x79, 0x33, 0x0e, 0x48, 0x35, 0x8b, 0xae, 0x5a, 0x54, 0xa1, 0x8e, 0xba,
+	0x62, 0x98, 0x75, 0x65, 0x6d, 0x5d, 0xb7, 0xef, 0x96, 0x9b, 0xb6, 0xe5, 0x58, 0x38, 0xe4, 0xee,
+	0x95, 0xf9, 0x9e, 0x34, 0x52, 0xb7, 0xac, 0x7a, 0x43, 0x57, 0xd4, 0xa6, 0xa1, 0xa8, 0xa6, 0x69,
+	0x39, 0xaa, 0x63, 0x58, 0x26, 0x75, 0xa5, 0xa5, 0xd3, 0x1c, 0x89, 0x21, 0x28, 0x4d, 0xb5, 0x6e,
+	0x98, 0x6c, 0x9f, 0x6f, 0xe7, 0xeb, 0x56, 0xdd, 0x62, 0x1f, 0x95, 0xd6, 0x27, 0xbe, 0x3a, 0x12,
+	0x51, 0xcf, 0xff, 0xba, 0xbb, 0xf2, 0x0a, 0x14, 0x6e, 0xb5, 0xd0, 0x16, 0xd5, 0x86, 0xa1, 0xa9,
+	0x8e, 0x65, 0xd3, 0x8a, 0xbe, 0xb6, 0xae, 0x53, 0x07, 0x0b, 0x30, 0x40, 0x1d, 0xd5, 0x59, 0xa7,
+	0xc3, 0xa4, 0x44, 0xc6, 0x06, 0x2b, 0xfc, 0x1b, 0xce, 0x00, 0xb4, 0x35, 0x0f, 0xf7, 0x96, 0xc8,
+	0xd8, 0xff, 0xa7, 0x4e, 0x96, 0xb9, 0x1d, 0xae, 0x6d, 0x0b, 0x6a, 0x5d, 0xe7, 0x30, 0x95, 0x80,
+	0xb0, 0xfc, 0x19, 0x81, 0xa7, 0x62, 0xda, 0x68, 0xd3, 0x32, 0xa9, 0x8e, 0xd7, 0x00, 0xde, 0xf5,
+	0x57, 0x87, 0x49, 0xa9, 0x2f, 0x08, 0xeb, 0x71, 0xf6, 0xcf, 0xcd, 0xf5, 0xdf, 0xdf, 0x1a, 0xed,
+	0xa9, 0x04, 0x8e, 0xe0, 0x55, 0x01, 0x2f, 0x49, 0xc4, 0xcb, 0x55, 0x18, 0x22, 0xb6, 0x06, 0x27,
+	0xc2, 0xbc, 0x3c, 0x27, 0xbc, 0x09, 0x43, 0xbe, 0x8a, 0xaa, 0xaa, 0x69, 0x36, 0x73, 0xc6, 0x13,
+	0x73, 0x97, 0x1f, 0x6f, 0x8d, 0x4e, 0xd4, 0x0d, 0x67, 0x79, 0x7d, 0xa9, 0x5c, 0xb3, 0x56, 0x15,
+	0xee, 0x63, 0xf7, 0xcf, 0x04, 0xd5, 0x56, 0x14, 0xe7, 0x6e, 0x53, 0xa7, 0x2d, 0xc2, 0xb3, 0x9a,
+	0x66, 0xeb, 0x94, 0x56, 0x8e, 0xf9, 0x40, 0xad, 0x15, 0xf9, 0x8d, 0xa8, 0xe3, 0x7d, 0x4f, 0xbc,
+	0x08, 0x83, 0xbe, 0x28, 0x53, 0x97, 0xc1, 0x11, 0xed, 0x13, 0xf2, 0xf7, 0x04, 0x4a, 0x61, 0xe4,
+	0x1b, 0x7a, 0x43, 0xaf, 0xbb, 0x81, 0x74, 0xe0, 0x76, 0xed, 0x27, 0x3c, 0x1e, 0x12, 0x38, 0x93,
+	0xc2, 0x9c, 0xbb, 0xc7, 0x86, 0xbc, 0xe6, 0x2f, 0x57, 0x6d, 0xbe, 0xec, 0x85, 0x8c, 0x1c, 0xf5,
+	0x54, 0x1b, 0xc2, 0x43, 0x98, 0x3b, 0xd5, 0x72, 0xd9, 0xd7, 0x8f, 0x46, 0x73, 0xf1, 0x3d, 0x5a,
+	0xc9, 0x69, 0xf1, 0xc5, 0x7d, 0xc5, 0xd6, 0x4f, 0x04, 0x2e, 0x84, 0xad, 0x7a, 0xdd, 0x5c, 0xb2,
+	0x4c, 0xcd, 0x30, 0xeb, 0x47, 0xe5, 0x62, 0x7e, 0x26, 0x30, 0x9e, 0xc5, 0x04, 0x7e, 0x43, 0xb7,
+	0x21, 0xb7, 0xee, 0xed, 0xc7, 0x2e, 0xe8, 0x6c, 0xf4, 0x82, 0x04, 0x50, 0x3c, 0xa8, 0xd1, 0x47,
+	0xe9, 0xce, 0x4d, 0xfc, 0x4a, 0x78, 0xce, 0x05, 0xef, 0xdd, 0x77, 0x3b, 0xbf, 0xf7, 0xbd, 0xb9,
+	0x7d, 0xb6, 0x56, 0xf3, 0xdd, 0xee, 0x03, 0x31, 0xb7, 0xc7, 0x2f, 0xb4, 0xb7, 0x4b, 0x15, 0xe4,
+	0x43, 0xaf, 0x9a, 0xc6, 0xc3, 0x18, 0x57, 0x20, 0x27, 0x48, 0x12, 0x5e, 0x4d, 0xb2, 0xe4, 0x48,
+	0xe1, 0xf1, 0xd6, 0x28, 0xc6, 0xd7, 0x2b, 0x18, 0x4f, 0x0f, 0xf9, 0x77, 0x02, 0xa3, 0x8c, 0x88,
+	0xe0, 0x2a, 0x8f, 0xb2, 0x83, 0x75, 0x28, 0x25, 0x9b, 0xc5, 0x1d, 0x3d, 0x0b, 0x03, 0x6e, 0x94,
+	0x72, 0xdf, 0x76, 0x10, 0xde, 0xfc, 0x60, 0xbb, 0x60, 0xdf, 0xf0, 0xec, 0x12, 0xd7, 0x85, 0x03,
+	0xf2, 0xdf, 0x3e, 0xea, 0xc2, 0x3d, 0xaf, 0x60, 0x8b, 0x99, 0x73, 0x17, 0xbd, 0xb5, 0xef, 0x82,
+	0xed, 0xfa, 0xeb, 0xe0, 0x2a, 0xb3, 0x4f, 0x7f, 0x97, 0xca, 0x7c, 0xf8, 0x6e, 0xc0, 0xaf, 0xcc,
+	0xbb, 0x98, 0x70, 0xc8, 0x2b, 0xf3, 0x3f, 0xbd, 0x70, 0x92, 0x99, 0x51, 0xd1, 0xb5, 0xff, 0xd2,
+	0xf3, 0x55, 0x40, 0x6a, 0xd7, 0xaa, 0xdd, 0xaa, 0x1f, 0xc7, 0xa9, 0x5d, 0x5b, 0x0c, 0x3d, 0xba,
+	0x55, 0x40, 0x8d, 0x3a, 0x51, 0x05, 0x7d, 0x7b, 0x56, 0xa0, 0x51, 0x67, 0x31, 0xe5, 0x55, 0xef,
+	0xef, 0x24, 0x76, 0x7e, 0x24, 0x20, 0x89, 0x9c, 0xce, 0x63, 0x45, 0x85, 0x82, 0xad, 0xa7, 0x24,
+	0xee, 0xd3, 0xd1, 0x70, 0x09, 0xc2, 0x44, 0x52, 0xf7, 0x84, 0xad, 0x77, 0x3b, 0x79, 0xbf, 0xf3,
+	0x1e, 0x1d, 0x3f, 0xf2, 0xe3, 0x23, 0xcc, 0xa1, 0x4c, 0xd9, 0x2f, 0x63, 0xe5, 0xfe, 0xb0, 0x4d,
+	0x43, 0xbf, 0x11, 0x28, 0x26, 0x30, 0x3c, 0xca, 0xcf, 0xf9, 0x3b, 0x89, 0x01, 0xd3, 0xad, 0xd1,
+	0x6b, 0x9a, 0x27, 0xd4, 0x4d, 0x83, 0x3a, 0x96, 0x6d, 0xd4, 0xd4, 0xc6, 0xbc, 0x79, 0xc7, 0x0a,
+	0x0c, 0xd4, 0xcb, 0xba, 0x51, 0x5f, 0x76, 0x18, 0x72, 0x5f, 0x85, 0x7f, 0x93, 0x6f, 0xc1, 0x29,
+	0xe1, 0x29, 0xce, 0x69, 0x0a, 0xfa, 0x97, 0x0d, 0xea, 0x70, 0x3a, 0xc5, 0x28, 0x9d, 0xc8, 0x29,
+	0x26, 0x2b, 0x23, 0x1c, 0x67, 0x90, 0x0b, 0x96, 0xd5, 0xe0, 0xea, 0xe5, 0xeb, 0xf0, 0x64, 0x60,
+	0x8d, 0x83, 0x97, 0xa1, 0xbf, 0x69, 0x59, 0x0d, 0x0e, 0x9e, 0x8f, 0x82, 0xb7, 0x64, 0xb9, 0x99,
+	0x4c, 0x4e, 0xce, 0x03, 0xba, 0x20, 0xaa, 0xad, 0xae, 0x7a, 0x79, 0x26, 0x7f, 0x44, 0x20, 0x17,
+	0x5a, 0xe6, 0xe8, 0xd3, 0x30, 0xd0, 0x64, 0x2b, 0x1c, 0xbf, 0x10, 0xc3, 0x67, 0xbb, 0x5e, 0x3f,
+	0xe4, 0xca, 0xee, 0x27, 0x74, 0xa7, 0x3e, 0xcf, 0xc1, 0xff, 0x18, 0x13, 0x7c, 0x9f, 0x00, 0xb4,
+	0x13, 0x0b, 0xcf, 0x45, 0x55, 0x8b, 0x7f, 0xf5, 0x90, 0xce, 0xef, 0x2a, 0xc7, 0x9b, 0x5e, 0xf9,
+	0x83, 0x87, 0x7f, 0x7f, 0xda, 0x3b, 0x82, 0x92, 0x12, 0xf9, 0x7d, 0x25, 0x90, 0x84, 0x1f, 0x13,
+	0x18, 0xf4, 0x8f, 0xe2, 0x33, 0xe9, 0xd0, 0x1e, 0x83, 0x73, 0xbb, 0x89, 0x71, 0x02, 0x57, 0x18,
+	0x81, 0x09, 0xbc, 0x98, 0x4c, 0x40, 0xd9, 0x08, 0xa7, 0xd2, 0x26, 0xde, 0x23, 0x90, 0x17, 0x4d,
+	0xd7, 0x38, 0x99, 0xae, 0x35, 0xde, 0x0f, 0x49, 0x97, 0x3b, 0x38, 0xc1, 0x29, 0x5f, 0x63, 0x94,
+	0x67, 0xf0, 0xb9, 0x0e, 0x28, 0x2b, 0x81, 0xb7, 0x09, 0xff, 0x22, 0x70, 0x3a, 0x75, 0x06, 0xc5,
+	0x99, 0x74, 0x56, 0x29, 0x0d, 0x9e, 0x74, 0x75, 0x2f, 0x47, 0xb9, 0x65, 0xf3, 0xcc, 0xb2, 0xeb,
+	0x38, 0xdb, 0x89, 0x65, 0xed, 0x56, 0x2c, 0x68, 0xe3, 0x37, 0x04, 0xa0, 0xad, 0x22, 0x21, 0x70,
+	0x63, 0x03, 0x96, 0x74, 0x7e, 0x57, 0x39, 0x4e, 0x75, 0x81, 0x51, 0x7d, 0x15, 0x6f, 0xee, 0xf1,
+	0x12, 0x94, 0x8d, 0x70, 0xe5, 0xdf, 0xc4, 0x47, 0x04, 0x72, 0x02, 0xef, 0xa0, 0x22, 0xa4, 0x94,
+	0x3c, 0x24, 0x4a, 0x93, 0xd9, 0x0f, 0x70, 0x63, 0x74, 0x66, 0x4c, 0x15, 0xdf, 0xee, 0x96, 0x31,
+	0xc2, 0x4b, 0xc1, 0x6f, 0x09, 0xe4, 0x45, 0x33, 0x4e, 0x42, 0xda, 0xa4, 0x0c, 0x72, 0x09, 0x69,
+	0x93, 0x36, 0x40, 0xc9, 0xd3, 0xcc, 0xc8, 0x32, 0x5e, 0x8a, 0x1a, 0x99, 0x7a, 0x2b, 0xad, 0x5c,
+	0x49, 0x9d, 0x0a, 0x12, 0x72, 0x25, 0xcb, 0x30, 0x94, 0x90, 0x2b, 0x99, 0x86, 0x90, 0xe4, 0x5c,
+	0xf1, 0x2d, 0xc8, 0x78, 0x2d, 0x14, 0xbf, 0x22, 0x70, 0x2c, 0xd4, 0xbd, 0xe2, 0x05, 0x21, 0x31,
+	0xd1, 0x58, 0x21, 0x8d, 0x67, 0x11, 0xe5, 0x9c, 0x67, 0x19, 0xe7, 0x17, 0x70, 0xa6, 0x13, 0xce,
+	0x76, 0x88, 0xd9, 0x0f, 0x04, 0x72, 0x82, 0x96, 0x2f, 0x21, 0x4b, 0x92, 0xbb, 0x5a, 0x69, 0x32,
+	0xfb, 0x01, 0xce, 0xfe, 0x25, 0xc6, 0xfe, 0x79, 0x7c, 0xb6, 0x13, 0xf6, 0x81, 0x77, 0xec, 0x17,
+	0x02, 0x18, 0xc7, 0xc7, 0x72, 0x46, 0x22, 0x1e, 0x71, 0x25, 0xb3, 0x3c, 0xe7, 0xfd, 0x1a, 0xe3,
+	0x3d, 0x8f, 0xaf, 0xec, 0x8d, 0x77, 0xfc, 0xf9, 0xfb, 0x82, 0xc0, 0x50, 0xb8, 0x61, 0x42, 0x71,
+	0x14, 0x08, 0x3b, 0x38, 0xe9, 0x62, 0x26, 0x59, 0x4e, 0x7e, 0x92, 0x91, 0x1f, 0xc7, 0xb1, 0x28,
+	0xf9, 0x65, 0x5f, 0xbe, 0x6a, 0x98, 0x77, 0x2c, 0x65, 0xc3, 0xed, 0x03, 0x37, 0xd1, 0x80, 0xfe,
+	0x56, 0xc3, 0x85, 0x25, 0xa1, 0x9a, 0x40, 0x2f, 0x27, 0x9d, 0x49, 0x91, 0xe0, 0xea, 0x47, 0x98,
+	0xfa, 0x02, 0xe6, 0xa3, 0xea, 0x5b, 0x7d, 0x1c, 0xae, 0xc1, 0x80, 0xdb, 0x7b, 0xa1, 0x2c, 0x86,
+	0x0a, 0xf6, 0x77, 0xd2, 0xd9, 0x54, 0x19, 0xae, 0xb0, 0xc8, 0x14, 0x0e, 0x63, 0x21, 0xa6, 0xd0,
+	0x6d, 0xf2, 0x5e, 0xbe, 0xbf, 0x5d, 0x24, 0x0f, 0xb6, 0x8b, 0xe4, 0xcf, 0xed, 0x22, 0xf9, 0x64,
+	0xa7, 0xd8, 0xf3, 0x60, 0xa7, 0xd8, 0xf3, 0xc7, 0x4e, 0xb1, 0xe7, 0xf6, 0xa5, 0xd4, 0xb6, 0xfe,
+	0x3d, 0x1f, 0x88, 0x35, 0xf8, 0x4b, 0x03, 0xec, 0x1f, 0x57, 0x57, 0xfe, 0x1d, 0x00, 0x69, 0xac,
+	0x8c, 0xc2, 0x57, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -376,6 +376,130 @@ func (m *MsgUndelegate) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgTokenizeShares defines an SDK message for converting an amount of a
+// delegation into transferable share tokens of the validator.
+type MsgTokenizeShares struct {
+	DelegatorAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Amount              types.Coin                                    `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	TokenizedShareOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=tokenized_share_owner,json=tokenizedShareOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"tokenized_share_owner,omitempty" yaml:"tokenized_share_owner"`
+}
+
+func (m *MsgTokenizeShares) Reset()         { *m = MsgTokenizeShares{} }
+func (m *MsgTokenizeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeShares) ProtoMessage()    {}
+func (*MsgTokenizeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{5}
+}
+func (m *MsgTokenizeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenizeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeShares.Merge(m, src)
+}
+func (m *MsgTokenizeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenizeShares proto.InternalMessageInfo
+
+func (m *MsgTokenizeShares) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgTokenizeShares) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgTokenizeShares) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgTokenizeShares) GetTokenizedShareOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.TokenizedShareOwner
+	}
+	return nil
+}
+
+// MsgRedeemTokensForShares defines an SDK message for converting share tokens
+// of a validator back into a delegation to that validator.
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Amount           types.Coin                                    `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForShares) Reset()         { *m = MsgRedeemTokensForShares{} }
+func (m *MsgRedeemTokensForShares) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForShares) ProtoMessage()    {}
+func (*MsgRedeemTokensForShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{6}
+}
+func (m *MsgRedeemTokensForShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemTokensForShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemTokensForShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemTokensForShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForShares.Merge(m, src)
+}
+func (m *MsgRedeemTokensForShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemTokensForShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemTokensForShares proto.InternalMessageInfo
+
+func (m *MsgRedeemTokensForShares) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgRedeemTokensForShares) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 // HistoricalInfo contains the historical information that gets stored at
 // each height.
 type HistoricalInfo struct {
@@ -387,7 +511,7 @@ func (m *HistoricalInfo) Reset()         { *m = HistoricalInfo{} }
 func (m *HistoricalInfo) String() string { return proto.CompactTextString(m) }
 func (*HistoricalInfo) ProtoMessage()    {}
 func (*HistoricalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{5}
+	return fileDescriptor_146003fcdb99b683, []int{7}
 }
 func (m *HistoricalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +565,7 @@ type CommissionRates struct {
 func (m *CommissionRates) Reset()      { *m = CommissionRates{} }
 func (*CommissionRates) ProtoMessage() {}
 func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{6}
+	return fileDescriptor_146003fcdb99b683, []int{8}
 }
 func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -479,7 +603,7 @@ type Commission struct {
 func (m *Commission) Reset()      { *m = Commission{} }
 func (*Commission) ProtoMessage() {}
 func (*Commission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{7}
+	return fileDescriptor_146003fcdb99b683, []int{9}
 }
 func (m *Commission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +651,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{8}
+	return fileDescriptor_146003fcdb99b683, []int{10}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +739,7 @@ type Validator struct {
 func (m *Validator) Reset()      { *m = Validator{} }
 func (*Validator) ProtoMessage() {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{9}
+	return fileDescriptor_146003fcdb99b683, []int{11}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +779,7 @@ type DVPair struct {
 func (m *DVPair) Reset()      { *m = DVPair{} }
 func (*DVPair) ProtoMessage() {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{10}
+	return fileDescriptor_146003fcdb99b683, []int{12}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +831,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{11}
+	return fileDescriptor_146003fcdb99b683, []int{13}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +880,7 @@ type DVVTriplet struct {
 func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
 func (*DVVTriplet) ProtoMessage() {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{12}
+	return fileDescriptor_146003fcdb99b683, []int{14}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,7 +939,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{13}
+	return fileDescriptor_146003fcdb99b683, []int{15}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +987,7 @@ type Delegation struct {
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{14}
+	return fileDescriptor_146003fcdb99b683, []int{16}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,7 +1041,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{15}
+	return fileDescriptor_146003fcdb99b683, []int{17}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1102,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{16}
+	return fileDescriptor_146003fcdb99b683, []int{18}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,7 +1156,7 @@ type RedelegationEntry struct {
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{17}
+	return fileDescriptor_146003fcdb99b683, []int{19}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1211,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{18}
+	return fileDescriptor_146003fcdb99b683, []int{20}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1151,12 +1275,17 @@ type Params struct {
 	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// tokenize_share_cap is the maximum fraction of the bonded tokens that may be
+	// held by tokenized delegations.
+	TokenizeShareCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=tokenize_share_cap,json=tokenizeShareCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tokenize_share_cap" yaml:"tokenize_share_cap"`
+	// min_commission_rate is the minimum commission rate of the validators.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{19}
+	return fileDescriptor_146003fcdb99b683, []int{21}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,7 +1359,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{20}
+	return fileDescriptor_146003fcdb99b683, []int{22}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1285,7 +1414,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{21}
+	return fileDescriptor_146003fcdb99b683, []int{23}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1462,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{22}
+	return fileDescriptor_146003fcdb99b683, []int{24}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1386,7 +1515,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{23}
+	return fileDescriptor_146003fcdb99b683, []int{25}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1415,12 +1544,135 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRewards defines the cumulative rewards per delegator share of
+// the tokenized shares pool delegation to a validator, which the distribution
+// hooks withdraw to the pool when the delegation is modified.
+type TokenizeShareRewards struct {
+	ValidatorAddress      github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins   `protobuf:"bytes,2,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
+}
+
+func (m *TokenizeShareRewards) Reset()         { *m = TokenizeShareRewards{} }
+func (m *TokenizeShareRewards) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRewards) ProtoMessage()    {}
+func (*TokenizeShareRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{26}
+}
+func (m *TokenizeShareRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRewards.Merge(m, src)
+}
+func (m *TokenizeShareRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRewards proto.InternalMessageInfo
+
+func (m *TokenizeShareRewards) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *TokenizeShareRewards) GetCumulativeRewardRatio() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CumulativeRewardRatio
+	}
+	return nil
+}
+
+// TokenizeShareRecord defines a tokenization of delegator shares, whose share
+// tokens have the share/{validator_address}/{id} denomination. Each share
+// token of the record is owed the rewards per share accrued since the record
+// was created.
+type TokenizeShareRecord struct {
+	ID               uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	// cumulative_reward_ratio is the cumulative rewards per share of the
+	// validator when the record was created.
+	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{27}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecord) GetCumulativeRewardRatio() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CumulativeRewardRatio
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.MsgCreateValidator")
 	proto.RegisterType((*MsgEditValidator)(nil), "cosmos.staking.MsgEditValidator")
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.MsgDelegate")
 	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.MsgBeginRedelegate")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.MsgUndelegate")
+	proto.RegisterType((*MsgTokenizeShares)(nil), "cosmos.staking.MsgTokenizeShares")
+	proto.RegisterType((*MsgRedeemTokensForShares)(nil), "cosmos.staking.MsgRedeemTokensForShares")
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.Commission")
@@ -1440,127 +1692,145 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.Pool")
+	proto.RegisterType((*TokenizeShareRewards)(nil), "cosmos.staking.TokenizeShareRewards")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.TokenizeShareRecord")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/staking.proto", fileDescriptor_146003fcdb99b683) }
 
 var fileDescriptor_146003fcdb99b683 = []byte{
-	// 1836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0xcd, 0x6f, 0x1b, 0x59,
-	0x3d, 0x63, 0xbb, 0x4e, 0xf2, 0x73, 0x62, 0x27, 0xaf, 0x6d, 0xd6, 0xc9, 0x76, 0x33, 0xe9, 0x1c,
-	0x50, 0x40, 0xbb, 0x8e, 0x28, 0x48, 0x2b, 0x05, 0x90, 0xa8, 0xed, 0x46, 0x89, 0x68, 0xa4, 0x32,
-	0xed, 0x06, 0x09, 0x90, 0xac, 0xe7, 0x99, 0x57, 0x67, 0xc8, 0x7c, 0x98, 0x79, 0xcf, 0xdd, 0x04,
-	0xed, 0x15, 0x09, 0x21, 0x3e, 0xf6, 0xb8, 0xc7, 0x8a, 0x3f, 0x00, 0x8e, 0xc0, 0x99, 0xcb, 0x72,
-	0xab, 0x38, 0x20, 0xc4, 0x61, 0x80, 0xf6, 0x00, 0xe2, 0x84, 0x7c, 0xe0, 0xc0, 0x05, 0xf4, 0x3e,
-	0xe6, 0xc3, 0x63, 0x67, 0x63, 0x87, 0xb2, 0x54, 0x6a, 0x2e, 0xad, 0xdf, 0x6f, 0x7e, 0x5f, 0xef,
-	0xf7, 0xfd, 0x7e, 0x81, 0x5b, 0x56, 0x40, 0xbd, 0x80, 0xee, 0x50, 0x86, 0x4f, 0x1c, 0xbf, 0x17,
-	0xff, 0xdf, 0xe8, 0x87, 0x01, 0x0b, 0x50, 0x55, 0x7e, 0x6d, 0x28, 0xe8, 0xc6, 0x8d, 0x5e, 0xd0,
-	0x0b, 0xc4, 0xa7, 0x1d, 0xfe, 0x4b, 0x62, 0x6d, 0xdc, 0x66, 0xc4, 0xb7, 0x49, 0xe8, 0x39, 0x3e,
-	0xdb, 0xc1, 0x5d, 0xcb, 0xd9, 0x61, 0x67, 0x7d, 0x42, 0xe5, 0xbf, 0x0a, 0x45, 0xef, 0x05, 0x41,
-	0xcf, 0x25, 0x3b, 0xe2, 0xd4, 0x1d, 0x3c, 0xde, 0x61, 0x8e, 0x47, 0x28, 0xc3, 0x5e, 0x5f, 0x21,
-	0x6c, 0xe6, 0x11, 0xec, 0x41, 0x88, 0x99, 0x13, 0xf8, 0xea, 0xfb, 0x75, 0xa5, 0xa7, 0x52, 0x48,
-	0x00, 0x8d, 0xa8, 0x04, 0xe8, 0x90, 0xf6, 0x5a, 0x21, 0xc1, 0x8c, 0x1c, 0x61, 0xd7, 0xb1, 0x31,
-	0x0b, 0x42, 0xd4, 0x82, 0x8a, 0x4d, 0xa8, 0x15, 0x3a, 0x7d, 0xce, 0xa0, 0xae, 0x6d, 0x69, 0xdb,
-	0x95, 0x3b, 0x6f, 0x36, 0x46, 0xef, 0xd2, 0x68, 0xa7, 0x28, 0xcd, 0xd2, 0xc7, 0x91, 0x3e, 0x67,
-	0x66, 0xa9, 0xd0, 0x3d, 0x00, 0x2b, 0xf0, 0x3c, 0x87, 0x52, 0xce, 0xa3, 0x20, 0x78, 0xe8, 0x79,
-	0x1e, 0xad, 0x04, 0xc3, 0xc4, 0x8c, 0x50, 0xc5, 0x27, 0x43, 0x88, 0x3e, 0x80, 0xeb, 0x9e, 0xe3,
-	0x77, 0x28, 0x71, 0x1f, 0x77, 0x6c, 0xe2, 0x92, 0x9e, 0xb8, 0x54, 0xbd, 0xb8, 0xa5, 0x6d, 0x2f,
-	0x36, 0xef, 0x73, 0xf4, 0x3f, 0x46, 0xfa, 0x67, 0x7a, 0x0e, 0x3b, 0x1e, 0x74, 0x1b, 0x56, 0xe0,
-	0xed, 0x8c, 0xdc, 0xf3, 0x1d, 0x6a, 0x9f, 0x28, 0x3b, 0x1e, 0xf8, 0x6c, 0x18, 0xe9, 0x1b, 0x67,
-	0xd8, 0x73, 0x77, 0x8d, 0x09, 0x2c, 0x0d, 0x73, 0xd5, 0x73, 0xfc, 0x87, 0xc4, 0x7d, 0xdc, 0x4e,
-	0x60, 0xe8, 0x7b, 0xb0, 0xaa, 0x30, 0x82, 0xb0, 0x83, 0x6d, 0x3b, 0x24, 0x94, 0xd6, 0x4b, 0x5b,
-	0xda, 0xf6, 0x52, 0xf3, 0x70, 0x18, 0xe9, 0x75, 0xc9, 0x6d, 0x0c, 0xc5, 0xf8, 0x57, 0xa4, 0xbf,
-	0x33, 0x85, 0x4e, 0x77, 0x2d, 0xeb, 0xae, 0xa4, 0x30, 0x57, 0x12, 0x26, 0x0a, 0xc2, 0x65, 0x3f,
-	0x89, 0x5d, 0x92, 0xc8, 0xbe, 0x96, 0x97, 0x3d, 0x86, 0x32, 0xad, 0xec, 0x23, 0xec, 0x26, 0xb2,
-	0x13, 0x26, 0xb1, 0xec, 0x35, 0x28, 0xf7, 0x07, 0xdd, 0x13, 0x72, 0x56, 0x2f, 0x73, 0x43, 0x9b,
-	0xea, 0x84, 0xb6, 0xe1, 0xda, 0x13, 0xec, 0x0e, 0x48, 0x7d, 0x5e, 0xf8, 0x73, 0x29, 0xf6, 0x67,
-	0x2b, 0x70, 0xe2, 0x20, 0x90, 0x08, 0xbb, 0xa5, 0xbf, 0x3d, 0xd5, 0x35, 0xe3, 0x57, 0x45, 0x58,
-	0x39, 0xa4, 0xbd, 0x7b, 0xb6, 0xc3, 0x5e, 0x72, 0x78, 0xf5, 0x27, 0x59, 0xa7, 0x20, 0xac, 0xd3,
-	0x1a, 0x46, 0x7a, 0x55, 0x5a, 0xe7, 0x65, 0xda, 0xc4, 0x83, 0x5a, 0x1a, 0x97, 0x9d, 0x10, 0x33,
-	0xa2, 0xa2, 0xb0, 0x3d, 0x65, 0x04, 0xb6, 0x89, 0x35, 0x8c, 0xf4, 0x35, 0xa9, 0x59, 0x8e, 0x95,
-	0x61, 0x56, 0xad, 0x91, 0x5c, 0x40, 0xa7, 0x93, 0x03, 0xbf, 0x24, 0x44, 0xee, 0xff, 0x0f, 0x83,
-	0x5e, 0xb9, 0xee, 0x97, 0x05, 0xa8, 0x1c, 0xd2, 0x9e, 0x82, 0x93, 0xc9, 0xa9, 0xa0, 0xfd, 0x1f,
-	0x53, 0xa1, 0xf0, 0xe9, 0xa4, 0xc2, 0xe7, 0xa0, 0x8c, 0xbd, 0x60, 0xe0, 0x33, 0xe1, 0xed, 0xc9,
-	0x31, 0xaf, 0x30, 0x94, 0xe5, 0x7e, 0x57, 0x14, 0x55, 0xb5, 0x49, 0x7a, 0x8e, 0x6f, 0x12, 0xfb,
-	0x55, 0x30, 0xe0, 0xf7, 0x35, 0xb8, 0x99, 0x9a, 0x87, 0x86, 0x56, 0xce, 0x8a, 0x5f, 0x1f, 0x46,
-	0xfa, 0xad, 0xbc, 0x15, 0x33, 0x68, 0x97, 0xb0, 0xe4, 0xf5, 0x84, 0xd1, 0xc3, 0xd0, 0x9a, 0xac,
-	0x87, 0x4d, 0x59, 0xa2, 0x47, 0xf1, 0x7c, 0x3d, 0x32, 0x68, 0xff, 0x95, 0x1e, 0x6d, 0xca, 0xc6,
-	0x9d, 0x5a, 0x9a, 0xd2, 0xa9, 0xbf, 0x2e, 0xc0, 0xf2, 0x21, 0xed, 0xbd, 0xe7, 0xdb, 0x57, 0x09,
-	0x31, 0x6b, 0x42, 0xfc, 0x48, 0x83, 0xea, 0xbe, 0x43, 0x59, 0x10, 0x3a, 0x16, 0x76, 0x0f, 0xfc,
-	0xc7, 0x01, 0xfa, 0x12, 0x94, 0x8f, 0x09, 0xb6, 0x49, 0xa8, 0xca, 0xff, 0x5b, 0x8d, 0x74, 0x06,
-	0x6a, 0xf0, 0x19, 0xa8, 0x21, 0x35, 0xd9, 0x17, 0x48, 0x31, 0x57, 0x49, 0x82, 0xde, 0x85, 0xf2,
-	0x13, 0xec, 0x52, 0xc2, 0xea, 0x85, 0xad, 0xe2, 0x76, 0xe5, 0xce, 0x7a, 0xbe, 0x77, 0x24, 0xbd,
-	0x26, 0x26, 0x94, 0xe8, 0x4a, 0x9d, 0x5f, 0x14, 0xa0, 0x96, 0x1b, 0x3c, 0x50, 0x13, 0x4a, 0xa2,
-	0xa2, 0x6b, 0xa2, 0xbc, 0x36, 0x66, 0x98, 0x2b, 0xda, 0xc4, 0x32, 0x05, 0x2d, 0xfa, 0x36, 0x2c,
-	0x78, 0xf8, 0x54, 0x76, 0x86, 0x82, 0xe0, 0x73, 0x77, 0x36, 0x3e, 0xc3, 0x48, 0xaf, 0xa9, 0x52,
-	0xad, 0xf8, 0x18, 0xe6, 0xbc, 0x87, 0x4f, 0x45, 0x3f, 0xe8, 0x43, 0x8d, 0x43, 0xad, 0x63, 0xec,
-	0xf7, 0x48, 0xb6, 0xfd, 0xec, 0xcf, 0x2c, 0x64, 0x2d, 0x15, 0x92, 0x61, 0x67, 0x98, 0xcb, 0x1e,
-	0x3e, 0x6d, 0x09, 0x00, 0x97, 0xb8, 0xbb, 0xf0, 0xd1, 0x53, 0x7d, 0x4e, 0x58, 0xec, 0xb7, 0x1a,
-	0x40, 0x6a, 0x31, 0xf4, 0x08, 0x56, 0x72, 0xed, 0x8b, 0x2a, 0x37, 0x5e, 0x38, 0xe0, 0x2d, 0x70,
-	0x65, 0x9f, 0x45, 0xba, 0x66, 0xd6, 0xac, 0x9c, 0x0b, 0xbe, 0x05, 0x95, 0x41, 0xdf, 0xc6, 0x8c,
-	0x74, 0xf8, 0x6c, 0xab, 0x26, 0xc6, 0x8d, 0x86, 0x9c, 0x6b, 0x1b, 0xf1, 0x5c, 0xdb, 0x78, 0x14,
-	0x0f, 0xbe, 0xcd, 0x4d, 0xce, 0x6b, 0x18, 0xe9, 0x48, 0x5e, 0x27, 0x43, 0x6c, 0x7c, 0xf8, 0x27,
-	0x5d, 0x33, 0x41, 0x42, 0x38, 0xc1, 0xe8, 0x5d, 0x2a, 0x99, 0xd9, 0x02, 0xd5, 0x61, 0xde, 0x0b,
-	0x7c, 0xe7, 0x44, 0x85, 0xe2, 0xa2, 0x19, 0x1f, 0xd1, 0x06, 0x2c, 0x38, 0x36, 0xf1, 0x99, 0xc3,
-	0xce, 0xa4, 0x3f, 0xcd, 0xe4, 0xcc, 0xa9, 0xde, 0x27, 0x5d, 0xea, 0xc4, 0x5e, 0x30, 0xe3, 0x23,
-	0xda, 0x83, 0x15, 0x4a, 0xac, 0x41, 0xe8, 0xb0, 0xb3, 0x8e, 0x15, 0xf8, 0x0c, 0x5b, 0x4c, 0x35,
-	0xed, 0x37, 0x87, 0x91, 0xfe, 0x86, 0xd4, 0x35, 0x8f, 0x61, 0x98, 0xb5, 0x18, 0xd4, 0x92, 0x10,
-	0x2e, 0xc1, 0x26, 0x0c, 0x3b, 0xae, 0x1c, 0xfa, 0x16, 0xcd, 0xf8, 0x98, 0xb9, 0xcb, 0xcf, 0xe7,
-	0x61, 0x31, 0x9d, 0xab, 0xde, 0x87, 0x95, 0xa0, 0x4f, 0xc2, 0x09, 0xf5, 0xe8, 0x7e, 0x2a, 0x39,
-	0x8f, 0x71, 0x89, 0x92, 0x50, 0x8b, 0x79, 0xc4, 0x15, 0x61, 0x8f, 0xc7, 0x83, 0x4f, 0x89, 0x4f,
-	0x07, 0xb4, 0xa3, 0xe6, 0xc6, 0x42, 0xfe, 0xca, 0x79, 0x0c, 0x83, 0x47, 0x80, 0x02, 0x3d, 0x90,
-	0xd3, 0xe5, 0x1a, 0x94, 0xbf, 0x83, 0x1d, 0x97, 0xd8, 0xc2, 0xa6, 0x0b, 0xa6, 0x3a, 0xa1, 0x03,
-	0x28, 0x53, 0x86, 0xd9, 0x40, 0x8e, 0xde, 0xd7, 0x9a, 0x9f, 0x9f, 0x52, 0xe7, 0x66, 0xe0, 0xdb,
-	0x0f, 0x05, 0xa1, 0xa9, 0x18, 0xa0, 0x3d, 0x28, 0xb3, 0xe0, 0x84, 0xf8, 0xca, 0xa8, 0x33, 0x65,
-	0xfa, 0x81, 0xcf, 0x4c, 0x45, 0x8d, 0x18, 0xa4, 0x45, 0xb9, 0x43, 0x8f, 0x71, 0x48, 0xa8, 0x1c,
-	0x95, 0x9b, 0x07, 0x33, 0xa7, 0xe3, 0x1b, 0xf9, 0x4e, 0x21, 0xf9, 0x19, 0x66, 0x2d, 0x01, 0x3d,
-	0x14, 0x90, 0xfc, 0xe4, 0x3c, 0x7f, 0xa9, 0xc9, 0x79, 0x0f, 0x56, 0x06, 0x7e, 0x37, 0xf0, 0x6d,
-	0xc7, 0xef, 0x75, 0x8e, 0x89, 0xd3, 0x3b, 0x66, 0xf5, 0x85, 0x2d, 0x6d, 0xbb, 0x98, 0xf5, 0x56,
-	0x1e, 0xc3, 0x30, 0x6b, 0x09, 0x68, 0x5f, 0x40, 0x90, 0x0d, 0xd5, 0x14, 0x4b, 0xa4, 0xec, 0xe2,
-	0x85, 0x29, 0x7b, 0x5b, 0xa5, 0xec, 0xcd, 0xbc, 0x94, 0x34, 0x6b, 0x97, 0x13, 0x20, 0x27, 0x43,
-	0x5f, 0x1d, 0x79, 0x46, 0x82, 0x92, 0x70, 0x6e, 0x95, 0x99, 0xfe, 0x05, 0x59, 0xf9, 0x54, 0x5e,
-	0x90, 0xbb, 0x4b, 0x3f, 0x78, 0xaa, 0xcf, 0x25, 0x09, 0xfb, 0xc3, 0x02, 0x94, 0xdb, 0x47, 0x0f,
-	0xb0, 0x13, 0xbe, 0xae, 0xe3, 0x43, 0xa6, 0x7a, 0x7d, 0x05, 0xe6, 0xa5, 0x2d, 0x28, 0xba, 0x03,
-	0xd7, 0xfa, 0xfc, 0x47, 0x5d, 0x13, 0x0d, 0x7d, 0x6d, 0x2c, 0xa4, 0x05, 0x5e, 0xfc, 0xc2, 0x14,
-	0xa8, 0xc6, 0xcf, 0x8a, 0x00, 0xed, 0xa3, 0xa3, 0x47, 0xa1, 0xd3, 0x77, 0x09, 0xbb, 0x1a, 0xaf,
-	0x5f, 0x9d, 0xf1, 0x3a, 0xe3, 0xe3, 0xaf, 0x41, 0x25, 0xf5, 0x11, 0x45, 0x5f, 0x86, 0x05, 0xa6,
-	0x7e, 0x2b, 0x57, 0x6f, 0x8c, 0xbb, 0x3a, 0x46, 0x57, 0xee, 0x4e, 0x28, 0x8c, 0xdf, 0x17, 0x00,
-	0x2e, 0x5a, 0xce, 0xbc, 0x06, 0x03, 0xf8, 0x1e, 0x94, 0x55, 0xc7, 0x29, 0x5e, 0x6a, 0x5a, 0x55,
-	0xd4, 0x19, 0x2f, 0xfd, 0xa5, 0x00, 0xd7, 0xdf, 0x8b, 0xcb, 0xee, 0x95, 0x85, 0xd1, 0x3e, 0xcc,
-	0x13, 0x9f, 0x85, 0x8e, 0x30, 0x31, 0x8f, 0xd2, 0xed, 0x7c, 0x94, 0x4e, 0xb0, 0xd6, 0x3d, 0x9f,
-	0x85, 0x67, 0x2a, 0x66, 0x63, 0xf2, 0x8c, 0x8d, 0x7f, 0x5a, 0x84, 0xfa, 0x79, 0x54, 0xa8, 0x05,
-	0x35, 0x2b, 0x24, 0x02, 0x10, 0xb7, 0x64, 0x4d, 0xb4, 0xe4, 0x8d, 0xcc, 0xc6, 0x68, 0x14, 0xc1,
-	0x30, 0xab, 0x31, 0x44, 0x35, 0xe4, 0x9e, 0x58, 0x50, 0xf1, 0x54, 0xe1, 0x58, 0x53, 0x0e, 0xd1,
-	0x86, 0xea, 0xc8, 0xe9, 0x5a, 0x2a, 0xcb, 0x40, 0xb6, 0xe4, 0x6a, 0x0a, 0x15, 0x3d, 0xf9, 0xbb,
-	0x50, 0x73, 0x7c, 0x87, 0x39, 0xd8, 0xed, 0x74, 0xb1, 0x8b, 0x7d, 0xeb, 0x32, 0x4f, 0x11, 0xd9,
-	0x4d, 0x95, 0xd8, 0x1c, 0x3b, 0xc3, 0xac, 0x2a, 0x48, 0x53, 0x02, 0xb8, 0x47, 0x62, 0x51, 0xa5,
-	0x4b, 0x0d, 0x6e, 0x31, 0x79, 0xc6, 0x23, 0x3f, 0x2e, 0xc2, 0x6a, 0xb2, 0x9f, 0xb9, 0x72, 0xc5,
-	0xb4, 0xae, 0x38, 0x04, 0x90, 0x05, 0x84, 0x77, 0x8e, 0x4b, 0x78, 0x83, 0x97, 0xa0, 0x45, 0xc9,
-	0xa1, 0x4d, 0x59, 0xc6, 0x1f, 0x7f, 0x2d, 0xc2, 0x52, 0xd6, 0x1f, 0x57, 0x2d, 0xfd, 0x15, 0xda,
-	0x98, 0xdd, 0x4d, 0x4b, 0x62, 0x49, 0x94, 0xc4, 0xdb, 0xf9, 0x92, 0x38, 0x96, 0x4a, 0xe7, 0xd7,
-	0xc2, 0x7f, 0x16, 0xa0, 0xfc, 0x00, 0x87, 0xd8, 0xa3, 0xc8, 0x1a, 0x7b, 0x45, 0xc8, 0x4d, 0xc2,
-	0xfa, 0x58, 0xa2, 0xb4, 0xd5, 0x1f, 0xb4, 0x2e, 0x78, 0x44, 0x7c, 0x34, 0xf1, 0x11, 0x51, 0xf5,
-	0xf0, 0x69, 0x27, 0xb9, 0x97, 0x74, 0xe2, 0x72, 0x73, 0x3d, 0xe5, 0x32, 0xfa, 0x5d, 0xee, 0x42,
-	0x92, 0xa7, 0x35, 0x45, 0xef, 0x42, 0x85, 0x63, 0xa4, 0x5d, 0x81, 0x93, 0xaf, 0xa5, 0xcb, 0x87,
-	0xcc, 0x47, 0xc3, 0x04, 0x0f, 0x9f, 0xde, 0x93, 0x07, 0x74, 0x1f, 0xd0, 0x71, 0xb2, 0xfa, 0xea,
-	0xa4, 0x26, 0xe4, 0xf4, 0x6f, 0x0d, 0x23, 0x7d, 0x5d, 0xd2, 0x8f, 0xe3, 0x18, 0xe6, 0x6a, 0x0a,
-	0x8c, 0xb9, 0x7d, 0x11, 0x80, 0xdf, 0xab, 0x63, 0x13, 0x3f, 0xf0, 0xd4, 0x13, 0xf6, 0xe6, 0x30,
-	0xd2, 0x57, 0x25, 0x97, 0xf4, 0x9b, 0x61, 0x2e, 0xf2, 0x43, 0x9b, 0xff, 0xce, 0x18, 0xfe, 0x27,
-	0x1a, 0xa0, 0xb4, 0xf7, 0x98, 0x84, 0xf6, 0xf9, 0x13, 0x9c, 0x3f, 0xb2, 0x32, 0x2f, 0x23, 0x6d,
-	0xf2, 0x23, 0x2b, 0xa5, 0x8b, 0x1f, 0x59, 0x99, 0x54, 0x7d, 0x3b, 0xad, 0xcf, 0x85, 0x73, 0xb7,
-	0x82, 0x13, 0x6a, 0xf0, 0x6f, 0x34, 0x58, 0x1f, 0x0b, 0x9c, 0x44, 0xaf, 0x23, 0x40, 0x61, 0xe6,
-	0xa3, 0x30, 0xcd, 0x99, 0xd2, 0x6f, 0xea, 0xf8, 0x5b, 0x0d, 0xc7, 0x6a, 0xfc, 0xcb, 0xeb, 0x26,
-	0x6a, 0xa3, 0xa8, 0xc1, 0x8d, 0xac, 0xf8, 0xe4, 0x02, 0x7b, 0xb0, 0x94, 0x95, 0xae, 0x54, 0xbf,
-	0xf5, 0x49, 0xaa, 0x2b, 0xad, 0x47, 0xe8, 0xd0, 0x41, 0x9a, 0x7d, 0x72, 0xe5, 0xf9, 0xd9, 0x0b,
-	0x6f, 0x1f, 0xeb, 0x90, 0xcf, 0x42, 0xa9, 0xf1, 0xbf, 0x35, 0x28, 0x3d, 0x08, 0x02, 0x17, 0x05,
-	0xb0, 0xea, 0x07, 0xac, 0xc3, 0x83, 0x85, 0xd8, 0x1d, 0xb5, 0x1b, 0x91, 0x5b, 0xd0, 0xd6, 0x6c,
-	0x46, 0xf9, 0x7b, 0xa4, 0x8f, 0xb3, 0x32, 0x6b, 0x7e, 0xc0, 0x9a, 0x02, 0xf2, 0x48, 0x6e, 0x4e,
-	0x3e, 0x80, 0xe5, 0x51, 0x61, 0x72, 0x53, 0xf4, 0x8d, 0x99, 0x85, 0x8d, 0xb2, 0x19, 0x46, 0xfa,
-	0x8d, 0x34, 0x09, 0x12, 0xb0, 0x61, 0x2e, 0x75, 0x33, 0xd2, 0x77, 0x17, 0xf8, 0xed, 0xff, 0xf1,
-	0x54, 0xd7, 0x9a, 0x7b, 0x1f, 0x3f, 0xdf, 0xd4, 0x9e, 0x3d, 0xdf, 0xd4, 0xfe, 0xfc, 0x7c, 0x53,
-	0xfb, 0xf0, 0xc5, 0xe6, 0xdc, 0xb3, 0x17, 0x9b, 0x73, 0x7f, 0x78, 0xb1, 0x39, 0xf7, 0xcd, 0xb7,
-	0x3f, 0x51, 0x85, 0xd3, 0xe4, 0x4f, 0xfd, 0x42, 0x99, 0x6e, 0x59, 0x14, 0xa8, 0x2f, 0xfc, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0xb2, 0x69, 0x31, 0x7c, 0x09, 0x20, 0x00, 0x00,
+	// 2081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4d, 0x6c, 0x1b, 0x59,
+	0x39, 0x63, 0xbb, 0x4e, 0xf2, 0xe5, 0xc7, 0xc9, 0x4b, 0x9b, 0x75, 0xb2, 0xdd, 0x4c, 0x3b, 0x48,
+	0x28, 0xc0, 0xae, 0x23, 0x0a, 0xd2, 0x4a, 0x01, 0x24, 0x6a, 0xbb, 0x51, 0xa2, 0x6d, 0x44, 0x99,
+	0xb6, 0x41, 0x02, 0x24, 0xeb, 0x65, 0xe6, 0x75, 0x32, 0xc4, 0x33, 0x63, 0xe6, 0x3d, 0xb7, 0xc9,
+	0x6a, 0xaf, 0x48, 0x08, 0xf1, 0xb3, 0xc7, 0x3d, 0xa1, 0x8a, 0x23, 0x07, 0x38, 0x70, 0x00, 0xce,
+	0x08, 0xa9, 0xdc, 0x2a, 0x0e, 0x80, 0x38, 0xcc, 0x42, 0x7b, 0x00, 0x71, 0x01, 0xf9, 0xc8, 0x05,
+	0xf4, 0x7e, 0xe6, 0xc7, 0x63, 0xa7, 0xb1, 0x43, 0xe9, 0x46, 0xda, 0x5c, 0x5a, 0xcf, 0x37, 0xdf,
+	0xdf, 0xfb, 0xbe, 0xf7, 0xfd, 0x4e, 0xe0, 0xaa, 0x15, 0x50, 0x2f, 0xa0, 0x1b, 0x94, 0xe1, 0x43,
+	0xd7, 0x77, 0xe2, 0xff, 0x6b, 0x9d, 0x30, 0x60, 0x01, 0x9a, 0x97, 0x6f, 0x6b, 0x0a, 0xba, 0x7a,
+	0xd9, 0x09, 0x9c, 0x40, 0xbc, 0xda, 0xe0, 0xbf, 0x24, 0xd6, 0xea, 0x75, 0x46, 0x7c, 0x9b, 0x84,
+	0x9e, 0xeb, 0xb3, 0x0d, 0xbc, 0x6f, 0xb9, 0x1b, 0xec, 0xb8, 0x43, 0xa8, 0xfc, 0x57, 0xa1, 0xe8,
+	0x4e, 0x10, 0x38, 0x6d, 0xb2, 0x21, 0x9e, 0xf6, 0xbb, 0x0f, 0x36, 0x98, 0xeb, 0x11, 0xca, 0xb0,
+	0xd7, 0x51, 0x08, 0x6b, 0x79, 0x04, 0xbb, 0x1b, 0x62, 0xe6, 0x06, 0xbe, 0x7a, 0xbf, 0xa4, 0xf4,
+	0x54, 0x0a, 0x09, 0xa0, 0x11, 0x95, 0x00, 0xed, 0x52, 0xa7, 0x11, 0x12, 0xcc, 0xc8, 0x1e, 0x6e,
+	0xbb, 0x36, 0x66, 0x41, 0x88, 0x1a, 0x30, 0x63, 0x13, 0x6a, 0x85, 0x6e, 0x87, 0x33, 0xa8, 0x6a,
+	0xd7, 0xb4, 0xf5, 0x99, 0x1b, 0xaf, 0xd7, 0xfa, 0xcf, 0x52, 0x6b, 0xa6, 0x28, 0xf5, 0xd2, 0x93,
+	0x48, 0x9f, 0x30, 0xb3, 0x54, 0xe8, 0x16, 0x80, 0x15, 0x78, 0x9e, 0x4b, 0x29, 0xe7, 0x51, 0x10,
+	0x3c, 0xf4, 0x3c, 0x8f, 0x46, 0x82, 0x61, 0x62, 0x46, 0xa8, 0xe2, 0x93, 0x21, 0x44, 0xef, 0xc1,
+	0x92, 0xe7, 0xfa, 0x2d, 0x4a, 0xda, 0x0f, 0x5a, 0x36, 0x69, 0x13, 0x47, 0x1c, 0xaa, 0x5a, 0xbc,
+	0xa6, 0xad, 0x4f, 0xd7, 0x6f, 0x73, 0xf4, 0x3f, 0x47, 0xfa, 0x27, 0x1d, 0x97, 0x1d, 0x74, 0xf7,
+	0x6b, 0x56, 0xe0, 0x6d, 0xf4, 0x9d, 0xf3, 0x2d, 0x6a, 0x1f, 0x2a, 0x3b, 0xee, 0xf8, 0xac, 0x17,
+	0xe9, 0xab, 0xc7, 0xd8, 0x6b, 0x6f, 0x1a, 0x43, 0x58, 0x1a, 0xe6, 0xa2, 0xe7, 0xfa, 0x77, 0x49,
+	0xfb, 0x41, 0x33, 0x81, 0xa1, 0x77, 0x61, 0x51, 0x61, 0x04, 0x61, 0x0b, 0xdb, 0x76, 0x48, 0x28,
+	0xad, 0x96, 0xae, 0x69, 0xeb, 0xb3, 0xf5, 0xdd, 0x5e, 0xa4, 0x57, 0x25, 0xb7, 0x01, 0x14, 0xe3,
+	0xdf, 0x91, 0xfe, 0xd6, 0x08, 0x3a, 0xdd, 0xb4, 0xac, 0x9b, 0x92, 0xc2, 0x5c, 0x48, 0x98, 0x28,
+	0x08, 0x97, 0xfd, 0x30, 0x76, 0x49, 0x22, 0xfb, 0x52, 0x5e, 0xf6, 0x00, 0xca, 0xa8, 0xb2, 0xf7,
+	0x70, 0x3b, 0x91, 0x9d, 0x30, 0x89, 0x65, 0x2f, 0x43, 0xb9, 0xd3, 0xdd, 0x3f, 0x24, 0xc7, 0xd5,
+	0x32, 0x37, 0xb4, 0xa9, 0x9e, 0xd0, 0x3a, 0x5c, 0x7a, 0x88, 0xdb, 0x5d, 0x52, 0x9d, 0x14, 0xfe,
+	0x9c, 0x8d, 0xfd, 0xd9, 0x08, 0xdc, 0xf8, 0x12, 0x48, 0x84, 0xcd, 0xd2, 0xdf, 0x1f, 0xeb, 0x9a,
+	0xf1, 0xab, 0x22, 0x2c, 0xec, 0x52, 0xe7, 0x96, 0xed, 0xb2, 0x97, 0x7c, 0xbd, 0x3a, 0xc3, 0xac,
+	0x53, 0x10, 0xd6, 0x69, 0xf4, 0x22, 0x7d, 0x5e, 0x5a, 0xe7, 0x65, 0xda, 0xc4, 0x83, 0x4a, 0x7a,
+	0x2f, 0x5b, 0x21, 0x66, 0x44, 0xdd, 0xc2, 0xe6, 0x88, 0x37, 0xb0, 0x49, 0xac, 0x5e, 0xa4, 0x2f,
+	0x4b, 0xcd, 0x72, 0xac, 0x0c, 0x73, 0xde, 0xea, 0x8b, 0x05, 0x74, 0x34, 0xfc, 0xe2, 0x97, 0x84,
+	0xc8, 0xed, 0xff, 0xe3, 0xa5, 0x57, 0xae, 0xfb, 0x65, 0x01, 0x66, 0x76, 0xa9, 0xa3, 0xe0, 0x64,
+	0x78, 0x28, 0x68, 0x1f, 0x61, 0x28, 0x14, 0x5e, 0x4d, 0x28, 0x7c, 0x1a, 0xca, 0xd8, 0x0b, 0xba,
+	0x3e, 0xab, 0x16, 0x4f, 0xbc, 0xf3, 0x0a, 0x43, 0x59, 0xee, 0xf7, 0x45, 0x91, 0x55, 0xeb, 0xc4,
+	0x71, 0x7d, 0x93, 0xd8, 0xe7, 0xc1, 0x80, 0xdf, 0xd1, 0xe0, 0x4a, 0x6a, 0x1e, 0x1a, 0x5a, 0x39,
+	0x2b, 0x7e, 0xb5, 0x17, 0xe9, 0x57, 0xf3, 0x56, 0xcc, 0xa0, 0x9d, 0xc1, 0x92, 0x4b, 0x09, 0xa3,
+	0xbb, 0xa1, 0x35, 0x5c, 0x0f, 0x9b, 0xb2, 0x44, 0x8f, 0xe2, 0xc9, 0x7a, 0x64, 0xd0, 0xfe, 0x27,
+	0x3d, 0x9a, 0x94, 0x0d, 0x3a, 0xb5, 0x34, 0xa2, 0x53, 0x7f, 0x5d, 0x80, 0xb9, 0x5d, 0xea, 0xdc,
+	0xf7, 0xed, 0x8b, 0x80, 0x18, 0x37, 0x20, 0x7e, 0x5b, 0x84, 0xc5, 0x5d, 0xea, 0xdc, 0x0b, 0x0e,
+	0x89, 0xef, 0xbe, 0x4b, 0xee, 0x1e, 0xe0, 0x90, 0xd0, 0x0b, 0xfb, 0x9d, 0x6e, 0x3f, 0x11, 0x2f,
+	0x4c, 0x99, 0xcd, 0x6e, 0x51, 0x6e, 0xb8, 0x56, 0xf0, 0xc8, 0x27, 0x61, 0xb5, 0x94, 0x8f, 0x97,
+	0xa1, 0x68, 0x67, 0x30, 0xd6, 0x52, 0xc2, 0x48, 0xf8, 0xe9, 0x2b, 0x9c, 0x8d, 0xf2, 0xe3, 0x13,
+	0x0d, 0xaa, 0xbb, 0xd4, 0xe1, 0x39, 0x8d, 0x78, 0xc2, 0x9b, 0x74, 0x2b, 0x08, 0xcf, 0x81, 0x3b,
+	0x53, 0x93, 0x16, 0x46, 0xbc, 0x92, 0xdf, 0xd7, 0x60, 0x7e, 0xdb, 0xa5, 0x2c, 0x08, 0x5d, 0x0b,
+	0xb7, 0x77, 0xfc, 0x07, 0x01, 0xfa, 0x02, 0x94, 0x0f, 0x08, 0xb6, 0x49, 0xa8, 0x3a, 0x92, 0x37,
+	0x6a, 0x69, 0x5b, 0x5e, 0xe3, 0x6d, 0x79, 0x4d, 0x6a, 0xb2, 0x2d, 0x90, 0x62, 0xae, 0x92, 0x04,
+	0xbd, 0x0d, 0xe5, 0x87, 0xb8, 0x4d, 0x09, 0xd7, 0xa0, 0xb8, 0x3e, 0x73, 0x63, 0x25, 0xdf, 0xce,
+	0x24, 0xed, 0x4f, 0x4c, 0x28, 0xd1, 0x95, 0x3a, 0x3f, 0x2f, 0x40, 0x25, 0xd7, 0x0b, 0xa3, 0x3a,
+	0x94, 0x44, 0x93, 0xa1, 0x89, 0x8a, 0x5f, 0x1b, 0xa3, 0xd5, 0x6d, 0x12, 0xcb, 0x14, 0xb4, 0xe8,
+	0x9b, 0x30, 0xe5, 0xe1, 0x23, 0xd9, 0xac, 0x14, 0x04, 0x9f, 0x9b, 0xe3, 0xf1, 0xe9, 0x45, 0x7a,
+	0x45, 0x75, 0x0f, 0x8a, 0x8f, 0x61, 0x4e, 0x7a, 0xf8, 0x48, 0xb4, 0x28, 0x1d, 0xa8, 0x70, 0xa8,
+	0x75, 0x80, 0x7d, 0x87, 0x64, 0x3b, 0xa2, 0xed, 0xb1, 0x85, 0x2c, 0xa7, 0x42, 0x32, 0xec, 0x0c,
+	0x73, 0xce, 0xc3, 0x47, 0x0d, 0x01, 0xe0, 0x12, 0x37, 0xa7, 0x3e, 0x78, 0xac, 0x4f, 0x08, 0x8b,
+	0xfd, 0x4e, 0x03, 0x48, 0x2d, 0x86, 0xee, 0xc1, 0x42, 0xae, 0xa3, 0xa2, 0x55, 0x6d, 0xb4, 0x99,
+	0x63, 0x8a, 0x2b, 0xfb, 0x34, 0xd2, 0x35, 0xb3, 0x62, 0xe5, 0x5c, 0xf0, 0x0d, 0x98, 0xe9, 0x76,
+	0x6c, 0xcc, 0x48, 0x8b, 0x8f, 0x5b, 0xea, 0x72, 0xad, 0xd6, 0xe4, 0xa8, 0x55, 0x8b, 0x47, 0xad,
+	0xda, 0xbd, 0x78, 0x16, 0xab, 0xaf, 0x71, 0x5e, 0xbd, 0x48, 0x47, 0xf2, 0x38, 0x19, 0x62, 0xe3,
+	0xfd, 0x0f, 0x75, 0xcd, 0x04, 0x09, 0xe1, 0x04, 0xfd, 0x67, 0x99, 0xc9, 0xb4, 0xbb, 0xa8, 0x0a,
+	0x93, 0x5e, 0xe0, 0xbb, 0x87, 0xea, 0x2a, 0x4e, 0x9b, 0xf1, 0x23, 0x5a, 0x85, 0x29, 0xd7, 0x26,
+	0x3e, 0x73, 0xd9, 0xb1, 0xf4, 0xa7, 0x99, 0x3c, 0x73, 0xaa, 0x47, 0x64, 0x9f, 0xba, 0xb1, 0x17,
+	0xcc, 0xf8, 0x11, 0x6d, 0xc1, 0x02, 0x25, 0x56, 0x37, 0x74, 0xd9, 0x71, 0xcb, 0x0a, 0x7c, 0x86,
+	0x2d, 0xa6, 0xfa, 0xc8, 0xd7, 0x7b, 0x91, 0xfe, 0x9a, 0xd4, 0x35, 0x8f, 0x61, 0x98, 0x95, 0x18,
+	0xd4, 0x90, 0x10, 0x2e, 0xc1, 0x26, 0x0c, 0xbb, 0x6d, 0x39, 0x87, 0x4c, 0x9b, 0xf1, 0x63, 0xe6,
+	0x2c, 0x3f, 0x9b, 0x84, 0xe9, 0xb4, 0xd5, 0x7f, 0x04, 0x0b, 0x41, 0x87, 0x84, 0x43, 0x72, 0xc2,
+	0xed, 0x54, 0x72, 0x1e, 0xe3, 0x0c, 0x59, 0xb6, 0x12, 0xf3, 0x88, 0x33, 0xc2, 0x16, 0xbf, 0x0f,
+	0x3e, 0x25, 0x3e, 0xed, 0xd2, 0x96, 0x1a, 0x65, 0x0a, 0xf9, 0x23, 0xe7, 0x31, 0x0c, 0xb3, 0x92,
+	0x80, 0xee, 0x08, 0x08, 0x1f, 0x84, 0xbe, 0x85, 0xdd, 0x36, 0xb1, 0x85, 0x4d, 0xa7, 0x4c, 0xf5,
+	0x84, 0x76, 0xa0, 0x4c, 0x19, 0x66, 0x5d, 0x39, 0x0d, 0x5e, 0xaa, 0x7f, 0x76, 0x44, 0x9d, 0xeb,
+	0x81, 0x6f, 0xdf, 0x15, 0x84, 0xa6, 0x62, 0x80, 0xb6, 0xa0, 0x2c, 0x52, 0xae, 0x32, 0xea, 0x58,
+	0x91, 0xbe, 0xe3, 0x33, 0x53, 0x51, 0x23, 0x06, 0x69, 0x62, 0x94, 0x35, 0x80, 0xca, 0xe9, 0xad,
+	0xbe, 0x33, 0x76, 0x38, 0xbe, 0x96, 0xcf, 0xd6, 0x92, 0x9f, 0x61, 0x56, 0x12, 0x90, 0x4a, 0xfb,
+	0xb9, 0x61, 0x6e, 0xf2, 0x4c, 0xc3, 0xdc, 0x16, 0x2c, 0x74, 0xfd, 0xfd, 0xc0, 0xb7, 0x5d, 0xdf,
+	0x69, 0x1d, 0x10, 0xd7, 0x39, 0x60, 0xd5, 0xa9, 0x6b, 0xda, 0x7a, 0x31, 0xeb, 0xad, 0x3c, 0x86,
+	0x61, 0x56, 0x12, 0xd0, 0xb6, 0x80, 0x20, 0x1b, 0xe6, 0x53, 0x2c, 0x11, 0xb2, 0xd3, 0xa7, 0x86,
+	0xec, 0x75, 0x15, 0xb2, 0x57, 0xf2, 0x52, 0xd2, 0xa8, 0x9d, 0x4b, 0x80, 0x9c, 0x0c, 0x7d, 0xb9,
+	0x6f, 0xb3, 0x01, 0x4a, 0xc2, 0x89, 0x59, 0x66, 0xf4, 0xa5, 0xc6, 0xcc, 0x2b, 0x59, 0x6a, 0x6c,
+	0xce, 0x7e, 0xf7, 0xb1, 0x3e, 0x91, 0x04, 0xec, 0xf7, 0x0a, 0x50, 0x6e, 0xee, 0xdd, 0xc1, 0x6e,
+	0xf8, 0x71, 0xed, 0xc8, 0x32, 0xd9, 0xeb, 0x4b, 0x30, 0x29, 0x6d, 0x41, 0xd1, 0x0d, 0xb8, 0xd4,
+	0xe1, 0x3f, 0xaa, 0x9a, 0x28, 0xe8, 0xcb, 0x03, 0x57, 0x5a, 0xe0, 0xc5, 0x4b, 0x0f, 0x81, 0x6a,
+	0xfc, 0xa4, 0x08, 0xd0, 0xdc, 0xdb, 0xbb, 0x17, 0xba, 0x9d, 0x36, 0x61, 0x17, 0x13, 0xdf, 0xf9,
+	0x99, 0xf8, 0x32, 0x3e, 0x7e, 0x07, 0x66, 0x52, 0x1f, 0x51, 0xf4, 0x45, 0x98, 0x62, 0xea, 0xb7,
+	0x72, 0xf5, 0xea, 0xa0, 0xab, 0x63, 0x74, 0xe5, 0xee, 0x84, 0xc2, 0xf8, 0x43, 0x01, 0xe0, 0xb4,
+	0x7d, 0xe1, 0xc7, 0x60, 0xa6, 0xd9, 0x82, 0xb2, 0xaa, 0x38, 0xc5, 0x33, 0x75, 0xab, 0x8a, 0x3a,
+	0xe3, 0xa5, 0xbf, 0x16, 0x60, 0xe9, 0x7e, 0x9c, 0x76, 0x2f, 0x2c, 0x8c, 0xb6, 0x61, 0x92, 0xf8,
+	0x2c, 0x74, 0x85, 0x89, 0xf9, 0x2d, 0x5d, 0xcf, 0xdf, 0xd2, 0x21, 0xd6, 0xba, 0xe5, 0xb3, 0xf0,
+	0x58, 0xdd, 0xd9, 0x98, 0x3c, 0x63, 0xe3, 0x1f, 0x15, 0xa1, 0x7a, 0x12, 0x15, 0x6a, 0x40, 0xc5,
+	0x0a, 0x89, 0x00, 0xc4, 0x25, 0x59, 0x13, 0x25, 0x79, 0x35, 0xb3, 0xc4, 0xec, 0x47, 0xe0, 0x4b,
+	0x4c, 0x05, 0x51, 0x05, 0xd9, 0x11, 0x3b, 0x53, 0x1e, 0x2a, 0x1c, 0x6b, 0xc4, 0x26, 0xda, 0x50,
+	0x15, 0x39, 0xdd, 0x94, 0x66, 0x19, 0xc8, 0x92, 0x3c, 0x9f, 0x42, 0x45, 0x4d, 0xfe, 0x36, 0x54,
+	0x5c, 0xdf, 0x65, 0x2e, 0x6e, 0xb7, 0xf6, 0x71, 0x1b, 0xfb, 0xd6, 0x59, 0x46, 0x11, 0x59, 0x4d,
+	0x95, 0xd8, 0x1c, 0x3b, 0xc3, 0x9c, 0x57, 0x90, 0xba, 0x04, 0x70, 0x8f, 0xc4, 0xa2, 0x4a, 0x67,
+	0x6a, 0xdc, 0x62, 0xf2, 0x8c, 0x47, 0x7e, 0x50, 0x84, 0xc5, 0x64, 0x65, 0x78, 0xe1, 0x8a, 0x51,
+	0x5d, 0xb1, 0x0b, 0x20, 0x13, 0x08, 0xaf, 0x1c, 0xd5, 0xd2, 0x99, 0x52, 0xd0, 0xb4, 0xe4, 0xd0,
+	0xa4, 0x2c, 0xe3, 0x8f, 0xbf, 0x15, 0x61, 0x36, 0xeb, 0x8f, 0x8b, 0x92, 0x7e, 0x8e, 0x96, 0xb8,
+	0x37, 0xd3, 0x94, 0x58, 0x12, 0x29, 0xf1, 0x7a, 0x3e, 0x25, 0x0e, 0x84, 0xd2, 0xc9, 0xb9, 0xf0,
+	0x9f, 0x25, 0x28, 0xdf, 0xc1, 0x21, 0xf6, 0x28, 0xb2, 0x06, 0xa6, 0x08, 0xb9, 0x49, 0x58, 0x19,
+	0x08, 0x94, 0xa6, 0xfa, 0xc6, 0x7a, 0xca, 0x10, 0xf1, 0xc1, 0xd0, 0x21, 0x62, 0x9e, 0x2f, 0x3b,
+	0x92, 0x73, 0x49, 0x27, 0xce, 0xd5, 0x57, 0x52, 0x2e, 0xfd, 0xef, 0xe5, 0x2e, 0x24, 0x19, 0xad,
+	0x29, 0x7a, 0x1b, 0x66, 0x38, 0x46, 0x5a, 0x15, 0x38, 0xf9, 0x72, 0xba, 0x7c, 0xc8, 0xbc, 0x34,
+	0x4c, 0xf0, 0xf0, 0xd1, 0x2d, 0xf9, 0x80, 0x6e, 0x03, 0x3a, 0x48, 0x56, 0x5f, 0xad, 0xd4, 0x84,
+	0x9c, 0xfe, 0x8d, 0x5e, 0xa4, 0xaf, 0x48, 0xfa, 0x41, 0x1c, 0xc3, 0x5c, 0x4c, 0x81, 0x31, 0xb7,
+	0xcf, 0x03, 0xf0, 0x73, 0xb5, 0x6c, 0xe2, 0x07, 0x9e, 0x1a, 0x61, 0xaf, 0xf4, 0x22, 0x7d, 0x51,
+	0x72, 0x49, 0xdf, 0x19, 0xe6, 0x34, 0x7f, 0x68, 0xf2, 0xdf, 0xe8, 0x18, 0x50, 0xbc, 0x67, 0x54,
+	0xfb, 0x4a, 0x0b, 0x77, 0xd4, 0xb8, 0xfa, 0xce, 0xd8, 0xe3, 0xea, 0x4a, 0xff, 0x0a, 0x34, 0xe5,
+	0x68, 0x98, 0x0b, 0x2c, 0xbb, 0x75, 0x6e, 0xe0, 0x4e, 0x3c, 0x7c, 0xe5, 0xbf, 0xe5, 0x4d, 0x8e,
+	0x3d, 0x7c, 0x49, 0xd9, 0x99, 0xe1, 0x6b, 0xe0, 0x9b, 0x1e, 0x1f, 0xbe, 0xfa, 0xd7, 0x4d, 0x99,
+	0x1b, 0xf7, 0x43, 0x0d, 0x50, 0x5a, 0x74, 0x4d, 0x42, 0x3b, 0x81, 0x4f, 0xc5, 0x74, 0x99, 0x19,
+	0x09, 0xb5, 0xe1, 0xd3, 0x65, 0x4a, 0x17, 0x4f, 0x97, 0x99, 0x1c, 0xf5, 0x66, 0x5a, 0x98, 0x4e,
+	0x5e, 0x87, 0x0e, 0x29, 0x3e, 0xbf, 0xd1, 0x60, 0x65, 0x20, 0x62, 0x12, 0xbd, 0xf6, 0x00, 0x85,
+	0x99, 0x97, 0xe2, 0x4e, 0x1c, 0x2b, 0xfd, 0x46, 0x0e, 0xbc, 0xc5, 0x70, 0xa0, 0xb8, 0xbd, 0xbc,
+	0x32, 0xaa, 0x56, 0xa9, 0x1a, 0x5c, 0xce, 0x8a, 0x4f, 0x0e, 0xb0, 0x05, 0xb3, 0x59, 0xe9, 0x4a,
+	0xf5, 0xab, 0x2f, 0x52, 0x5d, 0x69, 0xdd, 0x47, 0x87, 0x76, 0xd2, 0xb4, 0x23, 0x77, 0xbd, 0x9f,
+	0x3a, 0xf5, 0xf4, 0xb1, 0x0e, 0xf9, 0xf4, 0x23, 0x35, 0xfe, 0x8f, 0x06, 0xa5, 0x3b, 0x41, 0xd0,
+	0x46, 0x01, 0x2c, 0xfa, 0x01, 0x6b, 0xf1, 0x28, 0x21, 0x76, 0x4b, 0x2d, 0x85, 0xe4, 0xfa, 0xb7,
+	0x31, 0x9e, 0x51, 0xfe, 0x11, 0xe9, 0x83, 0xac, 0xcc, 0x8a, 0x1f, 0xb0, 0xba, 0x80, 0xc8, 0xe5,
+	0x3d, 0x7a, 0x0f, 0xe6, 0xfa, 0x85, 0xc9, 0x15, 0xd9, 0xd7, 0xc6, 0x16, 0xd6, 0xcf, 0xa6, 0x17,
+	0xe9, 0x97, 0xd3, 0xe8, 0x4f, 0xc0, 0x86, 0x39, 0xbb, 0x9f, 0x91, 0xbe, 0x39, 0xc5, 0x4f, 0xff,
+	0x2f, 0x6e, 0x81, 0x5f, 0x14, 0xe0, 0x72, 0xdf, 0xd7, 0x21, 0x93, 0x3c, 0xc2, 0xa1, 0x7d, 0x42,
+	0xc7, 0xad, 0xbd, 0x9a, 0x8e, 0xfb, 0xc7, 0x1a, 0xbc, 0x66, 0x75, 0xbd, 0x6e, 0x1b, 0x33, 0xf7,
+	0x21, 0x69, 0x85, 0x42, 0xa5, 0x96, 0x48, 0xf8, 0xca, 0xf1, 0x95, 0xd8, 0xf1, 0x4d, 0x62, 0x89,
+	0xd0, 0xba, 0xaf, 0xca, 0xc0, 0x9a, 0x6a, 0x97, 0x86, 0x53, 0x1b, 0x3f, 0xfd, 0x50, 0xff, 0xcc,
+	0x68, 0xf9, 0x85, 0x73, 0xa5, 0xe6, 0x95, 0x94, 0x91, 0x34, 0x8c, 0x29, 0xd8, 0xfc, 0xb1, 0x00,
+	0x4b, 0x39, 0xab, 0x59, 0x41, 0x68, 0xa3, 0x4f, 0x40, 0xc1, 0xb5, 0x85, 0x95, 0x4a, 0xf5, 0xa5,
+	0x67, 0x91, 0x5e, 0xd8, 0x69, 0xf6, 0x22, 0x7d, 0x5a, 0xf5, 0x52, 0xb6, 0x61, 0x16, 0x5c, 0xfb,
+	0x23, 0x9d, 0x65, 0x5e, 0x64, 0xd9, 0xe2, 0x39, 0xb0, 0x6c, 0x7d, 0xeb, 0xc9, 0xb3, 0x35, 0xed,
+	0xe9, 0xb3, 0x35, 0xed, 0x2f, 0xcf, 0xd6, 0xb4, 0xf7, 0x9f, 0xaf, 0x4d, 0x3c, 0x7d, 0xbe, 0x36,
+	0xf1, 0xa7, 0xe7, 0x6b, 0x13, 0x5f, 0x7f, 0xf3, 0x85, 0xdc, 0x8f, 0x92, 0x3f, 0x03, 0x13, 0x72,
+	0xf6, 0xcb, 0xa2, 0x53, 0xf8, 0xdc, 0x7f, 0x07, 0x00, 0x60, 0x32, 0x2f, 0xbf, 0x25, 0x26, 0x00,
+	0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1569,599 +1839,663 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9459 bytes of a gzipped FileDescriptorSet
+		// 10491 bytes of a gzipped FileDescriptorSet
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0xc7,
-		0x75, 0x18, 0xf6, 0x03, 0x8b, 0xdd, 0xb7, 0x8b, 0xc5, 0xa2, 0x81, 0xbb, 0xdb, 0x5b, 0x92, 0xb7,
-		0xc7, 0x39, 0x7e, 0xe0, 0xf8, 0x81, 0xa3, 0x4e, 0xfc, 0xdc, 0xa3, 0x48, 0x61, 0x01, 0xdc, 0x1d,
-		0x48, 0xe0, 0x0e, 0x1c, 0x00, 0x47, 0x8a, 0xb2, 0x3d, 0x1e, 0xcc, 0x36, 0x16, 0x43, 0xec, 0xce,
-		0x2c, 0x67, 0x66, 0xef, 0x00, 0x96, 0x52, 0xc5, 0x94, 0x64, 0x4b, 0x72, 0x64, 0x4b, 0x71, 0x1c,
-		0x87, 0x96, 0x25, 0x99, 0x52, 0x2a, 0x91, 0xa3, 0x24, 0xfe, 0x48, 0x1c, 0x7d, 0xd8, 0xf9, 0xa1,
-		0xaa, 0x7c, 0x58, 0xa9, 0x72, 0xa5, 0xa4, 0xc4, 0x49, 0xb9, 0x52, 0x29, 0xd8, 0x22, 0x55, 0x65,
-		0x45, 0x61, 0x12, 0xe5, 0x42, 0xbb, 0x5c, 0xd2, 0x8f, 0xb8, 0xfa, 0x6b, 0xbe, 0x76, 0x16, 0x33,
-		0x38, 0x1e, 0x29, 0xba, 0xa4, 0x5f, 0xd8, 0x7e, 0xfd, 0xde, 0xeb, 0xee, 0xd7, 0xaf, 0xdf, 0x7b,
-		0xfd, 0xba, 0x7b, 0x00, 0x5f, 0x4a, 0xc3, 0x6d, 0x9a, 0x69, 0x77, 0x4d, 0xfb, 0xcc, 0x8b, 0x7d,
-		0x6c, 0xed, 0x9d, 0xe9, 0xa9, 0x6d, 0xdd, 0x50, 0x1d, 0xdd, 0x34, 0x66, 0x7b, 0x96, 0xe9, 0x98,
-		0xa8, 0xc4, 0xaa, 0x67, 0x69, 0xb5, 0x64, 0x40, 0x71, 0x55, 0x6d, 0x63, 0x19, 0xbf, 0xd8, 0xc7,
-		0xb6, 0x83, 0x2a, 0x90, 0xd9, 0xc1, 0x7b, 0xd5, 0xd4, 0xc9, 0xd4, 0x4c, 0x49, 0x26, 0x3f, 0xd1,
-		0x51, 0xc8, 0x99, 0x5b, 0x5b, 0x36, 0x76, 0xaa, 0xe9, 0x93, 0xa9, 0x99, 0xac, 0xcc, 0x4b, 0x68,
-		0x1a, 0x46, 0x3b, 0x7a, 0x57, 0x77, 0xaa, 0x19, 0x0a, 0x66, 0x05, 0x54, 0x87, 0xa2, 0x66, 0xf6,
-		0x0d, 0x47, 0x71, 0x4c, 0x47, 0xed, 0x54, 0xb3, 0x27, 0x53, 0x33, 0x79, 0x19, 0x28, 0x68, 0x9d,
-		0x40, 0xa4, 0x27, 0xa1, 0xc4, 0xda, 0xb3, 0x7b, 0xa6, 0x61, 0x63, 0x74, 0x1c, 0xf2, 0x06, 0xde,
-		0x75, 0x14, 0xaf, 0xd5, 0x31, 0x52, 0x7e, 0x1a, 0xef, 0x91, 0x16, 0x18, 0x17, 0xd6, 0x30, 0x2b,
-		0x34, 0x9b, 0xdf, 0x78, 0xed, 0x44, 0xea, 0x9b, 0xaf, 0x9d, 0x48, 0xfd, 0xd9, 0x6b, 0x27, 0x52,
-		0x9f, 0x7a, 0xfd, 0xc4, 0xc8, 0x37, 0x5f, 0x3f, 0x31, 0xf2, 0x27, 0xaf, 0x9f, 0x18, 0x79, 0x7e,
-		0xa6, 0xad, 0x3b, 0xdb, 0xfd, 0xcd, 0x59, 0xcd, 0xec, 0x9e, 0xe1, 0x22, 0x60, 0x7f, 0xee, 0xb7,
-		0x5b, 0x3b, 0x67, 0x9c, 0xbd, 0x1e, 0xe6, 0x32, 0xd9, 0xcc, 0x51, 0x49, 0xbc, 0x17, 0x7e, 0xfd,
-		0x1c, 0x9c, 0x6c, 0x9b, 0x66, 0xbb, 0x83, 0xcf, 0x50, 0xc8, 0x66, 0x7f, 0xeb, 0x4c, 0x0b, 0xdb,
-		0x9a, 0xa5, 0xf7, 0x1c, 0xd3, 0xe2, 0xf2, 0x9a, 0x60, 0x18, 0xb3, 0x02, 0x43, 0x5a, 0x81, 0xc9,
-		0xf3, 0x7a, 0x07, 0x2f, 0xb8, 0x88, 0x6b, 0xd8, 0x41, 0x8f, 0x42, 0x76, 0x4b, 0xef, 0xe0, 0x6a,
-		0xea, 0x64, 0x66, 0xa6, 0x78, 0xf6, 0x8e, 0xd9, 0x10, 0xd1, 0x6c, 0x90, 0x62, 0x95, 0x80, 0x65,
-		0x4a, 0x21, 0x7d, 0x27, 0x0b, 0x53, 0x11, 0xb5, 0x08, 0x41, 0xd6, 0x50, 0xbb, 0x98, 0x4a, 0xa5,
-		0x20, 0xd3, 0xdf, 0xa8, 0x0a, 0x63, 0x3d, 0x55, 0xdb, 0x51, 0xdb, 0x98, 0x0a, 0xa5, 0x20, 0x8b,
-		0x22, 0x3a, 0x01, 0xd0, 0xc2, 0x3d, 0x6c, 0xb4, 0xb0, 0xa1, 0xed, 0x55, 0x33, 0x27, 0x33, 0x33,
-		0x05, 0xd9, 0x07, 0x41, 0xf7, 0xc2, 0x64, 0xaf, 0xbf, 0xd9, 0xd1, 0x35, 0xc5, 0x87, 0x06, 0x27,
-		0x33, 0x33, 0xa3, 0x72, 0x85, 0x55, 0x2c, 0x78, 0xc8, 0x77, 0xc3, 0xc4, 0x35, 0xac, 0xee, 0xf8,
-		0x51, 0x8b, 0x14, 0xb5, 0x4c, 0xc0, 0x3e, 0xc4, 0x79, 0x28, 0x75, 0xb1, 0x6d, 0xab, 0x6d, 0xac,
-		0x10, 0xf9, 0x56, 0xb3, 0x74, 0xf4, 0x27, 0x07, 0x46, 0x1f, 0x1e, 0x79, 0x91, 0x53, 0xad, 0xef,
-		0xf5, 0x30, 0x9a, 0x83, 0x02, 0x36, 0xfa, 0x5d, 0xc6, 0x61, 0x74, 0x88, 0xfc, 0x16, 0x8d, 0x7e,
-		0x37, 0xcc, 0x25, 0x4f, 0xc8, 0x38, 0x8b, 0x31, 0x1b, 0x5b, 0x57, 0x75, 0x0d, 0x57, 0x73, 0x94,
-		0xc1, 0xdd, 0x03, 0x0c, 0xd6, 0x58, 0x7d, 0x98, 0x87, 0xa0, 0x43, 0xf3, 0x50, 0xc0, 0xbb, 0x0e,
-		0x36, 0x6c, 0xdd, 0x34, 0xaa, 0x63, 0x94, 0xc9, 0x9d, 0x11, 0xb3, 0x88, 0x3b, 0xad, 0x30, 0x0b,
-		0x8f, 0x0e, 0x3d, 0x0c, 0x63, 0x66, 0x8f, 0xac, 0x35, 0xbb, 0x9a, 0x3f, 0x99, 0x9a, 0x29, 0x9e,
-		0xbd, 0x35, 0x52, 0x11, 0x2e, 0x33, 0x1c, 0x59, 0x20, 0xa3, 0x25, 0xa8, 0xd8, 0x66, 0xdf, 0xd2,
-		0xb0, 0xa2, 0x99, 0x2d, 0xac, 0xe8, 0xc6, 0x96, 0x59, 0x2d, 0x50, 0x06, 0xf5, 0xc1, 0x81, 0x50,
-		0xc4, 0x79, 0xb3, 0x85, 0x97, 0x8c, 0x2d, 0x53, 0x2e, 0xdb, 0x81, 0x32, 0x59, 0xaf, 0xf6, 0x9e,
-		0xe1, 0xa8, 0xbb, 0xd5, 0x12, 0xd5, 0x10, 0x5e, 0x92, 0xbe, 0x96, 0x83, 0x89, 0x24, 0x2a, 0x76,
-		0x0e, 0x46, 0xb7, 0xc8, 0x28, 0xab, 0xe9, 0xc3, 0xc8, 0x80, 0xd1, 0x04, 0x85, 0x98, 0xbb, 0x41,
-		0x21, 0xce, 0x41, 0xd1, 0xc0, 0xb6, 0x83, 0x5b, 0x4c, 0x23, 0x32, 0x09, 0x75, 0x0a, 0x18, 0xd1,
-		0xa0, 0x4a, 0x65, 0x6f, 0x48, 0xa5, 0x9e, 0x83, 0x09, 0xb7, 0x4b, 0x8a, 0xa5, 0x1a, 0x6d, 0xa1,
-		0x9b, 0x67, 0xe2, 0x7a, 0x32, 0xbb, 0x28, 0xe8, 0x64, 0x42, 0x26, 0x97, 0x71, 0xa0, 0x8c, 0x16,
-		0x00, 0x4c, 0x03, 0x9b, 0x5b, 0x4a, 0x0b, 0x6b, 0x9d, 0x6a, 0x7e, 0x88, 0x94, 0x2e, 0x13, 0x94,
-		0x01, 0x29, 0x99, 0x0c, 0xaa, 0x75, 0xd0, 0x63, 0x9e, 0xaa, 0x8d, 0x0d, 0xd1, 0x94, 0x15, 0xb6,
-		0xc8, 0x06, 0xb4, 0x6d, 0x03, 0xca, 0x16, 0x26, 0x7a, 0x8f, 0x5b, 0x7c, 0x64, 0x05, 0xda, 0x89,
-		0xd9, 0xd8, 0x91, 0xc9, 0x9c, 0x8c, 0x0d, 0x6c, 0xdc, 0xf2, 0x17, 0xd1, 0x29, 0x70, 0x01, 0x0a,
-		0x55, 0x2b, 0xa0, 0x56, 0xa8, 0x24, 0x80, 0x97, 0xd4, 0x2e, 0xae, 0xbd, 0x04, 0xe5, 0xa0, 0x78,
-		0x88, 0x99, 0xb7, 0x1d, 0xd5, 0x72, 0xa8, 0x16, 0x8e, 0xca, 0xac, 0x40, 0x1c, 0x11, 0x36, 0x5a,
-		0xd4, 0xca, 0x8d, 0xca, 0xe4, 0x27, 0x7a, 0xbf, 0x37, 0xe0, 0x0c, 0x1d, 0xf0, 0x5d, 0x83, 0x33,
-		0x1a, 0xe0, 0x1c, 0x1e, 0x77, 0xed, 0x11, 0x18, 0x0f, 0x0c, 0x20, 0x69, 0xd3, 0xd2, 0x87, 0xe0,
-		0x48, 0x24, 0x6b, 0xf4, 0x1c, 0x4c, 0xf7, 0x0d, 0xdd, 0x70, 0xb0, 0xd5, 0xb3, 0x30, 0xd1, 0x58,
-		0xd6, 0x54, 0xf5, 0xcf, 0xc7, 0x86, 0xe8, 0xdc, 0x86, 0x1f, 0x9b, 0x71, 0x91, 0xa7, 0xfa, 0x83,
-		0xc0, 0x7b, 0x0a, 0xf9, 0xef, 0x8e, 0x55, 0x5e, 0x7e, 0xf9, 0xe5, 0x97, 0xd3, 0xd2, 0x2b, 0x39,
-		0x98, 0x8e, 0x5a, 0x33, 0x91, 0xcb, 0xf7, 0x28, 0xe4, 0x8c, 0x7e, 0x77, 0x13, 0x5b, 0x54, 0x48,
-		0xa3, 0x32, 0x2f, 0xa1, 0x39, 0x18, 0xed, 0xa8, 0x9b, 0x98, 0xb9, 0xe4, 0xf2, 0xd9, 0x7b, 0x13,
-		0xad, 0xca, 0xd9, 0x65, 0x42, 0x22, 0x33, 0x4a, 0xf4, 0x04, 0x64, 0xb9, 0x89, 0x26, 0x1c, 0xee,
-		0x49, 0xc6, 0x81, 0xac, 0x25, 0x99, 0xd2, 0xa1, 0x5b, 0xa0, 0x40, 0xfe, 0x32, 0xdd, 0xc8, 0xd1,
-		0x3e, 0xe7, 0x09, 0x80, 0xe8, 0x05, 0xaa, 0x41, 0x9e, 0x2e, 0x93, 0x16, 0x16, 0xae, 0xcd, 0x2d,
-		0x13, 0xc5, 0x6a, 0xe1, 0x2d, 0xb5, 0xdf, 0x71, 0x94, 0xab, 0x6a, 0xa7, 0x8f, 0xa9, 0xc2, 0x17,
-		0xe4, 0x12, 0x07, 0x5e, 0x21, 0x30, 0x12, 0x79, 0xb0, 0x55, 0xa5, 0x1b, 0x2d, 0xbc, 0x4b, 0xad,
-		0xe7, 0xa8, 0xcc, 0x16, 0xda, 0x12, 0x81, 0x90, 0xe6, 0x5f, 0xb0, 0x4d, 0x43, 0xa8, 0x26, 0x6d,
-		0x82, 0x00, 0x68, 0xf3, 0x8f, 0x84, 0x0d, 0xf7, 0x6d, 0xd1, 0xc3, 0x0b, 0xeb, 0x94, 0xf4, 0xe5,
-		0x34, 0x64, 0xa9, 0xbd, 0x98, 0x80, 0xe2, 0xfa, 0x07, 0x56, 0x17, 0x95, 0x85, 0xcb, 0x1b, 0xcd,
-		0xe5, 0xc5, 0x4a, 0x0a, 0x95, 0x01, 0x28, 0xe0, 0xfc, 0xf2, 0xe5, 0xb9, 0xf5, 0x4a, 0xda, 0x2d,
-		0x2f, 0x5d, 0x5a, 0x7f, 0xf8, 0xc1, 0x4a, 0xc6, 0x25, 0xd8, 0x60, 0x80, 0xac, 0x1f, 0xe1, 0xbd,
-		0x67, 0x2b, 0xa3, 0xa8, 0x02, 0x25, 0xc6, 0x60, 0xe9, 0xb9, 0xc5, 0x85, 0x87, 0x1f, 0xac, 0xe4,
-		0x82, 0x90, 0xf7, 0x9e, 0xad, 0x8c, 0xa1, 0x71, 0x28, 0x50, 0x48, 0xf3, 0xf2, 0xe5, 0xe5, 0x4a,
-		0xde, 0xe5, 0xb9, 0xb6, 0x2e, 0x2f, 0x5d, 0xba, 0x50, 0x29, 0xb8, 0x3c, 0x2f, 0xc8, 0x97, 0x37,
-		0x56, 0x2b, 0xe0, 0x72, 0x58, 0x59, 0x5c, 0x5b, 0x9b, 0xbb, 0xb0, 0x58, 0x29, 0xba, 0x18, 0xcd,
-		0x0f, 0xac, 0x2f, 0xae, 0x55, 0x4a, 0x81, 0x6e, 0xbd, 0xf7, 0x6c, 0x65, 0xdc, 0x6d, 0x62, 0xf1,
-		0xd2, 0xc6, 0x4a, 0xa5, 0x8c, 0x26, 0x61, 0x9c, 0x35, 0x21, 0x3a, 0x31, 0x11, 0x02, 0x3d, 0xfc,
-		0x60, 0xa5, 0xe2, 0x75, 0x84, 0x71, 0x99, 0x0c, 0x00, 0x1e, 0x7e, 0xb0, 0x82, 0xa4, 0x79, 0x18,
-		0xa5, 0xda, 0x85, 0x10, 0x94, 0x97, 0xe7, 0x9a, 0x8b, 0xcb, 0xca, 0xe5, 0xd5, 0xf5, 0xa5, 0xcb,
-		0x97, 0xe6, 0x96, 0x2b, 0x29, 0x0f, 0x26, 0x2f, 0x3e, 0xb3, 0xb1, 0x24, 0x2f, 0x2e, 0x54, 0xd2,
-		0x7e, 0xd8, 0xea, 0xe2, 0xdc, 0xfa, 0xe2, 0x42, 0x25, 0x23, 0x69, 0x30, 0x1d, 0x65, 0x27, 0x23,
-		0x57, 0x86, 0x6f, 0x8a, 0xd3, 0x43, 0xa6, 0x98, 0xf2, 0x1a, 0x98, 0xe2, 0xd7, 0xd3, 0x30, 0x15,
-		0xe1, 0x2b, 0x22, 0x1b, 0x79, 0x12, 0x46, 0x99, 0x8a, 0x32, 0xef, 0x79, 0x3a, 0xd2, 0xe9, 0x50,
-		0x85, 0x1d, 0xf0, 0xa0, 0x94, 0xce, 0x1f, 0x41, 0x64, 0x86, 0x44, 0x10, 0x84, 0xc5, 0x80, 0x4d,
-		0xff, 0xe9, 0x01, 0x9b, 0xce, 0xdc, 0xde, 0xc3, 0x49, 0xdc, 0x1e, 0x85, 0x1d, 0xce, 0xb6, 0x8f,
-		0x46, 0xd8, 0xf6, 0x73, 0x30, 0x39, 0xc0, 0x28, 0xb1, 0x8d, 0xfd, 0x70, 0x0a, 0xaa, 0xc3, 0x84,
-		0x13, 0x63, 0xe9, 0xd2, 0x01, 0x4b, 0x77, 0x2e, 0x2c, 0xc1, 0xdb, 0x87, 0x4f, 0xc2, 0xc0, 0x5c,
-		0x7f, 0x31, 0x05, 0x47, 0xa3, 0x23, 0xc5, 0xc8, 0x3e, 0x3c, 0x01, 0xb9, 0x2e, 0x76, 0xb6, 0x4d,
-		0x11, 0x2d, 0xdd, 0x15, 0xe1, 0x83, 0x49, 0x75, 0x78, 0xb2, 0x39, 0x95, 0xdf, 0x89, 0x67, 0x86,
-		0x85, 0x7b, 0xac, 0x37, 0x03, 0x3d, 0xfd, 0x78, 0x1a, 0x8e, 0x44, 0x32, 0x8f, 0xec, 0xe8, 0x6d,
-		0x00, 0xba, 0xd1, 0xeb, 0x3b, 0x2c, 0x22, 0x62, 0x06, 0xb6, 0x40, 0x21, 0xd4, 0x78, 0x11, 0xe3,
-		0xd9, 0x77, 0xdc, 0xfa, 0x0c, 0xad, 0x07, 0x06, 0xa2, 0x08, 0x8f, 0x7a, 0x1d, 0xcd, 0xd2, 0x8e,
-		0x9e, 0x18, 0x32, 0xd2, 0x01, 0xc5, 0x7c, 0x00, 0x2a, 0x5a, 0x47, 0xc7, 0x86, 0xa3, 0xd8, 0x8e,
-		0x85, 0xd5, 0xae, 0x6e, 0xb4, 0xa9, 0x07, 0xc9, 0x37, 0x46, 0xb7, 0xd4, 0x8e, 0x8d, 0xe5, 0x09,
-		0x56, 0xbd, 0x26, 0x6a, 0x09, 0x05, 0x55, 0x20, 0xcb, 0x47, 0x91, 0x0b, 0x50, 0xb0, 0x6a, 0x97,
-		0x42, 0xfa, 0xe5, 0x02, 0x14, 0x7d, 0x71, 0x35, 0xba, 0x1d, 0x4a, 0x2f, 0xa8, 0x57, 0x55, 0x45,
-		0xec, 0x95, 0x98, 0x24, 0x8a, 0x04, 0xb6, 0xca, 0xf7, 0x4b, 0x0f, 0xc0, 0x34, 0x45, 0x31, 0xfb,
-		0x0e, 0xb6, 0x14, 0xad, 0xa3, 0xda, 0x36, 0x15, 0x5a, 0x9e, 0xa2, 0x22, 0x52, 0x77, 0x99, 0x54,
-		0xcd, 0x8b, 0x1a, 0xf4, 0x10, 0x4c, 0x51, 0x8a, 0x6e, 0xbf, 0xe3, 0xe8, 0xbd, 0x0e, 0x56, 0xc8,
-		0xee, 0xcd, 0xa6, 0x9e, 0xc4, 0xed, 0xd9, 0x24, 0xc1, 0x58, 0xe1, 0x08, 0xa4, 0x47, 0x36, 0x5a,
-		0x80, 0xdb, 0x28, 0x59, 0x1b, 0x1b, 0xd8, 0x52, 0x1d, 0xac, 0xe0, 0x17, 0xfb, 0x6a, 0xc7, 0x56,
-		0x54, 0xa3, 0xa5, 0x6c, 0xab, 0xf6, 0x76, 0x75, 0x9a, 0x30, 0x68, 0xa6, 0xab, 0x29, 0xf9, 0x38,
-		0x41, 0xbc, 0xc0, 0xf1, 0x16, 0x29, 0xda, 0x9c, 0xd1, 0xba, 0xa8, 0xda, 0xdb, 0xa8, 0x01, 0x47,
-		0x29, 0x17, 0xdb, 0xb1, 0x74, 0xa3, 0xad, 0x68, 0xdb, 0x58, 0xdb, 0x51, 0xfa, 0xce, 0xd6, 0xa3,
-		0xd5, 0x5b, 0xfc, 0xed, 0xd3, 0x1e, 0xae, 0x51, 0x9c, 0x79, 0x82, 0xb2, 0xe1, 0x6c, 0x3d, 0x8a,
-		0xd6, 0xa0, 0x44, 0x26, 0xa3, 0xab, 0xbf, 0x84, 0x95, 0x2d, 0xd3, 0xa2, 0xae, 0xb1, 0x1c, 0x61,
-		0x9a, 0x7c, 0x12, 0x9c, 0xbd, 0xcc, 0x09, 0x56, 0xcc, 0x16, 0x6e, 0x8c, 0xae, 0xad, 0x2e, 0x2e,
-		0x2e, 0xc8, 0x45, 0xc1, 0xe5, 0xbc, 0x69, 0x11, 0x85, 0x6a, 0x9b, 0xae, 0x80, 0x8b, 0x4c, 0xa1,
-		0xda, 0xa6, 0x10, 0xef, 0x43, 0x30, 0xa5, 0x69, 0x6c, 0xcc, 0xba, 0xa6, 0xf0, 0x3d, 0x96, 0x5d,
-		0xad, 0x04, 0x84, 0xa5, 0x69, 0x17, 0x18, 0x02, 0xd7, 0x71, 0x1b, 0x3d, 0x06, 0x47, 0x3c, 0x61,
-		0xf9, 0x09, 0x27, 0x07, 0x46, 0x19, 0x26, 0x7d, 0x08, 0xa6, 0x7a, 0x7b, 0x83, 0x84, 0x28, 0xd0,
-		0x62, 0x6f, 0x2f, 0x4c, 0xf6, 0x08, 0x4c, 0xf7, 0xb6, 0x7b, 0x83, 0x74, 0xf7, 0xf8, 0xe9, 0x50,
-		0x6f, 0xbb, 0x17, 0x26, 0xbc, 0x93, 0x6e, 0xb8, 0x2d, 0xac, 0xa9, 0x0e, 0x6e, 0x55, 0x8f, 0xf9,
-		0xd1, 0x7d, 0x15, 0xe8, 0x0c, 0x54, 0x34, 0x4d, 0xc1, 0x86, 0xba, 0xd9, 0xc1, 0x8a, 0x6a, 0x61,
-		0x43, 0xb5, 0xab, 0x75, 0x3f, 0x72, 0x59, 0xd3, 0x16, 0x69, 0xed, 0x1c, 0xad, 0x44, 0xf7, 0xc0,
-		0xa4, 0xb9, 0xf9, 0x82, 0xc6, 0x54, 0x52, 0xe9, 0x59, 0x78, 0x4b, 0xdf, 0xad, 0xde, 0x41, 0xe5,
-		0x3b, 0x41, 0x2a, 0xa8, 0x42, 0xae, 0x52, 0x30, 0x3a, 0x0d, 0x15, 0xcd, 0xde, 0x56, 0xad, 0x1e,
-		0xb5, 0xc9, 0x76, 0x4f, 0xd5, 0x70, 0xf5, 0x4e, 0x86, 0xca, 0xe0, 0x97, 0x04, 0x98, 0x2c, 0x09,
-		0xfb, 0x9a, 0xbe, 0xe5, 0x08, 0x8e, 0x77, 0xb3, 0x25, 0x41, 0x61, 0x9c, 0xdb, 0x0c, 0x54, 0x88,
-		0x28, 0x02, 0x0d, 0xcf, 0x50, 0xb4, 0x72, 0x6f, 0xbb, 0xe7, 0x6f, 0xf7, 0x14, 0x8c, 0x13, 0x4c,
-		0xaf, 0xd1, 0xd3, 0x2c, 0x20, 0xeb, 0x6d, 0xfb, 0x5a, 0x7c, 0x10, 0x8e, 0x12, 0xa4, 0x2e, 0x76,
-		0xd4, 0x96, 0xea, 0xa8, 0x3e, 0xec, 0xfb, 0x28, 0x36, 0x91, 0xfb, 0x0a, 0xaf, 0x0c, 0xf4, 0xd3,
-		0xea, 0x6f, 0xee, 0xb9, 0x9a, 0x75, 0x3f, 0xeb, 0x27, 0x81, 0x09, 0xdd, 0x7a, 0xdb, 0x82, 0x6e,
-		0xa9, 0x01, 0x25, 0xbf, 0xe2, 0xa3, 0x02, 0x30, 0xd5, 0xaf, 0xa4, 0x48, 0x14, 0x34, 0x7f, 0x79,
-		0x81, 0xc4, 0x2f, 0xcf, 0x2f, 0x56, 0xd2, 0x24, 0x8e, 0x5a, 0x5e, 0x5a, 0x5f, 0x54, 0xe4, 0x8d,
-		0x4b, 0xeb, 0x4b, 0x2b, 0x8b, 0x95, 0x8c, 0x2f, 0x60, 0x7f, 0x2a, 0x9b, 0xbf, 0xab, 0x72, 0xb7,
-		0xf4, 0xad, 0x34, 0x94, 0x83, 0x3b, 0x30, 0xf4, 0x38, 0x1c, 0x13, 0xe9, 0x12, 0x1b, 0x3b, 0xca,
-		0x35, 0xdd, 0xa2, 0x2b, 0xb2, 0xab, 0x32, 0xef, 0xe8, 0xea, 0xc4, 0x34, 0xc7, 0x5a, 0xc3, 0xce,
-		0xb3, 0xba, 0x45, 0xd6, 0x5b, 0x57, 0x75, 0xd0, 0x32, 0xd4, 0x0d, 0x53, 0xb1, 0x1d, 0xd5, 0x68,
-		0xa9, 0x56, 0x4b, 0xf1, 0x12, 0x55, 0x8a, 0xaa, 0x69, 0xd8, 0xb6, 0x4d, 0xe6, 0x09, 0x5d, 0x2e,
-		0xb7, 0x1a, 0xe6, 0x1a, 0x47, 0xf6, 0x5c, 0xc4, 0x1c, 0x47, 0x0d, 0xe9, 0x6f, 0x66, 0x98, 0xfe,
-		0xde, 0x02, 0x85, 0xae, 0xda, 0x53, 0xb0, 0xe1, 0x58, 0x7b, 0x34, 0xee, 0xce, 0xcb, 0xf9, 0xae,
-		0xda, 0x5b, 0x24, 0xe5, 0x77, 0x64, 0xfb, 0xf3, 0x54, 0x36, 0x9f, 0xaf, 0x14, 0x9e, 0xca, 0xe6,
-		0x0b, 0x15, 0x90, 0x5e, 0xcb, 0x40, 0xc9, 0x1f, 0x87, 0x93, 0x6d, 0x8d, 0x46, 0x5d, 0x56, 0x8a,
-		0x1a, 0xb5, 0x53, 0x07, 0x46, 0xed, 0xb3, 0xf3, 0xc4, 0x97, 0x35, 0x72, 0x2c, 0x3a, 0x96, 0x19,
-		0x25, 0x89, 0x23, 0x88, 0xb2, 0x61, 0x16, 0x8d, 0xe4, 0x65, 0x5e, 0x42, 0x17, 0x20, 0xf7, 0x82,
-		0x4d, 0x79, 0xe7, 0x28, 0xef, 0x3b, 0x0e, 0xe6, 0xfd, 0xd4, 0x1a, 0x65, 0x5e, 0x78, 0x6a, 0x4d,
-		0xb9, 0x74, 0x59, 0x5e, 0x99, 0x5b, 0x96, 0x39, 0x39, 0x3a, 0x0e, 0xd9, 0x8e, 0xfa, 0xd2, 0x5e,
-		0xd0, 0xeb, 0x51, 0x50, 0xd2, 0x49, 0x38, 0x0e, 0xd9, 0x6b, 0x58, 0xdd, 0x09, 0xfa, 0x1a, 0x0a,
-		0x7a, 0x1b, 0x17, 0xc3, 0x19, 0x18, 0xa5, 0xf2, 0x42, 0x00, 0x5c, 0x62, 0x95, 0x11, 0x94, 0x87,
-		0xec, 0xfc, 0x65, 0x99, 0x2c, 0x88, 0x0a, 0x94, 0x18, 0x54, 0x59, 0x5d, 0x5a, 0x9c, 0x5f, 0xac,
-		0xa4, 0xa5, 0x87, 0x20, 0xc7, 0x84, 0x40, 0x16, 0x8b, 0x2b, 0x86, 0xca, 0x08, 0x2f, 0x72, 0x1e,
-		0x29, 0x51, 0xbb, 0xb1, 0xd2, 0x5c, 0x94, 0x2b, 0xe9, 0xe0, 0x54, 0x67, 0x2b, 0xa3, 0x92, 0x0d,
-		0x25, 0x7f, 0x20, 0xfe, 0xce, 0x6c, 0xb2, 0xbf, 0x9e, 0x82, 0xa2, 0x2f, 0xb0, 0x26, 0x11, 0x91,
-		0xda, 0xe9, 0x98, 0xd7, 0x14, 0xb5, 0xa3, 0xab, 0x36, 0x57, 0x0d, 0xa0, 0xa0, 0x39, 0x02, 0x49,
-		0x3a, 0x75, 0xef, 0xd0, 0x12, 0x19, 0xad, 0xe4, 0xa4, 0xcf, 0xa5, 0xa0, 0x12, 0x8e, 0x6c, 0x43,
-		0xdd, 0x4c, 0xfd, 0x28, 0xbb, 0x29, 0x7d, 0x26, 0x05, 0xe5, 0x60, 0x38, 0x1b, 0xea, 0xde, 0xed,
-		0x3f, 0xd2, 0xee, 0xfd, 0x59, 0x1a, 0xc6, 0x03, 0x41, 0x6c, 0xd2, 0xde, 0xbd, 0x08, 0x93, 0x7a,
-		0x0b, 0x77, 0x7b, 0xa6, 0x83, 0x0d, 0x6d, 0x4f, 0xe9, 0xe0, 0xab, 0xb8, 0x53, 0x95, 0xa8, 0xd1,
-		0x38, 0x73, 0x70, 0x98, 0x3c, 0xbb, 0xe4, 0xd1, 0x2d, 0x13, 0xb2, 0xc6, 0xd4, 0xd2, 0xc2, 0xe2,
-		0xca, 0xea, 0xe5, 0xf5, 0xc5, 0x4b, 0xf3, 0x1f, 0x50, 0x36, 0x2e, 0x3d, 0x7d, 0xe9, 0xf2, 0xb3,
-		0x97, 0xe4, 0x8a, 0x1e, 0x42, 0x7b, 0x1b, 0x97, 0xfd, 0x2a, 0x54, 0xc2, 0x9d, 0x42, 0xc7, 0x20,
-		0xaa, 0x5b, 0x95, 0x11, 0x34, 0x05, 0x13, 0x97, 0x2e, 0x2b, 0x6b, 0x4b, 0x0b, 0x8b, 0xca, 0xe2,
-		0xf9, 0xf3, 0x8b, 0xf3, 0xeb, 0x6b, 0x2c, 0xf1, 0xe1, 0x62, 0xaf, 0x07, 0x16, 0xb8, 0xf4, 0xe9,
-		0x0c, 0x4c, 0x45, 0xf4, 0x04, 0xcd, 0xf1, 0x2d, 0x0b, 0xdb, 0x45, 0xdd, 0x9f, 0xa4, 0xf7, 0xb3,
-		0x24, 0x66, 0x58, 0x55, 0x2d, 0x87, 0xef, 0x70, 0x4e, 0x03, 0x91, 0x92, 0xe1, 0xe8, 0x5b, 0x3a,
-		0xb6, 0x78, 0x9e, 0x88, 0xed, 0x63, 0x26, 0x3c, 0x38, 0x4b, 0x15, 0xdd, 0x07, 0xa8, 0x67, 0xda,
-		0xba, 0xa3, 0x5f, 0xc5, 0x8a, 0x6e, 0x88, 0xa4, 0x52, 0x96, 0x9e, 0x32, 0x55, 0x44, 0xcd, 0x92,
-		0xe1, 0xb8, 0xd8, 0x06, 0x6e, 0xab, 0x21, 0x6c, 0x62, 0xcc, 0x33, 0x72, 0x45, 0xd4, 0xb8, 0xd8,
-		0xb7, 0x43, 0xa9, 0x65, 0xf6, 0x49, 0xb0, 0xc7, 0xf0, 0x88, 0xef, 0x48, 0xc9, 0x45, 0x06, 0x73,
-		0x51, 0x78, 0x18, 0xef, 0x65, 0xb3, 0x4a, 0x72, 0x91, 0xc1, 0x18, 0xca, 0xdd, 0x30, 0xa1, 0xb6,
-		0xdb, 0x16, 0x61, 0x2e, 0x18, 0xb1, 0x8d, 0x49, 0xd9, 0x05, 0x53, 0xc4, 0xda, 0x53, 0x90, 0x17,
-		0x72, 0x20, 0xae, 0x9a, 0x48, 0x42, 0xe9, 0xb1, 0xdd, 0x76, 0x7a, 0xa6, 0x20, 0xe7, 0x0d, 0x51,
-		0x79, 0x3b, 0x94, 0x74, 0x5b, 0xf1, 0x92, 0xf3, 0xe9, 0x93, 0xe9, 0x99, 0xbc, 0x5c, 0xd4, 0x6d,
-		0x37, 0xb1, 0x29, 0x7d, 0x31, 0x0d, 0xe5, 0xe0, 0xe1, 0x02, 0x5a, 0x80, 0x7c, 0xc7, 0xd4, 0xe8,
-		0xe9, 0x21, 0x3f, 0xd9, 0x9a, 0x89, 0x39, 0x8f, 0x98, 0x5d, 0xe6, 0xf8, 0xb2, 0x4b, 0x59, 0xfb,
-		0x8f, 0x29, 0xc8, 0x0b, 0x30, 0x3a, 0x0a, 0xd9, 0x9e, 0xea, 0x6c, 0x53, 0x76, 0xa3, 0xcd, 0x74,
-		0x25, 0x25, 0xd3, 0x32, 0x81, 0xdb, 0x3d, 0xd5, 0xa0, 0x2a, 0xc0, 0xe1, 0xa4, 0x4c, 0xe6, 0xb5,
-		0x83, 0xd5, 0x16, 0xdd, 0xf5, 0x98, 0xdd, 0x2e, 0x36, 0x1c, 0x5b, 0xcc, 0x2b, 0x87, 0xcf, 0x73,
-		0x30, 0xba, 0x17, 0x26, 0x1d, 0x4b, 0xd5, 0x3b, 0x01, 0xdc, 0x2c, 0xc5, 0xad, 0x88, 0x0a, 0x17,
-		0xb9, 0x01, 0xc7, 0x05, 0xdf, 0x16, 0x76, 0x54, 0x6d, 0x1b, 0xb7, 0x3c, 0xa2, 0x1c, 0xcd, 0x6e,
-		0x1c, 0xe3, 0x08, 0x0b, 0xbc, 0x5e, 0xd0, 0x4a, 0xdf, 0x4a, 0xc1, 0xa4, 0xd8, 0xa7, 0xb5, 0x5c,
-		0x61, 0xad, 0x00, 0xa8, 0x86, 0x61, 0x3a, 0x7e, 0x71, 0x0d, 0xaa, 0xf2, 0x00, 0xdd, 0xec, 0x9c,
-		0x4b, 0x24, 0xfb, 0x18, 0xd4, 0xba, 0x00, 0x5e, 0xcd, 0x50, 0xb1, 0xd5, 0xa1, 0xc8, 0x4f, 0x8e,
-		0xe8, 0xf1, 0x23, 0xdb, 0xd9, 0x03, 0x03, 0x91, 0x0d, 0x1d, 0x9a, 0x86, 0xd1, 0x4d, 0xdc, 0xd6,
-		0x0d, 0x9e, 0x0f, 0x66, 0x05, 0x91, 0x7f, 0xc9, 0xba, 0xf9, 0x97, 0xe6, 0x27, 0x53, 0x30, 0xa5,
-		0x99, 0xdd, 0x70, 0x7f, 0x9b, 0x95, 0x50, 0x7a, 0xc1, 0xbe, 0x98, 0x7a, 0xfe, 0x09, 0xdf, 0x49,
-		0x6b, 0xdb, 0xec, 0xa8, 0x46, 0xdb, 0x3b, 0x3f, 0xa5, 0x3f, 0xb4, 0xfb, 0xdb, 0xd8, 0xb8, 0xbf,
-		0x6d, 0xfa, 0x4e, 0x53, 0xcf, 0x79, 0x3f, 0xff, 0x2a, 0x95, 0xfa, 0x42, 0x3a, 0x73, 0x61, 0xb5,
-		0xf9, 0xa5, 0x74, 0xed, 0x02, 0x6b, 0x6e, 0x55, 0x88, 0x47, 0xc6, 0x5b, 0x1d, 0xac, 0x91, 0x21,
-		0xc3, 0xf7, 0xee, 0x85, 0xe9, 0xb6, 0xd9, 0x36, 0x29, 0xc7, 0x33, 0xe4, 0x17, 0x3f, 0x91, 0x2d,
-		0xb8, 0xd0, 0x5a, 0xec, 0xf1, 0x6d, 0xe3, 0x12, 0x4c, 0x71, 0x64, 0x85, 0x1e, 0x09, 0xb1, 0x8d,
-		0x0d, 0x3a, 0x30, 0xad, 0x56, 0xfd, 0xdd, 0xef, 0x50, 0x87, 0x2e, 0x4f, 0x72, 0x52, 0x52, 0xc7,
-		0xf6, 0x3e, 0x0d, 0x19, 0x8e, 0x04, 0xf8, 0xb1, 0x65, 0x8b, 0xad, 0x18, 0x8e, 0xff, 0x8e, 0x73,
-		0x9c, 0xf2, 0x71, 0x5c, 0xe3, 0xa4, 0x8d, 0x79, 0x18, 0x3f, 0x0c, 0xaf, 0x7f, 0xcf, 0x79, 0x95,
-		0xb0, 0x9f, 0xc9, 0x05, 0x98, 0xa0, 0x4c, 0xb4, 0xbe, 0xed, 0x98, 0x5d, 0x6a, 0x13, 0x0f, 0x66,
-		0xf3, 0x87, 0xdf, 0x61, 0xeb, 0xa8, 0x4c, 0xc8, 0xe6, 0x5d, 0xaa, 0x46, 0x03, 0xe8, 0x29, 0x58,
-		0x0b, 0x6b, 0x9d, 0x18, 0x0e, 0xdf, 0xe0, 0x1d, 0x71, 0xf1, 0x1b, 0x57, 0x60, 0x9a, 0xfc, 0xa6,
-		0x26, 0xcb, 0xdf, 0x93, 0xf8, 0x1c, 0x5c, 0xf5, 0x5b, 0x1f, 0x66, 0x4b, 0x75, 0xca, 0x65, 0xe0,
-		0xeb, 0x93, 0x6f, 0x16, 0xdb, 0xd8, 0x71, 0xb0, 0x65, 0x2b, 0x6a, 0x27, 0xaa, 0x7b, 0xbe, 0x24,
-		0x46, 0xf5, 0xd7, 0xde, 0x08, 0xce, 0xe2, 0x05, 0x46, 0x39, 0xd7, 0xe9, 0x34, 0x36, 0xe0, 0x58,
-		0x84, 0x56, 0x24, 0xe0, 0xf9, 0x69, 0xce, 0x73, 0x7a, 0x40, 0x33, 0x08, 0xdb, 0x55, 0x10, 0x70,
-		0x77, 0x2e, 0x13, 0xf0, 0xfc, 0x75, 0xce, 0x13, 0x71, 0x5a, 0x31, 0xa5, 0x84, 0xe3, 0x53, 0x30,
-		0x79, 0x15, 0x5b, 0x9b, 0xa6, 0xcd, 0x13, 0x47, 0x09, 0xd8, 0x7d, 0x86, 0xb3, 0x9b, 0xe0, 0x84,
-		0x34, 0x93, 0x44, 0x78, 0x3d, 0x06, 0xf9, 0x2d, 0x55, 0xc3, 0x09, 0x58, 0x7c, 0x96, 0xb3, 0x18,
-		0x23, 0xf8, 0x84, 0x74, 0x0e, 0x4a, 0x6d, 0x93, 0x7b, 0xad, 0x78, 0xf2, 0xcf, 0x71, 0xf2, 0xa2,
-		0xa0, 0xe1, 0x2c, 0x7a, 0x66, 0xaf, 0xdf, 0x21, 0x2e, 0x2d, 0x9e, 0xc5, 0x6f, 0x08, 0x16, 0x82,
-		0x86, 0xb3, 0x38, 0x84, 0x58, 0x5f, 0x15, 0x2c, 0x6c, 0x9f, 0x3c, 0x9f, 0x84, 0xa2, 0x69, 0x74,
-		0xf6, 0x4c, 0x23, 0x49, 0x27, 0x3e, 0xcf, 0x39, 0x00, 0x27, 0x21, 0x0c, 0xce, 0x41, 0x21, 0xe9,
-		0x44, 0xfc, 0xa3, 0x37, 0xc4, 0xf2, 0x10, 0x33, 0x70, 0x01, 0x26, 0x84, 0x81, 0xd2, 0x4d, 0x23,
-		0x01, 0x8b, 0x7f, 0xcc, 0x59, 0x94, 0x7d, 0x64, 0x7c, 0x18, 0x0e, 0xb6, 0x9d, 0x36, 0x4e, 0xc2,
-		0xe4, 0x8b, 0x62, 0x18, 0x9c, 0x84, 0x8b, 0x72, 0x13, 0x1b, 0xda, 0x76, 0x32, 0x0e, 0xbf, 0x29,
-		0x44, 0x29, 0x68, 0x08, 0x8b, 0x79, 0x18, 0xef, 0xaa, 0x96, 0xbd, 0xad, 0x76, 0x12, 0x4d, 0xc7,
-		0x3f, 0xe1, 0x3c, 0x4a, 0x2e, 0x11, 0x97, 0x48, 0xdf, 0x38, 0x0c, 0x9b, 0x2f, 0x09, 0x89, 0xf8,
-		0xc8, 0xf8, 0xd2, 0xb3, 0x1d, 0x9a, 0x65, 0x3b, 0x0c, 0xb7, 0x7f, 0x2a, 0x96, 0x1e, 0xa3, 0x5d,
-		0xf1, 0x73, 0x3c, 0x07, 0x05, 0x5b, 0x7f, 0x29, 0x11, 0x9b, 0x7f, 0x26, 0x66, 0x9a, 0x12, 0x10,
-		0xe2, 0x0f, 0xc0, 0xf1, 0x48, 0x37, 0x91, 0x80, 0xd9, 0x3f, 0xe7, 0xcc, 0x8e, 0x46, 0xb8, 0x0a,
-		0x6e, 0x12, 0x0e, 0xcb, 0xf2, 0xb7, 0x84, 0x49, 0xc0, 0x21, 0x5e, 0xab, 0x64, 0x1f, 0x61, 0xab,
-		0x5b, 0x87, 0x93, 0xda, 0x6f, 0x0b, 0xa9, 0x31, 0xda, 0x80, 0xd4, 0xd6, 0xe1, 0x28, 0xe7, 0x78,
-		0xb8, 0x79, 0xfd, 0x1d, 0x61, 0x58, 0x19, 0xf5, 0x46, 0x70, 0x76, 0x3f, 0x08, 0x35, 0x57, 0x9c,
-		0x22, 0x60, 0xb5, 0x95, 0xae, 0xda, 0x4b, 0xc0, 0xf9, 0x77, 0x39, 0x67, 0x61, 0xf1, 0xdd, 0x88,
-		0xd7, 0x5e, 0x51, 0x7b, 0x84, 0xf9, 0x73, 0x50, 0x15, 0xcc, 0xfb, 0x86, 0x85, 0x35, 0xb3, 0x6d,
-		0xe8, 0x2f, 0xe1, 0x56, 0x02, 0xd6, 0xff, 0x22, 0x34, 0x55, 0x1b, 0x3e, 0x72, 0xc2, 0x79, 0x09,
-		0x2a, 0x6e, 0xac, 0xa2, 0xe8, 0xdd, 0x9e, 0x69, 0x39, 0x31, 0x1c, 0xff, 0xa5, 0x98, 0x29, 0x97,
-		0x6e, 0x89, 0x92, 0x35, 0x16, 0xa1, 0x4c, 0x8b, 0x49, 0x55, 0xf2, 0xf7, 0x38, 0xa3, 0x71, 0x8f,
-		0x8a, 0x1b, 0x0e, 0xcd, 0xec, 0xf6, 0x54, 0x2b, 0x89, 0xfd, 0xfb, 0x57, 0xc2, 0x70, 0x70, 0x12,
-		0x6e, 0x38, 0x9c, 0xbd, 0x1e, 0x26, 0xde, 0x3e, 0x01, 0x87, 0x2f, 0x0b, 0xc3, 0x21, 0x68, 0x38,
-		0x0b, 0x11, 0x30, 0x24, 0x60, 0xf1, 0x15, 0xc1, 0x42, 0xd0, 0x10, 0x16, 0xcf, 0x78, 0x8e, 0xd6,
-		0xc2, 0x6d, 0xdd, 0x76, 0x2c, 0x16, 0x26, 0x1f, 0xcc, 0xea, 0xab, 0x6f, 0x04, 0x83, 0x30, 0xd9,
-		0x47, 0x4a, 0x2c, 0x11, 0x4f, 0xbb, 0xd2, 0x5d, 0x54, 0x7c, 0xc7, 0xbe, 0x26, 0x2c, 0x91, 0x8f,
-		0x8c, 0xf4, 0xcd, 0x17, 0x21, 0x12, 0xb1, 0x6b, 0x64, 0xef, 0x90, 0x80, 0xdd, 0xef, 0x87, 0x3a,
-		0xb7, 0x26, 0x68, 0x09, 0x4f, 0x5f, 0xfc, 0xd3, 0x37, 0x76, 0xf0, 0x5e, 0x22, 0xed, 0xfc, 0x83,
-		0x50, 0xfc, 0xb3, 0xc1, 0x28, 0x99, 0x0d, 0x99, 0x08, 0xc5, 0x53, 0x28, 0xee, 0xfe, 0x50, 0xf5,
-		0x6f, 0xbf, 0xc9, 0xc7, 0x1b, 0x0c, 0xa7, 0x1a, 0xcb, 0x44, 0xc9, 0x83, 0x41, 0x4f, 0x3c, 0xb3,
-		0x0f, 0xbf, 0xe9, 0xea, 0x79, 0x20, 0xe6, 0x69, 0x9c, 0x87, 0xf1, 0x40, 0xc0, 0x13, 0xcf, 0xea,
-		0x23, 0x9c, 0x55, 0xc9, 0x1f, 0xef, 0x34, 0x1e, 0x82, 0x2c, 0x09, 0x5e, 0xe2, 0xc9, 0x7f, 0x8e,
-		0x93, 0x53, 0xf4, 0xc6, 0xfb, 0x20, 0x2f, 0x82, 0x96, 0x78, 0xd2, 0x9f, 0xe7, 0xa4, 0x2e, 0x09,
-		0x21, 0x17, 0x01, 0x4b, 0x3c, 0xf9, 0x47, 0x05, 0xb9, 0x20, 0x21, 0xe4, 0xc9, 0x45, 0xf8, 0xf5,
-		0xbf, 0x93, 0xe5, 0x4e, 0x47, 0xc8, 0xee, 0x1c, 0x8c, 0xf1, 0x48, 0x25, 0x9e, 0xfa, 0xe3, 0xbc,
-		0x71, 0x41, 0xd1, 0x78, 0x04, 0x46, 0x13, 0x0a, 0xfc, 0x17, 0x39, 0x29, 0xc3, 0x6f, 0xcc, 0x43,
-		0xd1, 0x17, 0x9d, 0xc4, 0x93, 0xff, 0x12, 0x27, 0xf7, 0x53, 0x91, 0xae, 0xf3, 0xe8, 0x24, 0x9e,
-		0xc1, 0x27, 0x45, 0xd7, 0x39, 0x05, 0x11, 0x9b, 0x08, 0x4c, 0xe2, 0xa9, 0x3f, 0x25, 0xa4, 0x2e,
-		0x48, 0x1a, 0x4f, 0x42, 0xc1, 0x75, 0x36, 0xf1, 0xf4, 0x7f, 0x97, 0xd3, 0x7b, 0x34, 0x44, 0x02,
-		0x3e, 0x67, 0x17, 0xcf, 0xe2, 0x97, 0x85, 0x04, 0x7c, 0x54, 0x64, 0x19, 0x85, 0x03, 0x98, 0x78,
-		0x4e, 0x7f, 0x4f, 0x2c, 0xa3, 0x50, 0xfc, 0x42, 0x66, 0x93, 0xda, 0xfc, 0x78, 0x16, 0xbf, 0x22,
-		0x66, 0x93, 0xe2, 0x93, 0x6e, 0x84, 0x23, 0x82, 0x78, 0x1e, 0xff, 0x40, 0x74, 0x23, 0x14, 0x10,
-		0x34, 0x56, 0x01, 0x0d, 0x46, 0x03, 0xf1, 0xfc, 0x5e, 0xe1, 0xfc, 0x26, 0x07, 0x82, 0x81, 0xc6,
-		0xb3, 0x70, 0x34, 0x3a, 0x12, 0x88, 0xe7, 0xfa, 0x6b, 0x6f, 0x86, 0xf6, 0x6e, 0xfe, 0x40, 0xa0,
-		0xb1, 0xee, 0xb9, 0x14, 0x7f, 0x14, 0x10, 0xcf, 0xf6, 0xd3, 0x6f, 0x06, 0x0d, 0xb7, 0x3f, 0x08,
-		0x68, 0xcc, 0x01, 0x78, 0x0e, 0x38, 0x9e, 0xd7, 0x67, 0x38, 0x2f, 0x1f, 0x11, 0x59, 0x1a, 0xdc,
-		0xff, 0xc6, 0xd3, 0x7f, 0x56, 0x2c, 0x0d, 0x4e, 0x41, 0x96, 0x86, 0x70, 0xbd, 0xf1, 0xd4, 0x9f,
-		0x13, 0x4b, 0x43, 0x90, 0x10, 0xcd, 0xf6, 0x79, 0xb7, 0x78, 0x0e, 0x9f, 0x17, 0x9a, 0xed, 0xa3,
-		0x6a, 0x5c, 0x82, 0xc9, 0x01, 0x87, 0x18, 0xcf, 0xea, 0x0b, 0x9c, 0x55, 0x25, 0xec, 0x0f, 0xfd,
-		0xce, 0x8b, 0x3b, 0xc3, 0x78, 0x6e, 0xff, 0x30, 0xe4, 0xbc, 0xb8, 0x2f, 0x6c, 0x9c, 0x83, 0xbc,
-		0xd1, 0xef, 0x74, 0xc8, 0xe2, 0x41, 0x07, 0xdf, 0xf9, 0xab, 0xfe, 0x8f, 0x1f, 0x72, 0xe9, 0x08,
-		0x82, 0xc6, 0x43, 0x30, 0x8a, 0xbb, 0x9b, 0xb8, 0x15, 0x47, 0xf9, 0xbd, 0x1f, 0x0a, 0x83, 0x49,
-		0xb0, 0x1b, 0x4f, 0x02, 0xb0, 0xd4, 0x08, 0x3d, 0x1e, 0x8c, 0xa1, 0xfd, 0x9f, 0x3f, 0xe4, 0xb7,
-		0x71, 0x3c, 0x12, 0x8f, 0x01, 0xbb, 0xdb, 0x73, 0x30, 0x83, 0x37, 0x82, 0x0c, 0xe8, 0x8c, 0x3c,
-		0x06, 0x63, 0x2f, 0xd8, 0xa6, 0xe1, 0xa8, 0xed, 0x38, 0xea, 0xff, 0xc5, 0xa9, 0x05, 0x3e, 0x11,
-		0x58, 0xd7, 0xb4, 0xb0, 0xa3, 0xb6, 0xed, 0x38, 0xda, 0xff, 0xcd, 0x69, 0x5d, 0x02, 0x42, 0xac,
-		0xa9, 0xb6, 0x93, 0x64, 0xdc, 0xff, 0x47, 0x10, 0x0b, 0x02, 0xd2, 0x69, 0xf2, 0x7b, 0x07, 0xef,
-		0xc5, 0xd1, 0x7e, 0x5f, 0x74, 0x9a, 0xe3, 0x37, 0xde, 0x07, 0x05, 0xf2, 0x93, 0x5d, 0xb1, 0x8b,
-		0x21, 0xfe, 0xbf, 0x9c, 0xd8, 0xa3, 0x20, 0x2d, 0xdb, 0x4e, 0xcb, 0xd1, 0xe3, 0x85, 0x7d, 0x9d,
-		0xcf, 0xb4, 0xc0, 0x6f, 0xcc, 0x41, 0xd1, 0x76, 0x5a, 0xad, 0x3e, 0x8f, 0x4f, 0x63, 0xc8, 0xff,
-		0xdf, 0x0f, 0xdd, 0x94, 0x85, 0x4b, 0x43, 0x66, 0xfb, 0xda, 0x8e, 0xd3, 0x33, 0xe9, 0x11, 0x48,
-		0x1c, 0x87, 0x37, 0x39, 0x07, 0x1f, 0x49, 0x63, 0x1e, 0x4a, 0x64, 0x2c, 0x16, 0xee, 0x61, 0x7a,
-		0x5e, 0x15, 0xc3, 0xe2, 0x2f, 0xb8, 0x00, 0x02, 0x44, 0xcd, 0x9f, 0x1e, 0xf6, 0xee, 0x26, 0x3a,
-		0x6d, 0x0c, 0x17, 0xcc, 0x0b, 0x26, 0x4b, 0x18, 0x3f, 0x2f, 0x05, 0xd2, 0xc5, 0x6d, 0xd3, 0xcb,
-		0xd6, 0xba, 0x9b, 0x1c, 0xf8, 0xfd, 0x34, 0xdc, 0x49, 0xaf, 0xfb, 0x5a, 0x5d, 0xdd, 0x70, 0xce,
-		0x68, 0xd6, 0x5e, 0xcf, 0x31, 0xcf, 0x74, 0xb1, 0xb5, 0xd3, 0xc1, 0xfc, 0x0f, 0xcf, 0xfe, 0x56,
-		0x3d, 0xb4, 0x59, 0x86, 0x36, 0xcb, 0xea, 0x6b, 0x91, 0xd9, 0x62, 0x69, 0x1e, 0xc6, 0x56, 0x2d,
-		0xd3, 0xdc, 0xba, 0xdc, 0x43, 0x88, 0xdf, 0x60, 0xe6, 0x37, 0xe3, 0xa8, 0x1a, 0xf2, 0x17, 0x4f,
-		0x69, 0xef, 0xc5, 0x13, 0x82, 0x6c, 0x4b, 0x75, 0x54, 0x9a, 0x30, 0x2f, 0xc9, 0xf4, 0xb7, 0xd4,
-		0x84, 0x51, 0xca, 0x04, 0x3d, 0x06, 0x19, 0xb3, 0x67, 0xf3, 0xec, 0xfe, 0xed, 0xb3, 0xc3, 0xfa,
-		0x32, 0xcb, 0x9b, 0x6c, 0x66, 0xbf, 0xb1, 0x5f, 0x1f, 0x91, 0x09, 0x4d, 0x73, 0xf5, 0xaf, 0xbe,
-		0x7d, 0x22, 0xf5, 0x9b, 0xaf, 0x9d, 0x48, 0x0d, 0x7d, 0xc1, 0x34, 0xeb, 0x13, 0x94, 0x4f, 0x18,
-		0xc3, 0xe4, 0xe2, 0xbe, 0x63, 0xfa, 0x85, 0x34, 0x9c, 0xf0, 0x21, 0x75, 0xf4, 0x4d, 0xfb, 0xcc,
-		0xce, 0x55, 0xf6, 0xe4, 0x89, 0x4b, 0x0d, 0xf9, 0x7a, 0x4a, 0xea, 0x67, 0x77, 0xae, 0x0e, 0x91,
-		0xd7, 0x2c, 0x64, 0x57, 0x55, 0xdd, 0x8a, 0x78, 0x0a, 0x36, 0xed, 0x5d, 0x6e, 0x25, 0x30, 0x56,
-		0x90, 0xce, 0x42, 0xfe, 0xe9, 0xa5, 0x87, 0x1f, 0x4c, 0x42, 0x93, 0xe1, 0x34, 0x4d, 0x59, 0x88,
-		0xe2, 0xab, 0x11, 0xe2, 0xf8, 0xfa, 0xeb, 0x27, 0x52, 0x91, 0x8f, 0xba, 0xa2, 0x45, 0xc2, 0x47,
-		0xeb, 0x0a, 0xe3, 0x53, 0x69, 0xa8, 0x87, 0x4f, 0x05, 0xc8, 0x52, 0xb4, 0x1d, 0xb5, 0xdb, 0x1b,
-		0xf6, 0xa6, 0xeb, 0x1c, 0x14, 0xd6, 0x05, 0x0e, 0xaa, 0xc2, 0x98, 0x8d, 0x35, 0xd3, 0x68, 0xd9,
-		0x74, 0x24, 0x19, 0x59, 0x14, 0xc9, 0x68, 0x0c, 0xd5, 0x30, 0x6d, 0x7e, 0xe5, 0x94, 0x15, 0x9a,
-		0xbf, 0x9a, 0x3a, 0xdc, 0xda, 0x28, 0xbb, 0x4d, 0xd1, 0x05, 0xb2, 0x9a, 0x7a, 0xfe, 0xde, 0x83,
-		0x0e, 0x54, 0xd8, 0xcb, 0x35, 0x77, 0x08, 0xbe, 0xd3, 0x93, 0x13, 0xe1, 0xd3, 0x93, 0x67, 0x71,
-		0xa7, 0xf3, 0xb4, 0x61, 0x5e, 0x33, 0xd6, 0x09, 0x8d, 0x2b, 0x92, 0x4f, 0xa4, 0xe1, 0xc4, 0xc0,
-		0x41, 0x09, 0x37, 0x2f, 0xc3, 0x24, 0xd2, 0x80, 0xfc, 0x82, 0xb0, 0x5a, 0x87, 0x15, 0xc8, 0xaf,
-		0x1c, 0x52, 0x20, 0xe3, 0xa2, 0x25, 0x21, 0x8f, 0x7b, 0xe2, 0xe5, 0x21, 0xfa, 0x7f, 0x03, 0xe2,
-		0xf8, 0xc8, 0x13, 0x70, 0xbb, 0x4f, 0x81, 0xd4, 0x4d, 0x4d, 0xe7, 0xcf, 0x03, 0xfd, 0x2b, 0xe6,
-		0x88, 0x6f, 0xc5, 0x10, 0x94, 0x59, 0x5a, 0x19, 0xbd, 0x68, 0x6a, 0xc9, 0x6c, 0x57, 0x2d, 0x66,
-		0x95, 0xd6, 0xe2, 0x14, 0xb7, 0x16, 0x33, 0x8d, 0xd2, 0x5f, 0x8e, 0xc2, 0x98, 0x78, 0xcb, 0xf9,
-		0x28, 0x64, 0xb1, 0xb6, 0x6d, 0xf2, 0xdb, 0xee, 0xd2, 0x6c, 0xe4, 0x78, 0x66, 0x39, 0xf6, 0xa2,
-		0xb6, 0x6d, 0x5e, 0x1c, 0x91, 0x29, 0x05, 0x7d, 0x03, 0xd6, 0xe9, 0xdb, 0xdb, 0xfc, 0x52, 0xf2,
-		0xa9, 0x83, 0x49, 0xcf, 0x13, 0xd4, 0x8b, 0x23, 0x32, 0xa3, 0x21, 0xcd, 0xd2, 0xf7, 0x6b, 0xd9,
-		0x24, 0xcd, 0x2e, 0x19, 0x5b, 0xb4, 0x59, 0x42, 0x81, 0x2e, 0x02, 0xd8, 0xd8, 0x11, 0x57, 0x19,
-		0x46, 0x29, 0xfd, 0xdd, 0x07, 0xd3, 0xaf, 0x61, 0x87, 0xb9, 0xad, 0x8b, 0x23, 0x72, 0xc1, 0x16,
-		0x05, 0xc2, 0x49, 0x37, 0x74, 0x47, 0xd1, 0xb6, 0x55, 0xdd, 0xa0, 0x67, 0xf0, 0xb1, 0x9c, 0x96,
-		0x0c, 0xdd, 0x99, 0x27, 0xe8, 0x84, 0x93, 0x2e, 0x0a, 0x44, 0x14, 0xf4, 0xcd, 0x28, 0x7f, 0x64,
-		0x15, 0x23, 0x8a, 0x67, 0x08, 0x2a, 0x11, 0x05, 0xa5, 0x41, 0x4f, 0x43, 0x91, 0x1e, 0xb7, 0x2a,
-		0x9b, 0x1d, 0x53, 0xdb, 0xe1, 0x2f, 0x4b, 0x66, 0x0e, 0x66, 0xd1, 0x24, 0x04, 0x4d, 0x82, 0x7f,
-		0x71, 0x44, 0x86, 0x4d, 0xb7, 0x84, 0x9a, 0x90, 0x67, 0xd7, 0x7e, 0x9d, 0x5d, 0xfe, 0x36, 0xf0,
-		0xce, 0x83, 0x39, 0xd1, 0x1b, 0xc0, 0xeb, 0xbb, 0x17, 0x47, 0xe4, 0x31, 0x8d, 0xfd, 0x44, 0x8b,
-		0x50, 0xc0, 0x46, 0x8b, 0x77, 0xa7, 0xc8, 0x5f, 0x51, 0x1d, 0xac, 0x17, 0x46, 0x4b, 0x74, 0x26,
-		0x8f, 0xf9, 0x6f, 0xf4, 0x04, 0xe4, 0x34, 0xb3, 0xdb, 0xd5, 0x1d, 0xfa, 0xc6, 0xb0, 0x78, 0xf6,
-		0x8e, 0x98, 0x8e, 0x50, 0xdc, 0x8b, 0x23, 0x32, 0xa7, 0x22, 0xd3, 0xd3, 0xc2, 0x1d, 0xfd, 0x2a,
-		0xb6, 0xc8, 0x60, 0xa6, 0x92, 0x4c, 0xcf, 0x02, 0xc3, 0xa7, 0xc3, 0x29, 0xb4, 0x44, 0xa1, 0x39,
-		0xc6, 0xdd, 0x8b, 0x74, 0x37, 0x14, 0x7d, 0x9a, 0x4c, 0x2c, 0x16, 0xdf, 0x81, 0x70, 0x67, 0x2f,
-		0x8a, 0x52, 0x19, 0x4a, 0x7e, 0xbd, 0x95, 0xba, 0x2e, 0x21, 0x3d, 0xc4, 0xaf, 0xc2, 0xd8, 0x55,
-		0x6c, 0xd9, 0xec, 0x04, 0x9f, 0x12, 0xf2, 0x22, 0x3a, 0x05, 0xe3, 0x54, 0x6e, 0x8a, 0xa8, 0x67,
-		0xcf, 0x92, 0x4b, 0x14, 0x78, 0x85, 0x23, 0xd5, 0xa1, 0xd8, 0x3b, 0xdb, 0x73, 0x51, 0xd8, 0xdb,
-		0x68, 0xe8, 0x9d, 0xed, 0x71, 0x04, 0xa9, 0x01, 0x95, 0xb0, 0xea, 0xfa, 0xbd, 0x66, 0x21, 0xc2,
-		0x6b, 0x16, 0x84, 0xa7, 0xfd, 0x9d, 0xb4, 0x4b, 0xec, 0x6a, 0x2b, 0x59, 0x6e, 0xc4, 0x48, 0x50,
-		0xea, 0xe2, 0xd9, 0xda, 0x40, 0x68, 0xe7, 0xfa, 0x9a, 0x66, 0x9e, 0x84, 0x22, 0x9f, 0xfa, 0xd3,
-		0x7a, 0x4a, 0xa6, 0x14, 0xe8, 0x38, 0x51, 0x28, 0x55, 0x37, 0x14, 0xbd, 0x25, 0x5e, 0x13, 0xd3,
-		0xf2, 0x52, 0x0b, 0x3d, 0x03, 0x15, 0xcd, 0x34, 0x6c, 0x6c, 0xd8, 0x7d, 0x5b, 0xe9, 0xa9, 0x96,
-		0xda, 0xf5, 0x1e, 0xdd, 0x45, 0x4f, 0xd3, 0xbc, 0x40, 0x5f, 0xa5, 0xd8, 0xf2, 0x84, 0x16, 0x04,
-		0xa0, 0x65, 0x80, 0xab, 0x6a, 0x47, 0x6f, 0xa9, 0x8e, 0x69, 0xd9, 0xfc, 0x71, 0xca, 0x30, 0x66,
-		0x57, 0x04, 0xe2, 0x46, 0xaf, 0xa5, 0x3a, 0x98, 0x07, 0x51, 0x3e, 0x7a, 0x74, 0x17, 0x4c, 0xa8,
-		0xbd, 0x9e, 0x62, 0x3b, 0xaa, 0x83, 0x95, 0xcd, 0x3d, 0x07, 0xdb, 0xd4, 0x5e, 0x94, 0xe4, 0x71,
-		0xb5, 0xd7, 0x5b, 0x23, 0xd0, 0x26, 0x01, 0x4a, 0x2d, 0x77, 0xb6, 0xe9, 0xd2, 0x74, 0x63, 0xbb,
-		0x94, 0x17, 0xdb, 0x11, 0x18, 0xbd, 0x5a, 0xc1, 0x64, 0x20, 0x6e, 0xa3, 0xe4, 0xb6, 0xb1, 0xde,
-		0xde, 0x66, 0xcf, 0xdb, 0x33, 0x32, 0x2f, 0x91, 0x89, 0xe9, 0x59, 0xe6, 0x55, 0xcc, 0x5f, 0xb6,
-		0xb3, 0x82, 0xf4, 0xf7, 0xd3, 0x30, 0x39, 0xb0, 0x7c, 0x09, 0x5f, 0x7a, 0xc1, 0x9f, 0xb7, 0x45,
-		0x7e, 0xa3, 0x73, 0x84, 0xaf, 0xda, 0xe2, 0x8f, 0x56, 0x8a, 0x67, 0x6f, 0x1b, 0x22, 0x81, 0x8b,
-		0x14, 0x89, 0x0f, 0x9c, 0x93, 0xa0, 0x0d, 0xa8, 0x74, 0x54, 0xdb, 0x51, 0xd8, 0x2a, 0x62, 0xaf,
-		0x84, 0x33, 0x07, 0x5a, 0x82, 0x65, 0x55, 0xac, 0x3e, 0xa2, 0xdc, 0x9c, 0x5d, 0xb9, 0x13, 0x80,
-		0xa2, 0xe7, 0x60, 0x7a, 0x73, 0xef, 0x25, 0xd5, 0x70, 0x74, 0x83, 0x5e, 0x36, 0x0a, 0xce, 0x51,
-		0x7d, 0x08, 0xeb, 0xc5, 0xab, 0x7a, 0x0b, 0x1b, 0x9a, 0x98, 0x9c, 0x29, 0x97, 0x85, 0x3b, 0x79,
-		0xb6, 0xf4, 0x1c, 0x94, 0x83, 0xb6, 0x08, 0x95, 0x21, 0xed, 0xec, 0x72, 0x89, 0xa4, 0x9d, 0x5d,
-		0xf4, 0x30, 0x8f, 0xc8, 0xd3, 0xf4, 0xb6, 0xdc, 0x30, 0x67, 0xc1, 0xa9, 0xbd, 0xb7, 0x84, 0x92,
-		0xe4, 0xae, 0x04, 0xd7, 0x30, 0x84, 0x79, 0x4b, 0xa7, 0x61, 0x22, 0x64, 0xc4, 0x7c, 0xd3, 0x9a,
-		0xf2, 0x4f, 0xab, 0x34, 0x01, 0xe3, 0x01, 0x5b, 0x25, 0xfd, 0x51, 0x0e, 0xf2, 0xee, 0x37, 0x0a,
-		0x2e, 0x42, 0x01, 0xef, 0x6a, 0xb8, 0xe7, 0x08, 0xab, 0x70, 0x90, 0x11, 0x67, 0x34, 0x8b, 0x02,
-		0x9f, 0x98, 0x2b, 0x97, 0x18, 0x3d, 0x16, 0x70, 0xc9, 0xa7, 0xe2, 0x98, 0xf8, 0x7d, 0xf2, 0xe3,
-		0x41, 0x9f, 0x7c, 0x47, 0x0c, 0x6d, 0xc8, 0x29, 0x3f, 0x16, 0x70, 0xca, 0x71, 0x0d, 0x07, 0xbc,
-		0xf2, 0x52, 0x84, 0x57, 0x8e, 0x1b, 0xfe, 0x10, 0xb7, 0xbc, 0x14, 0xe1, 0x96, 0x67, 0x62, 0xfb,
-		0x12, 0xe9, 0x97, 0x1f, 0x0f, 0xfa, 0xe5, 0x38, 0x71, 0x84, 0x1c, 0xf3, 0x72, 0x94, 0x63, 0x3e,
-		0x1d, 0xc3, 0x63, 0xa8, 0x67, 0x9e, 0x1f, 0xf0, 0xcc, 0x77, 0xc5, 0xb0, 0x8a, 0x70, 0xcd, 0x4b,
-		0x01, 0x9f, 0x08, 0x89, 0x64, 0x13, 0xed, 0x14, 0xd1, 0xf9, 0x41, 0x2f, 0x7f, 0x77, 0x9c, 0xaa,
-		0x45, 0xb9, 0xf9, 0x27, 0x43, 0x6e, 0xfe, 0xce, 0xb8, 0x51, 0x85, 0xfc, 0xbc, 0xe7, 0x9d, 0x4f,
-		0x13, 0xfb, 0x18, 0x5a, 0x19, 0xc4, 0x96, 0x62, 0xcb, 0x32, 0x2d, 0xee, 0xf8, 0x58, 0x41, 0x9a,
-		0x21, 0x16, 0xdb, 0xd3, 0xff, 0x03, 0x3c, 0x39, 0x5d, 0xb4, 0x3e, 0x6d, 0x97, 0xbe, 0x9a, 0xf2,
-		0x68, 0xa9, 0x65, 0xf3, 0x5b, 0xfb, 0x02, 0xb7, 0xf6, 0x3e, 0x07, 0x9f, 0x0e, 0x3a, 0xf8, 0x3a,
-		0x14, 0x89, 0x4f, 0x09, 0xf9, 0x6e, 0xb5, 0x27, 0x7c, 0x37, 0xba, 0x07, 0x26, 0xa9, 0xfd, 0x65,
-		0x61, 0x00, 0x37, 0x24, 0x59, 0x6a, 0x48, 0x26, 0x48, 0x05, 0x93, 0x20, 0x73, 0x14, 0xf7, 0xc3,
-		0x94, 0x0f, 0x97, 0xf0, 0xa5, 0xbe, 0x80, 0x39, 0xa9, 0x8a, 0x8b, 0x3d, 0xd7, 0xeb, 0x5d, 0x54,
-		0xed, 0x6d, 0x69, 0xc5, 0x13, 0x90, 0x17, 0x17, 0x20, 0xc8, 0x6a, 0x66, 0x8b, 0x8d, 0x7b, 0x5c,
-		0xa6, 0xbf, 0x49, 0xac, 0xd0, 0x31, 0xdb, 0xfc, 0x06, 0x24, 0xf9, 0x49, 0xb0, 0xdc, 0xa5, 0x5d,
-		0x60, 0x6b, 0x56, 0xfa, 0xbd, 0x94, 0xc7, 0xcf, 0x0b, 0x15, 0xa2, 0xbc, 0x7a, 0xea, 0x66, 0x7a,
-		0xf5, 0xf4, 0x5b, 0xf3, 0xea, 0xd2, 0x9b, 0x29, 0x6f, 0x4a, 0x5d, 0x7f, 0x7d, 0x63, 0x22, 0x20,
-		0xda, 0xc5, 0x5e, 0x82, 0xb3, 0x9b, 0xba, 0xac, 0x20, 0x42, 0xad, 0x5c, 0x44, 0x82, 0x62, 0xcc,
-		0x97, 0xd4, 0x40, 0x0f, 0x51, 0x3f, 0x6f, 0x6e, 0x71, 0xd3, 0x50, 0x8f, 0x49, 0xf4, 0xc8, 0x0c,
-		0xdb, 0xe7, 0x5f, 0x0a, 0x81, 0xb0, 0xe1, 0x56, 0x28, 0x90, 0xae, 0xb3, 0xe7, 0x4f, 0xc0, 0xd3,
-		0x8b, 0x02, 0x20, 0xb5, 0x00, 0x0d, 0xda, 0x18, 0x74, 0x09, 0x72, 0xf8, 0x2a, 0xbd, 0x8d, 0xca,
-		0x92, 0x4d, 0xb7, 0x0e, 0x75, 0xc4, 0xd8, 0x70, 0x9a, 0x55, 0x22, 0xcc, 0xef, 0xed, 0xd7, 0x2b,
-		0x8c, 0xe6, 0x3e, 0xb3, 0xab, 0x3b, 0xb8, 0xdb, 0x73, 0xf6, 0x64, 0xce, 0x45, 0xfa, 0x68, 0x9a,
-		0xf8, 0xc3, 0x80, 0xfd, 0x89, 0x14, 0xaf, 0x58, 0x34, 0x69, 0x5f, 0x88, 0x94, 0x4c, 0xe4, 0xb7,
-		0x01, 0xb4, 0x55, 0x5b, 0xb9, 0xa6, 0x1a, 0x0e, 0x6e, 0x71, 0xb9, 0x17, 0xda, 0xaa, 0xfd, 0x2c,
-		0x05, 0x90, 0x78, 0x93, 0x54, 0xf7, 0x6d, 0xdc, 0xa2, 0x13, 0x90, 0x91, 0xc7, 0xda, 0xaa, 0xbd,
-		0x61, 0xe3, 0x96, 0x6f, 0xac, 0x63, 0x37, 0x63, 0xac, 0x41, 0x79, 0xe7, 0xc3, 0xf2, 0xfe, 0x78,
-		0xda, 0x5b, 0x1d, 0x5e, 0xf8, 0xf0, 0xe3, 0x29, 0x8b, 0xcf, 0xd2, 0x3d, 0x45, 0xd0, 0x09, 0xa0,
-		0x0f, 0xc0, 0xa4, 0xbb, 0x2a, 0x95, 0x3e, 0x5d, 0xad, 0x42, 0x0b, 0x0f, 0xb7, 0xb8, 0x2b, 0x57,
-		0x83, 0x60, 0x1b, 0xfd, 0x0c, 0x1c, 0x0b, 0xd9, 0x20, 0xb7, 0x81, 0xf4, 0xa1, 0x4c, 0xd1, 0x91,
-		0xa0, 0x29, 0x12, 0xfc, 0x3d, 0xe9, 0x65, 0x6e, 0xca, 0xaa, 0xb9, 0x83, 0x84, 0xb0, 0x7e, 0xf7,
-		0x16, 0xa5, 0x13, 0xd2, 0x1f, 0xa7, 0x60, 0x22, 0xd4, 0x41, 0xf4, 0x28, 0x8c, 0x32, 0x0f, 0x9c,
-		0x3a, 0x30, 0x11, 0x42, 0x25, 0xce, 0xc7, 0xc4, 0x08, 0xd0, 0x1c, 0xe4, 0x31, 0x8f, 0xae, 0xb9,
-		0x50, 0xee, 0x8c, 0x09, 0xc2, 0x39, 0xbd, 0x4b, 0x86, 0x16, 0xa0, 0xe0, 0x8a, 0x3e, 0x66, 0xe7,
-		0xe6, 0xce, 0x1c, 0x67, 0xe2, 0x11, 0x4a, 0xf3, 0x50, 0xf4, 0x75, 0x8f, 0xbd, 0x05, 0xdc, 0xe5,
-		0xdb, 0x2d, 0x16, 0x40, 0xe7, 0xbb, 0xea, 0x2e, 0xdd, 0x69, 0xa1, 0x63, 0x30, 0x46, 0x2a, 0xdb,
-		0xfc, 0xb1, 0x54, 0x46, 0xce, 0x75, 0xd5, 0xdd, 0x0b, 0xaa, 0x2d, 0x7d, 0x22, 0x05, 0xe5, 0x60,
-		0x3f, 0xd1, 0xbd, 0x80, 0x08, 0xae, 0xda, 0xc6, 0x8a, 0xd1, 0xef, 0x32, 0x1f, 0x29, 0x38, 0x4e,
-		0x74, 0xd5, 0xdd, 0xb9, 0x36, 0xbe, 0xd4, 0xef, 0xd2, 0xa6, 0x6d, 0xb4, 0x02, 0x15, 0x81, 0x2c,
-		0x92, 0x5d, 0x5c, 0x2a, 0xc7, 0x07, 0xbf, 0x57, 0xc3, 0x11, 0xd8, 0x5e, 0xf7, 0x15, 0xb2, 0xd7,
-		0x2d, 0x33, 0x7e, 0xa2, 0x46, 0x7a, 0x08, 0x26, 0x42, 0x23, 0x46, 0x12, 0x8c, 0xf7, 0xfa, 0x9b,
-		0xca, 0x0e, 0xde, 0xa3, 0xcf, 0xdf, 0x99, 0xaa, 0x17, 0xe4, 0x62, 0xaf, 0xbf, 0xf9, 0x34, 0xde,
-		0xa3, 0xb9, 0x43, 0x49, 0x83, 0x72, 0x70, 0x33, 0x45, 0x1c, 0x87, 0x65, 0xf6, 0x8d, 0x96, 0xf8,
-		0xb0, 0x01, 0x2d, 0xa0, 0x73, 0x30, 0x7a, 0xd5, 0x64, 0xda, 0x7c, 0xd0, 0xee, 0xe9, 0x8a, 0xe9,
-		0x60, 0xdf, 0x96, 0x8c, 0xd1, 0x48, 0x36, 0x8c, 0x52, 0xbd, 0x8c, 0x3c, 0xa8, 0xb8, 0x02, 0xa0,
-		0x3a, 0x8e, 0xa5, 0x6f, 0xf6, 0x3d, 0xf6, 0xd5, 0xd9, 0xc1, 0xb4, 0xfe, 0xec, 0xaa, 0xaa, 0x5b,
-		0xcd, 0x5b, 0xb9, 0x66, 0x4f, 0x7b, 0x34, 0x3e, 0xed, 0xf6, 0x71, 0x92, 0xde, 0xc8, 0x42, 0x8e,
-		0x6d, 0x37, 0xd1, 0x13, 0xc1, 0xe4, 0x47, 0xf1, 0xec, 0x89, 0x61, 0xdd, 0x67, 0x58, 0xbc, 0xf7,
-		0x6e, 0x04, 0x75, 0x57, 0x38, 0xa3, 0xd0, 0x2c, 0xbe, 0xb6, 0x5f, 0x1f, 0xa3, 0xd1, 0xc7, 0xd2,
-		0x82, 0x97, 0x5e, 0x18, 0xb6, 0xbb, 0x16, 0xb9, 0x8c, 0xec, 0xa1, 0x73, 0x19, 0x17, 0x61, 0xdc,
-		0x17, 0x6e, 0xe9, 0x2d, 0xbe, 0x4f, 0x39, 0x71, 0xd0, 0xa2, 0x5b, 0x5a, 0xe0, 0xfd, 0x2f, 0xba,
-		0xe1, 0xd8, 0x52, 0x0b, 0xcd, 0x04, 0x37, 0xd9, 0x34, 0x6a, 0x63, 0xe1, 0x82, 0x6f, 0xdf, 0x4c,
-		0xdf, 0xe4, 0xdf, 0x02, 0x05, 0xfa, 0xb0, 0x99, 0xa2, 0xb0, 0xe8, 0x21, 0x4f, 0x00, 0xb4, 0xf2,
-		0x6e, 0x98, 0xf0, 0x02, 0x1b, 0x86, 0x92, 0x67, 0x5c, 0x3c, 0x30, 0x45, 0x7c, 0x00, 0xa6, 0xe9,
-		0x07, 0xf0, 0xc2, 0xd8, 0x05, 0x8a, 0x8d, 0x48, 0xdd, 0x95, 0x20, 0xc5, 0x9d, 0x50, 0xf6, 0x4c,
-		0x28, 0xc5, 0x05, 0x96, 0xfa, 0x70, 0xa1, 0x14, 0xed, 0x38, 0xe4, 0xdd, 0xb0, 0xb3, 0xc8, 0xbe,
-		0xac, 0xa7, 0xb2, 0x68, 0xd3, 0x0d, 0x64, 0x2d, 0x6c, 0xf7, 0x3b, 0x0e, 0x67, 0x52, 0xa2, 0x38,
-		0x34, 0x90, 0x95, 0x19, 0x9c, 0xe2, 0x9e, 0x82, 0x71, 0x61, 0x55, 0x18, 0xde, 0x38, 0xc5, 0x2b,
-		0x09, 0x20, 0x45, 0x3a, 0x0d, 0x95, 0x9e, 0x65, 0xf6, 0x4c, 0x1b, 0x5b, 0x8a, 0xda, 0x6a, 0x59,
-		0xd8, 0xb6, 0xab, 0x65, 0xc6, 0x4f, 0xc0, 0xe7, 0x18, 0x58, 0x7a, 0x0f, 0x8c, 0x89, 0x78, 0x7a,
-		0x1a, 0x46, 0x9b, 0xae, 0x85, 0xcc, 0xca, 0xac, 0x40, 0xfc, 0xeb, 0x5c, 0xaf, 0xc7, 0xb3, 0x6b,
-		0xe4, 0xa7, 0xd4, 0x81, 0x31, 0x3e, 0x61, 0x91, 0x39, 0x95, 0x15, 0x28, 0xf5, 0x54, 0x8b, 0x0c,
-		0xc3, 0x9f, 0x59, 0x19, 0xb6, 0x23, 0x5c, 0x55, 0x2d, 0x67, 0x0d, 0x3b, 0x81, 0x04, 0x4b, 0x91,
-		0xd2, 0x33, 0x90, 0xf4, 0x18, 0x8c, 0x07, 0x70, 0xbc, 0xef, 0x10, 0xf2, 0x85, 0x4e, 0x0b, 0x6e,
-		0x4f, 0xd2, 0x5e, 0x4f, 0xa4, 0x73, 0x50, 0x70, 0xe7, 0x8a, 0x6c, 0x34, 0x84, 0x28, 0xf8, 0x87,
-		0x0d, 0x79, 0x91, 0x26, 0x91, 0xcc, 0x6b, 0xfc, 0x13, 0x4d, 0x19, 0x99, 0x15, 0x24, 0xec, 0x33,
-		0x4c, 0xcc, 0x9b, 0xa1, 0xc7, 0x61, 0x8c, 0x1b, 0x26, 0xbe, 0x1e, 0x87, 0xa5, 0x8b, 0x56, 0xa9,
-		0xa5, 0x12, 0xe9, 0x22, 0x66, 0xb7, 0xbc, 0x66, 0xd2, 0xfe, 0x66, 0x3e, 0x04, 0x79, 0x61, 0x7c,
-		0x82, 0x5e, 0x82, 0xb5, 0x70, 0x32, 0xce, 0x4b, 0xf0, 0x46, 0x3c, 0x42, 0xa2, 0x4d, 0xb6, 0xde,
-		0x36, 0x70, 0x4b, 0xf1, 0x96, 0x20, 0x7f, 0x30, 0x3b, 0xc1, 0x2a, 0x96, 0xc5, 0xfa, 0x92, 0x1e,
-		0x80, 0x1c, 0xeb, 0x6b, 0xa4, 0x89, 0x8b, 0x72, 0xad, 0xdf, 0x49, 0x41, 0x5e, 0xb8, 0x8f, 0x48,
-		0xa2, 0xc0, 0x20, 0xd2, 0x37, 0x3a, 0x88, 0x9b, 0x6f, 0x92, 0xee, 0x03, 0x44, 0x35, 0x45, 0xb9,
-		0x6a, 0x3a, 0xba, 0xd1, 0x56, 0xd8, 0x5c, 0xf0, 0x77, 0x83, 0xb4, 0xe6, 0x0a, 0xad, 0x58, 0x25,
-		0xf0, 0x7b, 0x4e, 0x41, 0xd1, 0x97, 0xe5, 0x42, 0x63, 0x90, 0xb9, 0x84, 0xaf, 0x55, 0x46, 0x50,
-		0x11, 0xc6, 0x64, 0x4c, 0x73, 0x04, 0x95, 0xd4, 0xd9, 0x37, 0xc6, 0x60, 0x62, 0xae, 0x39, 0xbf,
-		0x34, 0xd7, 0xeb, 0x75, 0x74, 0xfe, 0x9e, 0xee, 0x32, 0x64, 0xe9, 0x3e, 0x39, 0xc1, 0xf9, 0x4e,
-		0x2d, 0x49, 0xc2, 0x09, 0xc9, 0x30, 0x4a, 0xb7, 0xd3, 0x28, 0xc9, 0xb1, 0x4f, 0x2d, 0x51, 0x1e,
-		0x8a, 0x74, 0x92, 0x2a, 0x5c, 0x82, 0xd3, 0xa0, 0x5a, 0x92, 0xe4, 0x14, 0xfa, 0x19, 0x28, 0x78,
-		0xfb, 0xe4, 0xa4, 0x67, 0x44, 0xb5, 0xc4, 0x69, 0x2b, 0xc2, 0xdf, 0xdb, 0x19, 0x24, 0x3d, 0x9a,
-		0xa8, 0x25, 0xce, 0xd7, 0xa0, 0xe7, 0x60, 0x4c, 0xec, 0xc1, 0x92, 0x9d, 0xe2, 0xd4, 0x12, 0xa6,
-		0x94, 0xc8, 0xf4, 0xb1, 0xad, 0x73, 0x92, 0xa3, 0xaa, 0x5a, 0xa2, 0xbc, 0x19, 0xda, 0x80, 0x1c,
-		0x0f, 0x7e, 0x13, 0x9d, 0xf4, 0xd4, 0x92, 0x25, 0x8a, 0x88, 0x90, 0xbd, 0xe4, 0x44, 0xd2, 0xe3,
-		0xb9, 0x5a, 0xe2, 0x84, 0x21, 0x52, 0x01, 0x7c, 0xfb, 0xe9, 0xc4, 0xe7, 0x6e, 0xb5, 0xe4, 0x89,
-		0x40, 0xf4, 0x41, 0xc8, 0xbb, 0xbb, 0xa6, 0x84, 0x27, 0x69, 0xb5, 0xa4, 0xb9, 0xb8, 0xe6, 0x46,
-		0xe2, 0x5b, 0x12, 0xf7, 0xc6, 0xde, 0x92, 0xf0, 0x0e, 0xb9, 0xdd, 0x63, 0xf0, 0xbf, 0x48, 0xc1,
-		0xf1, 0xf0, 0x71, 0xb2, 0x6a, 0xec, 0x0d, 0xb9, 0x10, 0x30, 0xe4, 0xb6, 0xc8, 0xe3, 0x90, 0x99,
-		0x33, 0xf6, 0x48, 0xb0, 0x41, 0xbf, 0xed, 0xd7, 0xb7, 0x3a, 0x22, 0x4d, 0x47, 0xca, 0x1b, 0x56,
-		0x27, 0xfa, 0xd6, 0x48, 0x23, 0xfb, 0xfd, 0xcf, 0xd7, 0x47, 0x9a, 0x3b, 0x11, 0xa3, 0x8a, 0xb9,
-		0x2b, 0x90, 0x9f, 0x33, 0xf6, 0xc4, 0x35, 0x81, 0x51, 0x3a, 0xa0, 0xc3, 0x1e, 0xff, 0xbf, 0x5e,
-		0x22, 0x9c, 0x7d, 0xdf, 0x07, 0xe6, 0x23, 0xce, 0xb1, 0xd2, 0x90, 0x13, 0xfe, 0xf8, 0x1b, 0x03,
-		0xb5, 0xe1, 0xd2, 0x94, 0x2e, 0x40, 0x76, 0xde, 0xd4, 0x69, 0xc8, 0xd3, 0xc2, 0x86, 0xd9, 0x15,
-		0x39, 0x4f, 0x5a, 0x40, 0xa7, 0x20, 0xa7, 0x76, 0xcd, 0xbe, 0xe1, 0x88, 0xa8, 0x99, 0xb8, 0x92,
-		0xff, 0xb6, 0x5f, 0xcf, 0x2c, 0x19, 0x8e, 0xcc, 0xab, 0x1a, 0xd9, 0xef, 0xbe, 0x5a, 0x4f, 0x49,
-		0x4f, 0xc1, 0xd8, 0x02, 0xd6, 0x6e, 0x84, 0xd7, 0x02, 0xd6, 0x42, 0xbc, 0x4e, 0x43, 0x7e, 0xc9,
-		0x70, 0xd8, 0x47, 0xc3, 0x6e, 0x83, 0x8c, 0x6e, 0xb0, 0x63, 0x91, 0x50, 0xfb, 0x04, 0x4e, 0x50,
-		0x17, 0xb0, 0xe6, 0xa2, 0xb6, 0xb0, 0x16, 0x46, 0x25, 0xec, 0x09, 0x5c, 0x6a, 0x42, 0xe9, 0x8a,
-		0xda, 0xe1, 0xe1, 0x1e, 0xb6, 0xd1, 0x7d, 0x50, 0x50, 0x45, 0x81, 0xee, 0xac, 0x4a, 0xcd, 0xf2,
-		0x0f, 0xf6, 0xeb, 0xe0, 0x21, 0xc9, 0x1e, 0x42, 0x23, 0xfb, 0xf2, 0x7f, 0x3f, 0x99, 0x92, 0x4c,
-		0x18, 0xbb, 0xa0, 0xda, 0xd4, 0xd2, 0x3f, 0x18, 0x48, 0xa4, 0xd0, 0x48, 0xb1, 0x79, 0xe4, 0xfa,
-		0x7e, 0x7d, 0x72, 0x4f, 0xed, 0x76, 0x1a, 0x92, 0x57, 0x27, 0xf9, 0xf3, 0x2b, 0xb3, 0xbe, 0xfc,
-		0x0a, 0x8d, 0x24, 0x9b, 0x53, 0xd7, 0xf7, 0xeb, 0x13, 0x1e, 0x0d, 0xa9, 0x91, 0xdc, 0xa4, 0x8b,
-		0xd4, 0x83, 0x1c, 0x0b, 0x7a, 0x23, 0x4f, 0x08, 0x79, 0xca, 0x27, 0xed, 0xa5, 0x7c, 0x1a, 0x87,
-		0x4a, 0x33, 0xf0, 0xb8, 0x8c, 0x51, 0x34, 0xb2, 0x1f, 0x7b, 0xb5, 0x3e, 0x22, 0x59, 0x80, 0xd6,
-		0xf4, 0x6e, 0xbf, 0xc3, 0x1e, 0x7e, 0x8b, 0xa3, 0xa6, 0x07, 0x59, 0xbf, 0x69, 0x3a, 0x89, 0x05,
-		0x64, 0x13, 0xb3, 0x5c, 0x49, 0xb9, 0x40, 0x58, 0x9c, 0xf1, 0xcd, 0xfd, 0x7a, 0x8a, 0xf6, 0x9e,
-		0xca, 0xe8, 0x2e, 0xc8, 0xb1, 0x50, 0x9e, 0xc7, 0x3f, 0x65, 0x41, 0xc3, 0xc6, 0x24, 0xf3, 0x5a,
-		0xe9, 0x09, 0x18, 0x5b, 0xb1, 0xdb, 0x0b, 0x64, 0x48, 0xc7, 0x21, 0xdf, 0xb5, 0xdb, 0x8a, 0x2f,
-		0x9a, 0x1a, 0xeb, 0xda, 0xed, 0xf5, 0x21, 0x51, 0x18, 0x9f, 0x96, 0xf7, 0x42, 0x6e, 0x7d, 0x97,
-		0x92, 0x9f, 0x72, 0xa5, 0x94, 0xf1, 0xf7, 0x91, 0x73, 0x0f, 0x10, 0x7d, 0x24, 0x03, 0xb0, 0xbe,
-		0xeb, 0x8e, 0x70, 0xc8, 0x11, 0x1c, 0x92, 0x20, 0xe7, 0xec, 0xba, 0x11, 0x75, 0xa1, 0x09, 0xaf,
-		0xed, 0xd7, 0x73, 0xeb, 0xbb, 0x64, 0x7b, 0x21, 0xf3, 0x9a, 0x60, 0x2a, 0x2b, 0x13, 0x4a, 0x65,
-		0xb9, 0x09, 0xbc, 0x6c, 0x44, 0x02, 0x6f, 0xd4, 0x77, 0x02, 0x70, 0x0c, 0xc6, 0x2c, 0xf5, 0x9a,
-		0x42, 0x66, 0x94, 0x7d, 0x85, 0x34, 0x67, 0xa9, 0xd7, 0x96, 0xcd, 0x36, 0x9a, 0x87, 0x6c, 0xc7,
-		0x6c, 0x8b, 0xbc, 0xdb, 0x51, 0x31, 0x28, 0x12, 0x71, 0xf1, 0xdb, 0xc4, 0xcb, 0x66, 0xbb, 0x79,
-		0x8c, 0xc8, 0xff, 0x4b, 0x7f, 0x5a, 0x9f, 0x08, 0xc2, 0x6d, 0x99, 0x12, 0xbb, 0xc9, 0xc0, 0xfc,
-		0xd0, 0x64, 0x60, 0xe1, 0xa0, 0x64, 0x20, 0x04, 0x93, 0x81, 0x77, 0xd0, 0x33, 0x4d, 0x76, 0x86,
-		0x33, 0x3d, 0x10, 0x7c, 0xce, 0x19, 0x7b, 0xf4, 0x14, 0xf5, 0x56, 0x28, 0xb8, 0x17, 0x85, 0xf8,
-		0x67, 0x9f, 0x3d, 0x00, 0xd7, 0xb7, 0x8f, 0xa5, 0xa0, 0x1c, 0xec, 0x31, 0xcd, 0xe7, 0xd8, 0x6d,
-		0xfe, 0xc1, 0x54, 0x96, 0xf6, 0x24, 0x4a, 0xb1, 0x24, 0x32, 0xe5, 0x21, 0x9d, 0x9f, 0x0b, 0xe9,
-		0xfc, 0x94, 0x10, 0x10, 0x7b, 0xbb, 0xc3, 0x54, 0x7d, 0x9a, 0x4b, 0xa7, 0xe4, 0x03, 0xda, 0x9e,
-		0xea, 0x53, 0x8d, 0xf8, 0x59, 0x28, 0xfa, 0x6a, 0x23, 0x83, 0xfa, 0x47, 0x22, 0x92, 0x1d, 0x93,
-		0xee, 0x84, 0x88, 0x1a, 0x71, 0x84, 0xe0, 0xa1, 0xba, 0x8a, 0x5a, 0x70, 0x91, 0x92, 0x5e, 0xaf,
-		0x68, 0x2e, 0xfc, 0xc9, 0xb7, 0x4f, 0x8c, 0xbc, 0xfc, 0xda, 0x89, 0x91, 0xa1, 0xf7, 0x33, 0xa5,
-		0xf8, 0x2f, 0xcc, 0xbb, 0x5e, 0xe6, 0xe3, 0xef, 0x87, 0x5b, 0x39, 0x8e, 0xed, 0xa8, 0x3b, 0xba,
-		0xd1, 0x16, 0x7f, 0xb9, 0xbb, 0x29, 0xf3, 0xd1, 0x70, 0xe8, 0x8d, 0xbb, 0x9d, 0xb7, 0x7a, 0x69,
-		0xac, 0x16, 0xe5, 0x0d, 0xa5, 0xfd, 0x2c, 0xa0, 0x15, 0xbb, 0x3d, 0x6f, 0x61, 0xf6, 0xb1, 0x11,
-		0xbe, 0x4f, 0x0a, 0x3e, 0xf6, 0xe1, 0x36, 0xea, 0x96, 0xd9, 0xe0, 0x58, 0xdc, 0xef, 0x46, 0x7b,
-		0x39, 0xa2, 0xc0, 0x13, 0xa1, 0x45, 0x00, 0x9a, 0x5e, 0xb1, 0x6d, 0x2f, 0x99, 0x57, 0x0f, 0xf3,
-		0x98, 0x77, 0x31, 0x64, 0xd5, 0xc1, 0xb6, 0x98, 0x6b, 0x8f, 0x10, 0x7d, 0x08, 0xa6, 0xba, 0xba,
-		0xa1, 0xd8, 0xb8, 0xb3, 0xa5, 0xb4, 0x70, 0x87, 0x7e, 0x8a, 0x85, 0x1f, 0xdc, 0x15, 0x9a, 0xcb,
-		0xdc, 0x31, 0xdd, 0x15, 0x3f, 0x67, 0xb3, 0x4b, 0x86, 0x73, 0x7d, 0xbf, 0x5e, 0x63, 0xde, 0x21,
-		0x82, 0xa5, 0x24, 0x4f, 0x76, 0x75, 0x63, 0x0d, 0x77, 0xb6, 0x16, 0x5c, 0x18, 0x7a, 0x09, 0x26,
-		0x39, 0x86, 0xe9, 0x25, 0x3d, 0x88, 0xed, 0x29, 0x35, 0x57, 0xae, 0xef, 0xd7, 0xab, 0x8c, 0xdb,
-		0x00, 0x8a, 0xf4, 0x83, 0xfd, 0xfa, 0xfd, 0x09, 0xfa, 0x34, 0xa7, 0x69, 0xc2, 0x3d, 0x56, 0x5c,
-		0x26, 0x1c, 0x42, 0xda, 0xf6, 0x12, 0xf4, 0xa2, 0xed, 0xd1, 0x70, 0xdb, 0x03, 0x28, 0x49, 0xdb,
-		0xf6, 0xb9, 0x66, 0x2f, 0x83, 0x2f, 0xda, 0x3e, 0x0a, 0xb9, 0x5e, 0x7f, 0x53, 0x9c, 0xa2, 0x15,
-		0x64, 0x5e, 0x42, 0x33, 0xfe, 0x83, 0xb4, 0xe2, 0xd9, 0x92, 0x98, 0x4f, 0x12, 0xab, 0xb8, 0x69,
-		0x4e, 0x16, 0xfb, 0xd1, 0xe8, 0xe3, 0xab, 0x19, 0xa8, 0xac, 0xd8, 0xed, 0xc5, 0x96, 0xee, 0xdc,
-		0x64, 0xf5, 0xea, 0x45, 0x49, 0x87, 0x7a, 0xb3, 0xe6, 0xfc, 0xf5, 0xfd, 0x7a, 0x99, 0x49, 0xe7,
-		0x66, 0xca, 0xa4, 0x0b, 0x13, 0x9e, 0x5e, 0x2a, 0x96, 0xea, 0x70, 0xf7, 0xd4, 0x5c, 0x48, 0xa8,
-		0x81, 0x0b, 0x58, 0xbb, 0xbe, 0x5f, 0x3f, 0xca, 0x7a, 0x16, 0x62, 0x25, 0xc9, 0x65, 0x2d, 0xb0,
-		0x16, 0xd0, 0x6e, 0xb4, 0xe2, 0xd3, 0xf3, 0xa7, 0xe6, 0xc5, 0xb7, 0x51, 0xe9, 0xf9, 0xd4, 0x7d,
-		0x25, 0x0d, 0x45, 0xe2, 0xea, 0x19, 0x1c, 0x47, 0x2f, 0x85, 0xd4, 0x8f, 0x70, 0x29, 0xa4, 0xdf,
-		0x99, 0xa5, 0x70, 0x8f, 0x1b, 0x6b, 0x67, 0x86, 0xea, 0x7c, 0x30, 0xe4, 0xfe, 0x4f, 0x19, 0x6a,
-		0x55, 0xe9, 0x0e, 0x52, 0xc6, 0xad, 0x77, 0x83, 0x00, 0x7f, 0x2e, 0x05, 0x47, 0x3c, 0xf1, 0xd8,
-		0x96, 0x16, 0x92, 0xe2, 0x33, 0xd7, 0xf7, 0xeb, 0xb7, 0x86, 0xa5, 0xe8, 0x43, 0xbb, 0x01, 0x49,
-		0x4e, 0xb9, 0x8c, 0xd6, 0x2c, 0x2d, 0xba, 0x1f, 0x2d, 0xdb, 0x71, 0xfb, 0x91, 0x19, 0xde, 0x0f,
-		0x1f, 0xda, 0x5b, 0xea, 0xc7, 0x82, 0xed, 0x0c, 0x4e, 0x6a, 0x36, 0xe1, 0xa4, 0x7e, 0x2d, 0x0d,
-		0xe3, 0x2b, 0x76, 0x7b, 0xc3, 0x68, 0xfd, 0x64, 0x41, 0x1c, 0x76, 0x41, 0x7c, 0x22, 0x05, 0xe5,
-		0x8b, 0xba, 0xed, 0x98, 0x96, 0xae, 0xa9, 0x1d, 0xba, 0x9b, 0xf1, 0xee, 0x48, 0xa6, 0x0e, 0x7f,
-		0x47, 0xf2, 0x11, 0xc8, 0x5d, 0x55, 0x3b, 0xec, 0xdf, 0x15, 0x65, 0xe8, 0x19, 0x61, 0xc8, 0x77,
-		0x84, 0x73, 0xc0, 0x1c, 0x9d, 0x77, 0xe7, 0xb7, 0xd3, 0x30, 0x11, 0x0a, 0x3c, 0x50, 0x13, 0xb2,
-		0xd4, 0xa2, 0xb3, 0x0d, 0xef, 0xec, 0x21, 0xe2, 0x0a, 0xb2, 0x27, 0xa6, 0xb4, 0xe8, 0xa7, 0x20,
-		0xdf, 0x55, 0x77, 0x99, 0x67, 0x60, 0xfb, 0x9b, 0xb9, 0xc3, 0xf1, 0xf1, 0x76, 0xaf, 0x82, 0x8f,
-		0x24, 0x8f, 0x75, 0xd5, 0x5d, 0xea, 0x0f, 0x7a, 0x30, 0x41, 0xa0, 0xda, 0xb6, 0x6a, 0xb4, 0xb1,
-		0xdf, 0xfd, 0x5c, 0x3c, 0x74, 0x23, 0x47, 0xbd, 0x46, 0x7c, 0xec, 0x24, 0x79, 0xbc, 0xab, 0xee,
-		0xce, 0x53, 0x00, 0x69, 0xb1, 0x91, 0x7f, 0xe5, 0xd5, 0xfa, 0x08, 0x95, 0xd8, 0x7f, 0x48, 0x01,
-		0x78, 0x12, 0x43, 0xeb, 0x50, 0x09, 0xb9, 0x2f, 0x71, 0xc7, 0x28, 0x36, 0xc0, 0xf3, 0x36, 0xb6,
-		0x13, 0x5a, 0x68, 0x0a, 0x3e, 0x08, 0x45, 0x76, 0x4b, 0x40, 0xa1, 0xc9, 0xf8, 0x74, 0x6c, 0x32,
-		0xfe, 0x04, 0xe1, 0x75, 0x7d, 0xbf, 0x8e, 0xd8, 0x70, 0x7c, 0xc4, 0x12, 0x4d, 0xd1, 0x03, 0x83,
-		0x10, 0x82, 0xe0, 0x58, 0x8a, 0xbe, 0xd8, 0x82, 0xde, 0x3d, 0x33, 0x0d, 0x7d, 0x07, 0x5b, 0xee,
-		0x1e, 0x99, 0x15, 0x51, 0x0d, 0xf2, 0xec, 0xab, 0x82, 0xce, 0x9e, 0xf8, 0x77, 0x15, 0xa2, 0x4c,
-		0xa8, 0xae, 0xe1, 0x4d, 0x5b, 0x17, 0xb3, 0x20, 0x8b, 0x22, 0x3a, 0x0f, 0x15, 0x1b, 0x6b, 0x7d,
-		0x4b, 0x77, 0xf6, 0x14, 0xcd, 0x34, 0x1c, 0x55, 0x73, 0xb8, 0xd3, 0xbe, 0xe5, 0xfa, 0x7e, 0xfd,
-		0x18, 0xeb, 0x6b, 0x18, 0x43, 0x92, 0x27, 0x04, 0x68, 0x9e, 0x41, 0x48, 0x0b, 0x2d, 0xec, 0xa8,
-		0x7a, 0xc7, 0xe6, 0x1b, 0x5b, 0x51, 0xf4, 0x8d, 0xe5, 0xb7, 0xc6, 0xfc, 0x87, 0x51, 0xd7, 0xa0,
-		0x62, 0xf6, 0xb0, 0x15, 0x61, 0x8f, 0x96, 0xbd, 0x96, 0xc3, 0x18, 0x37, 0x60, 0x12, 0x26, 0x04,
-		0x0f, 0x61, 0x11, 0xce, 0x07, 0xee, 0x9c, 0xb1, 0xb8, 0x31, 0x1d, 0x1e, 0x72, 0x18, 0x43, 0xf2,
-		0x5f, 0x34, 0x63, 0xd1, 0xe5, 0x51, 0xc8, 0xbd, 0xa0, 0xea, 0x1d, 0xf1, 0xa9, 0x55, 0x99, 0x97,
-		0xd0, 0x12, 0xe4, 0x6c, 0x47, 0x75, 0xfa, 0x2c, 0xf4, 0x1e, 0x6d, 0xbe, 0x27, 0x61, 0x9f, 0x9b,
-		0xa6, 0xd1, 0x5a, 0xa3, 0x84, 0x32, 0x67, 0x80, 0xce, 0x43, 0xce, 0x31, 0x77, 0xb0, 0xc1, 0x85,
-		0x7a, 0xa8, 0x95, 0x4e, 0x13, 0x75, 0x8c, 0x1a, 0x39, 0xe0, 0x19, 0x65, 0xc5, 0xde, 0x56, 0x2d,
-		0x6c, 0xb3, 0x50, 0xb9, 0xb9, 0x74, 0xe8, 0xe5, 0x78, 0x2c, 0xec, 0x29, 0x18, 0x3f, 0x49, 0x9e,
-		0x70, 0x41, 0x6b, 0x14, 0x12, 0x8e, 0x9c, 0xc7, 0x6e, 0x28, 0x72, 0x3e, 0x0f, 0x95, 0xbe, 0xb1,
-		0x69, 0x1a, 0xf4, 0xb3, 0x88, 0x3c, 0x4d, 0x93, 0x3f, 0x99, 0x9a, 0xc9, 0xf8, 0x67, 0x2b, 0x8c,
-		0x21, 0xc9, 0x13, 0x2e, 0x88, 0xdf, 0x7e, 0x6c, 0x41, 0xd9, 0xc3, 0xa2, 0x4b, 0xb6, 0x10, 0xbb,
-		0x64, 0x6f, 0xe7, 0x4b, 0xf6, 0x48, 0xb8, 0x15, 0x6f, 0xd5, 0x8e, 0xbb, 0x40, 0x42, 0x86, 0xde,
-		0x1f, 0xd8, 0x46, 0x02, 0x6f, 0x61, 0xa8, 0x95, 0x49, 0xbe, 0x83, 0x2c, 0xbe, 0x23, 0x3b, 0xc8,
-		0x46, 0xe9, 0x63, 0xaf, 0xd6, 0x47, 0xdc, 0x05, 0xfb, 0x0b, 0x69, 0xc8, 0x2d, 0x5c, 0xa1, 0xcf,
-		0x28, 0x7f, 0x4c, 0xc3, 0x07, 0x9f, 0xf5, 0x7a, 0x1f, 0x8c, 0x31, 0x59, 0xd8, 0xe8, 0x2c, 0x8c,
-		0xf6, 0xc8, 0x0f, 0x9e, 0x6b, 0x3c, 0x3a, 0xa0, 0xd2, 0x14, 0x4f, 0xec, 0x30, 0x29, 0xaa, 0xf4,
-		0x85, 0x0c, 0xc0, 0xc2, 0x95, 0x2b, 0xeb, 0x96, 0xde, 0xeb, 0x60, 0xe7, 0x27, 0xe1, 0xf5, 0xbb,
-		0x27, 0xbc, 0xf6, 0xcd, 0xf1, 0xd3, 0x50, 0xf4, 0xe6, 0xc8, 0x46, 0x8f, 0x43, 0xde, 0xe1, 0xbf,
-		0xf9, 0x54, 0xd7, 0x06, 0xa7, 0x5a, 0xa0, 0xf3, 0xe9, 0x76, 0x29, 0xa4, 0xff, 0x9a, 0x06, 0x88,
-		0x4b, 0xce, 0xfc, 0x18, 0x04, 0xe0, 0xe7, 0x21, 0xc7, 0x3d, 0x4e, 0xe6, 0x86, 0xa2, 0x55, 0x4e,
-		0xed, 0x9b, 0xa5, 0x6f, 0xa7, 0x61, 0x6a, 0x43, 0x98, 0xdd, 0x9f, 0x48, 0x18, 0x5d, 0x84, 0x31,
-		0x6c, 0x38, 0x96, 0x8e, 0x45, 0x1a, 0x7c, 0x26, 0xac, 0xa5, 0x11, 0xd2, 0xa2, 0xff, 0x2e, 0x41,
-		0xdc, 0x96, 0xe3, 0xe4, 0x3e, 0x19, 0x7f, 0x32, 0x03, 0xd5, 0x61, 0x54, 0x68, 0x1e, 0x26, 0x34,
-		0x0b, 0x53, 0x80, 0xe2, 0x3f, 0x39, 0x69, 0xd6, 0x7c, 0x19, 0xa3, 0x20, 0x82, 0x24, 0x97, 0x05,
-		0x84, 0x3b, 0xe4, 0x36, 0x4d, 0x50, 0x91, 0xa5, 0x42, 0xb0, 0x12, 0x06, 0xd1, 0x12, 0xf7, 0xc8,
-		0x5e, 0x5a, 0xca, 0xcf, 0x80, 0xb9, 0xe4, 0xb2, 0x07, 0xa5, 0x3e, 0xf9, 0x45, 0x98, 0xd0, 0x0d,
-		0xdd, 0xd1, 0xd5, 0x8e, 0xb2, 0xa9, 0x76, 0x54, 0x43, 0xbb, 0x91, 0xad, 0x08, 0xf3, 0xa6, 0xbc,
-		0xd9, 0x10, 0x3b, 0x49, 0x2e, 0x73, 0x48, 0x93, 0x01, 0xc8, 0x8c, 0x88, 0xa6, 0xb2, 0x37, 0x14,
-		0xb8, 0x09, 0x72, 0xdf, 0x8c, 0xfc, 0x62, 0x06, 0x26, 0xdd, 0xfc, 0xcc, 0x4f, 0xa6, 0x22, 0xe9,
-		0x54, 0xac, 0x00, 0x30, 0x03, 0x42, 0x3c, 0xc7, 0x0d, 0xcc, 0x06, 0x31, 0x41, 0x05, 0xc6, 0x61,
-		0xc1, 0x76, 0x7c, 0xf3, 0xf1, 0xe7, 0x19, 0x28, 0xf9, 0xe7, 0xe3, 0x27, 0x2e, 0xfd, 0x5d, 0x94,
-		0x31, 0x9b, 0xf3, 0x4c, 0x62, 0x96, 0x7f, 0x17, 0x25, 0x64, 0x12, 0x07, 0x96, 0xd2, 0x70, 0x5b,
-		0xf8, 0x97, 0x69, 0xc8, 0xf1, 0x7b, 0xd9, 0xda, 0xc0, 0x2e, 0x22, 0x15, 0x77, 0xef, 0xfb, 0xe0,
-		0x4d, 0xc4, 0x2b, 0x91, 0x9b, 0x88, 0x72, 0x57, 0xdd, 0x55, 0x02, 0xaf, 0x98, 0x52, 0x33, 0xe3,
-		0xcd, 0xe3, 0x1e, 0x97, 0x60, 0x3d, 0xcb, 0x85, 0x78, 0x77, 0x72, 0xd1, 0x23, 0x50, 0x24, 0x18,
-		0x9e, 0x57, 0x20, 0xe4, 0x47, 0xbd, 0xe4, 0x83, 0xaf, 0x52, 0x92, 0xa1, 0xab, 0xee, 0x2e, 0xb2,
-		0x02, 0x5a, 0x06, 0xb4, 0xed, 0xa6, 0xbe, 0x14, 0x4f, 0x84, 0x84, 0xfe, 0xb6, 0xeb, 0xfb, 0xf5,
-		0xe3, 0x8c, 0x7e, 0x10, 0x47, 0x92, 0x27, 0x3d, 0xa0, 0xe0, 0xf6, 0x20, 0x00, 0x19, 0x97, 0xc2,
-		0xee, 0x84, 0xb0, 0x2d, 0xac, 0xef, 0xa2, 0x84, 0x57, 0x27, 0xc9, 0x05, 0x52, 0x58, 0x20, 0xbf,
-		0x7d, 0x82, 0xff, 0xa5, 0x14, 0x20, 0xcf, 0xf7, 0xb8, 0xe7, 0xf5, 0xef, 0xa7, 0xef, 0x12, 0xc5,
-		0xce, 0x28, 0x15, 0xbd, 0xc9, 0xf2, 0xe8, 0xc4, 0x26, 0xcb, 0xb7, 0x54, 0xef, 0xf3, 0xec, 0x73,
-		0x7a, 0x68, 0x56, 0x30, 0xc2, 0x06, 0xff, 0x9b, 0x14, 0x1c, 0x1f, 0x50, 0x1c, 0xb7, 0x5f, 0x57,
-		0x00, 0x59, 0xbe, 0x4a, 0xfe, 0x1f, 0x8a, 0x52, 0xfc, 0x3f, 0xfe, 0x25, 0xd4, 0xbf, 0x49, 0x6b,
-		0xc0, 0xc6, 0xdf, 0x3c, 0x6f, 0xc2, 0x33, 0x8a, 0x29, 0x98, 0xf6, 0x37, 0xef, 0x0e, 0xe0, 0x3c,
-		0x94, 0xfc, 0xad, 0xf3, 0xae, 0xdf, 0x7a, 0x50, 0xd7, 0x79, 0xaf, 0x03, 0x74, 0x68, 0xc9, 0x5b,
-		0x7d, 0xe2, 0x9f, 0x4e, 0xc6, 0x8d, 0xde, 0xbd, 0xc8, 0x16, 0x5a, 0x85, 0xac, 0xc7, 0xff, 0x3f,
-		0x05, 0xd9, 0x55, 0xd3, 0xec, 0x20, 0x13, 0x26, 0x0d, 0xd3, 0x51, 0x88, 0xb2, 0xe0, 0x96, 0xc2,
-		0x73, 0x23, 0x2c, 0x0b, 0x3a, 0x7f, 0x38, 0xa1, 0x7c, 0x6f, 0xbf, 0x3e, 0xc8, 0x4a, 0x9e, 0x30,
-		0x4c, 0xa7, 0x49, 0x21, 0xeb, 0x2c, 0x73, 0xf2, 0x21, 0x18, 0x0f, 0x36, 0xc6, 0x32, 0x45, 0xcf,
-		0x1e, 0xba, 0xb1, 0x20, 0x9b, 0xeb, 0xfb, 0xf5, 0x69, 0x6f, 0x11, 0xb8, 0x60, 0x49, 0x2e, 0x6d,
-		0xfa, 0x5a, 0x6f, 0xe4, 0xc9, 0xe8, 0xbf, 0xff, 0x6a, 0x3d, 0xd5, 0x3c, 0x3f, 0xf4, 0x06, 0xc0,
-		0x7d, 0x07, 0x76, 0x61, 0xd7, 0x3d, 0xea, 0x0f, 0xde, 0x05, 0xf8, 0xee, 0x19, 0xa8, 0x85, 0xee,
-		0x02, 0xd0, 0x77, 0xc8, 0x43, 0x6e, 0x02, 0x1c, 0xfc, 0x0f, 0xfc, 0x87, 0x5c, 0x14, 0x38, 0xf0,
-		0xb2, 0x81, 0xb4, 0x03, 0x47, 0xe9, 0x55, 0x4e, 0xcf, 0x6c, 0x89, 0xaf, 0xc4, 0x1c, 0x75, 0x13,
-		0x68, 0x29, 0xfe, 0xff, 0xc2, 0x59, 0x36, 0xec, 0x31, 0x00, 0xaf, 0x65, 0xf7, 0xc1, 0x0d, 0xef,
-		0x29, 0xeb, 0x3d, 0xfb, 0x47, 0xfe, 0x94, 0x8d, 0xec, 0x43, 0x96, 0x7e, 0x35, 0x05, 0xc7, 0x06,
-		0x5a, 0xe3, 0x5a, 0xff, 0x64, 0xe0, 0xc1, 0x68, 0x2a, 0x59, 0x8e, 0xde, 0xff, 0xe5, 0x87, 0x46,
-		0x44, 0xbf, 0x6a, 0x51, 0xfd, 0x62, 0x0d, 0x06, 0x3a, 0xf6, 0x22, 0x1c, 0x09, 0xf6, 0x4b, 0x08,
-		0xe1, 0x39, 0x28, 0x07, 0x77, 0x0b, 0x3c, 0x94, 0x78, 0xcf, 0xe1, 0x3d, 0xe4, 0x78, 0x60, 0xc7,
-		0x20, 0x3d, 0x1b, 0x16, 0xbc, 0x2b, 0x89, 0xf7, 0x0d, 0x5e, 0xbe, 0x8f, 0x15, 0x84, 0xef, 0x6d,
-		0xd6, 0x57, 0x52, 0x70, 0x32, 0xc8, 0xd9, 0x33, 0xc2, 0xf6, 0xdb, 0x3e, 0xae, 0xb7, 0xa2, 0x1e,
-		0xff, 0x39, 0x05, 0xb7, 0x1f, 0xd0, 0x73, 0x2e, 0x1e, 0x0b, 0xa6, 0x7d, 0xd6, 0xdd, 0xe2, 0x60,
-		0xa1, 0x32, 0xd2, 0x70, 0x0f, 0xe4, 0x1a, 0xb7, 0x5b, 0xf8, 0x55, 0xa4, 0xa9, 0xc1, 0x3a, 0x5b,
-		0x9e, 0x1a, 0xb4, 0xc8, 0x6f, 0x4d, 0xb7, 0xbe, 0x9e, 0x82, 0xd3, 0xc1, 0x51, 0x45, 0xec, 0xe8,
-		0xde, 0xdd, 0x13, 0xf3, 0x6f, 0x53, 0x70, 0x4f, 0x92, 0x21, 0xf0, 0x19, 0x7a, 0x1e, 0xa6, 0xbc,
-		0xf8, 0x2a, 0x3c, 0x41, 0xa7, 0x12, 0xec, 0x8a, 0xb9, 0x52, 0x23, 0x97, 0xcb, 0xcd, 0x99, 0x89,
-		0x3f, 0x4a, 0xf1, 0x35, 0xe7, 0x9f, 0x77, 0x57, 0xec, 0xc1, 0x2d, 0xc1, 0x21, 0xc5, 0xee, 0xdb,
-		0x16, 0x8c, 0x07, 0xb6, 0x05, 0x11, 0x13, 0x9a, 0xbe, 0x49, 0x16, 0xe4, 0xe7, 0x85, 0x35, 0x8d,
-		0x08, 0xce, 0x76, 0x60, 0x2a, 0x62, 0x91, 0xb8, 0x2f, 0x4e, 0xe3, 0xd7, 0xc8, 0xd1, 0x1f, 0xec,
-		0xd7, 0x23, 0xa2, 0x3e, 0x19, 0x0d, 0x2e, 0x0f, 0xe9, 0xbf, 0xa4, 0xa0, 0x4e, 0x3b, 0x12, 0x31,
-		0x95, 0x7f, 0x93, 0x05, 0x8c, 0xb9, 0x21, 0x8d, 0x1c, 0x16, 0x17, 0xf4, 0x1c, 0xe4, 0x98, 0x96,
-		0x72, 0xd9, 0x1e, 0x42, 0xbd, 0x39, 0xa1, 0x67, 0xb0, 0x17, 0xc4, 0xb8, 0xa2, 0xed, 0xc2, 0xdb,
-		0x24, 0xbf, 0xb7, 0x60, 0x17, 0xfe, 0xb5, 0x30, 0xd8, 0xd1, 0x3d, 0xe7, 0x22, 0xfa, 0xe0, 0x5b,
-		0x36, 0xd8, 0xfc, 0x4b, 0x42, 0x6f, 0x9b, 0x65, 0x76, 0xbb, 0x1f, 0x63, 0x99, 0xdf, 0x7d, 0x33,
-		0xe0, 0x5a, 0xe6, 0x98, 0x21, 0xbc, 0xcb, 0x2d, 0xf3, 0xf5, 0x34, 0x1c, 0xa7, 0xc3, 0xf0, 0xef,
-		0x48, 0xde, 0x01, 0xc9, 0x2b, 0x80, 0x6c, 0x4b, 0x53, 0x6e, 0x96, 0xfd, 0xa8, 0xd8, 0x96, 0x76,
-		0x25, 0xe0, 0x74, 0x15, 0x40, 0x2d, 0xdb, 0x09, 0x37, 0x90, 0xb9, 0xe1, 0x06, 0x5a, 0xb6, 0x73,
-		0xe5, 0x00, 0xaf, 0x9e, 0x3d, 0x8c, 0xee, 0xfc, 0x41, 0x0a, 0x6a, 0x51, 0x42, 0xe7, 0xba, 0xa2,
-		0xc2, 0xd1, 0xc0, 0x3e, 0x3a, 0xac, 0x2e, 0x77, 0x1c, 0xb4, 0x9b, 0x0c, 0x2d, 0xdd, 0x23, 0x16,
-		0xbe, 0xd9, 0x8b, 0xf7, 0xcb, 0xc2, 0xe9, 0xb8, 0x9a, 0x3f, 0xb8, 0x85, 0x79, 0x57, 0x2e, 0xd9,
-		0xdf, 0x18, 0x30, 0xf7, 0xef, 0xb6, 0xdd, 0xd0, 0x1f, 0xa7, 0xe0, 0xc4, 0x90, 0x1e, 0xfe, 0x4d,
-		0x76, 0xe7, 0x3f, 0x3b, 0x54, 0x61, 0x6e, 0xd6, 0xd6, 0xeb, 0x41, 0xbe, 0xa0, 0x82, 0xf7, 0xd6,
-		0x7c, 0x1b, 0xea, 0xc8, 0x6f, 0xcc, 0x3d, 0x03, 0xb7, 0x44, 0x52, 0xf1, 0x3e, 0x9d, 0x85, 0xec,
-		0xb6, 0x6e, 0x3b, 0xee, 0x87, 0x17, 0x42, 0xdd, 0x09, 0x51, 0x51, 0x5c, 0x09, 0x41, 0x85, 0xb2,
-		0x5c, 0x35, 0xcd, 0x0e, 0x6f, 0x5e, 0x9a, 0x87, 0x49, 0x1f, 0x8c, 0x33, 0x9f, 0x85, 0x6c, 0xcf,
-		0x34, 0x3b, 0x9c, 0xf9, 0x74, 0x98, 0x39, 0xc1, 0xe5, 0xc3, 0xa4, 0x78, 0xd2, 0x34, 0x20, 0xc6,
-		0x84, 0x7d, 0x12, 0x84, 0xb3, 0xfe, 0x68, 0x0a, 0xa6, 0x02, 0x60, 0xf7, 0xd1, 0x52, 0x2e, 0xf0,
-		0x35, 0xa9, 0x81, 0x23, 0x7a, 0x86, 0xef, 0x3e, 0x4f, 0x67, 0xd9, 0xdd, 0xb7, 0xa0, 0xba, 0x67,
-		0xff, 0xb0, 0x24, 0x5e, 0xb9, 0x2a, 0x00, 0xbe, 0x54, 0xec, 0x5d, 0xe1, 0x96, 0xa3, 0x93, 0x1e,
-		0xb5, 0xbb, 0x63, 0xf1, 0x78, 0xcc, 0x3b, 0x82, 0x7e, 0xca, 0x7f, 0x8d, 0xea, 0xce, 0x83, 0xe9,
-		0x04, 0xfb, 0xbb, 0xe2, 0xd0, 0x5c, 0xee, 0x7f, 0x0b, 0xa6, 0xa3, 0x76, 0xc1, 0xe8, 0x81, 0x83,
-		0x39, 0x0c, 0xc6, 0x2d, 0xb5, 0xf7, 0x1c, 0x82, 0xc2, 0x6d, 0xfe, 0x95, 0x14, 0xdc, 0x76, 0xe0,
-		0x66, 0x0f, 0x3d, 0x76, 0x30, 0xdb, 0x03, 0x22, 0xa9, 0x5a, 0xe3, 0x46, 0x48, 0xdd, 0xae, 0x29,
-		0x81, 0xf3, 0xfc, 0x68, 0x89, 0x0e, 0xec, 0x3f, 0x86, 0x4c, 0xec, 0x60, 0xac, 0x29, 0x8d, 0xa0,
-		0x97, 0xa2, 0xcf, 0xb5, 0xcf, 0x44, 0x72, 0x18, 0xbe, 0xe5, 0xa9, 0x3d, 0x90, 0x9c, 0xc0, 0x3f,
-		0xed, 0x51, 0xb1, 0xf4, 0x90, 0x69, 0x3f, 0x60, 0xc3, 0x30, 0x64, 0xda, 0x0f, 0x0a, 0xd4, 0xf9,
-		0xb4, 0x1f, 0x18, 0x49, 0x0e, 0x99, 0xf6, 0x24, 0x01, 0xf4, 0x90, 0x69, 0x4f, 0x14, 0xb8, 0x4a,
-		0x23, 0x68, 0x1b, 0xc6, 0x03, 0x71, 0x0a, 0x3a, 0x1d, 0xc9, 0x2e, 0x2a, 0x80, 0xac, 0xdd, 0x93,
-		0x04, 0xd5, 0x3f, 0xff, 0x11, 0xae, 0x79, 0xc8, 0xfc, 0x0f, 0x8f, 0x3e, 0x6a, 0x0f, 0x24, 0x27,
-		0x70, 0xdb, 0xbe, 0xe6, 0x1e, 0xb5, 0xf8, 0x10, 0xd0, 0x6c, 0x42, 0x4e, 0xa2, 0xe5, 0x33, 0x89,
-		0xf1, 0xdd, 0x86, 0x77, 0x06, 0x6e, 0x5b, 0x47, 0x0b, 0x2d, 0xd2, 0xb5, 0xd5, 0xee, 0x4d, 0x84,
-		0xeb, 0x36, 0xb6, 0xc2, 0xcf, 0x11, 0x4e, 0x46, 0x92, 0xf9, 0x9c, 0x56, 0xed, 0xf6, 0x03, 0x30,
-		0x5c, 0x76, 0x6b, 0xee, 0xc1, 0xa0, 0x14, 0x8d, 0xee, 0x77, 0x56, 0xb5, 0x53, 0x07, 0xe2, 0x08,
-		0xa6, 0x37, 0x3b, 0xd5, 0xff, 0xd7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x4d, 0xac, 0xd7, 0x80,
-		0x97, 0x00, 0x00,
+		0x75, 0xd8, 0xcd, 0xee, 0x02, 0xd8, 0x7d, 0xbb, 0x00, 0x16, 0x0d, 0xdc, 0xdd, 0xde, 0xf2, 0x78,
+		0x7b, 0x9c, 0x23, 0x79, 0x1f, 0x24, 0x01, 0xea, 0x78, 0xfc, 0xb8, 0x3d, 0x89, 0x34, 0x16, 0xc0,
+		0xdd, 0x81, 0x04, 0xee, 0xc0, 0x01, 0x70, 0xa4, 0xa8, 0xd8, 0xeb, 0xc1, 0x6e, 0x63, 0x31, 0xba,
+		0xdd, 0x99, 0xe1, 0xcc, 0xec, 0x1d, 0x40, 0x49, 0x55, 0xb4, 0x25, 0x4b, 0x96, 0x63, 0x5b, 0x8a,
+		0xed, 0x38, 0xb4, 0xbe, 0xa5, 0x94, 0x23, 0x5b, 0x4e, 0x2c, 0x29, 0x76, 0xf4, 0x61, 0xeb, 0x87,
+		0x52, 0xb1, 0x1c, 0x25, 0xe5, 0x4a, 0x49, 0xb1, 0x13, 0xbb, 0x5c, 0x29, 0xc8, 0xa2, 0x54, 0x65,
+		0x47, 0x61, 0x62, 0xe5, 0x42, 0xa7, 0x5c, 0xd2, 0x8f, 0xa4, 0xfa, 0x6b, 0xbe, 0x76, 0x76, 0x67,
+		0x70, 0x3c, 0x52, 0x74, 0x89, 0xbf, 0xb0, 0xdd, 0xfd, 0xde, 0xeb, 0xd7, 0xaf, 0xbb, 0xdf, 0x7b,
+		0xfd, 0xfa, 0xf5, 0x00, 0x3e, 0x7c, 0x0e, 0x8e, 0xb6, 0x0c, 0xa3, 0xd5, 0xc6, 0x33, 0xa6, 0x65,
+		0x38, 0xc6, 0x46, 0x77, 0x73, 0xa6, 0x89, 0xed, 0x86, 0xa5, 0x99, 0x8e, 0x61, 0x4d, 0xd3, 0x3a,
+		0x34, 0xce, 0x20, 0xa6, 0x05, 0x84, 0xbc, 0x0c, 0x13, 0xe7, 0xb5, 0x36, 0x9e, 0x77, 0x01, 0x57,
+		0xb1, 0x83, 0x1e, 0x81, 0xcc, 0xa6, 0xd6, 0xc6, 0x25, 0xe9, 0x68, 0xfa, 0x44, 0xfe, 0xf4, 0x9d,
+		0xd3, 0x21, 0xa4, 0xe9, 0x20, 0xc6, 0x0a, 0xa9, 0x56, 0x28, 0x86, 0xfc, 0xdd, 0x0c, 0x4c, 0x46,
+		0xb4, 0x22, 0x04, 0x19, 0x5d, 0xed, 0x10, 0x8a, 0xd2, 0x89, 0x9c, 0x42, 0x7f, 0xa3, 0x12, 0x8c,
+		0x98, 0x6a, 0xe3, 0xaa, 0xda, 0xc2, 0xa5, 0x14, 0xad, 0x16, 0x45, 0x74, 0x04, 0xa0, 0x89, 0x4d,
+		0xac, 0x37, 0xb1, 0xde, 0xd8, 0x29, 0xa5, 0x8f, 0xa6, 0x4f, 0xe4, 0x14, 0x5f, 0x0d, 0xba, 0x07,
+		0x26, 0xcc, 0xee, 0x46, 0x5b, 0x6b, 0xd4, 0x7d, 0x60, 0x70, 0x34, 0x7d, 0x62, 0x48, 0x29, 0xb2,
+		0x86, 0x79, 0x0f, 0xf8, 0x38, 0x8c, 0x5f, 0xc7, 0xea, 0x55, 0x3f, 0x68, 0x9e, 0x82, 0x8e, 0x91,
+		0x6a, 0x1f, 0xe0, 0x1c, 0x14, 0x3a, 0xd8, 0xb6, 0xd5, 0x16, 0xae, 0x3b, 0x3b, 0x26, 0x2e, 0x65,
+		0xe8, 0xe8, 0x8f, 0xf6, 0x8c, 0x3e, 0x3c, 0xf2, 0x3c, 0xc7, 0x5a, 0xdb, 0x31, 0x31, 0x9a, 0x85,
+		0x1c, 0xd6, 0xbb, 0x1d, 0x46, 0x61, 0xa8, 0x8f, 0xfc, 0x16, 0xf4, 0x6e, 0x27, 0x4c, 0x25, 0x4b,
+		0xd0, 0x38, 0x89, 0x11, 0x1b, 0x5b, 0xd7, 0xb4, 0x06, 0x2e, 0x0d, 0x53, 0x02, 0xc7, 0x7b, 0x08,
+		0xac, 0xb2, 0xf6, 0x30, 0x0d, 0x81, 0x87, 0xe6, 0x20, 0x87, 0xb7, 0x1d, 0xac, 0xdb, 0x9a, 0xa1,
+		0x97, 0x46, 0x28, 0x91, 0xbb, 0x22, 0x66, 0x11, 0xb7, 0x9b, 0x61, 0x12, 0x1e, 0x1e, 0x7a, 0x08,
+		0x46, 0x0c, 0xd3, 0xd1, 0x0c, 0xdd, 0x2e, 0x65, 0x8f, 0x4a, 0x27, 0xf2, 0xa7, 0x0f, 0x47, 0x2e,
+		0x84, 0xcb, 0x0c, 0x46, 0x11, 0xc0, 0x68, 0x11, 0x8a, 0xb6, 0xd1, 0xb5, 0x1a, 0xb8, 0xde, 0x30,
+		0x9a, 0xb8, 0xae, 0xe9, 0x9b, 0x46, 0x29, 0x47, 0x09, 0x54, 0x7a, 0x07, 0x42, 0x01, 0xe7, 0x8c,
+		0x26, 0x5e, 0xd4, 0x37, 0x0d, 0x65, 0xcc, 0x0e, 0x94, 0xd1, 0x01, 0x18, 0xb6, 0x77, 0x74, 0x47,
+		0xdd, 0x2e, 0x15, 0xe8, 0x0a, 0xe1, 0x25, 0xf9, 0xcb, 0xc3, 0x30, 0x9e, 0x64, 0x89, 0x9d, 0x83,
+		0xa1, 0x4d, 0x32, 0xca, 0x52, 0x6a, 0x2f, 0x32, 0x60, 0x38, 0x41, 0x21, 0x0e, 0xdf, 0xa4, 0x10,
+		0x67, 0x21, 0xaf, 0x63, 0xdb, 0xc1, 0x4d, 0xb6, 0x22, 0xd2, 0x09, 0xd7, 0x14, 0x30, 0xa4, 0xde,
+		0x25, 0x95, 0xb9, 0xa9, 0x25, 0xf5, 0x34, 0x8c, 0xbb, 0x2c, 0xd5, 0x2d, 0x55, 0x6f, 0x89, 0xb5,
+		0x39, 0x13, 0xc7, 0xc9, 0xf4, 0x82, 0xc0, 0x53, 0x08, 0x9a, 0x32, 0x86, 0x03, 0x65, 0x34, 0x0f,
+		0x60, 0xe8, 0xd8, 0xd8, 0xac, 0x37, 0x71, 0xa3, 0x5d, 0xca, 0xf6, 0x91, 0xd2, 0x65, 0x02, 0xd2,
+		0x23, 0x25, 0x83, 0xd5, 0x36, 0xda, 0xe8, 0xac, 0xb7, 0xd4, 0x46, 0xfa, 0xac, 0x94, 0x65, 0xb6,
+		0xc9, 0x7a, 0x56, 0xdb, 0x3a, 0x8c, 0x59, 0x98, 0xac, 0x7b, 0xdc, 0xe4, 0x23, 0xcb, 0x51, 0x26,
+		0xa6, 0x63, 0x47, 0xa6, 0x70, 0x34, 0x36, 0xb0, 0x51, 0xcb, 0x5f, 0x44, 0xc7, 0xc0, 0xad, 0xa8,
+		0xd3, 0x65, 0x05, 0x54, 0x0b, 0x15, 0x44, 0xe5, 0x25, 0xb5, 0x83, 0xcb, 0xcf, 0xc1, 0x58, 0x50,
+		0x3c, 0x68, 0x0a, 0x86, 0x6c, 0x47, 0xb5, 0x1c, 0xba, 0x0a, 0x87, 0x14, 0x56, 0x40, 0x45, 0x48,
+		0x63, 0xbd, 0x49, 0xb5, 0xdc, 0x90, 0x42, 0x7e, 0xa2, 0x9f, 0xf0, 0x06, 0x9c, 0xa6, 0x03, 0xbe,
+		0xbb, 0x77, 0x46, 0x03, 0x94, 0xc3, 0xe3, 0x2e, 0x3f, 0x0c, 0xa3, 0x81, 0x01, 0x24, 0xed, 0x5a,
+		0x7e, 0x27, 0xec, 0x8f, 0x24, 0x8d, 0x9e, 0x86, 0xa9, 0xae, 0xae, 0xe9, 0x0e, 0xb6, 0x4c, 0x0b,
+		0x93, 0x15, 0xcb, 0xba, 0x2a, 0xfd, 0xf5, 0x48, 0x9f, 0x35, 0xb7, 0xee, 0x87, 0x66, 0x54, 0x94,
+		0xc9, 0x6e, 0x6f, 0xe5, 0xa9, 0x5c, 0xf6, 0x6f, 0x46, 0x8a, 0xcf, 0x3f, 0xff, 0xfc, 0xf3, 0x29,
+		0xf9, 0x85, 0x61, 0x98, 0x8a, 0xda, 0x33, 0x91, 0xdb, 0xf7, 0x00, 0x0c, 0xeb, 0xdd, 0xce, 0x06,
+		0xb6, 0xa8, 0x90, 0x86, 0x14, 0x5e, 0x42, 0xb3, 0x30, 0xd4, 0x56, 0x37, 0x70, 0xbb, 0x94, 0x39,
+		0x2a, 0x9d, 0x18, 0x3b, 0x7d, 0x4f, 0xa2, 0x5d, 0x39, 0xbd, 0x44, 0x50, 0x14, 0x86, 0x89, 0x1e,
+		0x85, 0x0c, 0x57, 0xd1, 0x84, 0xc2, 0xa9, 0x64, 0x14, 0xc8, 0x5e, 0x52, 0x28, 0x1e, 0xba, 0x0d,
+		0x72, 0xe4, 0x2f, 0x5b, 0x1b, 0xc3, 0x94, 0xe7, 0x2c, 0xa9, 0x20, 0xeb, 0x02, 0x95, 0x21, 0x4b,
+		0xb7, 0x49, 0x13, 0x0b, 0xd3, 0xe6, 0x96, 0xc9, 0xc2, 0x6a, 0xe2, 0x4d, 0xb5, 0xdb, 0x76, 0xea,
+		0xd7, 0xd4, 0x76, 0x17, 0xd3, 0x05, 0x9f, 0x53, 0x0a, 0xbc, 0xf2, 0x0a, 0xa9, 0x43, 0x15, 0xc8,
+		0xb3, 0x5d, 0xa5, 0xe9, 0x4d, 0xbc, 0x4d, 0xb5, 0xe7, 0x90, 0xc2, 0x36, 0xda, 0x22, 0xa9, 0x21,
+		0xdd, 0xbf, 0xdd, 0x36, 0x74, 0xb1, 0x34, 0x69, 0x17, 0xa4, 0x82, 0x76, 0xff, 0x70, 0x58, 0x71,
+		0xdf, 0x1e, 0x3d, 0xbc, 0xf0, 0x9a, 0x92, 0xbf, 0x90, 0x82, 0x0c, 0xd5, 0x17, 0xe3, 0x90, 0x5f,
+		0x7b, 0xeb, 0xca, 0x42, 0x7d, 0xfe, 0xf2, 0x7a, 0x6d, 0x69, 0xa1, 0x28, 0xa1, 0x31, 0x00, 0x5a,
+		0x71, 0x7e, 0xe9, 0xf2, 0xec, 0x5a, 0x31, 0xe5, 0x96, 0x17, 0x2f, 0xad, 0x3d, 0x74, 0xa6, 0x98,
+		0x76, 0x11, 0xd6, 0x59, 0x45, 0xc6, 0x0f, 0xf0, 0xc0, 0xe9, 0xe2, 0x10, 0x2a, 0x42, 0x81, 0x11,
+		0x58, 0x7c, 0x7a, 0x61, 0xfe, 0xa1, 0x33, 0xc5, 0xe1, 0x60, 0xcd, 0x03, 0xa7, 0x8b, 0x23, 0x68,
+		0x14, 0x72, 0xb4, 0xa6, 0x76, 0xf9, 0xf2, 0x52, 0x31, 0xeb, 0xd2, 0x5c, 0x5d, 0x53, 0x16, 0x2f,
+		0x5d, 0x28, 0xe6, 0x5c, 0x9a, 0x17, 0x94, 0xcb, 0xeb, 0x2b, 0x45, 0x70, 0x29, 0x2c, 0x2f, 0xac,
+		0xae, 0xce, 0x5e, 0x58, 0x28, 0xe6, 0x5d, 0x88, 0xda, 0x5b, 0xd7, 0x16, 0x56, 0x8b, 0x85, 0x00,
+		0x5b, 0x0f, 0x9c, 0x2e, 0x8e, 0xba, 0x5d, 0x2c, 0x5c, 0x5a, 0x5f, 0x2e, 0x8e, 0xa1, 0x09, 0x18,
+		0x65, 0x5d, 0x08, 0x26, 0xc6, 0x43, 0x55, 0x0f, 0x9d, 0x29, 0x16, 0x3d, 0x46, 0x18, 0x95, 0x89,
+		0x40, 0xc5, 0x43, 0x67, 0x8a, 0x48, 0x9e, 0x83, 0x21, 0xba, 0xba, 0x10, 0x82, 0xb1, 0xa5, 0xd9,
+		0xda, 0xc2, 0x52, 0xfd, 0xf2, 0xca, 0xda, 0xe2, 0xe5, 0x4b, 0xb3, 0x4b, 0x45, 0xc9, 0xab, 0x53,
+		0x16, 0x9e, 0x5c, 0x5f, 0x54, 0x16, 0xe6, 0x8b, 0x29, 0x7f, 0xdd, 0xca, 0xc2, 0xec, 0xda, 0xc2,
+		0x7c, 0x31, 0x2d, 0x37, 0x60, 0x2a, 0x4a, 0x4f, 0x46, 0xee, 0x0c, 0xdf, 0x14, 0xa7, 0xfa, 0x4c,
+		0x31, 0xa5, 0xd5, 0x33, 0xc5, 0xdf, 0x49, 0xc1, 0x64, 0x84, 0xad, 0x88, 0xec, 0xe4, 0x31, 0x18,
+		0x62, 0x4b, 0x94, 0x59, 0xcf, 0x93, 0x91, 0x46, 0x87, 0x2e, 0xd8, 0x1e, 0x0b, 0x4a, 0xf1, 0xfc,
+		0x1e, 0x44, 0xba, 0x8f, 0x07, 0x41, 0x48, 0xf4, 0xe8, 0xf4, 0x9f, 0xec, 0xd1, 0xe9, 0xcc, 0xec,
+		0x3d, 0x94, 0xc4, 0xec, 0xd1, 0xba, 0xbd, 0xe9, 0xf6, 0xa1, 0x08, 0xdd, 0x7e, 0x0e, 0x26, 0x7a,
+		0x08, 0x25, 0xd6, 0xb1, 0xef, 0x96, 0xa0, 0xd4, 0x4f, 0x38, 0x31, 0x9a, 0x2e, 0x15, 0xd0, 0x74,
+		0xe7, 0xc2, 0x12, 0xbc, 0xa3, 0xff, 0x24, 0xf4, 0xcc, 0xf5, 0xa7, 0x25, 0x38, 0x10, 0xed, 0x29,
+		0x46, 0xf2, 0xf0, 0x28, 0x0c, 0x77, 0xb0, 0xb3, 0x65, 0x08, 0x6f, 0xe9, 0xee, 0x08, 0x1b, 0x4c,
+		0x9a, 0xc3, 0x93, 0xcd, 0xb1, 0xd0, 0xd9, 0x30, 0xaf, 0x95, 0x7e, 0x7e, 0x6b, 0x0f, 0xa7, 0xef,
+		0x4f, 0xc1, 0xfe, 0x48, 0xe2, 0x91, 0x8c, 0xde, 0x0e, 0xa0, 0xe9, 0x66, 0xd7, 0x61, 0x1e, 0x11,
+		0x53, 0xb0, 0x39, 0x5a, 0x43, 0x95, 0x17, 0x51, 0x9e, 0x5d, 0xc7, 0x6d, 0x4f, 0xd3, 0x76, 0x60,
+		0x55, 0x14, 0xe0, 0x11, 0x8f, 0xd1, 0x0c, 0x65, 0xf4, 0x48, 0x9f, 0x91, 0xf6, 0x2c, 0xcc, 0xfb,
+		0xa1, 0xd8, 0x68, 0x6b, 0x58, 0x77, 0xea, 0xb6, 0x63, 0x61, 0xb5, 0xa3, 0xe9, 0x2d, 0x6a, 0x41,
+		0xb2, 0xd5, 0xa1, 0x4d, 0xb5, 0x6d, 0x63, 0x65, 0x9c, 0x35, 0xaf, 0x8a, 0x56, 0x82, 0x41, 0x17,
+		0x90, 0xe5, 0xc3, 0x18, 0x0e, 0x60, 0xb0, 0x66, 0x17, 0x43, 0xfe, 0x95, 0x1c, 0xe4, 0x7d, 0x7e,
+		0x35, 0xba, 0x03, 0x0a, 0x6f, 0x57, 0xaf, 0xa9, 0x75, 0x71, 0x56, 0x62, 0x92, 0xc8, 0x93, 0xba,
+		0x15, 0x56, 0x85, 0xee, 0x87, 0x29, 0x0a, 0x62, 0x74, 0x1d, 0x6c, 0xd5, 0x1b, 0x6d, 0xd5, 0xb6,
+		0xa9, 0xd0, 0xb2, 0x14, 0x14, 0x91, 0xb6, 0xcb, 0xa4, 0x69, 0x4e, 0xb4, 0xa0, 0x07, 0x61, 0x92,
+		0x62, 0x74, 0xba, 0x6d, 0x47, 0x33, 0xdb, 0xb8, 0x4e, 0x4e, 0x6f, 0x76, 0x09, 0xfc, 0x9c, 0x4d,
+		0x10, 0x88, 0x65, 0x0e, 0x40, 0x38, 0xb2, 0xd1, 0x3c, 0xdc, 0x4e, 0xd1, 0x5a, 0x58, 0xc7, 0x96,
+		0xea, 0xe0, 0x3a, 0x7e, 0xb6, 0xab, 0xb6, 0xed, 0xba, 0xaa, 0x37, 0xeb, 0x5b, 0xaa, 0xbd, 0x55,
+		0x9a, 0x22, 0x04, 0x6a, 0xa9, 0x92, 0xa4, 0x1c, 0x22, 0x80, 0x17, 0x38, 0xdc, 0x02, 0x05, 0x9b,
+		0xd5, 0x9b, 0x17, 0x55, 0x7b, 0x0b, 0x55, 0xe1, 0x00, 0xa5, 0x62, 0x3b, 0x96, 0xa6, 0xb7, 0xea,
+		0x8d, 0x2d, 0xdc, 0xb8, 0x5a, 0xef, 0x3a, 0x9b, 0x8f, 0x94, 0x6e, 0xf3, 0xf7, 0x4f, 0x39, 0x5c,
+		0xa5, 0x30, 0x73, 0x04, 0x64, 0xdd, 0xd9, 0x7c, 0x04, 0xad, 0x42, 0x81, 0x4c, 0x46, 0x47, 0x7b,
+		0x0e, 0xd7, 0x37, 0x0d, 0x8b, 0x9a, 0xc6, 0xb1, 0x08, 0xd5, 0xe4, 0x93, 0xe0, 0xf4, 0x65, 0x8e,
+		0xb0, 0x6c, 0x34, 0x71, 0x75, 0x68, 0x75, 0x65, 0x61, 0x61, 0x5e, 0xc9, 0x0b, 0x2a, 0xe7, 0x0d,
+		0x8b, 0x2c, 0xa8, 0x96, 0xe1, 0x0a, 0x38, 0xcf, 0x16, 0x54, 0xcb, 0x10, 0xe2, 0x7d, 0x10, 0x26,
+		0x1b, 0x0d, 0x36, 0x66, 0xad, 0x51, 0xe7, 0x67, 0x2c, 0xbb, 0x54, 0x0c, 0x08, 0xab, 0xd1, 0xb8,
+		0xc0, 0x00, 0xf8, 0x1a, 0xb7, 0xd1, 0x59, 0xd8, 0xef, 0x09, 0xcb, 0x8f, 0x38, 0xd1, 0x33, 0xca,
+		0x30, 0xea, 0x83, 0x30, 0x69, 0xee, 0xf4, 0x22, 0xa2, 0x40, 0x8f, 0xe6, 0x4e, 0x18, 0xed, 0x61,
+		0x98, 0x32, 0xb7, 0xcc, 0x5e, 0xbc, 0x53, 0x7e, 0x3c, 0x64, 0x6e, 0x99, 0x61, 0xc4, 0xbb, 0xe8,
+		0x81, 0xdb, 0xc2, 0x0d, 0xd5, 0xc1, 0xcd, 0xd2, 0x41, 0x3f, 0xb8, 0xaf, 0x01, 0xcd, 0x40, 0xb1,
+		0xd1, 0xa8, 0x63, 0x5d, 0xdd, 0x68, 0xe3, 0xba, 0x6a, 0x61, 0x5d, 0xb5, 0x4b, 0x15, 0x3f, 0xf0,
+		0x58, 0xa3, 0xb1, 0x40, 0x5b, 0x67, 0x69, 0x23, 0x3a, 0x05, 0x13, 0xc6, 0xc6, 0xdb, 0x1b, 0x6c,
+		0x49, 0xd6, 0x4d, 0x0b, 0x6f, 0x6a, 0xdb, 0xa5, 0x3b, 0xa9, 0x7c, 0xc7, 0x49, 0x03, 0x5d, 0x90,
+		0x2b, 0xb4, 0x1a, 0x9d, 0x84, 0x62, 0xc3, 0xde, 0x52, 0x2d, 0x93, 0xea, 0x64, 0xdb, 0x54, 0x1b,
+		0xb8, 0x74, 0x17, 0x03, 0x65, 0xf5, 0x97, 0x44, 0x35, 0xd9, 0x12, 0xf6, 0x75, 0x6d, 0xd3, 0x11,
+		0x14, 0x8f, 0xb3, 0x2d, 0x41, 0xeb, 0x38, 0xb5, 0x13, 0x50, 0x24, 0xa2, 0x08, 0x74, 0x7c, 0x82,
+		0x82, 0x8d, 0x99, 0x5b, 0xa6, 0xbf, 0xdf, 0x63, 0x30, 0x6a, 0x6e, 0xf9, 0x3b, 0x3d, 0xc9, 0x1c,
+		0x32, 0x73, 0xcb, 0xd7, 0xe3, 0x19, 0x38, 0x40, 0x80, 0x3a, 0xd8, 0x51, 0x9b, 0xaa, 0xa3, 0xfa,
+		0xa0, 0xef, 0xa5, 0xd0, 0x44, 0xee, 0xcb, 0xbc, 0x31, 0xc0, 0xa7, 0xd5, 0xdd, 0xd8, 0x71, 0x57,
+		0xd6, 0x7d, 0x8c, 0x4f, 0x52, 0x27, 0xd6, 0xd6, 0xab, 0xe6, 0x74, 0xcb, 0x55, 0x28, 0xf8, 0x17,
+		0x3e, 0xca, 0x01, 0x5b, 0xfa, 0x45, 0x89, 0x78, 0x41, 0x73, 0x97, 0xe7, 0x89, 0xff, 0xf2, 0xcc,
+		0x42, 0x31, 0x45, 0xfc, 0xa8, 0xa5, 0xc5, 0xb5, 0x85, 0xba, 0xb2, 0x7e, 0x69, 0x6d, 0x71, 0x79,
+		0xa1, 0x98, 0xf6, 0x39, 0xec, 0x8f, 0x67, 0xb2, 0x77, 0x17, 0x8f, 0xcb, 0xdf, 0x4c, 0xc1, 0x58,
+		0xf0, 0x04, 0x86, 0xde, 0x0c, 0x07, 0x45, 0xb8, 0xc4, 0xc6, 0x4e, 0xfd, 0xba, 0x66, 0xd1, 0x1d,
+		0xd9, 0x51, 0x99, 0x75, 0x74, 0xd7, 0xc4, 0x14, 0x87, 0x5a, 0xc5, 0xce, 0x53, 0x9a, 0x45, 0xf6,
+		0x5b, 0x47, 0x75, 0xd0, 0x12, 0x54, 0x74, 0xa3, 0x6e, 0x3b, 0xaa, 0xde, 0x54, 0xad, 0x66, 0xdd,
+		0x0b, 0x54, 0xd5, 0xd5, 0x46, 0x03, 0xdb, 0xb6, 0xc1, 0x2c, 0xa1, 0x4b, 0xe5, 0xb0, 0x6e, 0xac,
+		0x72, 0x60, 0xcf, 0x44, 0xcc, 0x72, 0xd0, 0xd0, 0xfa, 0x4d, 0xf7, 0x5b, 0xbf, 0xb7, 0x41, 0xae,
+		0xa3, 0x9a, 0x75, 0xac, 0x3b, 0xd6, 0x0e, 0xf5, 0xbb, 0xb3, 0x4a, 0xb6, 0xa3, 0x9a, 0x0b, 0xa4,
+		0xfc, 0x9a, 0x1c, 0x7f, 0x1e, 0xcf, 0x64, 0xb3, 0xc5, 0xdc, 0xe3, 0x99, 0x6c, 0xae, 0x08, 0xf2,
+		0x8b, 0x69, 0x28, 0xf8, 0xfd, 0x70, 0x72, 0xac, 0x69, 0x50, 0x93, 0x25, 0x51, 0xa5, 0x76, 0x6c,
+		0xa0, 0xd7, 0x3e, 0x3d, 0x47, 0x6c, 0x59, 0x75, 0x98, 0x79, 0xc7, 0x0a, 0xc3, 0x24, 0x7e, 0x04,
+		0x59, 0x6c, 0x98, 0x79, 0x23, 0x59, 0x85, 0x97, 0xd0, 0x05, 0x18, 0x7e, 0xbb, 0x4d, 0x69, 0x0f,
+		0x53, 0xda, 0x77, 0x0e, 0xa6, 0xfd, 0xf8, 0x2a, 0x25, 0x9e, 0x7b, 0x7c, 0xb5, 0x7e, 0xe9, 0xb2,
+		0xb2, 0x3c, 0xbb, 0xa4, 0x70, 0x74, 0x74, 0x08, 0x32, 0x6d, 0xf5, 0xb9, 0x9d, 0xa0, 0xd5, 0xa3,
+		0x55, 0x49, 0x27, 0xe1, 0x10, 0x64, 0x48, 0xe0, 0x2d, 0x68, 0x6b, 0x68, 0xd5, 0xab, 0xb8, 0x19,
+		0x66, 0x60, 0x88, 0xca, 0x0b, 0x01, 0x70, 0x89, 0x15, 0xf7, 0xa1, 0x2c, 0x64, 0xe6, 0x2e, 0x2b,
+		0x64, 0x43, 0x14, 0xa1, 0xc0, 0x6a, 0xeb, 0x2b, 0x8b, 0x0b, 0x73, 0x0b, 0xc5, 0x94, 0xfc, 0x20,
+		0x0c, 0x33, 0x21, 0x90, 0xcd, 0xe2, 0x8a, 0xa1, 0xb8, 0x8f, 0x17, 0x39, 0x0d, 0x49, 0xb4, 0xae,
+		0x2f, 0xd7, 0x16, 0x94, 0x62, 0x2a, 0x38, 0xd5, 0x99, 0xe2, 0x90, 0x6c, 0x43, 0xc1, 0xef, 0x88,
+		0xbf, 0x36, 0x87, 0xec, 0xaf, 0x4a, 0x90, 0xf7, 0x39, 0xd6, 0xc4, 0x23, 0x52, 0xdb, 0x6d, 0xe3,
+		0x7a, 0x5d, 0x6d, 0x6b, 0xaa, 0xcd, 0x97, 0x06, 0xd0, 0xaa, 0x59, 0x52, 0x93, 0x74, 0xea, 0x5e,
+		0xa3, 0x2d, 0x32, 0x54, 0x1c, 0x96, 0x3f, 0x26, 0x41, 0x31, 0xec, 0xd9, 0x86, 0xd8, 0x94, 0x7e,
+		0x94, 0x6c, 0xca, 0x1f, 0x91, 0x60, 0x2c, 0xe8, 0xce, 0x86, 0xd8, 0xbb, 0xe3, 0x47, 0xca, 0xde,
+		0x5f, 0xa5, 0x60, 0x34, 0xe0, 0xc4, 0x26, 0xe5, 0xee, 0x59, 0x98, 0xd0, 0x9a, 0xb8, 0x63, 0x1a,
+		0x0e, 0x09, 0x8a, 0xd7, 0xdb, 0xf8, 0x1a, 0x6e, 0x97, 0x64, 0xaa, 0x34, 0x66, 0x06, 0xbb, 0xc9,
+		0xd3, 0x8b, 0x1e, 0xde, 0x12, 0x41, 0xab, 0x4e, 0x2e, 0xce, 0x2f, 0x2c, 0xaf, 0x5c, 0x5e, 0x5b,
+		0xb8, 0x34, 0xf7, 0xd6, 0xfa, 0xfa, 0xa5, 0x27, 0x2e, 0x5d, 0x7e, 0xea, 0x92, 0x52, 0xd4, 0x42,
+		0x60, 0xaf, 0xe2, 0xb6, 0x5f, 0x81, 0x62, 0x98, 0x29, 0x74, 0x10, 0xa2, 0xd8, 0x2a, 0xee, 0x43,
+		0x93, 0x30, 0x7e, 0xe9, 0x72, 0x7d, 0x75, 0x71, 0x7e, 0xa1, 0xbe, 0x70, 0xfe, 0xfc, 0xc2, 0xdc,
+		0xda, 0x2a, 0x0b, 0x7c, 0xb8, 0xd0, 0x6b, 0x81, 0x0d, 0x2e, 0x7f, 0x28, 0x0d, 0x93, 0x11, 0x9c,
+		0xa0, 0x59, 0x7e, 0x64, 0x61, 0xa7, 0xa8, 0xfb, 0x92, 0x70, 0x3f, 0x4d, 0x7c, 0x86, 0x15, 0xd5,
+		0x72, 0xf8, 0x09, 0xe7, 0x24, 0x10, 0x29, 0xe9, 0x8e, 0xb6, 0xa9, 0x61, 0x8b, 0xc7, 0x89, 0xd8,
+		0x39, 0x66, 0xdc, 0xab, 0x67, 0xa1, 0xa2, 0x7b, 0x01, 0x99, 0x86, 0xad, 0x39, 0xda, 0x35, 0x12,
+		0x6a, 0x17, 0x41, 0x25, 0x72, 0xae, 0xc9, 0x28, 0x45, 0xd1, 0xb2, 0xa8, 0x3b, 0x2e, 0xb4, 0x8e,
+		0x5b, 0x6a, 0x08, 0x9a, 0x28, 0xf3, 0xb4, 0x52, 0x14, 0x2d, 0x2e, 0xf4, 0x1d, 0x50, 0x68, 0x1a,
+		0x5d, 0xe2, 0xec, 0x31, 0x38, 0x62, 0x3b, 0x24, 0x25, 0xcf, 0xea, 0x5c, 0x10, 0xee, 0xc6, 0x7b,
+		0xd1, 0xac, 0x82, 0x92, 0x67, 0x75, 0x0c, 0xe4, 0x38, 0x8c, 0xab, 0xad, 0x96, 0x45, 0x88, 0x0b,
+		0x42, 0xec, 0x60, 0x32, 0xe6, 0x56, 0x53, 0xc0, 0xf2, 0xe3, 0x90, 0x15, 0x72, 0x20, 0xa6, 0x9a,
+		0x48, 0xa2, 0x6e, 0xb2, 0xd3, 0x76, 0x8a, 0x04, 0xb8, 0x74, 0xd1, 0x78, 0x07, 0x14, 0x34, 0xbb,
+		0xee, 0x05, 0xe7, 0x53, 0x47, 0x53, 0x27, 0xb2, 0x4a, 0x5e, 0xb3, 0xdd, 0xc0, 0xa6, 0xfc, 0xe9,
+		0x14, 0x8c, 0x05, 0x2f, 0x17, 0xd0, 0x3c, 0x64, 0xdb, 0x46, 0x43, 0xa5, 0x4b, 0x8b, 0xdd, 0x6c,
+		0x9d, 0x88, 0xb9, 0x8f, 0x98, 0x5e, 0xe2, 0xf0, 0x8a, 0x8b, 0x59, 0xfe, 0x4f, 0x12, 0x64, 0x45,
+		0x35, 0x3a, 0x00, 0x19, 0x53, 0x75, 0xb6, 0x28, 0xb9, 0xa1, 0x5a, 0xaa, 0x28, 0x29, 0xb4, 0x4c,
+		0xea, 0x6d, 0x53, 0xd5, 0x4b, 0x29, 0xaf, 0x9e, 0x94, 0xc9, 0xbc, 0xb6, 0xb1, 0xda, 0xa4, 0xa7,
+		0x1e, 0xa3, 0xd3, 0xc1, 0xba, 0x63, 0x8b, 0x79, 0xe5, 0xf5, 0x73, 0xbc, 0x9a, 0xdc, 0x71, 0x39,
+		0x96, 0xaa, 0xb5, 0x03, 0xb0, 0x19, 0x0a, 0x5b, 0x14, 0x0d, 0x2e, 0x70, 0x15, 0x0e, 0x09, 0xba,
+		0x4d, 0xec, 0xa8, 0x8d, 0x2d, 0xdc, 0xf4, 0x90, 0x86, 0x69, 0x74, 0xe3, 0x20, 0x07, 0x98, 0xe7,
+		0xed, 0x02, 0x57, 0xfe, 0xa6, 0x04, 0x13, 0xe2, 0x9c, 0xd6, 0x74, 0x85, 0xb5, 0x0c, 0xa0, 0xea,
+		0xba, 0xe1, 0xf8, 0xc5, 0xd5, 0xbb, 0x94, 0x7b, 0xf0, 0xa6, 0x67, 0x5d, 0x24, 0xc5, 0x47, 0xa0,
+		0xdc, 0x01, 0xf0, 0x5a, 0xfa, 0x8a, 0xad, 0x02, 0x79, 0x7e, 0x73, 0x44, 0xaf, 0x1f, 0xd9, 0xc9,
+		0x1e, 0x58, 0x15, 0x39, 0xd0, 0x91, 0xf8, 0xcb, 0x06, 0x6e, 0x69, 0x3a, 0x8f, 0x07, 0xb3, 0x82,
+		0x88, 0xbf, 0x64, 0xdc, 0xf8, 0x4b, 0xed, 0x03, 0x12, 0x4c, 0x36, 0x8c, 0x4e, 0x98, 0xdf, 0x5a,
+		0x31, 0x14, 0x5e, 0xb0, 0x2f, 0x4a, 0xcf, 0x3c, 0xda, 0xd2, 0x9c, 0xad, 0xee, 0xc6, 0x74, 0xc3,
+		0xe8, 0xcc, 0xb4, 0x8c, 0xb6, 0xaa, 0xb7, 0xbc, 0xfb, 0x53, 0xfa, 0xa3, 0x71, 0x5f, 0x0b, 0xeb,
+		0xf7, 0xb5, 0x0c, 0xdf, 0x6d, 0xea, 0x39, 0xef, 0xe7, 0xdf, 0x4b, 0xd2, 0xa7, 0x52, 0xe9, 0x0b,
+		0x2b, 0xb5, 0xcf, 0xa4, 0xca, 0x17, 0x58, 0x77, 0x2b, 0x42, 0x3c, 0x0a, 0xde, 0x6c, 0xe3, 0x06,
+		0x19, 0x32, 0x7c, 0xef, 0x1e, 0x98, 0x6a, 0x19, 0x2d, 0x83, 0x52, 0x9c, 0x21, 0xbf, 0xf8, 0x8d,
+		0x6c, 0xce, 0xad, 0x2d, 0xc7, 0x5e, 0xdf, 0x56, 0x2f, 0xc1, 0x24, 0x07, 0xae, 0xd3, 0x2b, 0x21,
+		0x76, 0xb0, 0x41, 0x03, 0xc3, 0x6a, 0xa5, 0xcf, 0x7f, 0x97, 0x1a, 0x74, 0x65, 0x82, 0xa3, 0x92,
+		0x36, 0x76, 0xf6, 0xa9, 0x2a, 0xb0, 0x3f, 0x40, 0x8f, 0x6d, 0x5b, 0x6c, 0xc5, 0x50, 0xfc, 0x1a,
+		0xa7, 0x38, 0xe9, 0xa3, 0xb8, 0xca, 0x51, 0xab, 0x73, 0x30, 0xba, 0x17, 0x5a, 0x7f, 0xcc, 0x69,
+		0x15, 0xb0, 0x9f, 0xc8, 0x05, 0x18, 0xa7, 0x44, 0x1a, 0x5d, 0xdb, 0x31, 0x3a, 0x54, 0x27, 0x0e,
+		0x26, 0xf3, 0xef, 0xbf, 0xcb, 0xf6, 0xd1, 0x18, 0x41, 0x9b, 0x73, 0xb1, 0xaa, 0x55, 0xa0, 0xb7,
+		0x60, 0xe4, 0x76, 0x2a, 0x86, 0xc2, 0xd7, 0x39, 0x23, 0x2e, 0x7c, 0xf5, 0x0a, 0x4c, 0x91, 0xdf,
+		0x54, 0x65, 0xf9, 0x39, 0x89, 0x8f, 0xc1, 0x95, 0xbe, 0xf9, 0x6e, 0xb6, 0x55, 0x27, 0x5d, 0x02,
+		0x3e, 0x9e, 0x7c, 0xb3, 0xd8, 0xc2, 0x8e, 0x83, 0x2d, 0xbb, 0xae, 0xb6, 0xa3, 0xd8, 0xf3, 0x05,
+		0x31, 0x4a, 0xbf, 0xf1, 0x52, 0x70, 0x16, 0x2f, 0x30, 0xcc, 0xd9, 0x76, 0xbb, 0xba, 0x0e, 0x07,
+		0x23, 0x56, 0x45, 0x02, 0x9a, 0x1f, 0xe2, 0x34, 0xa7, 0x7a, 0x56, 0x06, 0x21, 0xbb, 0x02, 0xa2,
+		0xde, 0x9d, 0xcb, 0x04, 0x34, 0x3f, 0xcc, 0x69, 0x22, 0x8e, 0x2b, 0xa6, 0x94, 0x50, 0x7c, 0x1c,
+		0x26, 0xae, 0x61, 0x6b, 0xc3, 0xb0, 0x79, 0xe0, 0x28, 0x01, 0xb9, 0x8f, 0x70, 0x72, 0xe3, 0x1c,
+		0x91, 0x46, 0x92, 0x08, 0xad, 0xb3, 0x90, 0xdd, 0x54, 0x1b, 0x38, 0x01, 0x89, 0x8f, 0x72, 0x12,
+		0x23, 0x04, 0x9e, 0xa0, 0xce, 0x42, 0xa1, 0x65, 0x70, 0xab, 0x15, 0x8f, 0xfe, 0x31, 0x8e, 0x9e,
+		0x17, 0x38, 0x9c, 0x84, 0x69, 0x98, 0xdd, 0x36, 0x31, 0x69, 0xf1, 0x24, 0x3e, 0x2e, 0x48, 0x08,
+		0x1c, 0x4e, 0x62, 0x0f, 0x62, 0xfd, 0x84, 0x20, 0x61, 0xfb, 0xe4, 0xf9, 0x18, 0xb9, 0x26, 0x6a,
+		0xef, 0x18, 0x7a, 0x12, 0x26, 0x3e, 0xc9, 0x29, 0x00, 0x47, 0x21, 0x04, 0xce, 0x41, 0x2e, 0xe9,
+		0x44, 0xfc, 0xe6, 0x4b, 0x62, 0x7b, 0x88, 0x19, 0xb8, 0x00, 0xe3, 0x42, 0x41, 0x91, 0x6b, 0xe5,
+		0x78, 0x12, 0xff, 0x82, 0x93, 0x18, 0xf3, 0xa1, 0xf1, 0x61, 0x38, 0xd8, 0x76, 0x5a, 0x38, 0x09,
+		0x91, 0x4f, 0x8b, 0x61, 0x70, 0x14, 0x2e, 0xca, 0x0d, 0xac, 0x37, 0xb6, 0x92, 0x51, 0xf8, 0x2d,
+		0x21, 0x4a, 0x81, 0x43, 0x48, 0xcc, 0xc1, 0x68, 0x47, 0xb5, 0xec, 0x2d, 0xb5, 0x9d, 0x68, 0x3a,
+		0x7e, 0x9b, 0xd3, 0x28, 0xb8, 0x48, 0x5c, 0x22, 0x5d, 0x7d, 0x2f, 0x64, 0x3e, 0x23, 0x24, 0xd2,
+		0xd5, 0x03, 0x84, 0x56, 0x60, 0xca, 0x76, 0x68, 0x94, 0x6d, 0x2f, 0xd4, 0x7e, 0x47, 0x6c, 0x3d,
+		0x86, 0xbb, 0xec, 0xa7, 0x78, 0x0e, 0x72, 0xb6, 0xf6, 0x5c, 0x22, 0x32, 0xff, 0x52, 0xcc, 0x34,
+		0x45, 0x20, 0xc8, 0x6f, 0x85, 0x43, 0x91, 0x66, 0x22, 0x01, 0xb1, 0x7f, 0xc5, 0x89, 0x1d, 0x88,
+		0x30, 0x15, 0x5c, 0x25, 0xec, 0x95, 0xe4, 0xef, 0x0a, 0x95, 0x80, 0x43, 0xb4, 0x56, 0xc8, 0x39,
+		0xc2, 0x56, 0x37, 0xf7, 0x26, 0xb5, 0xcf, 0x0a, 0xa9, 0x31, 0xdc, 0x80, 0xd4, 0xd6, 0xe0, 0x00,
+		0xa7, 0xb8, 0xb7, 0x79, 0xfd, 0x9c, 0x50, 0xac, 0x0c, 0x7b, 0x3d, 0x38, 0xbb, 0x6f, 0x83, 0xb2,
+		0x2b, 0x4e, 0xe1, 0xb0, 0xda, 0x75, 0x12, 0x99, 0x8a, 0xa7, 0xfc, 0x79, 0x4e, 0x59, 0x68, 0x7c,
+		0xd7, 0xe3, 0xb5, 0x97, 0x55, 0x93, 0x10, 0x7f, 0x1a, 0x4a, 0x82, 0x78, 0x57, 0xb7, 0x70, 0xc3,
+		0x68, 0xe9, 0xda, 0x73, 0xb8, 0x99, 0x80, 0xf4, 0xbf, 0x0e, 0x4d, 0xd5, 0xba, 0x0f, 0x9d, 0x50,
+		0x5e, 0x84, 0xa2, 0xeb, 0xab, 0xd4, 0xb5, 0x8e, 0x69, 0x58, 0x4e, 0x0c, 0xc5, 0xdf, 0x13, 0x33,
+		0xe5, 0xe2, 0x2d, 0x52, 0xb4, 0xea, 0x02, 0x8c, 0xd1, 0x62, 0xd2, 0x25, 0xf9, 0xfb, 0x9c, 0xd0,
+		0xa8, 0x87, 0xc5, 0x15, 0x47, 0xc3, 0xe8, 0x98, 0xaa, 0x95, 0x44, 0xff, 0xfd, 0x1b, 0xa1, 0x38,
+		0x38, 0x0a, 0x57, 0x1c, 0x24, 0xaa, 0x45, 0xac, 0x7d, 0x02, 0x0a, 0x5f, 0x10, 0x8a, 0x43, 0xe0,
+		0x70, 0x12, 0xc2, 0x61, 0x48, 0x40, 0xe2, 0x8b, 0x82, 0x84, 0xc0, 0x21, 0x24, 0x9e, 0xf4, 0x0c,
+		0xad, 0x85, 0x5b, 0x9a, 0xed, 0x58, 0xcc, 0x4d, 0x1e, 0x4c, 0xea, 0x4b, 0x2f, 0x05, 0x9d, 0x30,
+		0xc5, 0x87, 0x4a, 0x34, 0x11, 0x0f, 0xbb, 0xd2, 0x53, 0x54, 0x3c, 0x63, 0x5f, 0x16, 0x9a, 0xc8,
+		0x87, 0x46, 0x78, 0xf3, 0x79, 0x88, 0x44, 0xec, 0x0d, 0x72, 0x76, 0x48, 0x40, 0xee, 0x0f, 0x42,
+		0xcc, 0xad, 0x0a, 0x5c, 0x42, 0xd3, 0xe7, 0xff, 0x74, 0xf5, 0xab, 0x78, 0x27, 0xd1, 0xea, 0xfc,
+		0xc3, 0x90, 0xff, 0xb3, 0xce, 0x30, 0x99, 0x0e, 0x19, 0x0f, 0xf9, 0x53, 0x28, 0x2e, 0x7f, 0xa8,
+		0xf4, 0x33, 0x2f, 0xf3, 0xf1, 0x06, 0xdd, 0xa9, 0xea, 0x12, 0x14, 0x79, 0x8d, 0xe7, 0xc0, 0xc6,
+		0x12, 0x7b, 0xf7, 0xcb, 0xee, 0x3a, 0x0f, 0xf8, 0x3c, 0xd5, 0xf3, 0x30, 0x1a, 0x70, 0x78, 0xe2,
+		0x49, 0xbd, 0x87, 0x93, 0x2a, 0xf8, 0xfd, 0x9d, 0xea, 0x83, 0x90, 0x21, 0xce, 0x4b, 0x3c, 0xfa,
+		0xcf, 0x71, 0x74, 0x0a, 0x5e, 0x7d, 0x0b, 0x64, 0x85, 0xd3, 0x12, 0x8f, 0xfa, 0x5e, 0x8e, 0xea,
+		0xa2, 0x10, 0x74, 0xe1, 0xb0, 0xc4, 0xa3, 0xbf, 0x4f, 0xa0, 0x0b, 0x14, 0x82, 0x9e, 0x5c, 0x84,
+		0x5f, 0xfd, 0xc7, 0x19, 0x86, 0x2e, 0x50, 0xaa, 0xe4, 0xea, 0x9b, 0x79, 0x2a, 0xf1, 0xd8, 0xef,
+		0xe7, 0x9d, 0x0b, 0x8c, 0xea, 0xc3, 0x30, 0x94, 0x50, 0xe0, 0xbf, 0xc4, 0x51, 0x19, 0x7c, 0x75,
+		0x0e, 0xf2, 0x3e, 0xef, 0x24, 0x1e, 0xfd, 0x97, 0x39, 0xba, 0x1f, 0x8b, 0xb0, 0xce, 0xbd, 0x93,
+		0x78, 0x02, 0x1f, 0x10, 0xac, 0x73, 0x0c, 0x22, 0x36, 0xe1, 0x98, 0xc4, 0x63, 0x7f, 0x50, 0x48,
+		0x5d, 0xa0, 0x54, 0x1f, 0x83, 0x9c, 0x6b, 0x6c, 0xe2, 0xf1, 0xff, 0x09, 0xc7, 0xf7, 0x70, 0x88,
+		0x04, 0xba, 0xfa, 0x1e, 0x48, 0xfc, 0x8a, 0x90, 0x80, 0x0f, 0x8b, 0x6c, 0xa3, 0xb0, 0x03, 0x13,
+		0x4f, 0xe9, 0x57, 0xc5, 0x36, 0x0a, 0xf9, 0x2f, 0x64, 0x36, 0xa9, 0xce, 0x8f, 0x27, 0xf1, 0x6b,
+		0x62, 0x36, 0x29, 0x3c, 0x61, 0x23, 0xec, 0x11, 0xc4, 0xd3, 0xf8, 0x67, 0x82, 0x8d, 0x90, 0x43,
+		0x50, 0x5d, 0x01, 0xd4, 0xeb, 0x0d, 0xc4, 0xd3, 0x7b, 0x81, 0xd3, 0x9b, 0xe8, 0x71, 0x06, 0xaa,
+		0x4f, 0xc1, 0x81, 0x68, 0x4f, 0x20, 0x9e, 0xea, 0x6f, 0xbc, 0x1c, 0x3a, 0xbb, 0xf9, 0x1d, 0x81,
+		0xea, 0x1a, 0x4c, 0x45, 0x79, 0x01, 0xf1, 0x64, 0x3f, 0xf4, 0x72, 0x50, 0x71, 0xfb, 0x9d, 0x80,
+		0xea, 0x2c, 0x80, 0x67, 0x80, 0xe3, 0x69, 0x7d, 0x84, 0xd3, 0xf2, 0x21, 0x91, 0xad, 0xc1, 0xed,
+		0x6f, 0x3c, 0xfe, 0x47, 0xc5, 0xd6, 0xe0, 0x18, 0x64, 0x6b, 0x08, 0xd3, 0x1b, 0x8f, 0xfd, 0x31,
+		0xb1, 0x35, 0x04, 0x0a, 0x59, 0xd9, 0x3e, 0xeb, 0x16, 0x4f, 0xe1, 0x93, 0x62, 0x65, 0xfb, 0xb0,
+		0xaa, 0x97, 0x60, 0xa2, 0xc7, 0x20, 0xc6, 0x93, 0xfa, 0x14, 0x27, 0x55, 0x0c, 0xdb, 0x43, 0xbf,
+		0xf1, 0xe2, 0xc6, 0x30, 0x9e, 0xda, 0x3f, 0x0f, 0x19, 0x2f, 0x6e, 0x0b, 0xab, 0xe7, 0x20, 0xab,
+		0x77, 0xdb, 0x6d, 0xb2, 0x79, 0xd0, 0xe0, 0x9c, 0xbf, 0xd2, 0x7f, 0xff, 0x21, 0x97, 0x8e, 0x40,
+		0xa8, 0x3e, 0x08, 0x43, 0xb8, 0xb3, 0x81, 0x9b, 0x71, 0x98, 0xdf, 0xfb, 0xa1, 0x50, 0x98, 0x04,
+		0xba, 0xfa, 0x18, 0x00, 0x0b, 0x8d, 0xd0, 0xeb, 0xc1, 0x18, 0xdc, 0xff, 0xf1, 0x43, 0x9e, 0x8d,
+		0xe3, 0xa1, 0x78, 0x04, 0x58, 0x6e, 0xcf, 0x60, 0x02, 0x2f, 0x05, 0x09, 0xd0, 0x19, 0x39, 0x0b,
+		0x23, 0x24, 0xf5, 0xd1, 0x51, 0x5b, 0x71, 0xd8, 0xff, 0x93, 0x63, 0x0b, 0x78, 0x22, 0xb0, 0x8e,
+		0x61, 0x61, 0x47, 0x6d, 0xd9, 0x71, 0xb8, 0xff, 0x8b, 0xe3, 0xba, 0x08, 0x04, 0xb9, 0xa1, 0xda,
+		0x4e, 0x92, 0x71, 0xff, 0xad, 0x40, 0x16, 0x08, 0x84, 0x69, 0xf2, 0xfb, 0x2a, 0xde, 0x89, 0xc3,
+		0xfd, 0xbe, 0x60, 0x9a, 0xc3, 0x57, 0xdf, 0x02, 0x39, 0xf2, 0x93, 0xa5, 0xd8, 0xc5, 0x20, 0xff,
+		0x6f, 0x8e, 0xec, 0x61, 0x90, 0x9e, 0x6d, 0xa7, 0xe9, 0x68, 0xf1, 0xc2, 0xbe, 0xc1, 0x67, 0x5a,
+		0xc0, 0x57, 0x67, 0x21, 0x6f, 0x3b, 0xcd, 0x66, 0x97, 0xfb, 0xa7, 0x31, 0xe8, 0xff, 0xe7, 0x87,
+		0x6e, 0xc8, 0xc2, 0xc5, 0x21, 0xb3, 0x7d, 0xfd, 0xaa, 0x63, 0x1a, 0xf4, 0x0a, 0x24, 0x8e, 0xc2,
+		0xcb, 0x9c, 0x82, 0x0f, 0xa5, 0x3a, 0x07, 0x05, 0x32, 0x16, 0x0b, 0x9b, 0x98, 0xde, 0x57, 0xc5,
+		0x90, 0xf8, 0x3b, 0x2e, 0x80, 0x00, 0x52, 0xed, 0x27, 0xbf, 0xfe, 0xe2, 0x11, 0xe9, 0x1b, 0x2f,
+		0x1e, 0x91, 0xfe, 0xea, 0xc5, 0x23, 0xd2, 0x07, 0xbf, 0x73, 0x64, 0xdf, 0x37, 0xbe, 0x73, 0x64,
+		0xdf, 0x5f, 0x7c, 0xe7, 0xc8, 0xbe, 0xe8, 0xb0, 0x31, 0x5c, 0x30, 0x2e, 0x18, 0x2c, 0x60, 0xfc,
+		0x8c, 0x1c, 0x08, 0x17, 0xb7, 0x0c, 0x2f, 0x5a, 0xeb, 0x1e, 0x72, 0xe0, 0x0f, 0x52, 0x70, 0x17,
+		0x4d, 0xf7, 0xb5, 0x3a, 0x9a, 0xee, 0xcc, 0x34, 0xac, 0x1d, 0xd3, 0x31, 0x66, 0x3a, 0xd8, 0xba,
+		0xda, 0xc6, 0xfc, 0x0f, 0x23, 0x8e, 0x4a, 0x1e, 0xd8, 0x34, 0x03, 0x9b, 0x66, 0xed, 0xe5, 0xc8,
+		0x68, 0xb1, 0x3c, 0x07, 0x23, 0x2b, 0x96, 0x41, 0xae, 0x93, 0x49, 0x66, 0x9c, 0x9b, 0x2c, 0x90,
+		0xe3, 0x59, 0xc9, 0x45, 0x48, 0x5f, 0xc5, 0x3b, 0x34, 0x70, 0x5e, 0x50, 0xc8, 0x4f, 0x02, 0xd5,
+		0x54, 0x1d, 0x95, 0x06, 0xcc, 0x0b, 0x0a, 0xfd, 0x2d, 0xd7, 0x60, 0x88, 0x12, 0x41, 0x67, 0x21,
+		0x6d, 0x98, 0x36, 0x8f, 0xee, 0xdf, 0x31, 0xdd, 0x8f, 0x97, 0x69, 0xde, 0x65, 0x2d, 0xf3, 0xf5,
+		0xdd, 0xca, 0x3e, 0x85, 0xe0, 0xd4, 0x56, 0xfe, 0xfe, 0xdb, 0x47, 0xa4, 0xdf, 0x7a, 0xf1, 0x88,
+		0xd4, 0x4f, 0x92, 0xcf, 0x4c, 0xfb, 0x04, 0xe5, 0x13, 0x46, 0x3f, 0xb9, 0x6c, 0x0c, 0xd3, 0x11,
+		0x3e, 0x00, 0xbf, 0x90, 0x82, 0x23, 0x3e, 0xa0, 0xb6, 0xb6, 0x61, 0xcf, 0x5c, 0xbd, 0x36, 0x43,
+		0xc6, 0x67, 0x73, 0xa9, 0x21, 0x1f, 0xa7, 0xa4, 0x7d, 0xfa, 0xea, 0xb5, 0x3e, 0xf2, 0x9a, 0x86,
+		0xcc, 0x8a, 0xaa, 0x59, 0x42, 0x30, 0x92, 0x27, 0x98, 0x29, 0x2f, 0xb9, 0x95, 0xd4, 0xb1, 0x82,
+		0x7c, 0x1a, 0xb2, 0x4f, 0x2c, 0x3e, 0x74, 0x26, 0x09, 0x4e, 0x9a, 0xe3, 0xd4, 0x14, 0x21, 0x8a,
+		0x2f, 0x45, 0x88, 0xe3, 0xab, 0xdf, 0x39, 0x22, 0xb9, 0x22, 0x39, 0x11, 0x2b, 0x12, 0x3e, 0x5a,
+		0x57, 0x18, 0x1f, 0x4c, 0x41, 0x25, 0x7c, 0x2b, 0x40, 0xb6, 0xa2, 0xed, 0xa8, 0x1d, 0xb3, 0xdf,
+		0x9b, 0xae, 0x73, 0x90, 0x5b, 0x13, 0x30, 0xe4, 0x95, 0x95, 0x8d, 0x1b, 0x86, 0xde, 0xb4, 0xe9,
+		0x48, 0xd2, 0x8a, 0x28, 0x92, 0xd1, 0xe8, 0xaa, 0x6e, 0xd8, 0x3c, 0xe5, 0x94, 0x15, 0x6a, 0xbf,
+		0x2e, 0xed, 0x6d, 0x6f, 0x8c, 0xb9, 0x5d, 0xd1, 0x0d, 0xb2, 0x22, 0x3d, 0x73, 0xcf, 0xa0, 0x0b,
+		0x15, 0x3a, 0x8d, 0xde, 0x10, 0x7c, 0xb7, 0x27, 0x47, 0xc2, 0xb7, 0x27, 0x4f, 0xe1, 0x76, 0xfb,
+		0x09, 0xdd, 0xb8, 0xae, 0x93, 0x34, 0x0c, 0xdb, 0x15, 0xc9, 0x2f, 0xa6, 0xe0, 0x48, 0xcf, 0x45,
+		0x09, 0x57, 0x2f, 0xfd, 0x24, 0x52, 0x85, 0xec, 0xbc, 0xd0, 0x5a, 0x7b, 0x15, 0xc8, 0xaf, 0xed,
+		0x51, 0x20, 0xa3, 0xa2, 0x27, 0x21, 0x8f, 0x53, 0xf1, 0xf2, 0x10, 0xfc, 0xdf, 0x84, 0x38, 0xde,
+		0xf3, 0x28, 0xdc, 0xe1, 0x5b, 0x40, 0xea, 0x46, 0x43, 0x9b, 0xe1, 0x42, 0xf6, 0xed, 0x98, 0xfd,
+		0x1e, 0xc8, 0x34, 0x01, 0x99, 0xa6, 0x8d, 0xd1, 0x9b, 0xa6, 0x9c, 0x4c, 0x77, 0x95, 0x63, 0x76,
+		0x69, 0x39, 0x6e, 0xe1, 0x96, 0x63, 0xa6, 0x51, 0xfe, 0xbf, 0x43, 0x30, 0xa2, 0xe0, 0x67, 0xbb,
+		0xd8, 0xa6, 0x4f, 0x12, 0x71, 0x63, 0xcb, 0xe0, 0xd9, 0xee, 0xf2, 0x74, 0xe4, 0x78, 0xa6, 0x39,
+		0xf4, 0x42, 0x63, 0xcb, 0xb8, 0xb8, 0x4f, 0xa1, 0x18, 0xf4, 0x0d, 0x58, 0xbb, 0x6b, 0x6f, 0xf1,
+		0xa4, 0xe4, 0x63, 0x83, 0x51, 0xcf, 0x13, 0xd0, 0x8b, 0xfb, 0x14, 0x86, 0x43, 0xba, 0xa5, 0xef,
+		0xd7, 0x32, 0x49, 0xba, 0x25, 0x17, 0xa0, 0xa4, 0x5b, 0x82, 0x81, 0x2e, 0x02, 0xd8, 0xd8, 0x11,
+		0xa9, 0x0c, 0x43, 0x14, 0xff, 0xf8, 0x60, 0xfc, 0x55, 0xec, 0x30, 0xb3, 0x75, 0x71, 0x9f, 0x92,
+		0xb3, 0x45, 0x81, 0x50, 0xd2, 0x74, 0xcd, 0xa9, 0x37, 0xb6, 0x54, 0x4d, 0x2f, 0x0d, 0x27, 0xa1,
+		0xb4, 0xa8, 0x6b, 0xce, 0x1c, 0x01, 0x27, 0x94, 0x34, 0x51, 0x20, 0xa2, 0x78, 0xb6, 0x8b, 0x79,
+		0xee, 0x5b, 0xac, 0x28, 0x9e, 0x24, 0xa0, 0x44, 0x14, 0x14, 0x07, 0x3d, 0x01, 0x79, 0x7a, 0xdd,
+		0x5a, 0xdf, 0x68, 0x1b, 0x8d, 0xab, 0xfc, 0x65, 0xc9, 0x89, 0xc1, 0x24, 0x6a, 0x04, 0xa1, 0x46,
+		0xe0, 0x2f, 0xee, 0x53, 0x60, 0xc3, 0x2d, 0xa1, 0x1a, 0x64, 0x59, 0xda, 0xaf, 0xb3, 0xcd, 0xdf,
+		0x06, 0xde, 0x35, 0x98, 0x12, 0xcd, 0x00, 0x5e, 0xdb, 0xbe, 0xb8, 0x4f, 0x19, 0x69, 0xb0, 0x9f,
+		0x68, 0x81, 0xbc, 0x8b, 0x6b, 0x72, 0x76, 0xf2, 0xfc, 0x15, 0xd5, 0xe0, 0x75, 0xa1, 0x37, 0x05,
+		0x33, 0x59, 0xcc, 0x7f, 0x93, 0xb4, 0x77, 0x72, 0x55, 0xae, 0x39, 0xf4, 0x8d, 0x21, 0x49, 0x37,
+		0x19, 0xcc, 0x08, 0x85, 0xbd, 0xb8, 0x4f, 0xe1, 0x58, 0x64, 0x7a, 0x9a, 0xb8, 0xad, 0x91, 0x14,
+		0x6f, 0x67, 0xbb, 0x34, 0x99, 0x64, 0x7a, 0xe6, 0x19, 0x3c, 0x1d, 0x4e, 0xae, 0x29, 0x0a, 0xb5,
+		0x11, 0x6e, 0x5e, 0xe4, 0xe3, 0x90, 0xf7, 0xad, 0x64, 0xa2, 0xb1, 0xf8, 0x09, 0x84, 0x1b, 0x7b,
+		0x51, 0x94, 0xc7, 0xa0, 0xe0, 0x5f, 0xb7, 0x72, 0x07, 0xf2, 0xbe, 0xb5, 0x48, 0x10, 0xaf, 0x61,
+		0xcb, 0x66, 0x37, 0xf8, 0x14, 0x91, 0x17, 0x49, 0xd2, 0x2b, 0x95, 0x5b, 0x5d, 0xb4, 0xa7, 0x68,
+		0xc2, 0x48, 0x81, 0x56, 0x5e, 0xe1, 0x40, 0x15, 0xc8, 0x9b, 0xa7, 0x4d, 0x17, 0x24, 0x4d, 0x41,
+		0xc0, 0x3c, 0x6d, 0x72, 0x00, 0xb9, 0x0a, 0xc5, 0xf0, 0xd2, 0xf5, 0x5b, 0xcd, 0x5c, 0x84, 0xd5,
+		0xcc, 0x09, 0x4b, 0xfb, 0xb9, 0x14, 0x14, 0xc3, 0xab, 0x95, 0x6c, 0x37, 0xa2, 0x24, 0x28, 0x76,
+		0xfe, 0x74, 0xb9, 0xc7, 0xb5, 0x73, 0x6d, 0x4d, 0x2d, 0x4b, 0x5c, 0x91, 0x0f, 0x7e, 0xab, 0x22,
+		0x29, 0x14, 0x03, 0x1d, 0x22, 0x0b, 0x4a, 0xd5, 0xf4, 0xba, 0xd6, 0x14, 0xaf, 0x89, 0x69, 0x79,
+		0xb1, 0x89, 0x9e, 0x84, 0x62, 0xc3, 0xd0, 0x6d, 0xac, 0xdb, 0x5d, 0x9b, 0xe4, 0x93, 0xa8, 0x1d,
+		0xef, 0xd1, 0x5d, 0xf4, 0x34, 0xcd, 0x09, 0xf0, 0x15, 0x0a, 0xad, 0x8c, 0x37, 0x82, 0x15, 0x68,
+		0x09, 0xe0, 0x9a, 0xda, 0xd6, 0x9a, 0xaa, 0x63, 0x58, 0x36, 0x7f, 0x9c, 0xd2, 0x8f, 0xd8, 0x15,
+		0x01, 0xb8, 0x6e, 0x36, 0x55, 0x07, 0x73, 0x27, 0xca, 0x87, 0x8f, 0xee, 0x86, 0x71, 0xd5, 0x34,
+		0xeb, 0xb6, 0x43, 0x6e, 0x13, 0x37, 0x76, 0x1c, 0x6c, 0x53, 0x7d, 0x51, 0x50, 0x46, 0x55, 0xd3,
+		0x5c, 0x25, 0xb5, 0x35, 0x52, 0x29, 0x37, 0xa1, 0xe0, 0xdf, 0x9a, 0xae, 0x6f, 0x27, 0x79, 0xbe,
+		0x1d, 0xa9, 0xa3, 0xa9, 0x15, 0x4c, 0x06, 0x22, 0x1b, 0x65, 0x78, 0x0b, 0x6b, 0xad, 0x2d, 0x87,
+		0x0e, 0x3b, 0xad, 0xf0, 0x12, 0x99, 0x18, 0xd3, 0x32, 0xae, 0xb1, 0x6c, 0xa1, 0xac, 0xc2, 0x0a,
+		0xf2, 0x3f, 0x4d, 0xc1, 0x44, 0xcf, 0xf6, 0x25, 0x74, 0x69, 0x82, 0x3f, 0xef, 0x8b, 0xfc, 0x46,
+		0xe7, 0x08, 0x5d, 0xb5, 0x89, 0x2d, 0xf7, 0x0d, 0x52, 0xb4, 0x04, 0x2e, 0x52, 0x20, 0x3e, 0x70,
+		0x8e, 0x82, 0xd6, 0xa1, 0xd8, 0x56, 0x6d, 0xa7, 0xce, 0x76, 0x11, 0x7b, 0x25, 0x9c, 0x1e, 0xa8,
+		0x09, 0x96, 0x54, 0xb1, 0xfb, 0xc8, 0xe2, 0xe6, 0xe4, 0xc6, 0xda, 0x81, 0x5a, 0x92, 0x4b, 0xb6,
+		0xb1, 0xf3, 0x9c, 0xaa, 0x3b, 0x9a, 0x8e, 0xeb, 0x9e, 0x8c, 0xf9, 0x1c, 0x55, 0xfa, 0x90, 0x5e,
+		0xb8, 0xa6, 0x91, 0x37, 0xe0, 0x62, 0x72, 0x26, 0x5d, 0x12, 0xee, 0xe4, 0xd9, 0xf2, 0xd3, 0x30,
+		0x16, 0xd4, 0x45, 0x68, 0x0c, 0x52, 0xce, 0x36, 0x97, 0x48, 0xca, 0xd9, 0x46, 0x0f, 0x71, 0x8f,
+		0x3c, 0x45, 0xb3, 0xe5, 0xfa, 0x19, 0x0b, 0x8e, 0xed, 0xbd, 0x25, 0x94, 0x65, 0x28, 0x86, 0x15,
+		0x43, 0x98, 0xb6, 0x7c, 0x12, 0xc6, 0x43, 0x4a, 0xcc, 0x37, 0xad, 0x92, 0x7f, 0x5a, 0xe5, 0x71,
+		0x18, 0xe5, 0xa0, 0x4c, 0x2e, 0xf2, 0x9f, 0x0c, 0x43, 0x56, 0xc1, 0xb6, 0x49, 0x16, 0x31, 0xba,
+		0x48, 0x5e, 0x35, 0x37, 0xb0, 0xe9, 0x08, 0xad, 0x30, 0x48, 0x89, 0x33, 0x9c, 0x05, 0x01, 0x4f,
+		0xd4, 0x95, 0x8b, 0x8c, 0xce, 0x06, 0x4c, 0xf2, 0xb1, 0x38, 0x22, 0x7e, 0x9b, 0xfc, 0xe6, 0xa0,
+		0x4d, 0xbe, 0x33, 0x06, 0x37, 0x64, 0x94, 0xcf, 0x06, 0x8c, 0x72, 0x5c, 0xc7, 0x01, 0xab, 0xbc,
+		0x18, 0x61, 0x95, 0xe3, 0x86, 0xdf, 0xc7, 0x2c, 0x2f, 0x46, 0x98, 0xe5, 0x13, 0xb1, 0xbc, 0x44,
+		0xda, 0xe5, 0x37, 0x07, 0xed, 0x72, 0x9c, 0x38, 0x42, 0x86, 0x79, 0x29, 0xca, 0x30, 0x9f, 0x8c,
+		0xa1, 0xd1, 0xd7, 0x32, 0xcf, 0xf5, 0x58, 0xe6, 0xbb, 0x63, 0x48, 0x45, 0x98, 0xe6, 0xc5, 0x80,
+		0x4d, 0x84, 0x44, 0xb2, 0x89, 0x36, 0x8a, 0xe8, 0x7c, 0xaf, 0x95, 0x3f, 0x1e, 0xb7, 0xd4, 0xa2,
+		0xcc, 0xfc, 0x63, 0x21, 0x33, 0x7f, 0x57, 0xdc, 0xa8, 0x42, 0x76, 0xde, 0xb3, 0xce, 0x27, 0x61,
+		0x42, 0x00, 0xb9, 0x3b, 0x83, 0xe8, 0x52, 0x6c, 0x59, 0x86, 0xc5, 0x0d, 0x1f, 0x2b, 0xc8, 0x27,
+		0xa0, 0xe0, 0x82, 0x0e, 0xb6, 0xe4, 0x74, 0xd3, 0xfa, 0x56, 0xbb, 0xfc, 0x25, 0x09, 0x0a, 0xfe,
+		0x25, 0x1c, 0xd0, 0xf6, 0x39, 0xae, 0xed, 0x7d, 0x06, 0x3e, 0x15, 0x34, 0xf0, 0x24, 0xe5, 0xdb,
+		0xec, 0xb1, 0xdd, 0xaa, 0x29, 0x6c, 0x37, 0x79, 0x9a, 0x43, 0xf5, 0x2f, 0x73, 0x03, 0xb8, 0x22,
+		0xc9, 0x50, 0x45, 0x32, 0x4e, 0x1a, 0x98, 0x04, 0x69, 0x35, 0xba, 0x0f, 0x26, 0x7d, 0xb0, 0x84,
+		0x2e, 0xb5, 0x05, 0xcc, 0x48, 0x15, 0x5d, 0xe8, 0x59, 0xd3, 0x24, 0xef, 0xbb, 0xc8, 0x37, 0x45,
+		0x7a, 0xf6, 0x0e, 0x61, 0xbf, 0x61, 0x34, 0xd9, 0xb8, 0x47, 0x15, 0xfa, 0x9b, 0xf8, 0x0a, 0x6d,
+		0xa3, 0xc5, 0x33, 0x20, 0xc9, 0x4f, 0x02, 0xe5, 0x6e, 0xed, 0x1c, 0xdb, 0xb3, 0xf2, 0xef, 0x4b,
+		0x30, 0xd1, 0xb3, 0x81, 0x22, 0xad, 0xba, 0x74, 0x2b, 0xad, 0x7a, 0xea, 0x95, 0x59, 0x75, 0xf9,
+		0x65, 0x09, 0x46, 0x03, 0x3b, 0xf6, 0xe6, 0x45, 0x40, 0x56, 0x17, 0x7b, 0x09, 0xce, 0x32, 0x75,
+		0x59, 0x41, 0xb8, 0x5a, 0xc3, 0x11, 0x01, 0x8a, 0x11, 0x5f, 0x50, 0x03, 0x3d, 0x48, 0xed, 0xbc,
+		0xb1, 0xc9, 0x55, 0x43, 0x25, 0x26, 0xd0, 0xa3, 0x30, 0x68, 0x9f, 0x7d, 0xc9, 0x05, 0xdc, 0x86,
+		0xc3, 0x90, 0x23, 0xac, 0xb3, 0xe7, 0x4f, 0xc0, 0xc3, 0x8b, 0xa2, 0x42, 0x6e, 0x02, 0xea, 0xd5,
+		0x31, 0xe8, 0x12, 0x0c, 0xe3, 0x6b, 0x34, 0x1b, 0x95, 0x05, 0x9b, 0x0e, 0xf7, 0x35, 0xc4, 0x58,
+		0x77, 0x6a, 0x25, 0x22, 0xcc, 0xef, 0xed, 0x56, 0x8a, 0x0c, 0xe7, 0x5e, 0xa3, 0xa3, 0x39, 0xb8,
+		0x63, 0x3a, 0x3b, 0x0a, 0xa7, 0x22, 0xbf, 0x2f, 0x05, 0xe3, 0xa2, 0x1b, 0x61, 0x8e, 0xa3, 0xc4,
+		0x2b, 0x36, 0x4d, 0xca, 0xe7, 0x22, 0x25, 0x13, 0x39, 0x79, 0x13, 0xa8, 0xda, 0xf5, 0xeb, 0xaa,
+		0xee, 0xe0, 0x26, 0x97, 0x7b, 0xae, 0xa5, 0xda, 0x4f, 0xd1, 0x0a, 0xe2, 0x6f, 0x92, 0xe6, 0xae,
+		0x8d, 0x9b, 0x74, 0x02, 0xd2, 0xca, 0x48, 0x4b, 0xb5, 0xd7, 0x6d, 0xdc, 0xf4, 0x8d, 0x75, 0xe4,
+		0x56, 0x8c, 0x35, 0x28, 0xef, 0x6c, 0x58, 0xde, 0xef, 0x4f, 0xc1, 0x44, 0x8f, 0x0a, 0xfd, 0x31,
+		0x95, 0xc5, 0x47, 0xe9, 0x99, 0x22, 0x68, 0x04, 0xd0, 0x5b, 0x61, 0xc2, 0xdd, 0x95, 0xf5, 0x2e,
+		0xdd, 0xad, 0x62, 0x15, 0xee, 0x6d, 0x73, 0x17, 0xaf, 0x05, 0xab, 0x6d, 0xf4, 0x53, 0x70, 0x30,
+		0xa4, 0x83, 0xdc, 0x0e, 0x52, 0x7b, 0x52, 0x45, 0xfb, 0x83, 0xaa, 0x48, 0xd0, 0xf7, 0xa4, 0x97,
+		0xbe, 0x25, 0xbb, 0xe6, 0x4e, 0x18, 0x13, 0xe2, 0x61, 0xe6, 0x2d, 0x6a, 0x4d, 0xc8, 0x7f, 0x26,
+		0xc1, 0x78, 0x88, 0x41, 0xf4, 0x08, 0x0c, 0x31, 0x0b, 0x2c, 0x0d, 0x0c, 0x84, 0x50, 0x89, 0xf3,
+		0x31, 0x31, 0x04, 0x34, 0x0b, 0x59, 0xcc, 0xbd, 0xeb, 0x52, 0x6a, 0xa0, 0xe5, 0x15, 0x4e, 0x38,
+		0xc7, 0x77, 0xd1, 0xd0, 0x3c, 0xe4, 0x5c, 0xd1, 0xc7, 0x9c, 0xdc, 0xdc, 0x99, 0xe3, 0x44, 0x3c,
+		0x44, 0x79, 0x0e, 0xf2, 0x3e, 0xf6, 0xd8, 0x5b, 0xc0, 0x6d, 0x7e, 0xdc, 0x62, 0x0e, 0x74, 0xb6,
+		0xa3, 0x6e, 0xd3, 0x93, 0x16, 0x3a, 0x08, 0x23, 0xa4, 0xb1, 0xc5, 0x1f, 0x4b, 0xa5, 0x95, 0xe1,
+		0x8e, 0xba, 0x7d, 0x41, 0xb5, 0xe5, 0x5f, 0x94, 0x60, 0x2c, 0xc8, 0x27, 0xba, 0x07, 0x10, 0x81,
+		0x25, 0xef, 0x20, 0x49, 0x22, 0x1b, 0x1d, 0xb5, 0xa0, 0x38, 0xde, 0x51, 0xb7, 0x67, 0x5b, 0xf8,
+		0x52, 0xb7, 0x43, 0xbb, 0xb6, 0xd1, 0x32, 0x14, 0x05, 0xb0, 0x08, 0x76, 0x71, 0xa9, 0x1c, 0xea,
+		0xfd, 0x5e, 0x0d, 0x07, 0x60, 0x67, 0xdd, 0x17, 0xc8, 0x59, 0x77, 0x8c, 0xd1, 0x13, 0x2d, 0xf2,
+		0x83, 0x30, 0x1e, 0x1a, 0x31, 0x92, 0x61, 0xd4, 0xec, 0x6e, 0xd4, 0xaf, 0xe2, 0x1d, 0xfa, 0xfc,
+		0x9d, 0x2d, 0xf5, 0x9c, 0x92, 0x37, 0xbb, 0x1b, 0x4f, 0xe0, 0x1d, 0x1a, 0x3b, 0x94, 0x1b, 0x30,
+		0x16, 0x3c, 0x4c, 0x11, 0xc3, 0x61, 0x19, 0x5d, 0xbd, 0x29, 0x3e, 0x6c, 0x40, 0x0b, 0x24, 0x5e,
+		0x74, 0xcd, 0x60, 0xab, 0x79, 0xd0, 0xe9, 0xe9, 0x8a, 0xe1, 0x60, 0xdf, 0x91, 0x8c, 0xe1, 0xc8,
+		0x36, 0x0c, 0xd1, 0x75, 0x19, 0x79, 0x51, 0x71, 0x05, 0x40, 0x75, 0x1c, 0x4b, 0xdb, 0xe8, 0x7a,
+		0xe4, 0x4b, 0xd3, 0xbd, 0x61, 0xfd, 0x69, 0x12, 0x89, 0xaf, 0x1d, 0xe6, 0x2b, 0x7b, 0xca, 0xc3,
+		0xf1, 0xad, 0x6e, 0x1f, 0x25, 0xf9, 0xa5, 0x0c, 0x0c, 0xb3, 0xe3, 0x26, 0x7a, 0x34, 0x18, 0xfc,
+		0x20, 0xaf, 0xfc, 0xfb, 0xb0, 0xcf, 0xa0, 0x38, 0xf7, 0x02, 0x09, 0xdd, 0x1d, 0x8e, 0x28, 0xd4,
+		0xf2, 0x2f, 0xee, 0x56, 0x46, 0xa8, 0xf7, 0xb1, 0x38, 0xef, 0x85, 0x17, 0xfa, 0x9d, 0xae, 0x45,
+		0x2c, 0x23, 0xb3, 0xe7, 0x58, 0xc6, 0x45, 0x18, 0xf5, 0xb9, 0x5b, 0x5a, 0xb3, 0x34, 0x34, 0x90,
+		0x7f, 0xba, 0xb4, 0x16, 0xe7, 0x39, 0xff, 0x79, 0xd7, 0x1d, 0x5b, 0x6c, 0x92, 0x57, 0xd0, 0xfe,
+		0x43, 0x36, 0xf5, 0xda, 0x98, 0xbb, 0xe0, 0x3b, 0x37, 0xd3, 0x37, 0xf9, 0xb7, 0x41, 0x8e, 0x3e,
+		0x6c, 0xa6, 0x20, 0xcc, 0x7b, 0xc8, 0x92, 0x0a, 0xda, 0x78, 0x1c, 0xc6, 0x3d, 0xc7, 0x86, 0x81,
+		0x64, 0x19, 0x15, 0xaf, 0x9a, 0x02, 0xde, 0x0f, 0x53, 0x3a, 0xde, 0x76, 0xea, 0x61, 0xe8, 0x1c,
+		0x85, 0x46, 0xa4, 0xed, 0x4a, 0x10, 0xe3, 0x2e, 0x18, 0xf3, 0x54, 0x28, 0x85, 0x05, 0x16, 0xfa,
+		0x70, 0x6b, 0x29, 0xd8, 0x21, 0xc8, 0xba, 0x6e, 0x67, 0x9e, 0x02, 0x8c, 0xa8, 0xcc, 0xdb, 0x74,
+		0x1d, 0x59, 0x0b, 0xdb, 0xdd, 0xb6, 0xc3, 0x89, 0x14, 0x28, 0x0c, 0x75, 0x64, 0x15, 0x56, 0x4f,
+		0x61, 0x8f, 0xc1, 0xa8, 0xd0, 0x2a, 0x0c, 0x6e, 0x94, 0xc2, 0x15, 0x44, 0x25, 0x05, 0x3a, 0x09,
+		0x45, 0xd3, 0x32, 0x4c, 0xc3, 0x26, 0xa9, 0x89, 0xcd, 0xa6, 0x85, 0x6d, 0xbb, 0x34, 0xc6, 0xe8,
+		0x89, 0xfa, 0x59, 0x56, 0x2d, 0xbf, 0x09, 0x46, 0x84, 0x3f, 0x3d, 0x05, 0x43, 0x35, 0x57, 0x43,
+		0x66, 0x14, 0x56, 0x20, 0xf6, 0x75, 0xd6, 0x34, 0x79, 0x74, 0x8d, 0xfc, 0x94, 0xdb, 0x30, 0xc2,
+		0x27, 0x2c, 0x32, 0xa6, 0xb2, 0x0c, 0x05, 0x53, 0xb5, 0xc8, 0x30, 0xfc, 0x91, 0x95, 0x7e, 0x27,
+		0x42, 0xf2, 0x1a, 0x6a, 0x15, 0x3b, 0x81, 0x00, 0x4b, 0x9e, 0xe2, 0xb3, 0x2a, 0xf9, 0x2c, 0x8c,
+		0x06, 0x60, 0x08, 0x9b, 0x8e, 0xe1, 0xa8, 0x6d, 0xb1, 0xd1, 0x69, 0xc1, 0xe5, 0x24, 0xe5, 0x71,
+		0x42, 0x6e, 0x91, 0xdc, 0xb9, 0x22, 0x07, 0x0d, 0x21, 0x0a, 0x89, 0x8b, 0x9f, 0x15, 0x09, 0x41,
+		0xd3, 0xb8, 0xce, 0x3f, 0xd1, 0x94, 0x56, 0x58, 0x41, 0xc6, 0x3e, 0xc5, 0xc4, 0xac, 0x19, 0x7a,
+		0x33, 0x8c, 0x70, 0xc5, 0x54, 0x92, 0x06, 0x86, 0x8b, 0x56, 0xa8, 0xa6, 0x12, 0xe1, 0x22, 0xa6,
+		0xb7, 0xbc, 0x6e, 0x52, 0xfe, 0x6e, 0xde, 0x09, 0x59, 0xa1, 0x7c, 0x82, 0x56, 0x82, 0xf5, 0x70,
+		0x34, 0xce, 0x4a, 0xf0, 0x4e, 0x3c, 0x44, 0xb2, 0x9a, 0x6c, 0xad, 0xa5, 0xe3, 0x66, 0xdd, 0xdb,
+		0x82, 0xfc, 0xc1, 0xec, 0x38, 0x6b, 0x58, 0x12, 0xfb, 0x4b, 0xbe, 0x1f, 0x86, 0x19, 0xaf, 0x91,
+		0x2a, 0x2e, 0xca, 0xb4, 0x7e, 0x57, 0x82, 0xac, 0x30, 0x1f, 0x91, 0x48, 0x81, 0x41, 0xa4, 0x6e,
+		0x76, 0x10, 0xb7, 0x5e, 0x25, 0xdd, 0x0b, 0x88, 0xae, 0x94, 0xfa, 0x35, 0xc3, 0x21, 0xcf, 0x26,
+		0xd8, 0x5c, 0xf0, 0x77, 0x83, 0xb4, 0xe5, 0x0a, 0x6d, 0x58, 0x21, 0xf5, 0xa7, 0x8e, 0x41, 0xde,
+		0x17, 0xe5, 0x42, 0x23, 0x90, 0xbe, 0x84, 0xaf, 0x17, 0xf7, 0xa1, 0x3c, 0xb9, 0xcf, 0xa1, 0x31,
+		0x82, 0xa2, 0x74, 0xfa, 0xa5, 0x11, 0x18, 0x9f, 0xad, 0xcd, 0x2d, 0xce, 0x9a, 0x66, 0x5b, 0xe3,
+		0xef, 0xe9, 0x2e, 0x43, 0x86, 0x9e, 0x93, 0x13, 0xdc, 0xef, 0x94, 0x93, 0x04, 0x9c, 0x90, 0x02,
+		0x43, 0xf4, 0x38, 0x8d, 0x92, 0x5c, 0xfb, 0x94, 0x13, 0xc5, 0xa1, 0x08, 0x93, 0x74, 0xc1, 0x25,
+		0xb8, 0x0d, 0x2a, 0x27, 0x09, 0x4e, 0xa1, 0x9f, 0x82, 0x9c, 0x77, 0x4e, 0x4e, 0x7a, 0x47, 0x54,
+		0x4e, 0x1c, 0xb6, 0x22, 0xf4, 0xbd, 0x93, 0x41, 0xd2, 0xab, 0x89, 0x72, 0xe2, 0x78, 0x0d, 0x7a,
+		0x1a, 0x46, 0xc4, 0x19, 0x2c, 0xd9, 0x2d, 0x4e, 0x39, 0x61, 0x48, 0x89, 0x4c, 0x1f, 0x3b, 0x3a,
+		0x27, 0xb9, 0xaa, 0x2a, 0x27, 0x8a, 0x9b, 0xa1, 0x75, 0x18, 0xe6, 0xce, 0x6f, 0xa2, 0x9b, 0x9e,
+		0x72, 0xb2, 0x40, 0x11, 0x11, 0xb2, 0x17, 0x9c, 0x48, 0x7a, 0x3d, 0x57, 0x4e, 0x1c, 0x30, 0x44,
+		0x2a, 0x80, 0xef, 0x3c, 0x9d, 0xf8, 0xde, 0xad, 0x9c, 0x3c, 0x10, 0x88, 0xde, 0x06, 0x59, 0xf7,
+		0xd4, 0x94, 0xf0, 0x26, 0xad, 0x9c, 0x34, 0x16, 0x57, 0x5b, 0x4f, 0x9c, 0x25, 0x71, 0x4f, 0x6c,
+		0x96, 0x84, 0x77, 0xc9, 0xed, 0x5e, 0x83, 0xff, 0x9d, 0x04, 0x87, 0x98, 0x3a, 0xf3, 0xae, 0x93,
+		0x55, 0x7d, 0xa7, 0x4f, 0x42, 0x40, 0x9f, 0x6c, 0x91, 0x37, 0x43, 0x7a, 0x56, 0xdf, 0x21, 0xce,
+		0x06, 0x21, 0x5e, 0xef, 0x5a, 0x6d, 0x11, 0xa6, 0x23, 0xe5, 0x75, 0xab, 0x1d, 0x9d, 0x35, 0x52,
+		0xcd, 0x7c, 0xff, 0x93, 0x95, 0x7d, 0xb5, 0xab, 0x11, 0xa3, 0x8a, 0xc9, 0x15, 0xc8, 0xce, 0xea,
+		0x3b, 0x22, 0x4d, 0x60, 0x88, 0x0e, 0x68, 0xaf, 0xd7, 0xff, 0xdf, 0x1e, 0x25, 0x94, 0xed, 0x8e,
+		0x61, 0xcf, 0xb0, 0x3f, 0x7c, 0xc4, 0xc3, 0xac, 0xd4, 0xe7, 0x86, 0x3f, 0x3e, 0x63, 0xa0, 0xdc,
+		0x5f, 0x9a, 0xf2, 0x05, 0xc8, 0xcc, 0x19, 0x1a, 0x75, 0x79, 0x9a, 0x58, 0x37, 0x3a, 0x22, 0xe6,
+		0x49, 0x0b, 0xe8, 0x18, 0x0c, 0xab, 0x1d, 0xa3, 0xab, 0x3b, 0xc2, 0x6b, 0x26, 0xa6, 0xe4, 0x2f,
+		0x77, 0x2b, 0xe9, 0x45, 0xdd, 0x51, 0x78, 0x53, 0x35, 0xf3, 0x37, 0x9f, 0xa8, 0x48, 0xf2, 0xe3,
+		0x30, 0x32, 0x8f, 0x1b, 0x37, 0x43, 0x6b, 0x1e, 0x37, 0x42, 0xb4, 0x4e, 0x42, 0x76, 0x51, 0x77,
+		0xa8, 0xb8, 0xd0, 0xed, 0x90, 0xd6, 0x74, 0x76, 0x2d, 0x12, 0xea, 0x9f, 0xd4, 0x13, 0xd0, 0x79,
+		0xdc, 0x70, 0x41, 0x9b, 0xb8, 0x51, 0x92, 0x7a, 0xc9, 0x93, 0x7a, 0xb9, 0x06, 0x85, 0x2b, 0x6a,
+		0x9b, 0xbb, 0x7b, 0xd8, 0x46, 0xf7, 0x42, 0x4e, 0x15, 0x05, 0x7a, 0xb2, 0x2a, 0xd4, 0xc6, 0x7e,
+		0xb0, 0x5b, 0x01, 0x0f, 0x48, 0xf1, 0x00, 0xaa, 0x99, 0xe7, 0xff, 0xdb, 0x51, 0x49, 0x36, 0x60,
+		0xe4, 0x82, 0x6a, 0x53, 0x4d, 0x7f, 0x26, 0x10, 0x48, 0xa1, 0x9e, 0x62, 0x6d, 0xff, 0x8d, 0xdd,
+		0xca, 0xc4, 0x8e, 0xda, 0x69, 0x57, 0x65, 0xaf, 0x4d, 0xf6, 0xc7, 0x57, 0xa6, 0x7d, 0xf1, 0x15,
+		0xea, 0x49, 0xd6, 0x26, 0x6f, 0xec, 0x56, 0xc6, 0x3d, 0x1c, 0xd2, 0x22, 0xbb, 0x41, 0x17, 0xf9,
+		0x77, 0x24, 0x18, 0x66, 0x5e, 0x6f, 0xe4, 0x15, 0x21, 0x8f, 0xf9, 0xa4, 0xbc, 0x98, 0x4f, 0x75,
+		0x4f, 0x71, 0x06, 0xee, 0x98, 0x31, 0x0c, 0x74, 0x16, 0x46, 0x3b, 0x76, 0xab, 0x6e, 0xf1, 0xfd,
+		0x2e, 0x6e, 0xda, 0xa6, 0x7a, 0x9c, 0x8b, 0x59, 0x7d, 0x47, 0x29, 0x74, 0xec, 0x96, 0xd0, 0x0c,
+		0x76, 0x35, 0xf3, 0xf3, 0x9f, 0xa8, 0xec, 0x93, 0x2d, 0x40, 0xab, 0x5a, 0xa7, 0xdb, 0x66, 0x8f,
+		0xc6, 0x79, 0x23, 0x3a, 0xc3, 0xc6, 0x4c, 0x43, 0x51, 0xcc, 0x99, 0x1b, 0x9f, 0xe6, 0x0b, 0x9c,
+		0x0b, 0x93, 0xf9, 0x28, 0xdf, 0xd8, 0xad, 0x48, 0x74, 0xe4, 0x54, 0xbe, 0x77, 0xc3, 0x30, 0x3b,
+		0x06, 0x70, 0xdf, 0x69, 0x4c, 0xe0, 0x30, 0x71, 0x28, 0xbc, 0x55, 0xae, 0xc1, 0xc8, 0xb2, 0xdd,
+		0x9a, 0x27, 0xd2, 0x38, 0x04, 0x59, 0xc2, 0xbf, 0xcf, 0x13, 0x1b, 0xe9, 0xd8, 0xad, 0xb5, 0x3e,
+		0x1e, 0x5c, 0x35, 0x4b, 0x16, 0x1b, 0x9d, 0xd6, 0x67, 0x21, 0xb7, 0xb6, 0x2d, 0xa8, 0x1c, 0x73,
+		0xe5, 0x9c, 0xf6, 0xb3, 0xca, 0x9b, 0xb9, 0xe0, 0x7b, 0x44, 0x95, 0x4a, 0x2e, 0x2a, 0xda, 0xe5,
+		0x7b, 0xd2, 0x00, 0x6b, 0xdb, 0xae, 0x8c, 0xfa, 0x5c, 0x00, 0x22, 0x19, 0x86, 0x9d, 0x6d, 0xd7,
+		0x9f, 0xcf, 0xd5, 0xe0, 0xc5, 0xdd, 0xca, 0xf0, 0xda, 0x36, 0x39, 0xdc, 0x28, 0xbc, 0x25, 0x18,
+		0x48, 0x4b, 0x87, 0x02, 0x69, 0x6e, 0xf8, 0x30, 0x13, 0x11, 0x3e, 0x1c, 0xf2, 0xdd, 0x3f, 0x1c,
+		0x84, 0x11, 0x4b, 0xbd, 0x5e, 0x27, 0xcb, 0x89, 0x7d, 0x03, 0x75, 0xd8, 0x52, 0xaf, 0x2f, 0x19,
+		0x2d, 0x34, 0x07, 0x99, 0xb6, 0xd1, 0x12, 0x51, 0xbf, 0x03, 0x42, 0x1e, 0xc4, 0xdf, 0xe3, 0xb9,
+		0xcc, 0x4b, 0x46, 0xab, 0x76, 0x90, 0xcc, 0xe0, 0x67, 0xbe, 0x55, 0x19, 0x0f, 0xd6, 0xdb, 0x0a,
+		0x45, 0x76, 0x43, 0x91, 0xd9, 0xbe, 0xa1, 0xc8, 0xdc, 0xa0, 0x50, 0x24, 0x04, 0x43, 0x91, 0x77,
+		0xd2, 0x1b, 0x55, 0x76, 0x83, 0x14, 0x2d, 0x72, 0x72, 0x87, 0x7b, 0x18, 0x72, 0x6e, 0x9a, 0x12,
+		0xff, 0xe8, 0xb4, 0x57, 0xc1, 0x57, 0xec, 0xcf, 0x4b, 0x30, 0x16, 0xe4, 0x98, 0x46, 0x93, 0xec,
+		0x16, 0xff, 0x5c, 0x2b, 0x0b, 0xba, 0x92, 0x65, 0xb5, 0x28, 0xe2, 0xf4, 0xa1, 0x0d, 0x37, 0x1b,
+		0xda, 0x70, 0x93, 0x42, 0x40, 0xec, 0xe5, 0x10, 0xdb, 0x67, 0x53, 0x5c, 0x3a, 0x05, 0x5f, 0xa5,
+		0x2d, 0xf6, 0x1d, 0x5f, 0x11, 0x3f, 0x0d, 0x79, 0x5f, 0x6b, 0xe4, 0x91, 0xe2, 0xe1, 0x88, 0x50,
+		0xcb, 0x84, 0x3b, 0x21, 0xa2, 0x45, 0x5c, 0x60, 0x78, 0xa0, 0xbc, 0x87, 0x07, 0x20, 0xe7, 0x02,
+		0x25, 0x4d, 0xee, 0xa8, 0xcd, 0xff, 0xc5, 0xb7, 0x8f, 0xec, 0x7b, 0xfe, 0xc5, 0x23, 0xfb, 0xfa,
+		0x66, 0x87, 0xfa, 0xd3, 0x68, 0x03, 0xf6, 0xeb, 0x3e, 0xbb, 0x79, 0x35, 0x64, 0xdb, 0x7f, 0x73,
+		0x09, 0x0e, 0x73, 0x18, 0xdb, 0x51, 0xaf, 0x6a, 0x7a, 0x4b, 0xfc, 0xe5, 0xc6, 0x6e, 0x8c, 0x8f,
+		0x86, 0xd7, 0xde, 0xbc, 0xd1, 0x7b, 0xa5, 0x29, 0x6b, 0xe5, 0x28, 0x5b, 0x2c, 0xef, 0x66, 0x00,
+		0x2d, 0xdb, 0xad, 0x39, 0x0b, 0xb3, 0x4f, 0x9d, 0xf0, 0x53, 0x5a, 0xf0, 0xa9, 0x11, 0xd7, 0x72,
+		0xb7, 0x4d, 0x07, 0xc7, 0xe2, 0x7e, 0xb5, 0xda, 0x8b, 0x50, 0xf9, 0xb1, 0xd0, 0x02, 0x00, 0x0d,
+		0xee, 0xd8, 0xb6, 0x17, 0x4a, 0xac, 0x84, 0x69, 0xcc, 0xb9, 0x10, 0x8a, 0xea, 0x60, 0x5b, 0xcc,
+		0xb5, 0x87, 0x88, 0xde, 0x09, 0x93, 0x1d, 0x4d, 0xaf, 0xdb, 0xb8, 0x4d, 0xbe, 0xe4, 0xdd, 0xa6,
+		0x1f, 0x82, 0xe1, 0xd7, 0x86, 0xb9, 0xda, 0x12, 0x37, 0x8b, 0x77, 0xc7, 0xcf, 0xd9, 0xf4, 0xa2,
+		0xee, 0xdc, 0xd8, 0xad, 0x94, 0x99, 0x6d, 0x8a, 0x20, 0x29, 0x2b, 0x13, 0x1d, 0x4d, 0x5f, 0xc5,
+		0xed, 0xcd, 0x79, 0xb7, 0x0e, 0x3d, 0x07, 0x13, 0x1c, 0xc2, 0xf0, 0x42, 0x2e, 0x44, 0xf7, 0x14,
+		0x6a, 0xcb, 0x37, 0x76, 0x2b, 0x25, 0x46, 0xad, 0x07, 0x44, 0xfe, 0xc1, 0x6e, 0xe5, 0xbe, 0x04,
+		0x3c, 0xcd, 0x36, 0x1a, 0xc2, 0x38, 0x17, 0x5d, 0x22, 0xbc, 0x86, 0xf4, 0xed, 0x5d, 0x0f, 0x88,
+		0xbe, 0x87, 0xc2, 0x7d, 0xf7, 0x80, 0x24, 0xed, 0xdb, 0xe7, 0x18, 0x78, 0xf7, 0x07, 0xa2, 0x6f,
+		0xf2, 0xb5, 0xb6, 0xee, 0x86, 0xb8, 0xc3, 0xcb, 0x29, 0xbc, 0x84, 0x4e, 0xf8, 0xaf, 0xf1, 0xf2,
+		0xa7, 0x0b, 0x62, 0x3e, 0x89, 0xa7, 0xe4, 0x06, 0x59, 0x99, 0xe7, 0x49, 0x7d, 0x9f, 0x2f, 0xa5,
+		0xa1, 0xb8, 0x6c, 0xb7, 0x16, 0x9a, 0x9a, 0x73, 0x8b, 0x97, 0x97, 0x19, 0x25, 0x1d, 0x6a, 0x0f,
+		0x6b, 0x73, 0x37, 0x76, 0x2b, 0x63, 0x4c, 0x3a, 0xb7, 0x52, 0x26, 0x1d, 0x18, 0xf7, 0xd6, 0x65,
+		0xdd, 0x52, 0x1d, 0x6e, 0x9e, 0x6a, 0xf3, 0x09, 0x57, 0xe0, 0x3c, 0x6e, 0xdc, 0xd8, 0xad, 0x1c,
+		0x60, 0x9c, 0x85, 0x48, 0xc9, 0xca, 0x58, 0x23, 0xb0, 0x17, 0xd0, 0x76, 0xf4, 0xc2, 0xa7, 0xb7,
+		0x5f, 0xb5, 0x8b, 0xaf, 0xe2, 0xa2, 0xe7, 0x53, 0xf7, 0xc5, 0x14, 0xe4, 0x89, 0x97, 0xc0, 0xea,
+		0x71, 0xf4, 0x56, 0x90, 0x7e, 0x84, 0x5b, 0x21, 0xf5, 0xda, 0x6c, 0x85, 0x53, 0xae, 0xa7, 0x9f,
+		0xee, 0xbb, 0xe6, 0x83, 0x0e, 0xff, 0x7f, 0x4e, 0x53, 0xad, 0x4a, 0xcf, 0xaf, 0x0a, 0x6e, 0xbe,
+		0x1e, 0x04, 0xf8, 0x73, 0x12, 0xec, 0xf7, 0xc4, 0x63, 0x5b, 0x8d, 0x90, 0x14, 0x9f, 0xbc, 0xb1,
+		0x5b, 0x39, 0x1c, 0x96, 0xa2, 0x0f, 0xec, 0x26, 0x24, 0x39, 0xe9, 0x12, 0x5a, 0xb5, 0x1a, 0xd1,
+		0x7c, 0x34, 0x6d, 0xc7, 0xe5, 0x23, 0xdd, 0x9f, 0x0f, 0x1f, 0xd8, 0x2b, 0xe2, 0x63, 0xde, 0x76,
+		0x7a, 0x27, 0x35, 0x93, 0x70, 0x52, 0xbf, 0x4c, 0x3e, 0x5b, 0x67, 0xb7, 0xd6, 0xf5, 0xe6, 0x1b,
+		0x1b, 0x62, 0xaf, 0x1b, 0xe2, 0x6b, 0x69, 0x98, 0x58, 0xb6, 0x5b, 0x6b, 0xc6, 0x55, 0x4c, 0x9e,
+		0x6b, 0xae, 0x6e, 0xa9, 0x16, 0xb6, 0xdf, 0x90, 0x5f, 0xbc, 0xfc, 0xe8, 0x7e, 0x71, 0xb8, 0xd8,
+		0x9a, 0x75, 0xf2, 0xb1, 0x61, 0x5c, 0x37, 0xae, 0xeb, 0xd8, 0x2a, 0x65, 0xc2, 0xfb, 0x25, 0x12,
+		0xec, 0x26, 0x84, 0x35, 0xe9, 0x12, 0xa2, 0xf3, 0x74, 0x99, 0x90, 0xe1, 0xf3, 0xf8, 0x75, 0x09,
+		0x4a, 0xcb, 0xe4, 0xf0, 0xd7, 0xc4, 0xb8, 0x43, 0x67, 0xd3, 0x3e, 0x6f, 0x58, 0xaf, 0x83, 0xe9,
+		0x3c, 0x15, 0x88, 0xc6, 0x24, 0x59, 0x92, 0xe4, 0xba, 0xfc, 0xa2, 0x66, 0x3b, 0x86, 0xa5, 0x35,
+		0xd4, 0x36, 0x3d, 0xa2, 0x7b, 0x49, 0xc3, 0xd2, 0xde, 0x93, 0x86, 0x1f, 0x86, 0xe1, 0x6b, 0x6a,
+		0xdb, 0xc6, 0x0e, 0x3f, 0xc7, 0x1c, 0x0a, 0xbb, 0x33, 0xe1, 0x4b, 0x11, 0x0e, 0xce, 0xd9, 0xf9,
+		0x6c, 0x8a, 0x64, 0x36, 0x04, 0x7c, 0x61, 0x54, 0x83, 0x0c, 0x75, 0x32, 0x58, 0x04, 0x68, 0x7a,
+		0x0f, 0xae, 0x2e, 0x09, 0x12, 0x51, 0x5c, 0xf4, 0x8f, 0x80, 0xa4, 0x0e, 0x30, 0x67, 0x85, 0x1d,
+		0xb9, 0x67, 0xf7, 0x46, 0xc7, 0x0b, 0xe7, 0x08, 0x3a, 0xb2, 0x42, 0x32, 0x10, 0xa8, 0x8b, 0x62,
+		0x02, 0x49, 0x23, 0x20, 0x79, 0xa6, 0x7a, 0x0b, 0xfb, 0x3d, 0xa2, 0x8b, 0x7b, 0xee, 0xe4, 0x80,
+		0xd7, 0x89, 0x8f, 0x9c, 0xac, 0x8c, 0x76, 0xd4, 0xed, 0x39, 0x5a, 0x41, 0x7a, 0xac, 0x66, 0x5f,
+		0xf8, 0x44, 0x65, 0x1f, 0x95, 0xd8, 0x7f, 0x90, 0x00, 0x3c, 0x89, 0xa1, 0x35, 0x92, 0x74, 0x17,
+		0xf0, 0xa8, 0x44, 0xd2, 0x5d, 0xec, 0x99, 0xc3, 0x8b, 0xd6, 0x8c, 0x37, 0x42, 0x53, 0xf0, 0x36,
+		0xc8, 0xb3, 0xb4, 0x99, 0x3a, 0xbd, 0x9d, 0x4a, 0xc5, 0xde, 0x4e, 0x1d, 0x21, 0xb4, 0x6e, 0xec,
+		0x56, 0x10, 0x1b, 0x8e, 0x0f, 0x59, 0xa6, 0x77, 0x56, 0xc0, 0x6a, 0x08, 0x42, 0x70, 0x2c, 0x79,
+		0x9f, 0xbb, 0x4b, 0x93, 0x31, 0x0d, 0x5d, 0xbb, 0x8a, 0x2d, 0x37, 0xf0, 0xc3, 0x8a, 0xe4, 0xff,
+		0xb7, 0xb0, 0xcf, 0x6c, 0x3a, 0x3b, 0xe2, 0xff, 0xb7, 0x88, 0x32, 0xc1, 0xba, 0x8e, 0x37, 0x6c,
+		0x4d, 0xcc, 0x82, 0x22, 0x8a, 0xe8, 0x3c, 0xf9, 0xd4, 0x7f, 0xa3, 0x6b, 0x69, 0xce, 0x4e, 0xbd,
+		0x61, 0xe8, 0x8e, 0xda, 0x70, 0xb8, 0x1f, 0x79, 0xdb, 0x8d, 0xdd, 0xca, 0x41, 0xc6, 0x6b, 0x18,
+		0x42, 0x56, 0xc6, 0x45, 0xd5, 0x1c, 0xab, 0x21, 0x3d, 0x34, 0xb1, 0xa3, 0x6a, 0x6d, 0x9b, 0xc7,
+		0x5a, 0x44, 0xd1, 0x37, 0x96, 0xdf, 0x1d, 0xf1, 0xdf, 0xce, 0x5e, 0x87, 0xa2, 0x61, 0x62, 0x2b,
+		0x42, 0x27, 0x2c, 0x79, 0x3d, 0x87, 0x21, 0x6e, 0x42, 0xcb, 0x8e, 0x0b, 0x1a, 0xbc, 0x82, 0x0c,
+		0xd9, 0x97, 0x00, 0xc5, 0x8e, 0x32, 0xa9, 0xf0, 0x90, 0xc3, 0x10, 0xb2, 0x3f, 0xf3, 0x92, 0xd6,
+		0x90, 0x83, 0xd0, 0xdb, 0x55, 0xad, 0x2d, 0xbe, 0x3d, 0xac, 0xf0, 0x12, 0x5a, 0x84, 0x61, 0xdb,
+		0x51, 0x9d, 0x2e, 0x3b, 0x0d, 0x0e, 0xd5, 0xde, 0x94, 0x90, 0xe7, 0x9a, 0xa1, 0x37, 0x57, 0x29,
+		0xa2, 0xc2, 0x09, 0xa0, 0xf3, 0x30, 0x4c, 0x55, 0x2e, 0x17, 0xea, 0x9e, 0x76, 0x3a, 0x8d, 0x5c,
+		0x33, 0x6c, 0xe4, 0x80, 0xa7, 0x18, 0x99, 0x0d, 0xb0, 0xd9, 0xe9, 0xad, 0xb6, 0xb8, 0xe7, 0xed,
+		0x78, 0x30, 0xac, 0xad, 0x19, 0x3d, 0x59, 0x19, 0x77, 0xab, 0xb8, 0xda, 0x0f, 0x1d, 0xe6, 0x46,
+		0x6e, 0xea, 0x30, 0x77, 0x9e, 0x7c, 0x0d, 0x63, 0xc3, 0xd0, 0xe9, 0x77, 0x42, 0x79, 0xe4, 0x90,
+		0xc4, 0xd6, 0xd2, 0xfe, 0xd9, 0x0a, 0x43, 0xc8, 0xca, 0xb8, 0x5b, 0xc5, 0xd3, 0x81, 0x9b, 0x30,
+		0xe6, 0x41, 0xd1, 0x2d, 0x9b, 0x8b, 0xdd, 0xb2, 0x77, 0xf0, 0x2d, 0xbb, 0x3f, 0xdc, 0x8b, 0xb7,
+		0x6b, 0x47, 0xdd, 0x4a, 0x82, 0x86, 0x7e, 0x22, 0x10, 0xd9, 0x00, 0xde, 0x43, 0x5f, 0x2d, 0x93,
+		0x3c, 0xa8, 0x91, 0x7f, 0x4d, 0x82, 0x1a, 0xd5, 0x02, 0x89, 0x15, 0xba, 0x1b, 0xf6, 0x17, 0x52,
+		0x30, 0x3c, 0x7f, 0x85, 0xbe, 0x2b, 0xfe, 0x31, 0xf5, 0xc8, 0x7c, 0xda, 0xeb, 0x2d, 0x30, 0xc2,
+		0x64, 0x61, 0xa3, 0xd3, 0x30, 0x64, 0x92, 0x1f, 0x25, 0x29, 0x18, 0x29, 0x76, 0x97, 0x34, 0x85,
+		0x13, 0x41, 0x0f, 0x0a, 0x2a, 0x7f, 0x2a, 0x0d, 0x30, 0x7f, 0xe5, 0xca, 0x9a, 0xa5, 0x99, 0x6d,
+		0xec, 0xbc, 0x71, 0xe2, 0x7b, 0xfd, 0x9c, 0xf8, 0x7c, 0x73, 0xfc, 0x04, 0xe4, 0xbd, 0x39, 0x22,
+		0xff, 0x2d, 0x22, 0xeb, 0xf0, 0xdf, 0x7c, 0xaa, 0xcb, 0xbd, 0x53, 0x2d, 0xc0, 0xf9, 0x74, 0xbb,
+		0x18, 0xf2, 0x7f, 0x4d, 0x01, 0xc4, 0xc5, 0x0b, 0x7f, 0x0c, 0xce, 0x34, 0xe7, 0x61, 0x98, 0x5b,
+		0x9c, 0xf4, 0x4d, 0x79, 0xab, 0x1c, 0xdb, 0x37, 0x4b, 0xdf, 0x4e, 0x91, 0x6f, 0x98, 0x73, 0xb5,
+		0xfb, 0x86, 0x84, 0xd1, 0x45, 0x18, 0xc1, 0xba, 0x63, 0x69, 0x58, 0xdc, 0xcc, 0x9c, 0x08, 0xaf,
+		0xd2, 0x08, 0x69, 0xd1, 0xff, 0x1f, 0x22, 0xd2, 0x47, 0x39, 0xba, 0x4f, 0xc6, 0x1f, 0x48, 0x43,
+		0xa9, 0x1f, 0x16, 0x9a, 0x83, 0xf1, 0x86, 0x85, 0x69, 0x45, 0xdd, 0x7f, 0x99, 0x57, 0x2b, 0xfb,
+		0x82, 0x98, 0x41, 0x00, 0x12, 0xc4, 0xe4, 0x35, 0xdc, 0x20, 0xb7, 0x68, 0xcc, 0x94, 0x6c, 0x15,
+		0x02, 0x95, 0xd0, 0x89, 0x96, 0xb9, 0x45, 0xf6, 0x22, 0xa5, 0x7e, 0x02, 0xcc, 0x24, 0x8f, 0x79,
+		0xb5, 0xd4, 0x26, 0x3f, 0x0b, 0xe3, 0x9a, 0xae, 0x39, 0x9a, 0xda, 0xae, 0x6f, 0xa8, 0x6d, 0x55,
+		0x6f, 0xdc, 0xcc, 0x51, 0x84, 0x59, 0x53, 0xde, 0x6d, 0x88, 0x9c, 0xac, 0x8c, 0xf1, 0x9a, 0x1a,
+		0xab, 0x20, 0x33, 0x22, 0xba, 0xca, 0xdc, 0x94, 0xe3, 0x26, 0xd0, 0x7d, 0x33, 0xf2, 0x4b, 0x69,
+		0xf2, 0x66, 0xa2, 0xf9, 0xc6, 0x54, 0xec, 0x6d, 0x2a, 0x96, 0x01, 0x98, 0x02, 0x21, 0x96, 0xa3,
+		0x94, 0xb9, 0x29, 0x15, 0x94, 0x63, 0x14, 0xe6, 0x6d, 0xc7, 0x37, 0x1f, 0x7f, 0x9d, 0x86, 0x82,
+		0x7f, 0x3e, 0xde, 0x30, 0xe9, 0xaf, 0xa3, 0x20, 0xee, 0xac, 0xa7, 0x12, 0x33, 0xfc, 0x43, 0x41,
+		0x21, 0x95, 0xd8, 0xb3, 0x95, 0xfa, 0xeb, 0xc2, 0xbf, 0xcd, 0xc0, 0x30, 0x7f, 0xa8, 0xd0, 0xe8,
+		0x39, 0x45, 0x48, 0x71, 0x0f, 0x21, 0x06, 0x1f, 0x22, 0x5e, 0x88, 0x3c, 0x44, 0x90, 0x27, 0x13,
+		0xf5, 0xc0, 0xb3, 0x3e, 0xe9, 0xc4, 0x68, 0xed, 0x90, 0x47, 0x25, 0xd8, 0xce, 0x62, 0x21, 0x5e,
+		0x92, 0x3a, 0x7a, 0x18, 0xf2, 0x04, 0xc2, 0xb3, 0x0a, 0x04, 0xfd, 0x80, 0x17, 0x7c, 0xf0, 0x35,
+		0xca, 0x0a, 0x74, 0xd4, 0xed, 0x05, 0x56, 0x40, 0x4b, 0x80, 0xb6, 0xdc, 0xd0, 0x57, 0xdd, 0x13,
+		0x21, 0xc1, 0xbf, 0xfd, 0xc6, 0x6e, 0xe5, 0x10, 0xc3, 0xef, 0x85, 0x91, 0x95, 0x09, 0xaf, 0x52,
+		0x50, 0x3b, 0x03, 0x40, 0xc6, 0x55, 0x67, 0x49, 0x52, 0xec, 0x08, 0xeb, 0xcb, 0x1c, 0xf2, 0xda,
+		0x64, 0x25, 0x47, 0x0a, 0xf3, 0xe4, 0x37, 0xda, 0x01, 0x24, 0xe2, 0x8c, 0x3c, 0x5e, 0xd9, 0x50,
+		0x4d, 0x7e, 0x5c, 0x7d, 0x62, 0xcf, 0xc7, 0xd5, 0x43, 0xc1, 0x10, 0xa8, 0x47, 0x51, 0x56, 0x8a,
+		0xa2, 0x92, 0x9e, 0x57, 0xe7, 0x54, 0x53, 0x1c, 0xbe, 0xc2, 0x77, 0x79, 0x23, 0x7b, 0x3e, 0x7c,
+		0xb1, 0xbe, 0x7d, 0x87, 0xaf, 0x9e, 0x3b, 0x3d, 0x72, 0xf8, 0x0a, 0x86, 0x9b, 0x7c, 0x2b, 0xee,
+		0x97, 0x25, 0x40, 0x9e, 0xd1, 0x75, 0x73, 0x67, 0x7e, 0x82, 0xbe, 0x50, 0xe6, 0xb5, 0xee, 0xf7,
+		0x26, 0x7a, 0xce, 0xd3, 0x02, 0x42, 0x9c, 0x2e, 0x3d, 0x1c, 0x74, 0xaf, 0x67, 0x98, 0xfa, 0x87,
+		0x43, 0x23, 0x8c, 0xcf, 0xbf, 0x93, 0xe0, 0x50, 0xcf, 0x8e, 0x71, 0xf9, 0xba, 0x02, 0xc8, 0xf2,
+		0x35, 0xf2, 0xff, 0x55, 0x26, 0xf1, 0xff, 0xfd, 0x99, 0x70, 0xe3, 0x4d, 0x58, 0xe1, 0x86, 0x5b,
+		0x68, 0x46, 0x79, 0x28, 0x55, 0x82, 0x29, 0x7f, 0xf7, 0xee, 0x00, 0xce, 0x43, 0xc1, 0xdf, 0x3b,
+		0x67, 0xfd, 0xf0, 0x20, 0xd6, 0x39, 0xd7, 0x01, 0x3c, 0xb4, 0xe8, 0xa9, 0x1d, 0xf1, 0xef, 0x67,
+		0xe3, 0x46, 0xef, 0xa6, 0xb4, 0x86, 0xd4, 0x0f, 0xe3, 0xf8, 0xff, 0x49, 0x90, 0x59, 0x31, 0x8c,
+		0x36, 0x32, 0x60, 0x42, 0x37, 0x9c, 0x3a, 0xd9, 0x25, 0xb8, 0x59, 0xe7, 0x41, 0x21, 0x16, 0xfe,
+		0x9d, 0xdb, 0x9b, 0x50, 0xbe, 0xb7, 0x5b, 0xe9, 0x25, 0xa5, 0x8c, 0xeb, 0x86, 0x53, 0xa3, 0x35,
+		0x2c, 0x78, 0x8f, 0xde, 0x09, 0xa3, 0xc1, 0xce, 0x58, 0x88, 0xec, 0xa9, 0x3d, 0x77, 0x16, 0x24,
+		0x73, 0x63, 0xb7, 0x32, 0xe5, 0xed, 0x7e, 0xb7, 0x5a, 0x56, 0x0a, 0x1b, 0xbe, 0xde, 0x59, 0xc6,
+		0xda, 0xf7, 0x89, 0x04, 0x7e, 0x2f, 0x05, 0x53, 0x81, 0xdb, 0x21, 0x05, 0x5f, 0x57, 0xad, 0x66,
+		0x1f, 0x8f, 0x5b, 0x7a, 0x6d, 0x3c, 0xee, 0x8f, 0x49, 0x70, 0xb0, 0xd1, 0x65, 0xf9, 0x7f, 0xd7,
+		0x70, 0xdd, 0xa2, 0x2c, 0xd5, 0xa9, 0xc2, 0x2f, 0xa5, 0x82, 0xd9, 0x74, 0x3c, 0x57, 0xb4, 0xb6,
+		0xce, 0xcd, 0xc0, 0x11, 0xee, 0x2e, 0x45, 0x63, 0xcb, 0x9f, 0xf9, 0x56, 0xe5, 0x9e, 0x64, 0xfa,
+		0x85, 0x50, 0x25, 0x8f, 0x30, 0x5d, 0x42, 0x4c, 0x30, 0x0a, 0x25, 0xf3, 0xe7, 0x29, 0x98, 0x0c,
+		0x49, 0xad, 0x61, 0x58, 0x4d, 0x74, 0x0c, 0x52, 0x9a, 0xc8, 0xe1, 0x9c, 0x7c, 0x71, 0xb7, 0x92,
+		0x5a, 0x9c, 0xbf, 0xb1, 0x5b, 0xc9, 0x71, 0x5f, 0xaa, 0x29, 0x2b, 0x29, 0xad, 0xf9, 0x23, 0x3d,
+		0xcb, 0x0c, 0x92, 0x6c, 0xfa, 0x75, 0x20, 0xd9, 0xda, 0xf9, 0xbe, 0xd9, 0x61, 0xf7, 0x0e, 0xa4,
+		0xbe, 0xed, 0xa6, 0x81, 0x05, 0xf3, 0xc4, 0xfe, 0x08, 0xe0, 0x20, 0x03, 0xf3, 0x00, 0xb6, 0xfb,
+		0xa4, 0x88, 0xc5, 0x66, 0x7a, 0x45, 0xe7, 0x90, 0x0d, 0xcc, 0x43, 0x8b, 0xce, 0xfe, 0x3a, 0x0c,
+		0xe5, 0xde, 0xe4, 0x2f, 0xa1, 0xaa, 0xe4, 0x32, 0x94, 0xc2, 0x99, 0x3b, 0x6e, 0xdb, 0x7e, 0x98,
+		0xf4, 0xa5, 0x86, 0xb8, 0xd5, 0x57, 0xa1, 0xdc, 0x9b, 0xf7, 0x20, 0x5a, 0xd1, 0x72, 0xef, 0x01,
+		0x64, 0x2f, 0x5f, 0x53, 0x0a, 0x1d, 0x33, 0xe4, 0x4d, 0xd8, 0x1f, 0xb8, 0x8f, 0x7f, 0xb5, 0xfa,
+		0xb9, 0x00, 0x87, 0x7a, 0xee, 0xae, 0xdd, 0xbe, 0xbc, 0x8b, 0x47, 0x29, 0xee, 0xe2, 0x51, 0xbe,
+		0x04, 0x47, 0xfb, 0x5d, 0x9e, 0xde, 0x0c, 0xbd, 0xd3, 0x1f, 0x1f, 0x82, 0xf4, 0xb2, 0xdd, 0x42,
+		0x2a, 0x8c, 0x87, 0x13, 0xf8, 0xe4, 0xb0, 0x45, 0xea, 0x9d, 0xe7, 0xf2, 0xa9, 0x78, 0x18, 0x97,
+		0xad, 0xb7, 0xc1, 0x68, 0x30, 0x85, 0xeb, 0x68, 0x04, 0x72, 0x00, 0xa2, 0x7c, 0x22, 0x0e, 0xc2,
+		0x25, 0xbe, 0x44, 0x92, 0xde, 0x79, 0x4e, 0xc5, 0x6d, 0x11, 0x58, 0xa2, 0xb1, 0x7c, 0x6c, 0x40,
+		0xa3, 0x4b, 0x4d, 0x85, 0xf1, 0x70, 0xe2, 0x4d, 0x94, 0x34, 0x42, 0x30, 0xe5, 0x53, 0xf1, 0x30,
+		0x6e, 0x17, 0x0a, 0x80, 0x2f, 0x0d, 0xe4, 0xf6, 0x08, 0x4c, 0xaf, 0xb9, 0x7c, 0xd7, 0xc0, 0x66,
+		0x97, 0xe6, 0x4f, 0xc1, 0x58, 0x28, 0x3d, 0xe2, 0x8e, 0x08, 0xc4, 0x20, 0x48, 0xf9, 0x64, 0x2c,
+		0x88, 0x4b, 0xdf, 0x86, 0xfd, 0xd1, 0xd7, 0xf6, 0x51, 0xf3, 0x14, 0x09, 0x59, 0xbe, 0x3f, 0x29,
+		0xa4, 0xeb, 0xed, 0xdc, 0x6a, 0x35, 0xfa, 0xb5, 0x21, 0xd8, 0xcf, 0xb6, 0xf0, 0x8c, 0x6a, 0x6a,
+		0x33, 0x5b, 0x8e, 0x23, 0xbe, 0x34, 0x0a, 0xac, 0x7a, 0x5a, 0x35, 0x35, 0x79, 0x07, 0x32, 0x17,
+		0x1d, 0xc7, 0x44, 0xa7, 0x60, 0xc8, 0xea, 0xb6, 0xdd, 0x4f, 0x2a, 0xb8, 0x99, 0xd5, 0xaa, 0xa9,
+		0x4d, 0x13, 0x00, 0xa5, 0xdb, 0xc6, 0x0a, 0x03, 0x41, 0x0b, 0x50, 0xd9, 0xec, 0xb6, 0xdb, 0x3b,
+		0xf5, 0x26, 0x6e, 0x18, 0x4d, 0x62, 0x54, 0xf8, 0x7f, 0xe1, 0xc7, 0xdb, 0xa6, 0xaa, 0xbb, 0x19,
+		0xac, 0x59, 0xe5, 0x30, 0x05, 0x9b, 0xa7, 0x50, 0xe2, 0x3f, 0xf0, 0x2f, 0x08, 0x18, 0xf9, 0x2f,
+		0x53, 0x90, 0x15, 0xa4, 0xc9, 0x5d, 0xad, 0x8d, 0xdb, 0xb8, 0xe1, 0xb8, 0xdf, 0xde, 0x71, 0xcb,
+		0x08, 0x41, 0xba, 0x85, 0xf9, 0xdb, 0x91, 0x8b, 0xfb, 0x14, 0x52, 0x20, 0x75, 0x66, 0x97, 0x25,
+		0x85, 0xd0, 0x3a, 0xb3, 0x4b, 0x3e, 0x84, 0x96, 0x31, 0x0d, 0x11, 0xd2, 0x20, 0xdf, 0x8a, 0x22,
+		0x25, 0x54, 0x82, 0x61, 0xb2, 0x80, 0x1c, 0xf6, 0xff, 0x11, 0x49, 0x3d, 0x2f, 0xa3, 0x03, 0xe4,
+		0xd2, 0xc2, 0x69, 0xb0, 0xb7, 0xd4, 0xa4, 0x81, 0x15, 0x49, 0x7a, 0x02, 0xfb, 0x2e, 0x35, 0xff,
+		0xd2, 0xca, 0xed, 0x7e, 0x61, 0xb0, 0x7f, 0x00, 0x46, 0xf8, 0x5e, 0x51, 0x1d, 0x07, 0x5b, 0xe4,
+		0x1b, 0x50, 0x1c, 0x9c, 0xe4, 0x6d, 0x6f, 0x18, 0x4d, 0xf6, 0xfd, 0xa7, 0x9c, 0x42, 0x7f, 0x93,
+		0xb7, 0xca, 0xe2, 0xa5, 0x40, 0x9d, 0x36, 0xb2, 0x6c, 0xf4, 0x82, 0xa8, 0xac, 0x11, 0xa0, 0x05,
+		0x98, 0x54, 0x9b, 0x4d, 0x8d, 0xa8, 0x51, 0x12, 0xb9, 0xd1, 0xe8, 0xc9, 0xd7, 0x2e, 0xe5, 0x07,
+		0xcc, 0x05, 0xf2, 0x10, 0x6a, 0x1c, 0xbe, 0x96, 0x83, 0x11, 0x93, 0x31, 0x25, 0x9f, 0x83, 0x89,
+		0x1e, 0x4e, 0x09, 0x7f, 0x57, 0x35, 0xfe, 0x75, 0x80, 0x9c, 0x42, 0x7f, 0x47, 0x7d, 0x69, 0xae,
+		0xf6, 0xb3, 0xfd, 0xbf, 0x95, 0x3a, 0xe6, 0x7b, 0xff, 0xa4, 0x9a, 0x5a, 0x2d, 0x47, 0xe9, 0xf3,
+		0xb7, 0x4f, 0xb3, 0xbc, 0x81, 0x7d, 0x1e, 0x75, 0xda, 0xb0, 0x5a, 0x33, 0x2d, 0xac, 0x0b, 0xeb,
+		0x4b, 0x9a, 0x54, 0x53, 0xb3, 0xe9, 0x72, 0xf4, 0xfe, 0xa9, 0xa0, 0x7d, 0xce, 0xf7, 0x9b, 0x7e,
+		0x39, 0x35, 0x73, 0x61, 0x76, 0x65, 0xd1, 0x5d, 0xc7, 0xff, 0x36, 0x05, 0x87, 0x7d, 0xeb, 0xd8,
+		0x07, 0xdc, 0xbb, 0x9c, 0xcb, 0xd1, 0x2b, 0x3e, 0xc1, 0xbf, 0xe4, 0x7b, 0x02, 0x32, 0x04, 0x1e,
+		0x1d, 0x19, 0xfc, 0xcf, 0x51, 0x4b, 0x9f, 0xfb, 0x8f, 0x5f, 0x91, 0x8f, 0x4a, 0x7d, 0x67, 0x85,
+		0x12, 0xa9, 0xbd, 0x37, 0xb9, 0xfc, 0x8a, 0xde, 0xff, 0x53, 0xb4, 0x6f, 0x9d, 0x18, 0xc3, 0x32,
+		0xfc, 0x4c, 0x0a, 0x6e, 0xe7, 0x9a, 0x83, 0x7e, 0x6c, 0x6c, 0xc6, 0x54, 0x5b, 0x9a, 0xee, 0xff,
+		0xd6, 0x6e, 0x81, 0x35, 0x4f, 0xd3, 0x66, 0x59, 0x87, 0xfc, 0x8a, 0xda, 0xc2, 0xe2, 0xab, 0xad,
+		0xbd, 0x9f, 0x50, 0x3e, 0x00, 0xc3, 0xc6, 0xe6, 0xa6, 0xcd, 0x77, 0x65, 0x46, 0xe1, 0x25, 0xf2,
+		0x8e, 0xa0, 0xad, 0x75, 0x34, 0xb6, 0x31, 0x33, 0x0a, 0x2b, 0x90, 0xaf, 0x58, 0x35, 0x88, 0x15,
+		0xae, 0xb3, 0x37, 0xeb, 0x19, 0xf1, 0x0f, 0x7c, 0xba, 0xba, 0xb3, 0x46, 0x6a, 0xe4, 0xc7, 0xa0,
+		0xc0, 0xfa, 0xe3, 0x0a, 0xf8, 0x10, 0x64, 0xe9, 0x07, 0x08, 0xbc, 0x5e, 0x47, 0x48, 0x99, 0xbf,
+		0x20, 0x67, 0x54, 0x58, 0xc7, 0xac, 0x50, 0xab, 0xf5, 0x55, 0x9e, 0x27, 0xe2, 0x3d, 0x5c, 0x26,
+		0x13, 0x57, 0x58, 0x7f, 0xfe, 0x10, 0x94, 0x43, 0xfe, 0x21, 0x05, 0xe8, 0xe3, 0x82, 0x0e, 0x5c,
+		0x9c, 0xe5, 0xc1, 0x62, 0xbf, 0x19, 0xf7, 0x54, 0xbe, 0x0a, 0x07, 0xe8, 0x13, 0x58, 0x2f, 0xba,
+		0x25, 0xe6, 0xe9, 0x80, 0x9b, 0x67, 0xc1, 0x76, 0x38, 0x2f, 0xa1, 0xb3, 0x00, 0x5e, 0xcf, 0xee,
+		0x87, 0x4a, 0xfc, 0x33, 0x3e, 0xed, 0x9b, 0x6e, 0xc5, 0x07, 0x2c, 0xff, 0xba, 0x04, 0x07, 0x7b,
+		0x7a, 0xe3, 0xb3, 0xf4, 0x58, 0xe0, 0x43, 0x5b, 0x52, 0xb2, 0x54, 0x2e, 0x1f, 0x0a, 0xaa, 0x46,
+		0xf0, 0x55, 0x8e, 0xe2, 0x8b, 0x75, 0x18, 0x60, 0xec, 0x59, 0xd8, 0x1f, 0xe4, 0x4b, 0x08, 0xe1,
+		0x69, 0x18, 0x0b, 0x1e, 0xc4, 0xf8, 0x11, 0xf8, 0x4d, 0x7b, 0x3f, 0x8c, 0x8d, 0x06, 0x0e, 0x63,
+		0xf2, 0x53, 0x61, 0xc1, 0xbb, 0x92, 0x78, 0x4b, 0xef, 0x47, 0x0b, 0x62, 0x05, 0xe1, 0x61, 0xc8,
+		0x5f, 0x94, 0xe0, 0x68, 0x90, 0xb2, 0x17, 0xb2, 0xb2, 0x5f, 0xf5, 0x71, 0xbd, 0x92, 0xe5, 0xf1,
+		0xa7, 0x12, 0xdc, 0x31, 0x80, 0x73, 0x2e, 0x1e, 0x0b, 0xa6, 0x7c, 0xb1, 0x30, 0xef, 0xe1, 0x1c,
+		0x5b, 0x32, 0x72, 0xff, 0x78, 0x9d, 0xeb, 0x1c, 0xdd, 0xc6, 0x1f, 0x51, 0x4d, 0xf6, 0xb6, 0xd9,
+		0xca, 0x64, 0x6f, 0xfc, 0xea, 0x95, 0xad, 0xad, 0xaf, 0x4a, 0x70, 0x32, 0x38, 0xaa, 0x88, 0x8b,
+		0xbf, 0xd7, 0xf7, 0xc4, 0xfc, 0x91, 0x04, 0xa7, 0x92, 0x0c, 0x81, 0xcf, 0xd0, 0x33, 0x30, 0xe9,
+		0x85, 0xe1, 0xc3, 0x13, 0x74, 0x2c, 0xc1, 0xe5, 0x29, 0x5f, 0xd4, 0xc8, 0xa5, 0x72, 0x6b, 0x66,
+		0xe2, 0x4f, 0x24, 0xbe, 0xe7, 0xfc, 0xf3, 0xee, 0x8a, 0x3d, 0x78, 0x73, 0xb4, 0x47, 0xb1, 0xfb,
+		0x6e, 0x8f, 0x46, 0x03, 0xb7, 0x47, 0x11, 0x13, 0x9a, 0xba, 0x45, 0x1a, 0xe4, 0xbd, 0x42, 0x9b,
+		0x46, 0x84, 0xb2, 0xaf, 0xc2, 0x64, 0xc4, 0x26, 0x71, 0xbf, 0xd4, 0x15, 0xbf, 0x47, 0x0e, 0xfc,
+		0x60, 0xb7, 0x12, 0x11, 0x23, 0x57, 0x50, 0xef, 0xf6, 0x90, 0xff, 0x8b, 0x04, 0x15, 0xca, 0x48,
+		0xc4, 0x54, 0xfe, 0x43, 0x16, 0x30, 0x86, 0xa3, 0xfd, 0x87, 0xc5, 0x05, 0x3d, 0x0b, 0xc3, 0x6c,
+		0x95, 0x72, 0xd9, 0xee, 0x61, 0x79, 0x73, 0x44, 0x4f, 0x61, 0xcf, 0x8b, 0x71, 0x45, 0xeb, 0x85,
+		0x57, 0x49, 0x7e, 0xaf, 0x40, 0x2f, 0x7c, 0x45, 0x28, 0xec, 0x68, 0xce, 0xdd, 0x10, 0xc6, 0x2b,
+		0x55, 0xd8, 0xfc, 0x0b, 0xcc, 0xaf, 0x9a, 0x66, 0x76, 0xd9, 0x8f, 0xd1, 0xcc, 0xaf, 0xbf, 0x19,
+		0x70, 0x35, 0x73, 0xcc, 0x10, 0x5e, 0xe7, 0x9a, 0xf9, 0x46, 0x0a, 0x0e, 0xd1, 0x61, 0xf8, 0xef,
+		0x6f, 0x5e, 0x03, 0xc9, 0xd7, 0x01, 0x91, 0x5b, 0xfa, 0x5b, 0xa5, 0x3f, 0x8a, 0xb6, 0xd5, 0xb8,
+		0x12, 0x30, 0xba, 0x75, 0x40, 0xe4, 0xfa, 0x3d, 0xd4, 0x41, 0xfa, 0xa6, 0x3b, 0x68, 0xda, 0xce,
+		0x95, 0x01, 0x56, 0x3d, 0xb3, 0x97, 0xb5, 0xf3, 0x87, 0x12, 0x94, 0xa3, 0x84, 0xee, 0x86, 0xf3,
+		0x0e, 0x04, 0x6e, 0x1d, 0xc3, 0xcb, 0xe5, 0xce, 0x41, 0x77, 0x6f, 0xa1, 0xad, 0xbb, 0xdf, 0xc2,
+		0xb7, 0x7a, 0xf3, 0x7e, 0x41, 0x18, 0x1d, 0x77, 0xe5, 0xf7, 0x1e, 0x61, 0x5e, 0x97, 0x5b, 0xf6,
+		0xe3, 0x3d, 0xea, 0xfe, 0xf5, 0x76, 0x1a, 0xfa, 0x33, 0x09, 0x8e, 0xf4, 0xe1, 0xf0, 0x1f, 0xb2,
+		0x39, 0xff, 0xe9, 0xbe, 0x0b, 0xe6, 0x56, 0x1d, 0xbd, 0xce, 0xf0, 0x0d, 0x15, 0x7c, 0xde, 0xe4,
+		0x3b, 0x50, 0x47, 0x7e, 0x9b, 0xff, 0x49, 0xb8, 0x2d, 0x12, 0x8b, 0xf3, 0x74, 0x1a, 0x32, 0x24,
+		0xf5, 0xc3, 0xfd, 0x60, 0x65, 0x88, 0x9d, 0x10, 0x16, 0x85, 0x95, 0x11, 0x14, 0x29, 0x49, 0x72,
+		0xb7, 0xcd, 0xbb, 0x97, 0xe7, 0x60, 0xc2, 0x57, 0xc7, 0x89, 0x4f, 0x93, 0x28, 0xa7, 0xd1, 0xe6,
+		0xc4, 0xa7, 0xc2, 0xc4, 0x09, 0x2c, 0x1f, 0x26, 0x85, 0x93, 0xa7, 0x00, 0x31, 0x22, 0xec, 0x53,
+		0xaa, 0x9c, 0xf4, 0xfb, 0x24, 0x98, 0x0c, 0x54, 0xbb, 0x1f, 0x6c, 0x19, 0x0e, 0x7c, 0x85, 0xbb,
+		0x27, 0x93, 0x9b, 0xc1, 0xbb, 0x9f, 0xf5, 0xa3, 0xa5, 0x57, 0xb2, 0x74, 0x4f, 0xbf, 0x30, 0x29,
+		0xbe, 0x0e, 0xf6, 0x33, 0x12, 0x80, 0x2f, 0x65, 0xe7, 0xee, 0x70, 0xd7, 0xd1, 0x51, 0x8f, 0xf2,
+		0xf1, 0x58, 0x38, 0xee, 0xf4, 0xca, 0x3f, 0xfb, 0xa7, 0xdf, 0xfd, 0xd5, 0xd4, 0x61, 0x54, 0x9e,
+		0x09, 0xc5, 0x57, 0x7c, 0x9b, 0xf0, 0x03, 0x92, 0xff, 0x45, 0xce, 0x5d, 0x83, 0x49, 0x0b, 0x0e,
+		0xee, 0x8e, 0x03, 0xe3, 0x0c, 0x3c, 0x40, 0x19, 0xb8, 0x0f, 0xdd, 0xd3, 0x9f, 0x81, 0x99, 0x77,
+		0x04, 0xb7, 0xd2, 0xbb, 0xd0, 0x57, 0x24, 0x98, 0x8a, 0x3a, 0x5d, 0xa3, 0xfb, 0x07, 0xf7, 0xda,
+		0xeb, 0x0f, 0x95, 0xdf, 0xb4, 0x07, 0x0c, 0xce, 0xf2, 0x63, 0x94, 0xe5, 0xb3, 0xe8, 0xe1, 0x3d,
+		0xb0, 0x3c, 0xe3, 0xb3, 0x4d, 0xe8, 0xdb, 0x12, 0xdc, 0x3e, 0xf0, 0x0c, 0x8a, 0xce, 0x0e, 0xe6,
+		0x6a, 0x80, 0x83, 0x57, 0xae, 0xde, 0x0c, 0x2a, 0x1f, 0xd9, 0x22, 0x1d, 0xd9, 0x1c, 0x9a, 0xdd,
+		0xcb, 0xc8, 0x3c, 0x57, 0xcc, 0x3f, 0xc6, 0xcf, 0x4a, 0x81, 0xbc, 0xf6, 0xe8, 0xe5, 0xd0, 0x73,
+		0xc0, 0x2a, 0x1f, 0x8f, 0x85, 0xe3, 0xac, 0xae, 0x50, 0x56, 0x1f, 0x47, 0x17, 0x6f, 0x72, 0x12,
+		0x66, 0xde, 0x11, 0xd4, 0xfc, 0xef, 0x42, 0xdf, 0x92, 0xa2, 0x13, 0xc6, 0x67, 0x22, 0x59, 0xea,
+		0x7f, 0x48, 0x2c, 0xdf, 0x9f, 0x1c, 0x81, 0x0f, 0x06, 0xd3, 0xc1, 0xd4, 0xd1, 0x4f, 0xde, 0xaa,
+		0xc1, 0x44, 0x4e, 0x0a, 0xfa, 0xbc, 0x04, 0x53, 0x51, 0x67, 0x9c, 0x3e, 0xdb, 0x66, 0xc0, 0x41,
+		0xae, 0xcf, 0xb6, 0x19, 0x74, 0x80, 0x92, 0xcf, 0xd0, 0x41, 0x4e, 0xa3, 0x7b, 0xc3, 0x83, 0x1c,
+		0x38, 0x2b, 0x64, 0xaf, 0x0c, 0x3c, 0x15, 0xf4, 0xd9, 0x2b, 0x49, 0x0e, 0x43, 0x7d, 0xf6, 0x4a,
+		0xa2, 0x43, 0x48, 0xff, 0xbd, 0xe2, 0x8e, 0x20, 0xe1, 0xb4, 0xd8, 0xe8, 0xb7, 0xe9, 0xff, 0x53,
+		0xf0, 0xd7, 0x9c, 0x8c, 0x64, 0x2c, 0xea, 0x58, 0x51, 0x3e, 0x95, 0x04, 0x94, 0xf3, 0x3c, 0x4b,
+		0x79, 0x3e, 0x87, 0xce, 0xee, 0x85, 0x67, 0x2b, 0xc0, 0xd9, 0x97, 0x24, 0x98, 0x8c, 0x70, 0xf9,
+		0xfa, 0xec, 0x92, 0xfe, 0x5e, 0x6d, 0xf9, 0xfe, 0xe4, 0x08, 0x9c, 0xfb, 0x47, 0x29, 0xf7, 0x8f,
+		0xa0, 0x87, 0xf6, 0xc2, 0xbd, 0xcf, 0x8e, 0xfd, 0xb1, 0x97, 0x2f, 0xe9, 0xa3, 0x8f, 0xa6, 0x13,
+		0x32, 0x22, 0x18, 0x9f, 0x49, 0x0c, 0xcf, 0xf9, 0xbe, 0x4c, 0xf9, 0x5e, 0x44, 0x17, 0x6e, 0x8e,
+		0xef, 0x5e, 0xf3, 0xf7, 0xe1, 0xde, 0xb7, 0xe7, 0xd1, 0xab, 0x20, 0xd2, 0x83, 0x2b, 0xdf, 0x93,
+		0x08, 0x96, 0x33, 0x7f, 0x3f, 0x65, 0xfe, 0x14, 0x3a, 0x11, 0x66, 0xde, 0x97, 0xdd, 0x4b, 0x3e,
+		0x5f, 0x36, 0xf3, 0x0e, 0xe6, 0x07, 0xbe, 0x0b, 0x69, 0x3c, 0x19, 0xf1, 0x68, 0x64, 0x37, 0x3e,
+		0x5f, 0xae, 0x7c, 0xc7, 0x00, 0x08, 0xde, 0xfd, 0x61, 0xda, 0xfd, 0x01, 0x34, 0x15, 0xee, 0x9e,
+		0xf8, 0x71, 0xe8, 0x59, 0x37, 0xe5, 0x5a, 0x8e, 0x26, 0xe5, 0xf7, 0xef, 0xca, 0xc7, 0x06, 0xc2,
+		0xf0, 0x0e, 0x8f, 0xd0, 0x0e, 0x4b, 0xe8, 0x40, 0x4f, 0x87, 0xcc, 0xc9, 0xbb, 0xc5, 0x29, 0x09,
+		0xff, 0x7f, 0x00, 0xd7, 0x0b, 0x15, 0x59, 0xdc, 0xaa, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2353,6 +2687,66 @@ func (this *MsgUndelegate) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgTokenizeShares) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTokenizeShares)
+	if !ok {
+		that2, ok := that.(MsgTokenizeShares)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if !bytes.Equal(this.TokenizedShareOwner, that1.TokenizedShareOwner) {
+		return false
+	}
+	return true
+}
+func (this *MsgRedeemTokensForShares) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRedeemTokensForShares)
+	if !ok {
+		that2, ok := that.(MsgRedeemTokensForShares)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	return true
+}
 func (this *HistoricalInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2792,6 +3186,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.TokenizeShareCap.Equal(that1.TokenizeShareCap) {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
 	return true
 }
 func (this *DelegationResponse) Equal(that interface{}) bool {
@@ -3203,7 +3603,7 @@ func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
+func (m *MsgTokenizeShares) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3213,25 +3613,119 @@ func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Valset) > 0 {
-		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
+	if len(m.TokenizedShareOwner) > 0 {
+		i -= len(m.TokenizedShareOwner)
+		copy(dAtA[i:], m.TokenizedShareOwner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.TokenizedShareOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valset) > 0 {
+		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
 			dAtA[i] = 0x12
@@ -3323,12 +3817,12 @@ func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
-	if err9 != nil {
-		return 0, err9
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -3442,12 +3936,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -3810,12 +4304,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err14 != nil {
-		return 0, err14
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n14
-	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i -= n16
+	i = encodeVarintStaking(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3866,12 +4360,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err15 != nil {
-		return 0, err15
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n15
-	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i -= n17
+	i = encodeVarintStaking(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3960,6 +4454,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TokenizeShareCap.Size()
+		i -= size
+		if _, err := m.TokenizeShareCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3982,12 +4496,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err16 != nil {
-		return 0, err16
+	n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n16
-	i = encodeVarintStaking(dAtA, i, uint64(n16))
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4169,6 +4683,99 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -4293,6 +4900,44 @@ func (m *MsgUndelegate) Size() (n int) {
 	return n
 }
 
+func (m *MsgTokenizeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.TokenizedShareOwner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemTokensForShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *HistoricalInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4599,6 +5244,10 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.TokenizeShareCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4658,18 +5307,59 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *TokenizeShareRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovStaking(uint64(m.ID))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowStaking
@@ -5638,7 +6328,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5661,17 +6351,17 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5681,28 +6371,63 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5729,11 +6454,44 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valset = append(m.Valset, Validator{})
-			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedShareOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizedShareOwner = append(m.TokenizedShareOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenizedShareOwner == nil {
+				m.TokenizedShareOwner = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5758,7 +6516,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemTokensForShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5781,17 +6539,17 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5801,65 +6559,31 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5869,23 +6593,22 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5913,7 +6636,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5936,15 +6659,15 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5971,13 +6694,13 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6004,7 +6727,8 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Valset = append(m.Valset, Validator{})
+			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6032,7 +6756,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6055,15 +6779,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6091,11 +6815,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6123,11 +6849,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6155,23 +6883,293 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7347,15 +8345,188 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7383,7 +8554,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7406,10 +8577,10 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7500,7 +8671,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7528,7 +8699,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7556,7 +8727,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7579,17 +8750,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.CreationHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7599,16 +8770,31 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7618,30 +8804,31 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSrcAddress == nil {
+				m.ValidatorSrcAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7651,31 +8838,31 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorDstAddress == nil {
+				m.ValidatorDstAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7685,23 +8872,23 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7729,7 +8916,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7752,17 +8939,17 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7772,31 +8959,87 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DelegatorAddress == nil {
-				m.DelegatorAddress = []byte{}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7806,31 +9049,150 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenizeShareCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorSrcAddress == nil {
-				m.ValidatorSrcAddress = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7840,29 +9202,28 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorDstAddress == nil {
-				m.ValidatorDstAddress = []byte{}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7889,8 +9250,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7918,7 +9278,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7941,15 +9301,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7976,70 +9336,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
-			}
-			m.MaxValidators = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
-			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
-			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8067,7 +9370,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8093,7 +9398,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8116,15 +9421,15 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8151,13 +9456,13 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8184,7 +9489,8 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8212,7 +9518,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8235,17 +9541,17 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -8255,28 +9561,29 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8304,7 +9611,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8332,7 +9639,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8355,17 +9662,17 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -8375,28 +9682,29 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8423,8 +9731,8 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8452,7 +9760,7 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8475,17 +9783,36 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -8495,31 +9822,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -8529,23 +9856,23 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
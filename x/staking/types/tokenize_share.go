@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ShareTokenDenomPrefix is the prefix of the bank denomination of the share
+// tokens minted when a delegation is tokenized.
+const ShareTokenDenomPrefix = "share/"
+
+// GetShareTokenDenom returns the bank denomination of the share tokens of the
+// given tokenize share record of the given validator. One share token
+// represents one delegator share of the validator, so its value in bond tokens
+// follows the validator exchange rate.
+func GetShareTokenDenom(valAddr sdk.ValAddress, recordID uint64) string {
+	return fmt.Sprintf("%s%s/%d", ShareTokenDenomPrefix, valAddr, recordID)
+}
+
+// ParseShareTokenDenom returns the validator address and the tokenize share
+// record ID of the given share token denomination.
+func ParseShareTokenDenom(denom string) (sdk.ValAddress, uint64, error) {
+	if !strings.HasPrefix(denom, ShareTokenDenomPrefix) {
+		return nil, 0, sdkerrors.Wrap(ErrInvalidShareTokenDenom, denom)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(denom, ShareTokenDenomPrefix), "/")
+	if len(parts) != 2 {
+		return nil, 0, sdkerrors.Wrap(ErrInvalidShareTokenDenom, denom)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(parts[0])
+	if err != nil {
+		return nil, 0, sdkerrors.Wrapf(ErrInvalidShareTokenDenom, "%s: %s", denom, err)
+	}
+
+	recordID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrapf(ErrInvalidShareTokenDenom, "%s: %s", denom, err)
+	}
+
+	return valAddr, recordID, nil
+}
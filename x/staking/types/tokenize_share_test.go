@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestShareTokenDenom(t *testing.T) {
+	denom := GetShareTokenDenom(valAddr1, 7)
+	require.NoError(t, sdk.ValidateDenom(denom))
+
+	valAddr, recordID, err := ParseShareTokenDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, valAddr1, valAddr)
+	require.Equal(t, uint64(7), recordID)
+
+	_, _, err = ParseShareTokenDenom(sdk.DefaultBondDenom)
+	require.Error(t, err)
+
+	_, _, err = ParseShareTokenDenom(ShareTokenDenomPrefix + "foo/1")
+	require.Error(t, err)
+
+	_, _, err = ParseShareTokenDenom(ShareTokenDenomPrefix + valAddr1.String())
+	require.Error(t, err)
+
+	_, _, err = ParseShareTokenDenom(ShareTokenDenomPrefix + valAddr1.String() + "/foo")
+	require.Error(t, err)
+}
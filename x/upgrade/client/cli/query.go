@@ -21,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
+		GetPlanHistoryCmd(),
 	)
 
 	return cmd
@@ -113,3 +114,38 @@ func GetAppliedPlanCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetPlanHistoryCmd returns the history of the scheduled, cancelled, applied
+// and skipped upgrade plans.
+func GetPlanHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "get the history of upgrade plans",
+		Long: "Gets the history of the upgrade plans, with the block height at which each plan was scheduled, " +
+			"cancelled, applied or skipped, from the oldest to the latest",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryPlanHistoryRequest{
+				Pagination: client.ReadPageRequest(cmd.Flags()),
+			}
+			res, err := queryClient.PlanHistory(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "upgrade plan history")
+
+	return cmd
+}
@@ -59,8 +59,9 @@ existing software will cease to process ABCI messages and a new version with cod
 All upgrades are coordinated by a unique upgrade name that cannot be reused on the same blockchain. In order for the upgrade
 module to know that the upgrade has been safely applied, a handler with the name of the upgrade must be installed.
 Here is an example handler for an upgrade named "my-fancy-upgrade":
-	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan) {
+	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// Perform any migrations of the state store needed for this upgrade
+		return fromVM, nil
 	})
 
 This upgrade handler performs the dual function of alerting the upgrade module that the named upgrade has been applied,
@@ -68,16 +69,32 @@ as well as providing the opportunity for the upgraded software to perform any ne
 (with the old binary) and applying the migration (with the new binary) are enforced in the state machine. Actually
 switching the binaries is an ops task and not handled inside the sdk / abci app.
 
-Here is a sample code to set store migrations with an upgrade:
-
-	// this configures a no-op upgrade handler for the "my-fancy-upgrade" upgrade
-	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan) {
-		// upgrade changes here
+Module Versions and In-Place Store Migrations
+
+Each module declares a ConsensusVersion, which must be incremented on every consensus-breaking change of the
+module. The upgrade module records the version of every module in its store at genesis (see
+Keeper.SetModuleVersionMap) and after every applied upgrade. An upgrade handler receives the recorded versions,
+and returns the versions to be recorded after the upgrade.
+
+Modules register a migration for each version bump with the module manager's Configurator, migrating their store
+from version N to N+1, and the module manager's RunMigrations runs the migrations of each module between its
+recorded and its current version. Modules missing from the recorded versions are new modules, and are initialized
+with their default genesis state:
+	cfg := module.NewConfigurator(app.cdc)
+	app.mm.RegisterMigrations(cfg)
+	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, cfg, fromVM)
 	})
 
+For the first upgrade of a chain started without recorded module versions, the handler must fill fromVM with the
+versions of the modules before the upgrade, otherwise every module is initialized again.
+
+Here is a sample code to set store migrations with an upgrade:
+
 	upgradeInfo := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if upgradeInfo.Name == "my-fancy-upgrade" && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		storeUpgrades := store.StoreUpgrades{
+			Added: []string{"baz"},
 			Renamed: []store.StoreRename{{
 				OldKey: "foo",
 				NewKey: "bar",
@@ -31,6 +31,5 @@ func handleSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper, p *types.So
 }
 
 func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper, _ *types.CancelSoftwareUpgradeProposal) error {
-	k.ClearUpgradePlan(ctx)
-	return nil
+	return k.CancelUpgrade(ctx)
 }
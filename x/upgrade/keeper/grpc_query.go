@@ -2,8 +2,15 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -32,3 +39,69 @@ func (k Keeper) AppliedPlan(c context.Context, req *types.QueryAppliedPlanReques
 
 	return &types.QueryAppliedPlanResponse{Height: applied}, nil
 }
+
+// ModuleVersions implements the Query/ModuleVersions gRPC method
+func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersionsRequest) (*types.QueryModuleVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vm := k.GetModuleVersionMap(ctx)
+
+	// check if a specific module was requested
+	if len(req.ModuleName) > 0 {
+		version, found := vm[req.ModuleName]
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "x/upgrade: QueryModuleVersions module %s not found", req.ModuleName)
+		}
+
+		return &types.QueryModuleVersionsResponse{
+			ModuleVersions: []types.ModuleVersion{{Name: req.ModuleName, Version: version}},
+		}, nil
+	}
+
+	names := make([]string, 0, len(vm))
+	for name := range vm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mv := make([]types.ModuleVersion, 0, len(names))
+	for _, name := range names {
+		mv = append(mv, types.ModuleVersion{Name: name, Version: vm[name]})
+	}
+
+	return &types.QueryModuleVersionsResponse{
+		ModuleVersions: mv,
+	}, nil
+}
+
+// PlanHistory implements the Query/PlanHistory gRPC method
+func (k Keeper) PlanHistory(c context.Context, req *types.QueryPlanHistoryRequest) (*types.QueryPlanHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var entries []types.PlanHistoryEntry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PlanHistoryByte})
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var entry types.PlanHistoryEntry
+		if err := k.cdc.UnmarshalBinaryBare(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPlanHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
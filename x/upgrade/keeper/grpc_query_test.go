@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -111,7 +112,9 @@ func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
 
 				suite.ctx = suite.ctx.WithBlockHeight(expHeight)
-				suite.app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan types.Plan) {})
+				suite.app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+					return vm, nil
+				})
 				suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx, plan)
 
 				req = &types.QueryAppliedPlanRequest{Name: planName}
@@ -139,6 +142,128 @@ func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestModuleVersions() {
+	testCases := []struct {
+		msg     string
+		req     types.QueryModuleVersionsRequest
+		single  bool
+		expPass bool
+	}{
+		{
+			msg:     "test full query",
+			req:     types.QueryModuleVersionsRequest{},
+			single:  false,
+			expPass: true,
+		},
+		{
+			msg:     "test single module",
+			req:     types.QueryModuleVersionsRequest{ModuleName: "bank"},
+			single:  true,
+			expPass: true,
+		},
+		{
+			msg:     "test non-existent module",
+			req:     types.QueryModuleVersionsRequest{ModuleName: "abcdefg"},
+			single:  true,
+			expPass: false,
+		},
+	}
+
+	vm := suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx)
+	suite.Require().NotEmpty(vm)
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := suite.queryClient.ModuleVersions(gocontext.Background(), &tc.req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				if tc.single {
+					// test that the single module response is valid
+					suite.Require().Len(res.ModuleVersions, 1)
+					// make sure we got the right values
+					suite.Require().Equal(vm[tc.req.ModuleName], res.ModuleVersions[0].Version)
+					suite.Require().Equal(tc.req.ModuleName, res.ModuleVersions[0].Name)
+				} else {
+					// check that the full response is valid
+					suite.Require().Len(res.ModuleVersions, len(vm))
+					for i, v := range res.ModuleVersions {
+						suite.Require().Equal(vm[v.Name], v.Version)
+						if i > 0 {
+							// module versions are sorted by name
+							suite.Require().True(res.ModuleVersions[i-1].Name < v.Name)
+						}
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	_, err := suite.app.UpgradeKeeper.ModuleVersions(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().Error(err)
+}
+
+func (suite *UpgradeTestSuite) TestQueryPlanHistory() {
+	var (
+		req         *types.QueryPlanHistoryRequest
+		expResponse types.QueryPlanHistoryResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"without plan history",
+			func() {
+				req = &types.QueryPlanHistoryRequest{}
+				expResponse = types.QueryPlanHistoryResponse{}
+			},
+			true,
+		},
+		{
+			"with scheduled and cancelled plans",
+			func() {
+				plan := types.Plan{Name: "test-plan", Height: 5}
+				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
+				suite.app.UpgradeKeeper.CancelUpgrade(suite.ctx)
+
+				req = &types.QueryPlanHistoryRequest{}
+				expResponse = types.QueryPlanHistoryResponse{
+					Entries: []types.PlanHistoryEntry{
+						{Plan: plan, Status: types.StatusScheduled},
+						{Plan: plan, Status: types.StatusCancelled},
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			res, err := suite.queryClient.PlanHistory(gocontext.Background(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expResponse.Entries, res.Entries)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
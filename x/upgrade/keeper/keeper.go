@@ -8,6 +8,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
@@ -19,6 +20,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // UpgradeInfoFileName file to store upgrade information
@@ -51,8 +53,8 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 }
 
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
-// If there is another Plan already scheduled, it will overwrite it
-// (implicitly cancelling the current plan)
+// If there is another Plan already scheduled, it must be cancelled first
+// with CancelUpgrade.
 func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 	if err := plan.ValidateBasic(); err != nil {
 		return err
@@ -70,13 +72,59 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
+	if current, found := k.GetUpgradePlan(ctx); found {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"upgrade %s is already scheduled and must be cancelled before scheduling %s", current.Name, plan.Name,
+		)
+	}
+
 	bz := k.cdc.MustMarshalBinaryBare(&plan)
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.PlanKey(), bz)
 
+	k.appendPlanHistory(ctx, plan, types.StatusScheduled)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleUpgrade,
+			sdk.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyPlanDueAt, plan.DueAt()),
+		),
+	)
+
+	return nil
+}
+
+// CancelUpgrade cancels the scheduled upgrade. It returns an error if no
+// upgrade is scheduled.
+func (k Keeper) CancelUpgrade(ctx sdk.Context) error {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no upgrade is scheduled")
+	}
+
+	k.ClearUpgradePlan(ctx)
+	k.appendPlanHistory(ctx, plan, types.StatusCancelled)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyPlanDueAt, plan.DueAt()),
+		),
+	)
+
 	return nil
 }
 
+// SkipUpgrade clears the scheduled upgrade without applying it, when its
+// height is part of the skip upgrade heights of the node.
+func (k Keeper) SkipUpgrade(ctx sdk.Context, plan types.Plan) {
+	k.ClearUpgradePlan(ctx)
+	k.appendPlanHistory(ctx, plan, types.StatusSkipped)
+}
+
 // GetDoneHeight returns the height at which the given upgrade was executed
 func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DoneByte})
@@ -126,17 +174,107 @@ func (k Keeper) HasHandler(name string) bool {
 	return ok
 }
 
-// ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
+// ApplyUpgrade will execute the handler associated with the Plan, store the
+// resulting module versions and mark the plan as done.
 func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 	handler := k.upgradeHandlers[plan.Name]
 	if handler == nil {
 		panic("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
-	handler(ctx, plan)
+	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	if err != nil {
+		panic(err)
+	}
+
+	k.SetModuleVersionMap(ctx, updatedVM)
 
 	k.ClearUpgradePlan(ctx)
 	k.setDone(ctx, plan.Name)
+	k.appendPlanHistory(ctx, plan, types.StatusApplied)
+}
+
+// appendPlanHistory records the status change of a plan at the current block
+// height in the plan history.
+func (k Keeper) appendPlanHistory(ctx sdk.Context, plan types.Plan, status types.PlanStatus) {
+	store := ctx.KVStore(k.storeKey)
+
+	var sequence uint64
+	if bz := store.Get(types.PlanHistorySequenceKey()); bz != nil {
+		sequence = binary.BigEndian.Uint64(bz)
+	}
+
+	entry := types.PlanHistoryEntry{
+		Plan:   plan,
+		Status: status,
+		Height: ctx.BlockHeight(),
+	}
+	store.Set(types.PlanHistoryKey(sequence), k.cdc.MustMarshalBinaryBare(&entry))
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence+1)
+	store.Set(types.PlanHistorySequenceKey(), bz)
+}
+
+// IteratePlanHistory iterates over the plan history entries, from the oldest
+// to the latest, and performs a callback function. The iteration stops when
+// the callback returns true.
+func (k Keeper) IteratePlanHistory(ctx sdk.Context, cb func(entry types.PlanHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.PlanHistoryByte})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PlanHistoryEntry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// SetModuleVersionMap saves a given version map to state
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	if len(vm) > 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+
+		// Even though the underlying store (cachekv) store is sorted, we still
+		// prefer a deterministic iteration order of the map, to avoid undesired
+		// surprises if we ever change stores.
+		sortedModNames := make([]string, 0, len(vm))
+		for key := range vm {
+			sortedModNames = append(sortedModNames, key)
+		}
+		sort.Strings(sortedModNames)
+
+		for _, modName := range sortedModNames {
+			ver := vm[modName]
+			nameBytes := []byte(modName)
+			verBytes := make([]byte, 8)
+			binary.BigEndian.PutUint64(verBytes, ver)
+			store.Set(nameBytes, verBytes)
+		}
+	}
+}
+
+// GetModuleVersionMap returns a map of key module name and value module consensus version
+// as recorded by the last applied upgrade (or at genesis).
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, []byte{types.VersionMapByte})
+
+	vm := make(module.VersionMap)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		moduleBytes := it.Key()
+		// first byte is prefix key, so we remove it here
+		name := string(moduleBytes[1:])
+		moduleVersion := binary.BigEndian.Uint64(it.Value())
+		vm[name] = moduleVersion
+	}
+
+	return vm
 }
 
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
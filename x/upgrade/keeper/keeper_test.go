@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (suite *UpgradeTestSuite) TestModuleVersionMap() {
+	// the module versions are set at genesis
+	vm := suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx)
+	suite.Require().Equal(module.VersionMap{"bank": 3, "upgrade": 1}, module.VersionMap{"bank": vm["bank"], "upgrade": vm["upgrade"]})
+
+	vm["bank"] = 4
+	vm["newmodule"] = 1
+	suite.app.UpgradeKeeper.SetModuleVersionMap(suite.ctx, vm)
+	suite.Require().Equal(vm, suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx))
+}
+
+func (suite *UpgradeTestSuite) TestApplyUpgradeModuleVersions() {
+	plan := types.Plan{Name: "test-plan", Height: 5}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+
+	var fromVM module.VersionMap
+	suite.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		fromVM = vm
+
+		toVM := module.VersionMap{}
+		for name, version := range vm {
+			toVM[name] = version
+		}
+		toVM["bank"] = 4
+		return toVM, nil
+	})
+
+	expVM := suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx)
+	suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx.WithBlockHeight(plan.Height), plan)
+
+	// the handler received the recorded versions and the returned versions are recorded
+	suite.Require().Equal(expVM, fromVM)
+	expVM["bank"] = 4
+	suite.Require().Equal(expVM, suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx))
+}
+
+func (suite *UpgradeTestSuite) TestApplyUpgradeHandlerError() {
+	plan := types.Plan{Name: "test-plan", Height: 5}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+
+	suite.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "migration failed")
+	})
+
+	suite.Require().Panics(func() {
+		suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx.WithBlockHeight(plan.Height), plan)
+	})
+}
+
+func (suite *UpgradeTestSuite) TestPlanHistory() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	first := types.Plan{Name: "first", Height: 20}
+	second := types.Plan{Name: "second", Height: 30}
+
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(ctx, first))
+	suite.Require().Error(suite.app.UpgradeKeeper.ScheduleUpgrade(ctx, second))
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.app.UpgradeKeeper.CancelUpgrade(ctx))
+	suite.Require().Equal(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(types.AttributeKeyPlanName, first.Name),
+			sdk.NewAttribute(types.AttributeKeyPlanDueAt, first.DueAt()),
+		),
+	}, ctx.EventManager().Events())
+	suite.Require().Error(suite.app.UpgradeKeeper.CancelUpgrade(ctx))
+
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(ctx, second))
+	suite.app.UpgradeKeeper.SetUpgradeHandler(second.Name, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	suite.app.UpgradeKeeper.ApplyUpgrade(ctx.WithBlockHeight(30), second)
+
+	var entries []types.PlanHistoryEntry
+	suite.app.UpgradeKeeper.IteratePlanHistory(ctx, func(entry types.PlanHistoryEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+
+	suite.Require().Equal([]types.PlanHistoryEntry{
+		{Plan: first, Status: types.StatusScheduled, Height: 10},
+		{Plan: first, Status: types.StatusCancelled, Height: 11},
+		{Plan: second, Status: types.StatusScheduled, Height: 11},
+		{Plan: second, Status: types.StatusApplied, Height: 30},
+	}, entries)
+}
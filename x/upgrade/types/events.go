@@ -0,0 +1,10 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeScheduleUpgrade = "schedule_upgrade"
+	EventTypeCancelUpgrade   = "cancel_upgrade"
+
+	AttributeKeyPlanName  = "name"
+	AttributeKeyPlanDueAt = "due_at"
+)
@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName is the name of this module
 	ModuleName = "upgrade"
@@ -19,6 +21,12 @@ const (
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
+	// VersionMapByte is a prefix to look up module names (key) and versions (value)
+	VersionMapByte = 0x2
+	// PlanHistoryByte is a prefix to look up the plan history entries by sequence
+	PlanHistoryByte = 0x3
+	// PlanHistorySequenceByte specifies the Byte under which the next plan history sequence is stored
+	PlanHistorySequenceByte = 0x4
 )
 
 // PlanKey is the key under which the current plan is saved
@@ -26,3 +34,16 @@ const (
 func PlanKey() []byte {
 	return []byte{PlanByte}
 }
+
+// PlanHistorySequenceKey is the key under which the next plan history sequence is saved
+func PlanHistorySequenceKey() []byte {
+	return []byte{PlanHistorySequenceByte}
+}
+
+// PlanHistoryKey returns the key of the plan history entry with the given sequence
+func PlanHistoryKey(sequence uint64) []byte {
+	bz := make([]byte, 9)
+	bz[0] = PlanHistoryByte
+	binary.BigEndian.PutUint64(bz[1:], sequence)
+	return bz
+}
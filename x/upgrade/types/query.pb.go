@@ -6,8 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -202,35 +205,251 @@ func (m *QueryAppliedPlanResponse) GetHeight() int64 {
 	return 0
 }
 
+// QueryModuleVersionsRequest is the request type for the Query/ModuleVersions RPC method
+type QueryModuleVersionsRequest struct {
+	// module_name is a field to query a specific module's consensus version.
+	// If empty, the consensus versions of all modules are returned.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryModuleVersionsRequest) Reset()         { *m = QueryModuleVersionsRequest{} }
+func (m *QueryModuleVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsRequest) ProtoMessage()    {}
+func (*QueryModuleVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_569a61f8872b804e, []int{4}
+}
+func (m *QueryModuleVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsRequest.Merge(m, src)
+}
+func (m *QueryModuleVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryModuleVersionsResponse is the response type for the Query/ModuleVersions RPC method
+type QueryModuleVersionsResponse struct {
+	// module_versions is a list of module names with their consensus versions.
+	ModuleVersions []ModuleVersion `protobuf:"bytes,1,rep,name=module_versions,json=moduleVersions,proto3" json:"module_versions"`
+}
+
+func (m *QueryModuleVersionsResponse) Reset()         { *m = QueryModuleVersionsResponse{} }
+func (m *QueryModuleVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsResponse) ProtoMessage()    {}
+func (*QueryModuleVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_569a61f8872b804e, []int{5}
+}
+func (m *QueryModuleVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsResponse.Merge(m, src)
+}
+func (m *QueryModuleVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsResponse) GetModuleVersions() []ModuleVersion {
+	if m != nil {
+		return m.ModuleVersions
+	}
+	return nil
+}
+
+// QueryPlanHistoryRequest is the request type for the Query/PlanHistory RPC method
+type QueryPlanHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPlanHistoryRequest) Reset()         { *m = QueryPlanHistoryRequest{} }
+func (m *QueryPlanHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanHistoryRequest) ProtoMessage()    {}
+func (*QueryPlanHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_569a61f8872b804e, []int{6}
+}
+func (m *QueryPlanHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanHistoryRequest.Merge(m, src)
+}
+func (m *QueryPlanHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPlanHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPlanHistoryResponse is the response type for the Query/PlanHistory RPC method
+type QueryPlanHistoryResponse struct {
+	// entries is the list of plan status changes, from the oldest to the latest.
+	Entries []PlanHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPlanHistoryResponse) Reset()         { *m = QueryPlanHistoryResponse{} }
+func (m *QueryPlanHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanHistoryResponse) ProtoMessage()    {}
+func (*QueryPlanHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_569a61f8872b804e, []int{7}
+}
+func (m *QueryPlanHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanHistoryResponse.Merge(m, src)
+}
+func (m *QueryPlanHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPlanHistoryResponse) GetEntries() []PlanHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryPlanHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.QueryCurrentPlanResponse")
 	proto.RegisterType((*QueryAppliedPlanRequest)(nil), "cosmos.upgrade.QueryAppliedPlanRequest")
 	proto.RegisterType((*QueryAppliedPlanResponse)(nil), "cosmos.upgrade.QueryAppliedPlanResponse")
+	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.QueryModuleVersionsRequest")
+	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.QueryModuleVersionsResponse")
+	proto.RegisterType((*QueryPlanHistoryRequest)(nil), "cosmos.upgrade.QueryPlanHistoryRequest")
+	proto.RegisterType((*QueryPlanHistoryResponse)(nil), "cosmos.upgrade.QueryPlanHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/query.proto", fileDescriptor_569a61f8872b804e) }
 
 var fileDescriptor_569a61f8872b804e = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa,
-	0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x41, 0xe5, 0xa4, 0x64, 0xd0,
-	0xd4, 0x42, 0x69, 0x88, 0x6a, 0x25, 0x49, 0x2e, 0xf1, 0x40, 0x90, 0x66, 0xe7, 0xd2, 0xa2, 0xa2,
-	0xd4, 0xbc, 0x92, 0x80, 0x9c, 0xc4, 0xbc, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x17,
-	0x2e, 0x09, 0x4c, 0xa9, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x0d, 0x2e, 0x96, 0x82, 0x9c,
-	0xc4, 0x3c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d, 0x54, 0x3b, 0xf5, 0xc0, 0x6a,
-	0xc1, 0x2a, 0x94, 0x74, 0xa1, 0x16, 0x38, 0x16, 0x14, 0xe4, 0x64, 0xa6, 0xa6, 0x20, 0x59, 0x20,
-	0x24, 0xc4, 0xc5, 0x92, 0x97, 0x98, 0x9b, 0x0a, 0x36, 0x84, 0x33, 0x08, 0xcc, 0x56, 0x32, 0x82,
-	0x5a, 0x8a, 0xa2, 0x1c, 0x6a, 0xa9, 0x18, 0x17, 0x5b, 0x46, 0x6a, 0x66, 0x7a, 0x46, 0x09, 0x58,
-	0x07, 0x73, 0x10, 0x94, 0x67, 0x74, 0x9e, 0x91, 0x8b, 0x15, 0xac, 0x49, 0x28, 0x89, 0x8b, 0x1b,
-	0xc9, 0xb5, 0x42, 0xea, 0xe8, 0xee, 0xc2, 0xe1, 0x55, 0x29, 0x0d, 0xc2, 0x0a, 0x21, 0x6e, 0x50,
-	0x62, 0x00, 0xd9, 0x81, 0xe4, 0x38, 0x1c, 0x76, 0x60, 0xfa, 0x16, 0x87, 0x1d, 0x58, 0xfc, 0xa9,
-	0xc4, 0xe0, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xd0, 0x88, 0x85, 0x50, 0xba, 0xc5,
-	0x29, 0xd9, 0xfa, 0x15, 0xf0, 0x58, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x47, 0xb2,
-	0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x34, 0x23, 0x68, 0x30, 0x02, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xbb, 0x75, 0xc5, 0x57, 0xa8, 0x30, 0x2c, 0xda, 0x8d, 0xdd, 0xb4, 0x46, 0x65,
+	0x83, 0xeb, 0x36, 0x50, 0x4f, 0x0a, 0x82, 0xae, 0x3f, 0xf0, 0xa0, 0xb2, 0x16, 0xf1, 0xe0, 0xa5,
+	0xcc, 0xb6, 0x43, 0x1a, 0xb6, 0x9d, 0xc9, 0x66, 0x26, 0x62, 0x11, 0x2f, 0x1e, 0xbc, 0x09, 0xa2,
+	0x17, 0xff, 0x1a, 0xcf, 0x7b, 0x5c, 0xf0, 0xe2, 0x49, 0xa4, 0xf5, 0x0f, 0x91, 0xcc, 0x4c, 0x34,
+	0x4d, 0x52, 0xea, 0x29, 0x69, 0xde, 0xf7, 0xbd, 0xef, 0x67, 0xde, 0x7c, 0x29, 0x58, 0x03, 0x2e,
+	0x26, 0x5c, 0x78, 0x71, 0xe8, 0x47, 0x64, 0x48, 0xbd, 0xe3, 0x98, 0x46, 0xd3, 0x4e, 0x18, 0x71,
+	0xc9, 0x71, 0x5d, 0xd7, 0x3a, 0xa6, 0x66, 0x35, 0x7d, 0xce, 0xfd, 0x31, 0xf5, 0x48, 0x18, 0x78,
+	0x84, 0x31, 0x2e, 0x89, 0x0c, 0x38, 0x13, 0x5a, 0x6d, 0x6d, 0xfa, 0xdc, 0xe7, 0xea, 0xd5, 0x4b,
+	0xde, 0xcc, 0xd7, 0x6d, 0x33, 0x5f, 0xcd, 0xf5, 0x42, 0xe2, 0x07, 0x4c, 0x75, 0x99, 0x72, 0x33,
+	0x67, 0x6f, 0x9e, 0xba, 0xea, 0x6c, 0xc1, 0xc5, 0xe7, 0x49, 0xdf, 0xfd, 0x38, 0x8a, 0x28, 0x93,
+	0x07, 0x63, 0xc2, 0x7a, 0xf4, 0x38, 0xa6, 0x42, 0x3a, 0x0f, 0xa0, 0x51, 0x2c, 0x89, 0x90, 0x33,
+	0x41, 0xb1, 0x0b, 0xd5, 0x70, 0x4c, 0x58, 0x03, 0xb5, 0x91, 0x5b, 0xeb, 0x6e, 0x76, 0x16, 0x8f,
+	0xd1, 0x51, 0x5a, 0xa5, 0x70, 0xf6, 0x8c, 0xc1, 0xbd, 0x30, 0x1c, 0x07, 0x74, 0x98, 0x31, 0xc0,
+	0x18, 0xaa, 0x8c, 0x4c, 0xa8, 0x1a, 0x72, 0xae, 0xa7, 0xde, 0x9d, 0x2e, 0x34, 0x8a, 0x72, 0x63,
+	0x7a, 0x01, 0x36, 0x46, 0x34, 0xf0, 0x47, 0x52, 0x75, 0xac, 0xf7, 0xcc, 0x2f, 0xe7, 0x0e, 0x58,
+	0xaa, 0xe7, 0x29, 0x1f, 0xc6, 0x63, 0xfa, 0x92, 0x46, 0x22, 0xd9, 0x59, 0xea, 0xd2, 0x82, 0xda,
+	0x44, 0x15, 0xfa, 0x19, 0x33, 0xd0, 0x9f, 0x9e, 0x25, 0x96, 0x47, 0x70, 0xa9, 0xb4, 0xdd, 0xb8,
+	0x3e, 0x81, 0xf3, 0xa6, 0xff, 0xb5, 0x29, 0x35, 0x50, 0x7b, 0xdd, 0xad, 0x75, 0xb7, 0xf3, 0xa7,
+	0x5e, 0x18, 0xb0, 0x5f, 0x3d, 0xf9, 0xd9, 0xaa, 0xf4, 0xea, 0x93, 0x85, 0xa9, 0xce, 0x0b, 0xb3,
+	0x8e, 0xe4, 0x60, 0x8f, 0x03, 0x21, 0x79, 0x34, 0x4d, 0x41, 0x6f, 0x01, 0xfc, 0xbb, 0x3c, 0xb3,
+	0xd9, 0xad, 0xd4, 0x43, 0x87, 0xe6, 0x80, 0xf8, 0xd4, 0xc8, 0x7b, 0x19, 0xb1, 0xf3, 0x15, 0x41,
+	0xa3, 0x38, 0xd6, 0x1c, 0xe0, 0x2e, 0x9c, 0xa5, 0x4c, 0x46, 0x01, 0x4d, 0xc1, 0xdb, 0x65, 0xd7,
+	0x65, 0xba, 0x1e, 0x32, 0x19, 0x4d, 0x0d, 0x7b, 0xda, 0x86, 0x6f, 0x2f, 0x90, 0xad, 0x29, 0x32,
+	0xab, 0x8c, 0x4c, 0x3b, 0x66, 0xd1, 0xba, 0xdf, 0xaa, 0x70, 0x46, 0xa1, 0xe1, 0x0f, 0x08, 0x6a,
+	0x99, 0x2c, 0xe1, 0x9d, 0x3c, 0xc6, 0x92, 0x20, 0x5a, 0xee, 0x6a, 0xa1, 0x36, 0x76, 0xae, 0xbe,
+	0xff, 0xfe, 0xfb, 0xcb, 0x9a, 0x8d, 0x9b, 0x5e, 0x2e, 0xf4, 0x03, 0x2d, 0xee, 0x27, 0x91, 0xc4,
+	0x1f, 0x11, 0xd4, 0x32, 0xf9, 0x5a, 0x02, 0x52, 0x0c, 0xac, 0xe5, 0xae, 0x16, 0x1a, 0x90, 0x5d,
+	0x05, 0x72, 0x0d, 0x5f, 0xc9, 0x83, 0x10, 0x2d, 0x56, 0x20, 0xde, 0xdb, 0x24, 0x91, 0xef, 0xf0,
+	0x67, 0x04, 0xf5, 0xc5, 0xf0, 0xe1, 0xeb, 0xa5, 0x4e, 0xa5, 0x01, 0xb7, 0x76, 0xff, 0x4b, 0x6b,
+	0xc0, 0x76, 0x14, 0xd8, 0x65, 0xdc, 0xca, 0x83, 0xe5, 0x32, 0xae, 0x6e, 0x2b, 0x93, 0x8b, 0x25,
+	0x4b, 0x2a, 0xc6, 0xd8, 0x72, 0x57, 0x0b, 0x57, 0xdd, 0x56, 0xb2, 0x9c, 0xfe, 0x48, 0xab, 0xf7,
+	0x1f, 0x9d, 0xcc, 0x6c, 0x74, 0x3a, 0xb3, 0xd1, 0xaf, 0x99, 0x8d, 0x3e, 0xcd, 0xed, 0xca, 0xe9,
+	0xdc, 0xae, 0xfc, 0x98, 0xdb, 0x95, 0x57, 0x37, 0xfc, 0x40, 0x8e, 0xe2, 0xc3, 0xce, 0x80, 0x4f,
+	0xd2, 0x09, 0xfa, 0xb1, 0x27, 0x86, 0x47, 0xde, 0x9b, 0xbf, 0xe3, 0xe4, 0x34, 0xa4, 0xe2, 0x70,
+	0x43, 0xfd, 0xe1, 0xdd, 0xfc, 0x33, 0x00, 0x55, 0x18, 0xaf, 0x94, 0x8f, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -249,6 +468,12 @@ type QueryClient interface {
 	CurrentPlan(ctx context.Context, in *QueryCurrentPlanRequest, opts ...grpc.CallOption) (*QueryCurrentPlanResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name
 	AppliedPlan(ctx context.Context, in *QueryAppliedPlanRequest, opts ...grpc.CallOption) (*QueryAppliedPlanResponse, error)
+	// ModuleVersions queries the consensus versions of the modules recorded by
+	// the last applied upgrade
+	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// PlanHistory queries the history of the scheduled, cancelled, applied and
+	// skipped upgrade plans
+	PlanHistory(ctx context.Context, in *QueryPlanHistoryRequest, opts ...grpc.CallOption) (*QueryPlanHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -277,12 +502,36 @@ func (c *queryClient) AppliedPlan(ctx context.Context, in *QueryAppliedPlanReque
 	return out, nil
 }
 
+func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error) {
+	out := new(QueryModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.Query/ModuleVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlanHistory(ctx context.Context, in *QueryPlanHistoryRequest, opts ...grpc.CallOption) (*QueryPlanHistoryResponse, error) {
+	out := new(QueryPlanHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.Query/PlanHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan
 	CurrentPlan(context.Context, *QueryCurrentPlanRequest) (*QueryCurrentPlanResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name
 	AppliedPlan(context.Context, *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error)
+	// ModuleVersions queries the consensus versions of the modules recorded by
+	// the last applied upgrade
+	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// PlanHistory queries the history of the scheduled, cancelled, applied and
+	// skipped upgrade plans
+	PlanHistory(context.Context, *QueryPlanHistoryRequest) (*QueryPlanHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -295,6 +544,12 @@ func (*UnimplementedQueryServer) CurrentPlan(ctx context.Context, req *QueryCurr
 func (*UnimplementedQueryServer) AppliedPlan(ctx context.Context, req *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppliedPlan not implemented")
 }
+func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
+}
+func (*UnimplementedQueryServer) PlanHistory(ctx context.Context, req *QueryPlanHistoryRequest) (*QueryPlanHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -336,6 +591,42 @@ func _Query_AppliedPlan_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.Query/ModuleVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleVersions(ctx, req.(*QueryModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlanHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlanHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.Query/PlanHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlanHistory(ctx, req.(*QueryPlanHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -348,6 +639,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AppliedPlan",
 			Handler:    _Query_AppliedPlan_Handler,
 		},
+		{
+			MethodName: "ModuleVersions",
+			Handler:    _Query_ModuleVersions_Handler,
+		},
+		{
+			MethodName: "PlanHistory",
+			Handler:    _Query_PlanHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/query.proto",
@@ -469,68 +768,279 @@ func (m *QueryAppliedPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCurrentPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryModuleVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryModuleVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppliedPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ModuleVersions) > 0 {
+		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppliedPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPlanHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPlanHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
+
+func (m *QueryPlanHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCurrentPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppliedPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppliedPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryModuleVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for _, e := range m.ModuleVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPlanHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
@@ -832,6 +1342,390 @@ func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, ModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PlanHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
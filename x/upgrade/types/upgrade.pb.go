@@ -27,6 +27,46 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PlanStatus defines the status of an upgrade plan in the plan history.
+type PlanStatus int32
+
+const (
+	// PLAN_STATUS_UNSPECIFIED defines no plan status.
+	StatusNil PlanStatus = 0
+	// PLAN_STATUS_SCHEDULED defines a plan scheduled by a software upgrade proposal.
+	StatusScheduled PlanStatus = 1
+	// PLAN_STATUS_CANCELLED defines a plan cancelled by a cancel software upgrade proposal.
+	StatusCancelled PlanStatus = 2
+	// PLAN_STATUS_APPLIED defines a plan applied by its upgrade handler.
+	StatusApplied PlanStatus = 3
+	// PLAN_STATUS_SKIPPED defines a plan skipped by the node's skip upgrade heights.
+	StatusSkipped PlanStatus = 4
+)
+
+var PlanStatus_name = map[int32]string{
+	0: "PLAN_STATUS_UNSPECIFIED",
+	1: "PLAN_STATUS_SCHEDULED",
+	2: "PLAN_STATUS_CANCELLED",
+	3: "PLAN_STATUS_APPLIED",
+	4: "PLAN_STATUS_SKIPPED",
+}
+
+var PlanStatus_value = map[string]int32{
+	"PLAN_STATUS_UNSPECIFIED": 0,
+	"PLAN_STATUS_SCHEDULED":   1,
+	"PLAN_STATUS_CANCELLED":   2,
+	"PLAN_STATUS_APPLIED":     3,
+	"PLAN_STATUS_SKIPPED":     4,
+}
+
+func (x PlanStatus) String() string {
+	return proto.EnumName(PlanStatus_name, int32(x))
+}
+
+func (PlanStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f096ad3e7ee0b803, []int{0}
+}
+
 // Plan specifies information about a planned upgrade and when it should occur
 type Plan struct {
 	// Sets the name for the upgrade. This name will be used by the upgraded version of the software to apply any
@@ -155,39 +195,138 @@ func (m *CancelSoftwareUpgradeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelSoftwareUpgradeProposal proto.InternalMessageInfo
 
+// ModuleVersion specifies a module and its consensus version.
+type ModuleVersion struct {
+	// name of the app module
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// consensus version of the app module
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
+func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersion) ProtoMessage()    {}
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f096ad3e7ee0b803, []int{3}
+}
+func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersion.Merge(m, src)
+}
+func (m *ModuleVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
+
+// PlanHistoryEntry records a status change of an upgrade plan.
+type PlanHistoryEntry struct {
+	Plan   Plan       `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
+	Status PlanStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.upgrade.PlanStatus" json:"status,omitempty"`
+	// block height at which the status of the plan changed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PlanHistoryEntry) Reset()         { *m = PlanHistoryEntry{} }
+func (m *PlanHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PlanHistoryEntry) ProtoMessage()    {}
+func (*PlanHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f096ad3e7ee0b803, []int{4}
+}
+func (m *PlanHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanHistoryEntry.Merge(m, src)
+}
+func (m *PlanHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanHistoryEntry proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("cosmos.upgrade.PlanStatus", PlanStatus_name, PlanStatus_value)
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.CancelSoftwareUpgradeProposal")
+	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.ModuleVersion")
+	proto.RegisterType((*PlanHistoryEntry)(nil), "cosmos.upgrade.PlanHistoryEntry")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/upgrade.proto", fileDescriptor_f096ad3e7ee0b803) }
 
 var fileDescriptor_f096ad3e7ee0b803 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xb1, 0x4e, 0xf3, 0x30,
-	0x10, 0x8e, 0xff, 0xe6, 0xaf, 0xfe, 0xba, 0xd2, 0x3f, 0x58, 0x15, 0x44, 0x15, 0x38, 0x51, 0xa7,
-	0x0e, 0xe0, 0x48, 0x65, 0x41, 0x8c, 0x65, 0x47, 0x55, 0x80, 0x05, 0x89, 0xc1, 0x4d, 0xdd, 0x34,
-	0x22, 0xc9, 0x45, 0xb1, 0x2b, 0xe0, 0x05, 0x60, 0xed, 0x23, 0xf0, 0x38, 0x1d, 0x3b, 0x76, 0x02,
-	0xda, 0x2e, 0x3c, 0x06, 0x8a, 0x9d, 0x20, 0xd8, 0x99, 0xee, 0xbe, 0xbb, 0x2f, 0xdf, 0x7d, 0x17,
-	0x1f, 0x3e, 0x08, 0x41, 0xa6, 0x20, 0xfd, 0x79, 0x1e, 0x15, 0x7c, 0x22, 0xea, 0xc8, 0xf2, 0x02,
-	0x14, 0x90, 0xff, 0xa6, 0xcb, 0xaa, 0x6a, 0xb7, 0x13, 0x41, 0x04, 0xba, 0xe5, 0x97, 0x99, 0x61,
-	0x75, 0xdd, 0x08, 0x20, 0x4a, 0x84, 0xaf, 0xd1, 0x78, 0x3e, 0xf5, 0x55, 0x9c, 0x0a, 0xa9, 0x78,
-	0x9a, 0x1b, 0x42, 0xef, 0x09, 0x61, 0x7b, 0x94, 0xf0, 0x8c, 0x10, 0x6c, 0x67, 0x3c, 0x15, 0x0e,
-	0xf2, 0x50, 0xbf, 0x15, 0xe8, 0x9c, 0x9c, 0x62, 0xbb, 0xe4, 0x3b, 0x7f, 0x3c, 0xd4, 0x6f, 0x0f,
-	0xba, 0xcc, 0x88, 0xb1, 0x5a, 0x8c, 0x5d, 0xd5, 0x62, 0xc3, 0x7f, 0xcb, 0x57, 0xd7, 0x5a, 0xbc,
-	0xb9, 0x28, 0xd0, 0x5f, 0x90, 0x3d, 0xdc, 0x9c, 0x89, 0x38, 0x9a, 0x29, 0xa7, 0xe1, 0xa1, 0x7e,
-	0x23, 0xa8, 0x50, 0x39, 0x25, 0xce, 0xa6, 0xe0, 0xd8, 0x66, 0x4a, 0x99, 0x9f, 0xd9, 0x1f, 0x2f,
-	0x2e, 0xea, 0x3d, 0x23, 0xbc, 0x7f, 0x09, 0x53, 0x75, 0xcf, 0x0b, 0x71, 0x6d, 0x76, 0x1a, 0x15,
-	0x90, 0x83, 0xe4, 0x09, 0xe9, 0xe0, 0xbf, 0x2a, 0x56, 0x49, 0x6d, 0xce, 0x00, 0xe2, 0xe1, 0xf6,
-	0x44, 0xc8, 0xb0, 0x88, 0x73, 0x15, 0x43, 0xa6, 0x4d, 0xb6, 0x82, 0xef, 0x25, 0xc2, 0xb0, 0x9d,
-	0x27, 0x3c, 0xd3, 0x1e, 0xda, 0x83, 0x0e, 0xfb, 0xf9, 0xcb, 0x58, 0xb9, 0xf7, 0xd0, 0x2e, 0x9d,
-	0x07, 0x9a, 0x57, 0x39, 0xb9, 0xc5, 0x87, 0xe7, 0x3c, 0x0b, 0x45, 0xf2, 0xcb, 0x76, 0x8c, 0xfc,
-	0xf0, 0x62, 0xb9, 0xa1, 0xd6, 0x7a, 0x43, 0xad, 0xe5, 0x96, 0xa2, 0xd5, 0x96, 0xa2, 0xf7, 0x2d,
-	0x45, 0x8b, 0x1d, 0xb5, 0x56, 0x3b, 0x6a, 0xad, 0x77, 0xd4, 0xba, 0x39, 0x8a, 0x62, 0x35, 0x9b,
-	0x8f, 0x59, 0x08, 0xa9, 0x5f, 0xdd, 0x80, 0x09, 0xc7, 0x72, 0x72, 0xe7, 0x3f, 0x7c, 0x1d, 0x84,
-	0x7a, 0xcc, 0x85, 0x1c, 0x37, 0xf5, 0x73, 0x9c, 0x7c, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x41,
-	0xa7, 0xa3, 0x2f, 0x02, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0xad, 0x29, 0xed, 0x55, 0x29, 0xc1, 0x0d, 0x34, 0xb2, 0xc0, 0xb1, 0x3a, 0x45,
+	0x11, 0xd8, 0x52, 0x58, 0x50, 0xb7, 0xfc, 0x30, 0x34, 0x22, 0x44, 0x96, 0x9d, 0x30, 0x20, 0xa1,
+	0xca, 0x89, 0x2f, 0xce, 0xa9, 0x8e, 0xcf, 0xb2, 0x2f, 0x40, 0xfe, 0x01, 0x40, 0x99, 0x3a, 0x76,
+	0x89, 0x54, 0x89, 0x7f, 0x26, 0x63, 0xc7, 0x4e, 0x40, 0x93, 0x85, 0xbf, 0x80, 0x19, 0xf9, 0xce,
+	0x69, 0x03, 0x14, 0x89, 0x81, 0xe9, 0xee, 0xdd, 0x7d, 0xee, 0xfb, 0xbe, 0xef, 0x9d, 0x1e, 0x7c,
+	0xd0, 0x23, 0xf1, 0x90, 0xc4, 0xfa, 0x28, 0xf4, 0x22, 0xc7, 0x45, 0xcb, 0x55, 0x0b, 0x23, 0x42,
+	0x89, 0xb4, 0xc3, 0x6f, 0xb5, 0xf4, 0x54, 0xce, 0x79, 0xc4, 0x23, 0xec, 0x4a, 0x4f, 0x76, 0x9c,
+	0x92, 0x0b, 0x1e, 0x21, 0x9e, 0x8f, 0x74, 0x16, 0x75, 0x47, 0x7d, 0x9d, 0xe2, 0x21, 0x8a, 0xa9,
+	0x33, 0x0c, 0x39, 0xb0, 0xff, 0x01, 0x40, 0xd1, 0xf4, 0x9d, 0x40, 0x92, 0xa0, 0x18, 0x38, 0x43,
+	0x94, 0x07, 0x2a, 0x28, 0x6e, 0x59, 0x6c, 0x2f, 0x3d, 0x85, 0x62, 0xc2, 0xe7, 0xd7, 0x54, 0x50,
+	0xdc, 0x2e, 0xcb, 0x1a, 0x17, 0xd3, 0x96, 0x62, 0x5a, 0x7b, 0x29, 0x56, 0xdd, 0x9c, 0x7d, 0x29,
+	0x08, 0x27, 0x5f, 0x0b, 0xc0, 0x62, 0x2f, 0xa4, 0xfb, 0x70, 0x63, 0x80, 0xb0, 0x37, 0xa0, 0xf9,
+	0x75, 0x15, 0x14, 0xd7, 0xad, 0x34, 0x4a, 0xb2, 0xe0, 0xa0, 0x4f, 0xf2, 0x22, 0xcf, 0x92, 0xec,
+	0x0f, 0xc4, 0xef, 0x67, 0x05, 0xb0, 0xff, 0x11, 0xc0, 0x3d, 0x9b, 0xf4, 0xe9, 0x3b, 0x27, 0x42,
+	0x1d, 0x5e, 0x93, 0x19, 0x91, 0x90, 0xc4, 0x8e, 0x2f, 0xe5, 0xe0, 0x2d, 0x8a, 0xa9, 0xbf, 0x34,
+	0xc7, 0x03, 0x49, 0x85, 0xdb, 0x2e, 0x8a, 0x7b, 0x11, 0x0e, 0x29, 0x26, 0x01, 0x33, 0xb9, 0x65,
+	0xad, 0x1e, 0x49, 0x1a, 0x14, 0x43, 0xdf, 0x09, 0x98, 0x87, 0xed, 0x72, 0x4e, 0xfb, 0xb5, 0x65,
+	0x5a, 0x52, 0x77, 0x55, 0x4c, 0x9c, 0x5b, 0x8c, 0x4b, 0x9d, 0xbc, 0x81, 0x0f, 0x6b, 0x4e, 0xd0,
+	0x43, 0xfe, 0x7f, 0xb6, 0x93, 0xca, 0x3f, 0x87, 0x99, 0x97, 0xc4, 0x1d, 0xf9, 0xe8, 0x15, 0x8a,
+	0x62, 0x4c, 0x6e, 0xee, 0x7c, 0x1e, 0xde, 0x7e, 0xcb, 0xaf, 0x99, 0x90, 0x68, 0x2d, 0xc3, 0x83,
+	0xcd, 0xd3, 0xb3, 0x02, 0x60, 0x42, 0xa7, 0x00, 0x66, 0x93, 0x12, 0x0e, 0x71, 0x4c, 0x49, 0x34,
+	0x36, 0x02, 0x1a, 0x8d, 0xaf, 0x4a, 0x06, 0xff, 0x56, 0xb2, 0x54, 0x86, 0x1b, 0x31, 0x75, 0xe8,
+	0x28, 0x66, 0x79, 0x76, 0xca, 0xf2, 0x4d, 0x2f, 0x6c, 0x46, 0x58, 0x29, 0xf9, 0xb7, 0xcf, 0xbd,
+	0xb6, 0x56, 0xfa, 0x01, 0x20, 0xbc, 0x7e, 0x28, 0x95, 0xe0, 0x9e, 0xd9, 0xac, 0xb4, 0x8e, 0xec,
+	0x76, 0xa5, 0xdd, 0xb1, 0x8f, 0x3a, 0x2d, 0xdb, 0x34, 0x6a, 0x8d, 0x67, 0x0d, 0xa3, 0x9e, 0x15,
+	0xe4, 0xcc, 0x64, 0xaa, 0x6e, 0x71, 0xb0, 0x85, 0x7d, 0x49, 0x83, 0xf7, 0x56, 0x59, 0xbb, 0x76,
+	0x68, 0xd4, 0x3b, 0x4d, 0xa3, 0x9e, 0x05, 0xf2, 0xee, 0x64, 0xaa, 0xde, 0xe1, 0xa4, 0xdd, 0x1b,
+	0xa0, 0xa4, 0x87, 0xee, 0xef, 0x7c, 0xad, 0xd2, 0xaa, 0x19, 0xcd, 0x84, 0x5f, 0x5b, 0xe5, 0xf9,
+	0x87, 0x26, 0x7c, 0x09, 0xee, 0xae, 0xf2, 0x15, 0xd3, 0x6c, 0x26, 0x3e, 0xd6, 0xe5, 0xbb, 0x93,
+	0xa9, 0x9a, 0xe1, 0x74, 0x25, 0x0c, 0x7d, 0xfc, 0x27, 0x6b, 0xbf, 0x68, 0x98, 0xa6, 0x51, 0xcf,
+	0x8a, 0xab, 0xac, 0x7d, 0x8c, 0xc3, 0x10, 0xb9, 0xb2, 0xf8, 0xe9, 0xb3, 0x22, 0x54, 0x5b, 0xb3,
+	0x4b, 0x45, 0xb8, 0xb8, 0x54, 0x84, 0xd9, 0x5c, 0x01, 0xe7, 0x73, 0x05, 0x7c, 0x9b, 0x2b, 0xe0,
+	0x64, 0xa1, 0x08, 0xe7, 0x0b, 0x45, 0xb8, 0x58, 0x28, 0xc2, 0xeb, 0x47, 0x1e, 0xa6, 0x83, 0x51,
+	0x57, 0xeb, 0x91, 0xa1, 0x9e, 0x0e, 0x38, 0x5f, 0x1e, 0xc7, 0xee, 0xb1, 0xfe, 0xfe, 0x6a, 0xda,
+	0xe9, 0x38, 0x44, 0x71, 0x77, 0x83, 0xcd, 0xda, 0x93, 0x9f, 0x03, 0x00, 0x79, 0x6e, 0xd0, 0xcf,
+	0x0c, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -280,6 +419,63 @@ func (this *CancelSoftwareUpgradeProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ModuleVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleVersion)
+	if !ok {
+		that2, ok := that.(ModuleVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *PlanHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PlanHistoryEntry)
+	if !ok {
+		that2, ok := that.(PlanHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Plan.Equal(&that1.Plan) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,6 +610,84 @@ func (m *CancelSoftwareUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ModuleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -483,6 +757,39 @@ func (m *CancelSoftwareUpgradeProposal) Size() (n int) {
 	return n
 }
 
+func (m *ModuleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovUpgrade(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *PlanHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovUpgrade(uint64(m.Status))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -925,6 +1232,234 @@ func (m *CancelSoftwareUpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PlanStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0